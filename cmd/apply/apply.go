@@ -0,0 +1,95 @@
+// Package apply is the apply command for the marksman service
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aide-family/marksman/cmd"
+	"github.com/aide-family/marksman/cmd/client"
+	"github.com/aide-family/marksman/internal/biz/manifest"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const cmdApplyLong = `Apply the declarative manifests of a directory to a namespace.
+
+Every YAML document is one resource, with apiVersion marksman/v1, a kind of
+Level, Datasource, StrategyGroup or Strategy, metadata.name and a spec. The
+resources are matched by name within the namespace of the request, the plan of
+creates, updates and deletes is printed and applied in one transaction.
+
+Resources created or updated by apply are marked as managed, a managed resource
+that is no longer declared is deleted. Use --prune to delete the undeclared
+resources that are not managed as well, and --dry-run to only print the plan.
+
+Example:
+
+  apiVersion: marksman/v1
+  kind: Strategy
+  metadata:
+    name: high-cpu
+  spec:
+    strategyGroup: node
+    metric:
+      expr: avg by (instance) (rate(node_cpu_seconds_total{mode!="idle"}[5m])) * 100
+      summary: '{{ $labels.instance }} cpu is high'
+      datasources: [prometheus]
+      levels:
+        - level: critical
+          condition: CONDITION_METRIC_GT
+          values: [90]
+          duration: 5m`
+
+func NewCmd() *cobra.Command {
+	applyCmd := &cobra.Command{
+		Use:   "apply -f dir",
+		Short: "Apply declarative manifests to a marksman server",
+		Long:  cmdApplyLong,
+		Args:  cobra.NoArgs,
+		Annotations: map[string]string{
+			"group": cmd.BasicCommands,
+		},
+		RunE: runApply,
+	}
+	flags.addFlags(applyCmd)
+	return applyCmd
+}
+
+func runApply(c *cobra.Command, _ []string) error {
+	files, err := manifest.ReadDir(flags.dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no manifests found in %s", flags.dir)
+	}
+	req := &apiv1.ApplyManifestsRequest{
+		Files:  make([]*apiv1.ManifestFile, 0, len(files)),
+		DryRun: flags.dryRun,
+		Prune:  flags.prune,
+	}
+	for _, file := range files {
+		content := file.Content
+		if flags.expandEnv {
+			if content, err = manifest.ExpandEnv(content, os.LookupEnv); err != nil {
+				return fmt.Errorf("%s: %v", file.Name, err)
+			}
+		}
+		req.Files = append(req.Files, &apiv1.ManifestFile{Name: file.Name, Content: string(content)})
+	}
+	ctx := context.Background()
+	httpClient, err := flags.NewHTTPClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer httpClient.Close()
+	reply, err := apiv1.NewManifestHTTPClient(httpClient).ApplyManifests(ctx, req)
+	if err != nil {
+		return err
+	}
+	client.PrintChanges(c.OutOrStdout(), reply.GetDryRun(), reply.GetChanges())
+	return nil
+}
@@ -0,0 +1,27 @@
+package apply
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/aide-family/marksman/cmd/client"
+)
+
+type Flags struct {
+	client.Flags
+
+	dir       string
+	dryRun    bool
+	prune     bool
+	expandEnv bool
+}
+
+var flags Flags
+
+func (f *Flags) addFlags(c *cobra.Command) {
+	f.AddFlags(c)
+	c.Flags().StringVarP(&f.dir, "filename", "f", "", `Directory of the manifests, its *.yaml and *.yml files are read recursively`)
+	c.Flags().BoolVar(&f.dryRun, "dry-run", false, `Print the plan without applying it`)
+	c.Flags().BoolVar(&f.prune, "prune", false, `Also delete the resources that were not created by apply and are not declared`)
+	c.Flags().BoolVar(&f.expandEnv, "env", false, `Replace ${NAME} in the manifests with environment variables, e.g. for datasource secrets`)
+	_ = c.MarkFlagRequired("filename")
+}
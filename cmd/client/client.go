@@ -0,0 +1,99 @@
+// Package client holds what the commands talking to a marksman server share.
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/spf13/cobra"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+type Flags struct {
+	endpoint string
+	token    string
+	headers  []string
+}
+
+func (f *Flags) AddFlags(c *cobra.Command) {
+	c.PersistentFlags().StringVar(&f.endpoint, "endpoint", "http://127.0.0.1:18080", `HTTP address of the marksman server`)
+	c.PersistentFlags().StringVar(&f.token, "token", "", `Bearer token sent in the Authorization header`)
+	c.PersistentFlags().StringArrayVarP(&f.headers, "header", "H", []string{}, `Extra request header, e.g. the namespace. Example: -H "Key: Value"`)
+}
+
+// NewHTTPClient connects to the server, every request carries the token and headers of the flags.
+func (f *Flags) NewHTTPClient(ctx context.Context) (*khttp.Client, error) {
+	headers := make(map[string]string, len(f.headers)+1)
+	for _, header := range f.headers {
+		key, value, ok := strings.Cut(header, ":")
+		if !ok {
+			return nil, fmt.Errorf(`invalid header %q, expected "Key: Value"`, header)
+		}
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if f.token != "" {
+		headers["Authorization"] = "Bearer " + f.token
+	}
+	return khttp.NewClient(ctx,
+		khttp.WithEndpoint(f.endpoint),
+		khttp.WithMiddleware(withHeaders(headers)),
+	)
+}
+
+func withHeaders(headers map[string]string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				for key, value := range headers {
+					tr.RequestHeader().Set(key, value)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+var changeSymbols = map[apiv1.ChangeAction]string{
+	apiv1.ChangeAction_CHANGE_CREATE:    "+",
+	apiv1.ChangeAction_CHANGE_UPDATE:    "~",
+	apiv1.ChangeAction_CHANGE_DELETE:    "-",
+	apiv1.ChangeAction_CHANGE_UNCHANGED: "=",
+	apiv1.ChangeAction_CHANGE_SKIP:      "!",
+}
+
+// PrintChanges prints a change per line followed by its diff, and the counts by action.
+func PrintChanges(w io.Writer, dryRun bool, changes []*apiv1.ResourceChange) {
+	counts := make(map[apiv1.ChangeAction]int, len(changeSymbols))
+	for _, change := range changes {
+		counts[change.GetAction()]++
+		name := change.GetName()
+		if change.GetParent() != "" {
+			name = change.GetParent() + "/" + name
+		}
+		fmt.Fprintf(w, "%s %s %s", changeSymbols[change.GetAction()], change.GetKind(), name)
+		if change.GetReason() != "" {
+			fmt.Fprintf(w, ": %s", change.GetReason())
+		}
+		fmt.Fprintln(w)
+		for _, line := range change.GetDiff() {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+	fmt.Fprintf(w, "\n%d to create, %d to update, %d to delete, %d unchanged, %d skipped",
+		counts[apiv1.ChangeAction_CHANGE_CREATE],
+		counts[apiv1.ChangeAction_CHANGE_UPDATE],
+		counts[apiv1.ChangeAction_CHANGE_DELETE],
+		counts[apiv1.ChangeAction_CHANGE_UNCHANGED],
+		counts[apiv1.ChangeAction_CHANGE_SKIP],
+	)
+	if dryRun {
+		fmt.Fprint(w, " (dry run, nothing applied)")
+	}
+	fmt.Fprintln(w)
+}
@@ -9,12 +9,12 @@ import (
 	"github.com/aide-family/marksman/cmd/run"
 )
 
-const cmdAllLong = `Start the marksman service with all services (HTTP, gRPC)`
+const cmdAllLong = `Start the marksman service with all services (HTTP, gRPC, Job)`
 
 func NewCmd() *cobra.Command {
 	runCmd := &cobra.Command{
 		Use:   "all",
-		Short: "Start the marksman service with all services (HTTP, gRPC) and bind Swagger and Metrics",
+		Short: "Start the marksman service with all services (HTTP, gRPC, Job) and bind Swagger and Metrics",
 		Long:  cmdAllLong,
 		Annotations: map[string]string{
 			"group": cmd.ServiceCommands,
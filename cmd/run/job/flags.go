@@ -0,0 +1,42 @@
+package job
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/cmd/run"
+)
+
+type Flags struct {
+	*run.RunFlags
+
+	jobTimeout     time.Duration
+	jobCoreTimeout time.Duration
+}
+
+var flags Flags
+
+func (f *Flags) addFlags(c *cobra.Command) {
+	f.RunFlags = run.GetRunFlags()
+	c.Flags().StringVar(&f.Server.Job.Address, "job-address", f.Server.Job.Address, `Example: --job-address="0.0.0.0:17070", --job-address=":17070"`)
+	c.Flags().StringVar(&f.Server.Job.Network, "job-network", f.Server.Job.Network, `Example: --job-network="tcp"`)
+	c.Flags().DurationVar(&f.jobTimeout, "job-timeout", f.Server.Job.Timeout.AsDuration(), `Example: --job-timeout="10s", --job-timeout="1m"`)
+	c.Flags().Int32Var(&f.JobCore.WorkerTotal, "job-worker-total", f.JobCore.WorkerTotal, `Example: --job-worker-total=10`)
+	c.Flags().Uint32Var(&f.JobCore.BufferSize, "job-buffer-size", f.JobCore.BufferSize, `Example: --job-buffer-size=1000`)
+	c.Flags().DurationVar(&f.jobCoreTimeout, "job-core-timeout", f.JobCore.Timeout.AsDuration(), `Example: --job-core-timeout="10s", --job-core-timeout="1m"`)
+}
+
+func (f *Flags) applyToBootstrap() error {
+	if err := f.ApplyToBootstrap(); err != nil {
+		return err
+	}
+	if f.jobTimeout > 0 {
+		f.Server.Job.Timeout = durationpb.New(f.jobTimeout)
+	}
+	if f.jobCoreTimeout > 0 {
+		f.JobCore.Timeout = durationpb.New(f.jobCoreTimeout)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Package job is the job command for the marksman service
+package job
+
+import (
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+
+	"github.com/aide-family/marksman/cmd"
+	"github.com/aide-family/marksman/cmd/run"
+)
+
+const cmdJobLong = `Start the marksman job service only, it evaluates strategies and sends notifications`
+
+func NewCmd() *cobra.Command {
+	runCmd := &cobra.Command{
+		Use:   "job",
+		Short: "Start the marksman job service only",
+		Long:  cmdJobLong,
+		Annotations: map[string]string{
+			"group": cmd.ServiceCommands,
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			if err := flags.applyToBootstrap(); err != nil {
+				klog.Errorw("msg", "apply to bootstrap failed", "error", err)
+				return
+			}
+			run.NewEngine(run.NewEndpoint(WireApp)).Start()
+		},
+	}
+
+	flags.addFlags(runCmd)
+	return runCmd
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-kratos/kratos/v2/config/env"
 	klog "github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/spf13/cobra"
 
@@ -167,8 +168,11 @@ func NewApp(serviceName string, d *data.Data, srvs server.Servers, bc *conf.Boot
 			kratos.Server(srv.Instance()),
 		}
 
-		if registry := d.Registry(); registry != nil {
-			opts = append(opts, kratos.Registrar(registry))
+		// only servers that expose endpoints are registered
+		if _, ok := srv.Instance().(transport.Endpointer); ok {
+			if registry := d.Registry(); registry != nil {
+				opts = append(opts, kratos.Registrar(registry))
+			}
 		}
 
 		if srvName := srv.Name(); srvName == "http" {
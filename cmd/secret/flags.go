@@ -0,0 +1,45 @@
+package secret
+
+import (
+	"strings"
+
+	"github.com/aide-family/magicbox/dir"
+	"github.com/aide-family/magicbox/strutil"
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/spf13/cobra"
+
+	"github.com/aide-family/marksman/internal/conf"
+)
+
+type Flags struct {
+	*conf.Bootstrap
+
+	configPaths []string
+}
+
+var flags Flags
+
+func (f *Flags) addFlags(c *cobra.Command, bc *conf.Bootstrap) {
+	f.Bootstrap = bc
+	c.PersistentFlags().StringSliceVarP(&f.configPaths, "config", "c", []string{}, `Example: -c=./config1/ -c=./config2/`)
+}
+
+func (f *Flags) applyToBootstrap() error {
+	if len(f.configPaths) == 0 {
+		return nil
+	}
+	sourceOpts := []kconfig.Source{env.NewSource()}
+	for _, configPath := range f.configPaths {
+		if strutil.IsNotEmpty(configPath) {
+			sourceOpts = append(sourceOpts, file.NewSource(dir.ExpandHomeDir(strings.TrimSpace(configPath))))
+		}
+	}
+	var bc conf.Bootstrap
+	if err := conf.Load(&bc, sourceOpts...); err != nil {
+		return err
+	}
+	f.Bootstrap = &bc
+	return nil
+}
@@ -0,0 +1,89 @@
+// Package secret is the secret command for the marksman service
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/config/env"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+
+	"github.com/aide-family/marksman/cmd"
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/conf"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl"
+	"github.com/aide-family/marksman/internal/data/secret"
+)
+
+const cmdSecretLong = `Manage the key that encrypts datasource secrets in the database.
+
+To rotate the key, set encryption.key to a new key, move the old key to
+encryption.previousKeys and run "marksman secret rotate". Once it finished,
+the old key can be removed from the config.`
+
+func NewCmd(defaultServerConfigBytes []byte) *cobra.Command {
+	secretCmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Manage the encryption key of datasource secrets",
+		Long:  cmdSecretLong,
+		Annotations: map[string]string{
+			"group": cmd.DatabaseCommands,
+		},
+	}
+	var bc conf.Bootstrap
+	if err := conf.Load(&bc, env.NewSource(), conf.NewBytesSource(defaultServerConfigBytes)); err != nil {
+		klog.Errorw("msg", "load config failed", "error", err)
+		panic(err)
+	}
+	flags.addFlags(secretCmd, &bc)
+	secretCmd.AddCommand(newGenerateKeyCmd(), newRotateCmd())
+	return secretCmd
+}
+
+func newGenerateKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "generate-key",
+		Short: "Print a new random encryption key",
+		RunE: func(c *cobra.Command, _ []string) error {
+			key, err := secret.GenerateKey()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(c.OutOrStdout(), key)
+			return nil
+		},
+	}
+}
+
+func newRotateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate",
+		Short: "Re-encrypt all datasource secrets with the current encryption key",
+		RunE: func(c *cobra.Command, _ []string) error {
+			if err := flags.applyToBootstrap(); err != nil {
+				return err
+			}
+			helper := klog.NewHelper(klog.GetLogger())
+			d, cleanup, err := data.New(flags.Bootstrap, helper)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+			if !d.Keyring().Enabled() {
+				return secret.ErrNoKey
+			}
+			datasourceRepo, err := impl.NewDatasourceRepository(d)
+			if err != nil {
+				return err
+			}
+			rotated, err := biz.NewDatasource(datasourceRepo, helper).RotateSecrets(context.Background())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(c.OutOrStdout(), "rotated secrets of %d datasources\n", rotated)
+			return nil
+		},
+	}
+}
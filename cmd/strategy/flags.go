@@ -0,0 +1,5 @@
+package strategy
+
+import "github.com/aide-family/marksman/cmd/client"
+
+var flags client.Flags
@@ -0,0 +1,189 @@
+// Package strategy is the strategy command for the marksman service
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/spf13/cobra"
+
+	"github.com/aide-family/marksman/cmd"
+	"github.com/aide-family/marksman/cmd/client"
+	_ "github.com/aide-family/marksman/internal/server" // registers the yaml codec
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const cmdImportLong = `Import Prometheus rule files into strategy groups and metric strategies.
+
+Every rule group becomes a strategy group and every alerting rule a metric
+strategy of the same name. Rules sharing a name become levels of one strategy,
+the level is picked by the severity label. Arguments are rule files or
+directories, the *.yml and *.yaml files of a directory are read.
+
+Use --dry-run to print the changes without applying them.`
+
+func NewCmd() *cobra.Command {
+	strategyCmd := &cobra.Command{
+		Use:   "strategy",
+		Short: "Manage the strategies of a marksman server",
+		Annotations: map[string]string{
+			"group": cmd.BasicCommands,
+		},
+	}
+	flags.AddFlags(strategyCmd)
+	strategyCmd.AddCommand(newImportCmd(), newExportCmd())
+	return strategyCmd
+}
+
+func newImportCmd() *cobra.Command {
+	var (
+		datasourceUIDs []int64
+		dryRun         bool
+		severityLabel  string
+		defaultLevel   string
+	)
+	importCmd := &cobra.Command{
+		Use:   "import [file|dir]...",
+		Short: "Import Prometheus rule files",
+		Long:  cmdImportLong,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			files, err := readRuleFiles(args)
+			if err != nil {
+				return err
+			}
+			ctx := context.Background()
+			httpClient, err := flags.NewHTTPClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer httpClient.Close()
+			reply, err := apiv1.NewStrategyHTTPClient(httpClient).ImportPrometheusRules(ctx, &apiv1.ImportPrometheusRulesRequest{
+				Files:          files,
+				DatasourceUIDs: datasourceUIDs,
+				DryRun:         dryRun,
+				SeverityLabel:  severityLabel,
+				DefaultLevel:   defaultLevel,
+			})
+			if err != nil {
+				return err
+			}
+			client.PrintChanges(c.OutOrStdout(), reply.GetDryRun(), reply.GetChanges())
+			return nil
+		},
+	}
+	importCmd.Flags().Int64SliceVar(&datasourceUIDs, "datasource", []int64{}, `UIDs of the datasources the strategies query. Example: --datasource=1,2`)
+	importCmd.Flags().BoolVar(&dryRun, "dry-run", false, `Print the changes without applying them`)
+	importCmd.Flags().StringVar(&severityLabel, "severity-label", "severity", `Rule label holding the level name`)
+	importCmd.Flags().StringVar(&defaultLevel, "default-level", "", `Level of the rules without the severity label`)
+	return importCmd
+}
+
+// readRuleFiles reads the files of paths, directories are expanded to their YAML files.
+func readRuleFiles(paths []string) ([]*apiv1.PrometheusRuleFile, error) {
+	var names []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			names = append(names, path)
+			continue
+		}
+		for _, pattern := range []string{"*.yml", "*.yaml"} {
+			matches, err := filepath.Glob(filepath.Join(path, pattern))
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, matches...)
+		}
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+	files := make([]*apiv1.PrometheusRuleFile, 0, len(names))
+	for _, name := range names {
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &apiv1.PrometheusRuleFile{Name: name, Content: string(content)})
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no rule files found in %v", paths)
+	}
+	return files, nil
+}
+
+const cmdExportLong = `Export the enabled metric strategies as a Prometheus rule file or as a
+monitoring.coreos.com/v1 PrometheusRule manifest, the inverse of import.
+
+Every strategy group becomes a rule group and every level of a strategy an
+alerting rule named after the strategy, with the level name in the severity label.`
+
+var exportFormats = map[string]apiv1.ExportFormat{
+	"rule-file":       apiv1.ExportFormat_EXPORT_RULE_FILE,
+	"prometheus-rule": apiv1.ExportFormat_EXPORT_PROMETHEUS_RULE,
+}
+
+func newExportCmd() *cobra.Command {
+	var (
+		strategyGroupUID int64
+		format           string
+		name             string
+		namespace        string
+		labels           map[string]string
+		severityLabel    string
+		output           string
+	)
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export strategies as Prometheus rules",
+		Long:  cmdExportLong,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			exportFormat, ok := exportFormats[format]
+			if !ok {
+				return fmt.Errorf("unknown format %q, expected rule-file or prometheus-rule", format)
+			}
+			ctx := context.Background()
+			httpClient, err := flags.NewHTTPClient(ctx)
+			if err != nil {
+				return err
+			}
+			defer httpClient.Close()
+			reply, err := apiv1.NewStrategyHTTPClient(httpClient).ExportPrometheusRules(ctx, &apiv1.ExportPrometheusRulesRequest{
+				StrategyGroupUID: strategyGroupUID,
+				Format:           exportFormat,
+				Name:             name,
+				Namespace:        namespace,
+				Labels:           labels,
+				SeverityLabel:    severityLabel,
+			})
+			if err != nil {
+				return err
+			}
+			content, err := encoding.GetCodec("yaml").Marshal(reply)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				_, err = c.OutOrStdout().Write(content)
+				return err
+			}
+			return os.WriteFile(output, content, 0o644)
+		},
+	}
+	exportCmd.Flags().Int64Var(&strategyGroupUID, "group", 0, `UID of the strategy group to export, all groups of the namespace by default`)
+	exportCmd.Flags().StringVar(&format, "format", "rule-file", `Output format, rule-file or prometheus-rule`)
+	exportCmd.Flags().StringVar(&name, "name", "", `metadata.name of the PrometheusRule, "marksman" by default`)
+	exportCmd.Flags().StringVar(&namespace, "namespace", "", `metadata.namespace of the PrometheusRule`)
+	exportCmd.Flags().StringToStringVar(&labels, "label", map[string]string{}, `metadata.labels of the PrometheusRule. Example: --label=release=prometheus`)
+	exportCmd.Flags().StringVar(&severityLabel, "severity-label", "severity", `Rule label holding the level name`)
+	exportCmd.Flags().StringVarP(&output, "output", "o", "", `File to write, stdout by default`)
+	return exportCmd
+}
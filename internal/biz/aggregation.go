@@ -0,0 +1,69 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewAggregation(
+	aggregationRepo repository.Aggregation,
+	strategyGroupRepo repository.StrategyGroup,
+	helper *klog.Helper,
+) *AggregationBiz {
+	return &AggregationBiz{
+		aggregationRepo:   aggregationRepo,
+		strategyGroupRepo: strategyGroupRepo,
+		helper:            klog.NewHelper(klog.With(helper.Logger(), "biz", "aggregation")),
+	}
+}
+
+type AggregationBiz struct {
+	helper            *klog.Helper
+	aggregationRepo   repository.Aggregation
+	strategyGroupRepo repository.StrategyGroup
+}
+
+func (a *AggregationBiz) SaveAggregation(ctx context.Context, req *bo.SaveAggregationBo) error {
+	if _, err := a.strategyGroupRepo.GetStrategyGroup(ctx, req.StrategyGroupUID); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorParams("strategy group %d not found", req.StrategyGroupUID.Int64())
+		}
+		a.helper.Errorw("msg", "get strategy group failed", "error", err, "strategyGroupUID", req.StrategyGroupUID)
+		return merr.ErrorInternalServer("save aggregation failed").WithCause(err)
+	}
+	if err := a.aggregationRepo.SaveAggregation(ctx, req); err != nil {
+		a.helper.Errorw("msg", "save aggregation failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("save aggregation failed").WithCause(err)
+	}
+	return nil
+}
+
+func (a *AggregationBiz) GetAggregation(ctx context.Context, strategyGroupUID snowflake.ID) (*bo.AggregationItemBo, error) {
+	item, err := a.aggregationRepo.GetAggregation(ctx, strategyGroupUID)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("aggregation of strategy group %d not found", strategyGroupUID.Int64())
+		}
+		a.helper.Errorw("msg", "get aggregation failed", "error", err, "strategyGroupUID", strategyGroupUID)
+		return nil, merr.ErrorInternalServer("get aggregation failed").WithCause(err)
+	}
+	return item, nil
+}
+
+// DeleteAggregation stops grouping the events of the strategy group, the pending groups are still sent.
+func (a *AggregationBiz) DeleteAggregation(ctx context.Context, strategyGroupUID snowflake.ID) error {
+	if err := a.aggregationRepo.DeleteAggregation(ctx, strategyGroupUID); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("aggregation of strategy group %d not found", strategyGroupUID.Int64())
+		}
+		a.helper.Errorw("msg", "delete aggregation failed", "error", err, "strategyGroupUID", strategyGroupUID)
+		return merr.ErrorInternalServer("delete aggregation failed").WithCause(err)
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+// Package aggregator batches the notifications of the alerts sharing their group labels,
+// the same as group_by, group_wait, group_interval and repeat_interval of Alertmanager:
+// a new group waits GroupWait for more alerts before its first batch, later changes are
+// batched every GroupInterval, and a group that keeps firing is sent again every RepeatInterval.
+package aggregator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+// GroupByAll groups the alerts by all of their labels, every series gets its own group.
+const GroupByAll = "..."
+
+const (
+	DefaultGroupWait      = 30 * time.Second
+	DefaultGroupInterval  = 5 * time.Minute
+	DefaultRepeatInterval = 4 * time.Hour
+
+	defaultFlushLimit = 100
+)
+
+// Config is the grouping of the alerts of a route.
+type Config struct {
+	// GroupBy are the label names the alerts are grouped by, no names put every alert of
+	// the route in one group.
+	GroupBy        []string
+	GroupWait      time.Duration
+	GroupInterval  time.Duration
+	RepeatInterval time.Duration
+}
+
+// Route is where the alerts being grouped go.
+type Route struct {
+	NamespaceUID snowflake.ID
+	ReceiverUID  snowflake.ID
+	// Scope keeps apart the groups of routes to the same receiver, e.g. the strategy group uid.
+	Scope string
+	Config
+}
+
+// Group is a pending group, persisted by the Store.
+type Group struct {
+	Key            string
+	NamespaceUID   snowflake.ID
+	ReceiverUID    snowflake.ID
+	Labels         map[string]string
+	GroupInterval  time.Duration
+	RepeatInterval time.Duration
+	NextFlushAt    time.Time
+	// LastSentAt is zero until the first batch of the group is sent.
+	LastSentAt time.Time
+	Alerts     []*Alert
+}
+
+// Alert is the last message of an event in a group.
+type Alert struct {
+	Message *notifier.Message
+	// Notified reports whether the message was sent in a batch.
+	Notified bool
+}
+
+// Store persists the pending groups, so that a restart neither drops nor repeats them.
+type Store interface {
+	// AddAlerts creates the group unless it exists, scheduling its first flush at group.NextFlushAt,
+	// and saves the messages as not notified, replacing the earlier messages of the same events.
+	AddAlerts(ctx context.Context, group *Group, msgs []*notifier.Message) error
+	// ListDueGroups returns at most limit groups to flush at now, with their alerts.
+	ListDueGroups(ctx context.Context, now time.Time, limit int) ([]*Group, error)
+	// CompleteFlush marks the sent messages notified unless their event changed in the meantime,
+	// deletes the notified resolved alerts and schedules the group at group.NextFlushAt with
+	// group.LastSentAt. The group is deleted when no alert is left. In a job guarded by the
+	// leader election it fails once another node took the lease over.
+	CompleteFlush(ctx context.Context, group *Group, sent []*notifier.Message) error
+}
+
+// Batch is the JSON body sent for one group.
+type Batch struct {
+	Version      string              `json:"version"`
+	GroupKey     string              `json:"groupKey"`
+	Status       string              `json:"status"`
+	NamespaceUID snowflake.ID        `json:"namespaceUID"`
+	ReceiverUID  snowflake.ID        `json:"receiverUID"`
+	GroupLabels  map[string]string   `json:"groupLabels"`
+	CommonLabels map[string]string   `json:"commonLabels"`
+	Alerts       []*notifier.Message `json:"alerts"`
+}
+
+// Sink sends a batch. statusCode is 0 when no response was received.
+type Sink interface {
+	Send(ctx context.Context, batch *Batch) (statusCode int, err error)
+}
+
+// Recorder keeps the audit trail of the attempts to send batches.
+type Recorder interface {
+	RecordBatchAttempt(ctx context.Context, batch *Batch, attempt *notifier.Attempt)
+}
+
+type Option func(*Aggregator)
+
+// WithRecorder records every attempt to send a batch.
+func WithRecorder(recorder Recorder) Option {
+	return func(a *Aggregator) {
+		a.recorder = recorder
+	}
+}
+
+// WithEngine sends the batches on the workers of engine, one attempt per job with the retries
+// waiting off the workers, Flush sends them itself otherwise.
+func WithEngine(engine *job.Engine) Option {
+	return func(a *Aggregator) {
+		a.engine = engine
+	}
+}
+
+func WithBackoff(backoff notifier.Backoff) Option {
+	return func(a *Aggregator) {
+		a.backoff = backoff
+	}
+}
+
+// WithFlushLimit bounds the groups flushed by one Flush.
+func WithFlushLimit(limit int) Option {
+	return func(a *Aggregator) {
+		a.flushLimit = limit
+	}
+}
+
+// WithClock sets the clock the timers of the groups run on.
+func WithClock(now func() time.Time) Option {
+	return func(a *Aggregator) {
+		a.now = now
+	}
+}
+
+// NewAggregator returns an Aggregator keeping its groups in store and sending their batches to sink.
+func NewAggregator(store Store, sink Sink, helper *klog.Helper, opts ...Option) *Aggregator {
+	a := &Aggregator{
+		store:      store,
+		sink:       sink,
+		helper:     helper,
+		backoff:    notifier.DefaultBackoff,
+		flushLimit: defaultFlushLimit,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.engine != nil {
+		a.retrier = notifier.NewRetrier(a.engine, a.backoff, notifier.DefaultAttemptTimeout)
+	}
+	return a
+}
+
+// Aggregator groups messages and emits one batch per group. Add may be called on every node,
+// Flush must only run on one node at a time, e.g. as a job guarded by the leader election.
+type Aggregator struct {
+	store      Store
+	sink       Sink
+	recorder   Recorder
+	helper     *klog.Helper
+	engine     *job.Engine
+	retrier    *notifier.Retrier
+	backoff    notifier.Backoff
+	flushLimit int
+	now        func() time.Time
+}
+
+// Add puts the messages in the groups of route, a new group is flushed after route.GroupWait.
+func (a *Aggregator) Add(ctx context.Context, route *Route, msgs ...*notifier.Message) error {
+	now := a.now()
+	groups := make(map[string]*Group)
+	pending := make(map[string][]*notifier.Message)
+	for _, msg := range msgs {
+		labels := groupLabels(route.GroupBy, msg.Labels)
+		key := GroupKey(route, labels)
+		if _, ok := groups[key]; !ok {
+			groups[key] = &Group{
+				Key:            key,
+				NamespaceUID:   route.NamespaceUID,
+				ReceiverUID:    route.ReceiverUID,
+				Labels:         labels,
+				GroupInterval:  route.GroupInterval,
+				RepeatInterval: route.RepeatInterval,
+				NextFlushAt:    now.Add(route.GroupWait),
+			}
+		}
+		pending[key] = append(pending[key], msg)
+	}
+	for _, key := range slices.Sorted(maps.Keys(groups)) {
+		if err := a.store.AddAlerts(ctx, groups[key], pending[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush sends the batches of the groups that are due, it returns the number of groups flushed.
+// A group is saved as sent before its batch is emitted, so that a crash never sends a batch twice.
+func (a *Aggregator) Flush(ctx context.Context) (int, error) {
+	now := a.now()
+	groups, err := a.store.ListDueGroups(ctx, now, a.flushLimit)
+	if err != nil {
+		return 0, err
+	}
+	for i, group := range groups {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+		batch := group.batch(now)
+		var sent []*notifier.Message
+		if batch != nil {
+			sent = batch.Alerts
+			group.LastSentAt = now
+		}
+		group.NextFlushAt = now.Add(group.GroupInterval)
+		if err := a.store.CompleteFlush(ctx, group, sent); err != nil {
+			return i, err
+		}
+		if batch != nil {
+			a.emit(ctx, batch)
+		}
+	}
+	return len(groups), nil
+}
+
+// Stop drops the batches waiting for a retry, the engine waits for the running attempts.
+func (a *Aggregator) Stop() {
+	if a.retrier != nil {
+		a.retrier.Stop()
+	}
+}
+
+func (a *Aggregator) emit(ctx context.Context, batch *Batch) {
+	if a.retrier == nil {
+		a.send(ctx, batch)
+		return
+	}
+	// the attempt interrupted by Stop is still recorded
+	recordCtx := context.WithoutCancel(ctx)
+	err := a.retrier.Retry("notify-batch", func(ctx context.Context) (int, error) {
+		return a.sink.Send(ctx, batch)
+	}, func(attempt *notifier.Attempt) {
+		a.record(recordCtx, batch, attempt)
+	}, func(err error) {
+		a.warn(batch, err)
+	})
+	if err != nil {
+		a.helper.Warnw("msg", "notify queue is full, drop batch", "groupKey", batch.GroupKey, "receiverUID", batch.ReceiverUID, "error", err)
+	}
+}
+
+func (a *Aggregator) send(ctx context.Context, batch *Batch) {
+	// the attempt interrupted by a stop is still recorded
+	recordCtx := context.WithoutCancel(ctx)
+	err := notifier.Retry(ctx, func(ctx context.Context) (int, error) {
+		return a.sink.Send(ctx, batch)
+	}, a.backoff, func(attempt *notifier.Attempt) {
+		a.record(recordCtx, batch, attempt)
+	})
+	a.warn(batch, err)
+}
+
+func (a *Aggregator) record(ctx context.Context, batch *Batch, attempt *notifier.Attempt) {
+	if a.recorder != nil {
+		a.recorder.RecordBatchAttempt(ctx, batch, attempt)
+	}
+}
+
+func (a *Aggregator) warn(batch *Batch, err error) {
+	if err != nil {
+		a.helper.Warnw("msg", "send batch failed", "groupKey", batch.GroupKey, "receiverUID", batch.ReceiverUID, "error", err)
+	}
+}
+
+// batch returns the batch to send at now, nil when nothing changed since the last batch
+// and the repeat interval has not elapsed. The batch holds the firing alerts and the resolved
+// alerts not notified yet.
+func (g *Group) batch(now time.Time) *Batch {
+	changed, firing := false, false
+	alerts := make([]*notifier.Message, 0, len(g.Alerts))
+	for _, alert := range g.Alerts {
+		changed = changed || !alert.Notified
+		if alert.Message.Status == notifier.StatusFiring {
+			firing = true
+			alerts = append(alerts, alert.Message)
+		} else if !alert.Notified {
+			alerts = append(alerts, alert.Message)
+		}
+	}
+	repeat := firing && !g.LastSentAt.IsZero() && now.Sub(g.LastSentAt) >= g.RepeatInterval
+	if len(alerts) == 0 || (!changed && !repeat) {
+		return nil
+	}
+	status := notifier.StatusResolved
+	if firing {
+		status = notifier.StatusFiring
+	}
+	return &Batch{
+		Version:      notifier.MessageVersion,
+		GroupKey:     g.Key,
+		Status:       status,
+		NamespaceUID: g.NamespaceUID,
+		ReceiverUID:  g.ReceiverUID,
+		GroupLabels:  g.Labels,
+		CommonLabels: commonLabels(alerts),
+		Alerts:       alerts,
+	}
+}
+
+// GroupKey identifies the group of route with the group labels.
+func GroupKey(route *Route, labels map[string]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d/%d/%s/", route.NamespaceUID.Int64(), route.ReceiverUID.Int64(), route.Scope)
+	for _, name := range slices.Sorted(maps.Keys(labels)) {
+		fmt.Fprintf(&b, "%s=%q,", name, labels[name])
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// groupLabels returns the labels of the alert named by groupBy, the names missing on the alert are left out.
+func groupLabels(groupBy []string, labels map[string]string) map[string]string {
+	if slices.Contains(groupBy, GroupByAll) {
+		return maps.Clone(labels)
+	}
+	group := make(map[string]string, len(groupBy))
+	for _, name := range groupBy {
+		if value, ok := labels[name]; ok {
+			group[name] = value
+		}
+	}
+	return group
+}
+
+// commonLabels returns the labels all the alerts have with the same value.
+func commonLabels(alerts []*notifier.Message) map[string]string {
+	if len(alerts) == 0 {
+		return map[string]string{}
+	}
+	common := maps.Clone(alerts[0].Labels)
+	if common == nil {
+		common = map[string]string{}
+	}
+	for _, alert := range alerts[1:] {
+		for name, value := range common {
+			if v, ok := alert.Labels[name]; !ok || v != value {
+				delete(common, name)
+			}
+		}
+	}
+	return common
+}
@@ -0,0 +1,342 @@
+package aggregator_test
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+// memoryStore keeps the groups the way the database repository does.
+type memoryStore struct {
+	mu     sync.Mutex
+	groups map[string]*aggregator.Group
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{groups: make(map[string]*aggregator.Group)}
+}
+
+func (s *memoryStore) AddAlerts(_ context.Context, group *aggregator.Group, msgs []*notifier.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.groups[group.Key]
+	if !ok {
+		stored = &aggregator.Group{
+			Key:          group.Key,
+			NamespaceUID: group.NamespaceUID,
+			ReceiverUID:  group.ReceiverUID,
+			Labels:       group.Labels,
+			NextFlushAt:  group.NextFlushAt,
+		}
+		s.groups[group.Key] = stored
+	}
+	stored.GroupInterval, stored.RepeatInterval = group.GroupInterval, group.RepeatInterval
+	for _, msg := range msgs {
+		i := slices.IndexFunc(stored.Alerts, func(a *aggregator.Alert) bool { return a.Message.EventUID == msg.EventUID })
+		if i < 0 {
+			stored.Alerts = append(stored.Alerts, &aggregator.Alert{Message: msg})
+			continue
+		}
+		stored.Alerts[i] = &aggregator.Alert{Message: msg}
+	}
+	return nil
+}
+
+func (s *memoryStore) ListDueGroups(_ context.Context, now time.Time, limit int) ([]*aggregator.Group, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*aggregator.Group
+	for _, key := range slices.Sorted(maps.Keys(s.groups)) {
+		group := s.groups[key]
+		if group.NextFlushAt.After(now) || len(list) >= limit {
+			continue
+		}
+		clone := *group
+		clone.Alerts = make([]*aggregator.Alert, 0, len(group.Alerts))
+		for _, alert := range group.Alerts {
+			a := *alert
+			clone.Alerts = append(clone.Alerts, &a)
+		}
+		list = append(list, &clone)
+	}
+	return list, nil
+}
+
+func (s *memoryStore) CompleteFlush(_ context.Context, group *aggregator.Group, sent []*notifier.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.groups[group.Key]
+	if !ok {
+		return nil
+	}
+	for _, msg := range sent {
+		for _, alert := range stored.Alerts {
+			if alert.Message.EventUID == msg.EventUID && alert.Message.Status == msg.Status {
+				alert.Notified = true
+			}
+		}
+	}
+	stored.Alerts = slices.DeleteFunc(stored.Alerts, func(a *aggregator.Alert) bool {
+		return a.Notified && a.Message.Status == notifier.StatusResolved
+	})
+	if len(stored.Alerts) == 0 {
+		delete(s.groups, group.Key)
+		return nil
+	}
+	stored.NextFlushAt, stored.LastSentAt = group.NextFlushAt, group.LastSentAt
+	return nil
+}
+
+func (s *memoryStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.groups)
+}
+
+// fakeWebhook records the batches it receives.
+type fakeWebhook struct {
+	mu      sync.Mutex
+	batches []*aggregator.Batch
+}
+
+func (f *fakeWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var batch aggregator.Batch
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.batches = append(f.batches, &batch)
+}
+
+// take returns the batches received since the last call, ordered by their group labels.
+func (f *fakeWebhook) take() []*aggregator.Batch {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	batches := f.batches
+	f.batches = nil
+	sort.Slice(batches, func(i, j int) bool {
+		return batches[i].GroupLabels["cluster"] < batches[j].GroupLabels["cluster"]
+	})
+	return batches
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+var route = &aggregator.Route{
+	NamespaceUID: 1,
+	ReceiverUID:  2,
+	Scope:        "3",
+	Config: aggregator.Config{
+		GroupBy:        []string{"cluster"},
+		GroupWait:      30 * time.Second,
+		GroupInterval:  5 * time.Minute,
+		RepeatInterval: time.Hour,
+	},
+}
+
+func message(eventUID int64, status, cluster, instance string) *notifier.Message {
+	return &notifier.Message{
+		Version:  notifier.MessageVersion,
+		Status:   status,
+		EventUID: snowflake.ParseInt64(eventUID),
+		Labels:   map[string]string{"alertname": "HighCPU", "cluster": cluster, "instance": instance},
+		StartsAt: time.Unix(1700000000, 0),
+	}
+}
+
+func newAggregator(t *testing.T, store aggregator.Store, clock *fakeClock) (*aggregator.Aggregator, *fakeWebhook) {
+	t.Helper()
+	hook := &fakeWebhook{}
+	srv := httptest.NewServer(hook)
+	t.Cleanup(srv.Close)
+	a := aggregator.NewAggregator(store, aggregator.NewWebhookSink(srv.URL, nil), klog.NewHelper(klog.DefaultLogger),
+		aggregator.WithClock(clock.Now),
+		aggregator.WithBackoff(notifier.Backoff{MaxAttempts: 1}),
+	)
+	return a, hook
+}
+
+func flush(t *testing.T, a *aggregator.Aggregator) {
+	t.Helper()
+	if _, err := a.Flush(context.Background()); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+}
+
+func eventUIDs(batch *aggregator.Batch) []int64 {
+	uids := make([]int64, 0, len(batch.Alerts))
+	for _, alert := range batch.Alerts {
+		uids = append(uids, alert.EventUID.Int64())
+	}
+	slices.Sort(uids)
+	return uids
+}
+
+func TestAggregatorGroupTimers(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	store := newMemoryStore()
+	a, hook := newAggregator(t, store, clock)
+
+	err := a.Add(ctx, route,
+		message(1, notifier.StatusFiring, "a", "a1"),
+		message(2, notifier.StatusFiring, "a", "a2"),
+		message(3, notifier.StatusFiring, "b", "b1"),
+	)
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	clock.Advance(10 * time.Second)
+	flush(t, a)
+	if batches := hook.take(); len(batches) != 0 {
+		t.Fatalf("got %d batches before group_wait", len(batches))
+	}
+
+	// an alert joining within group_wait is sent with the first batch
+	if err := a.Add(ctx, route, message(4, notifier.StatusFiring, "b", "b2")); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	clock.Advance(20 * time.Second)
+	flush(t, a)
+	batches := hook.take()
+	if len(batches) != 2 {
+		t.Fatalf("got %d batches after group_wait, want 2", len(batches))
+	}
+	if got := eventUIDs(batches[0]); !slices.Equal(got, []int64{1, 2}) || batches[0].GroupLabels["cluster"] != "a" {
+		t.Fatalf("batch a: labels %v events %v", batches[0].GroupLabels, got)
+	}
+	if got := eventUIDs(batches[1]); !slices.Equal(got, []int64{3, 4}) {
+		t.Fatalf("batch b: events %v", got)
+	}
+	if batches[0].Status != notifier.StatusFiring || batches[0].CommonLabels["cluster"] != "a" || batches[0].CommonLabels["instance"] != "" {
+		t.Fatalf("batch a: status %s common labels %v", batches[0].Status, batches[0].CommonLabels)
+	}
+
+	// nothing changed, nothing is sent at the group interval
+	clock.Advance(5 * time.Minute)
+	flush(t, a)
+	if batches := hook.take(); len(batches) != 0 {
+		t.Fatalf("got %d batches without changes", len(batches))
+	}
+
+	// a resolved alert waits for the next group interval, then is sent with the firing one
+	if err := a.Add(ctx, route, message(2, notifier.StatusResolved, "a", "a2")); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	clock.Advance(time.Minute)
+	flush(t, a)
+	if batches := hook.take(); len(batches) != 0 {
+		t.Fatalf("got %d batches before the group interval", len(batches))
+	}
+	clock.Advance(4 * time.Minute)
+	flush(t, a)
+	batches = hook.take()
+	if len(batches) != 1 || !slices.Equal(eventUIDs(batches[0]), []int64{1, 2}) {
+		t.Fatalf("got batches %+v, want group a with events 1 and 2", batches)
+	}
+	for _, alert := range batches[0].Alerts {
+		if want := map[int64]string{1: notifier.StatusFiring, 2: notifier.StatusResolved}[alert.EventUID.Int64()]; alert.Status != want {
+			t.Fatalf("event %d status %s, want %s", alert.EventUID, alert.Status, want)
+		}
+	}
+
+	// the firing alerts are sent again once the repeat interval elapsed
+	clock.Advance(55 * time.Minute)
+	flush(t, a)
+	batches = hook.take()
+	if len(batches) != 1 || batches[0].GroupLabels["cluster"] != "b" {
+		t.Fatalf("got %d batches, want the repeat of group b", len(batches))
+	}
+	clock.Advance(5 * time.Minute)
+	flush(t, a)
+	batches = hook.take()
+	if len(batches) != 1 || !slices.Equal(eventUIDs(batches[0]), []int64{1}) {
+		t.Fatalf("got batches %+v, want the repeat of group a with event 1", batches)
+	}
+
+	// a group is deleted once its last alert is resolved and sent
+	if err := a.Add(ctx, route, message(1, notifier.StatusResolved, "a", "a1")); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	clock.Advance(5 * time.Minute)
+	flush(t, a)
+	batches = hook.take()
+	if len(batches) != 1 || batches[0].Status != notifier.StatusResolved {
+		t.Fatalf("got batches %+v, want the resolved group a", batches)
+	}
+	if n := store.count(); n != 1 {
+		t.Fatalf("store has %d groups, want 1", n)
+	}
+}
+
+func TestAggregatorSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	store := newMemoryStore()
+	a, hook := newAggregator(t, store, clock)
+	if err := a.Add(ctx, route, message(1, notifier.StatusFiring, "a", "a1")); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	// the pending group is flushed by the aggregator of the next process
+	restarted, restartedHook := newAggregator(t, store, clock)
+	clock.Advance(30 * time.Second)
+	flush(t, restarted)
+	if n := len(restartedHook.take()); n != 1 {
+		t.Fatalf("got %d batches after restart, want 1", n)
+	}
+
+	// the batch is not sent again by another restart
+	again, againHook := newAggregator(t, store, clock)
+	clock.Advance(5 * time.Minute)
+	flush(t, again)
+	flush(t, a)
+	if n := len(againHook.take()) + len(hook.take()); n != 0 {
+		t.Fatalf("got %d duplicate batches", n)
+	}
+}
+
+func TestGroupKey(t *testing.T) {
+	labels := map[string]string{"cluster": "a"}
+	if aggregator.GroupKey(route, labels) != aggregator.GroupKey(route, map[string]string{"cluster": "a"}) {
+		t.Fatal("group key is not stable")
+	}
+	other := *route
+	other.ReceiverUID = 9
+	if aggregator.GroupKey(route, labels) == aggregator.GroupKey(&other, labels) {
+		t.Fatal("groups of different receivers share a key")
+	}
+	if aggregator.GroupKey(route, labels) == aggregator.GroupKey(route, map[string]string{"cluster": "b"}) {
+		t.Fatal("groups with different labels share a key")
+	}
+}
@@ -0,0 +1,20 @@
+package aggregator
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+// NewWebhookSink returns a Sink posting the batch as JSON to url.
+func NewWebhookSink(url string, headers map[string]string, opts ...notifier.WebhookOption) *WebhookSink {
+	return &WebhookSink{webhook: notifier.NewWebhookSender(url, headers, opts...)}
+}
+
+type WebhookSink struct {
+	webhook *notifier.WebhookSender
+}
+
+func (w *WebhookSink) Send(ctx context.Context, batch *Batch) (int, error) {
+	return w.webhook.Post(ctx, batch)
+}
@@ -8,5 +8,18 @@ var ProviderSetBiz = wire.NewSet(
 	NewNamespace,
 	NewLevel,
 	NewDatasource,
+	NewStrategy,
+	NewStrategyMetric,
+	NewEvaluate,
+	NewLeaderElector,
+	NewEvent,
+	NewReceiver,
+	NewNotify,
+	NewSilence,
+	NewInhibitRule,
+	NewAggregation,
+	NewEscalationPolicy,
+	NewOnCallSchedule,
+	NewManifest,
 	NewLoginBiz,
 )
@@ -0,0 +1,96 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// minGroupInterval bounds how often the batches of a group are sent.
+const minGroupInterval = 10 * time.Second
+
+type SaveAggregationBo struct {
+	StrategyGroupUID snowflake.ID
+	GroupBy          []string
+	GroupWait        time.Duration
+	GroupInterval    time.Duration
+	RepeatInterval   time.Duration
+}
+
+// NewSaveAggregationBo validates the grouping of a strategy group, a missing timer takes
+// the default of Alertmanager.
+func NewSaveAggregationBo(req *apiv1.SaveAggregationRequest) (*SaveAggregationBo, error) {
+	for _, label := range req.GetGroupBy() {
+		if label != aggregator.GroupByAll && !labelNameRegexp.MatchString(label) {
+			return nil, merr.ErrorParams("invalid groupBy label name %q", label)
+		}
+	}
+	b := &SaveAggregationBo{
+		StrategyGroupUID: snowflake.ParseInt64(req.GetStrategyGroupUID()),
+		GroupBy:          req.GetGroupBy(),
+		GroupWait:        aggregator.DefaultGroupWait,
+		GroupInterval:    aggregator.DefaultGroupInterval,
+		RepeatInterval:   aggregator.DefaultRepeatInterval,
+	}
+	if req.GroupWait != nil {
+		b.GroupWait = req.GetGroupWait().AsDuration()
+	}
+	if req.GroupInterval != nil {
+		b.GroupInterval = req.GetGroupInterval().AsDuration()
+	}
+	if req.RepeatInterval != nil {
+		b.RepeatInterval = req.GetRepeatInterval().AsDuration()
+	}
+	if b.GroupWait < 0 {
+		return nil, merr.ErrorParams("groupWait must not be negative")
+	}
+	if b.GroupInterval < minGroupInterval {
+		return nil, merr.ErrorParams("groupInterval must be at least %s", minGroupInterval)
+	}
+	if b.RepeatInterval < b.GroupInterval {
+		return nil, merr.ErrorParams("repeatInterval must not be less than groupInterval")
+	}
+	return b, nil
+}
+
+type AggregationItemBo struct {
+	StrategyGroupUID snowflake.ID
+	GroupBy          []string
+	GroupWait        time.Duration
+	GroupInterval    time.Duration
+	RepeatInterval   time.Duration
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (b *AggregationItemBo) ToAPIV1AggregationItem() *apiv1.AggregationItem {
+	return &apiv1.AggregationItem{
+		StrategyGroupUID: b.StrategyGroupUID.Int64(),
+		GroupBy:          b.GroupBy,
+		GroupWait:        durationpb.New(b.GroupWait),
+		GroupInterval:    durationpb.New(b.GroupInterval),
+		RepeatInterval:   durationpb.New(b.RepeatInterval),
+		CreatedAt:        b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:        b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+// ToAggregatorRoute returns the route of the events of the strategy group to a receiver.
+func (b *AggregationItemBo) ToAggregatorRoute(namespaceUID, receiverUID snowflake.ID) *aggregator.Route {
+	return &aggregator.Route{
+		NamespaceUID: namespaceUID,
+		ReceiverUID:  receiverUID,
+		Scope:        b.StrategyGroupUID.String(),
+		Config: aggregator.Config{
+			GroupBy:        b.GroupBy,
+			GroupWait:      b.GroupWait,
+			GroupInterval:  b.GroupInterval,
+			RepeatInterval: b.RepeatInterval,
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+)
+
+// AlertStateBo is the checkpoint of one evaluator alert.
+type AlertStateBo struct {
+	NamespaceUID  snowflake.ID
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	DatasourceUID snowflake.ID
+	Fingerprint   uint64
+	Labels        map[string]string
+	Value         float64
+	// State is the evaluator.State of the alert
+	State      uint8
+	ActiveAt   time.Time
+	StartsAt   time.Time
+	LastEvalAt time.Time
+	// ManuallyResolved is set when the event of the firing alert was resolved by hand.
+	ManuallyResolved bool
+}
+
+func NewAlertStateBo(alert *evaluator.Alert) *AlertStateBo {
+	return &AlertStateBo{
+		NamespaceUID:  alert.NamespaceUID,
+		StrategyUID:   alert.StrategyUID,
+		LevelUID:      alert.LevelUID,
+		DatasourceUID: alert.DatasourceUID,
+		Fingerprint:   alert.Fingerprint,
+		Labels:        alert.Labels,
+		Value:         alert.Value,
+		State:         uint8(alert.State),
+		ActiveAt:      alert.ActiveAt,
+		StartsAt:      alert.StartsAt,
+		LastEvalAt:    alert.LastEvalAt,
+
+		ManuallyResolved: alert.ManuallyResolved,
+	}
+}
+
+func (b *AlertStateBo) ToEvaluatorAlert() *evaluator.Alert {
+	return &evaluator.Alert{
+		NamespaceUID:  b.NamespaceUID,
+		StrategyUID:   b.StrategyUID,
+		LevelUID:      b.LevelUID,
+		DatasourceUID: b.DatasourceUID,
+		Fingerprint:   b.Fingerprint,
+		Labels:        b.Labels,
+		Value:         b.Value,
+		State:         evaluator.State(b.State),
+		ActiveAt:      b.ActiveAt,
+		StartsAt:      b.StartsAt,
+		LastEvalAt:    b.LastEvalAt,
+
+		ManuallyResolved: b.ManuallyResolved,
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
 	"github.com/bwmarrin/snowflake"
 
+	"github.com/aide-family/marksman/internal/biz/evaluator"
 	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
 )
 
@@ -14,15 +16,27 @@ type CreateDatasourceBo struct {
 	Type     enum.DatasourceType
 	Driver   enum.DatasourceDriver
 	Metadata map[string]string
+	Config   *DatasourceConfigBo
 }
 
-func NewCreateDatasourceBo(req *apiv1.CreateDatasourceRequest) *CreateDatasourceBo {
-	return &CreateDatasourceBo{
+func NewCreateDatasourceBo(req *apiv1.CreateDatasourceRequest) (*CreateDatasourceBo, error) {
+	b := &CreateDatasourceBo{
 		Name:     req.GetName(),
 		Type:     req.GetType(),
 		Driver:   req.GetDriver(),
 		Metadata: req.GetMetadata(),
+		Config:   NewDatasourceConfigBo(req.GetConfig()),
 	}
+	if err := validateDatasourceMetadata(b.Metadata); err != nil {
+		return nil, err
+	}
+	if err := validateDatasourceConfig(b.Type, b.Driver, b.Config); err != nil {
+		return nil, err
+	}
+	if b.Config.HasUnchangedSecrets() {
+		return nil, merr.ErrorParams("secrets of a new datasource must be set")
+	}
+	return b, nil
 }
 
 type UpdateDatasourceBo struct {
@@ -31,16 +45,25 @@ type UpdateDatasourceBo struct {
 	Type     enum.DatasourceType
 	Driver   enum.DatasourceDriver
 	Metadata map[string]string
+	Config   *DatasourceConfigBo
 }
 
-func NewUpdateDatasourceBo(req *apiv1.UpdateDatasourceRequest) *UpdateDatasourceBo {
-	return &UpdateDatasourceBo{
+func NewUpdateDatasourceBo(req *apiv1.UpdateDatasourceRequest) (*UpdateDatasourceBo, error) {
+	b := &UpdateDatasourceBo{
 		UID:      snowflake.ParseInt64(req.GetUid()),
 		Name:     req.GetName(),
 		Type:     req.GetType(),
 		Driver:   req.GetDriver(),
 		Metadata: req.GetMetadata(),
+		Config:   NewDatasourceConfigBo(req.GetConfig()),
+	}
+	if err := validateDatasourceMetadata(b.Metadata); err != nil {
+		return nil, err
+	}
+	if err := validateDatasourceConfig(b.Type, b.Driver, b.Config); err != nil {
+		return nil, err
 	}
+	return b, nil
 }
 
 type DatasourceItemBo struct {
@@ -49,7 +72,9 @@ type DatasourceItemBo struct {
 	Type      enum.DatasourceType
 	Driver    enum.DatasourceDriver
 	Metadata  map[string]string
+	Config    *DatasourceConfigBo
 	Status    enum.GlobalStatus
+	Managed   bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -61,7 +86,9 @@ func (b *DatasourceItemBo) ToAPIV1DatasourceItem() *apiv1.DatasourceItem {
 		Type:      b.Type,
 		Driver:    b.Driver,
 		Metadata:  b.Metadata,
+		Config:    b.Config.ToAPIV1DatasourceConfig(),
 		Status:    b.Status,
+		Managed:   b.Managed,
 		CreatedAt: b.CreatedAt.Format(time.DateTime),
 		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
 	}
@@ -97,3 +124,59 @@ func ToAPIV1ListDatasourceReply(pageResponseBo *PageResponseBo[*DatasourceItemBo
 		Items:    items,
 	}
 }
+
+// TestDatasourceBo tests either a saved datasource by UID or an unsaved one.
+type TestDatasourceBo struct {
+	UID        snowflake.ID
+	Datasource *CreateDatasourceBo
+}
+
+func NewTestDatasourceBo(req *apiv1.TestDatasourceRequest) (*TestDatasourceBo, error) {
+	switch target := req.GetTarget().(type) {
+	case *apiv1.TestDatasourceRequest_Uid:
+		if target.Uid <= 0 {
+			return nil, merr.ErrorParams("uid must be greater than 0")
+		}
+		return &TestDatasourceBo{UID: snowflake.ParseInt64(target.Uid)}, nil
+	case *apiv1.TestDatasourceRequest_Datasource:
+		datasource, err := NewCreateDatasourceBo(target.Datasource)
+		if err != nil {
+			return nil, err
+		}
+		return &TestDatasourceBo{Datasource: datasource}, nil
+	default:
+		return nil, merr.ErrorParams("either uid or datasource is required")
+	}
+}
+
+type TestDatasourceResultBo struct {
+	Success      bool
+	Latency      time.Duration
+	BuildInfo    *evaluator.BuildInfo
+	ErrorCode    apiv1.DatasourceTestErrorCode
+	ErrorMessage string
+}
+
+func (b *TestDatasourceResultBo) ToAPIV1TestDatasourceReply() *apiv1.TestDatasourceReply {
+	reply := &apiv1.TestDatasourceReply{
+		Success:   b.Success,
+		LatencyMs: b.Latency.Milliseconds(),
+	}
+	if b.BuildInfo != nil {
+		reply.BuildInfo = &apiv1.DatasourceBuildInfo{
+			Version:   b.BuildInfo.Version,
+			Revision:  b.BuildInfo.Revision,
+			Branch:    b.BuildInfo.Branch,
+			BuildUser: b.BuildInfo.BuildUser,
+			BuildDate: b.BuildInfo.BuildDate,
+			GoVersion: b.BuildInfo.GoVersion,
+		}
+	}
+	if !b.Success {
+		reply.Error = &apiv1.DatasourceTestError{
+			Code:    b.ErrorCode,
+			Message: b.ErrorMessage,
+		}
+	}
+	return reply
+}
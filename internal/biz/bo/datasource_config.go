@@ -0,0 +1,478 @@
+package bo
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const (
+	DatasourceConfigFieldURL            = "url"
+	DatasourceConfigFieldAuthMode       = "authMode"
+	DatasourceConfigFieldUsername       = "username"
+	DatasourceConfigFieldPassword       = "password"
+	DatasourceConfigFieldBearerToken    = "bearerToken"
+	DatasourceConfigFieldHeaders        = "headers"
+	DatasourceConfigFieldTLSCACert      = "tls.caCert"
+	DatasourceConfigFieldTLSInsecure    = "tls.insecureSkipVerify"
+	DatasourceConfigFieldTLSServerName  = "tls.serverName"
+	DatasourceConfigFieldTimeout        = "timeout"
+	DatasourceConfigFieldScrapeInterval = "scrapeInterval"
+
+	datasourceConfigFieldTypeString   = "string"
+	datasourceConfigFieldTypeText     = "text"
+	datasourceConfigFieldTypeBool     = "bool"
+	datasourceConfigFieldTypeEnum     = "enum"
+	datasourceConfigFieldTypeDuration = "duration"
+	datasourceConfigFieldTypeMap      = "map"
+
+	// DefaultDatasourceTimeout applies when a datasource config has no timeout.
+	DefaultDatasourceTimeout = 30 * time.Second
+	maxDatasourceTimeout     = 5 * time.Minute
+
+	// datasourceMetadata* are the metadata keys datasources used before they had a typed config.
+	datasourceMetadataURL      = "url"
+	datasourceMetadataUsername = "username"
+	datasourceMetadataPassword = "password"
+
+	// SecretUnchanged replaces secrets in responses, sending it back on update keeps the stored secret.
+	SecretUnchanged = "__unchanged__"
+)
+
+type DatasourceTLSConfigBo struct {
+	CACert             string
+	InsecureSkipVerify bool
+	ServerName         string
+}
+
+// DatasourceConfigBo is the typed connection config of a datasource, see DatasourceConfigSchemaBo
+// for the fields every driver accepts.
+type DatasourceConfigBo struct {
+	URL            string
+	AuthMode       apiv1.DatasourceAuthMode
+	Username       string
+	Password       string
+	BearerToken    string
+	Headers        map[string]string
+	TLS            *DatasourceTLSConfigBo
+	Timeout        time.Duration
+	ScrapeInterval time.Duration
+}
+
+func NewDatasourceConfigBo(config *apiv1.DatasourceConfig) *DatasourceConfigBo {
+	if config == nil {
+		return nil
+	}
+	b := &DatasourceConfigBo{
+		URL:            config.GetUrl(),
+		AuthMode:       config.GetAuthMode(),
+		Username:       config.GetUsername(),
+		Password:       config.GetPassword(),
+		BearerToken:    config.GetBearerToken(),
+		Headers:        config.GetHeaders(),
+		Timeout:        config.GetTimeout().AsDuration(),
+		ScrapeInterval: config.GetScrapeInterval().AsDuration(),
+	}
+	if tls := config.GetTls(); tls != nil {
+		b.TLS = &DatasourceTLSConfigBo{
+			CACert:             tls.GetCaCert(),
+			InsecureSkipVerify: tls.GetInsecureSkipVerify(),
+			ServerName:         tls.GetServerName(),
+		}
+	}
+	return b
+}
+
+// NewDatasourceConfigBoFromMetadata reads the url and basic auth keys datasources kept in
+// their metadata before they had a typed config.
+func NewDatasourceConfigBoFromMetadata(metadata map[string]string) *DatasourceConfigBo {
+	if metadata[datasourceMetadataURL] == "" {
+		return nil
+	}
+	b := &DatasourceConfigBo{
+		URL:      metadata[datasourceMetadataURL],
+		AuthMode: apiv1.DatasourceAuthMode_AUTH_NONE,
+		Username: metadata[datasourceMetadataUsername],
+		Password: metadata[datasourceMetadataPassword],
+	}
+	if b.Username != "" || b.Password != "" {
+		b.AuthMode = apiv1.DatasourceAuthMode_AUTH_BASIC
+	}
+	return b
+}
+
+// WithoutDatasourceMetadataCredentials returns a copy of metadata without the legacy basic
+// auth keys, once they have been moved into the typed config.
+func WithoutDatasourceMetadataCredentials(metadata map[string]string) map[string]string {
+	stripped := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if key != datasourceMetadataUsername && key != datasourceMetadataPassword {
+			stripped[key] = value
+		}
+	}
+	return stripped
+}
+
+// validateDatasourceMetadata rejects the legacy basic auth keys, credentials are only taken in
+// the config where they are encrypted at rest and redacted in responses.
+func validateDatasourceMetadata(metadata map[string]string) error {
+	for _, key := range []string{datasourceMetadataUsername, datasourceMetadataPassword} {
+		if _, ok := metadata[key]; ok {
+			return merr.ErrorParams("metadata %s is not allowed, set the credentials in config", key)
+		}
+	}
+	return nil
+}
+
+func (b *DatasourceConfigBo) ToAPIV1DatasourceConfig() *apiv1.DatasourceConfig {
+	if b == nil {
+		return nil
+	}
+	config := &apiv1.DatasourceConfig{
+		Url:         b.URL,
+		AuthMode:    b.AuthMode,
+		Username:    b.Username,
+		Password:    redactSecret(b.Password),
+		BearerToken: redactSecret(b.BearerToken),
+		Headers:     redactHeaders(b.Headers),
+	}
+	if b.TLS != nil {
+		config.Tls = &apiv1.DatasourceTLSConfig{
+			CaCert:             b.TLS.CACert,
+			InsecureSkipVerify: b.TLS.InsecureSkipVerify,
+			ServerName:         b.TLS.ServerName,
+		}
+	}
+	if b.Timeout > 0 {
+		config.Timeout = durationpb.New(b.Timeout)
+	}
+	if b.ScrapeInterval > 0 {
+		config.ScrapeInterval = durationpb.New(b.ScrapeInterval)
+	}
+	return config
+}
+
+// HasUnchangedSecrets reports whether a secret was sent back as SecretUnchanged.
+func (b *DatasourceConfigBo) HasUnchangedSecrets() bool {
+	if b == nil {
+		return false
+	}
+	if b.Password == SecretUnchanged || b.BearerToken == SecretUnchanged {
+		return true
+	}
+	for _, value := range b.Headers {
+		if value == SecretUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// KeepSecrets replaces SecretUnchanged with the secrets of the stored config.
+func (b *DatasourceConfigBo) KeepSecrets(stored *DatasourceConfigBo) error {
+	if stored == nil {
+		stored = &DatasourceConfigBo{}
+	}
+	if b.Password == SecretUnchanged {
+		if stored.Password == "" {
+			return merr.ErrorParams("datasource has no password to keep")
+		}
+		b.Password = stored.Password
+	}
+	if b.BearerToken == SecretUnchanged {
+		if stored.BearerToken == "" {
+			return merr.ErrorParams("datasource has no bearer token to keep")
+		}
+		b.BearerToken = stored.BearerToken
+	}
+	for name, value := range b.Headers {
+		if value != SecretUnchanged {
+			continue
+		}
+		if stored.Headers[name] == "" {
+			return merr.ErrorParams("datasource has no header %s to keep", name)
+		}
+		b.Headers[name] = stored.Headers[name]
+	}
+	return nil
+}
+
+func redactSecret(value string) string {
+	if value == "" {
+		return ""
+	}
+	return SecretUnchanged
+}
+
+// redactHeaders redacts the values of headers, they often carry credentials such as an
+// Authorization or an API key header.
+func redactHeaders(headers map[string]string) map[string]string {
+	if len(headers) == 0 {
+		return headers
+	}
+	redacted := make(map[string]string, len(headers))
+	for name, value := range headers {
+		redacted[name] = redactSecret(value)
+	}
+	return redacted
+}
+
+// setFields lists the schema names of the fields that are set, used to reject fields a
+// driver does not support.
+func (b *DatasourceConfigBo) setFields() []string {
+	var fields []string
+	if b.URL != "" {
+		fields = append(fields, DatasourceConfigFieldURL)
+	}
+	if b.AuthMode != apiv1.DatasourceAuthMode_DatasourceAuthMode_UNKNOWN {
+		fields = append(fields, DatasourceConfigFieldAuthMode)
+	}
+	if b.Username != "" {
+		fields = append(fields, DatasourceConfigFieldUsername)
+	}
+	if b.Password != "" {
+		fields = append(fields, DatasourceConfigFieldPassword)
+	}
+	if b.BearerToken != "" {
+		fields = append(fields, DatasourceConfigFieldBearerToken)
+	}
+	if len(b.Headers) > 0 {
+		fields = append(fields, DatasourceConfigFieldHeaders)
+	}
+	if b.TLS != nil {
+		if b.TLS.CACert != "" {
+			fields = append(fields, DatasourceConfigFieldTLSCACert)
+		}
+		if b.TLS.InsecureSkipVerify {
+			fields = append(fields, DatasourceConfigFieldTLSInsecure)
+		}
+		if b.TLS.ServerName != "" {
+			fields = append(fields, DatasourceConfigFieldTLSServerName)
+		}
+	}
+	if b.Timeout != 0 {
+		fields = append(fields, DatasourceConfigFieldTimeout)
+	}
+	if b.ScrapeInterval != 0 {
+		fields = append(fields, DatasourceConfigFieldScrapeInterval)
+	}
+	return fields
+}
+
+type DatasourceConfigFieldBo struct {
+	Name         string
+	Type         string
+	Required     bool
+	Secret       bool
+	Description  string
+	Options      []string
+	DefaultValue string
+}
+
+func (b *DatasourceConfigFieldBo) ToAPIV1DatasourceConfigField() *apiv1.DatasourceConfigField {
+	return &apiv1.DatasourceConfigField{
+		Name:         b.Name,
+		Type:         b.Type,
+		Required:     b.Required,
+		Secret:       b.Secret,
+		Description:  b.Description,
+		Options:      b.Options,
+		DefaultValue: b.DefaultValue,
+	}
+}
+
+// DatasourceConfigSchemaBo describes the config a driver accepts, the UI renders its form from it.
+type DatasourceConfigSchemaBo struct {
+	Driver    enum.DatasourceDriver
+	Type      enum.DatasourceType
+	AuthModes []apiv1.DatasourceAuthMode
+	Fields    []*DatasourceConfigFieldBo
+}
+
+func (b *DatasourceConfigSchemaBo) field(name string) *DatasourceConfigFieldBo {
+	for _, f := range b.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (b *DatasourceConfigSchemaBo) ToAPIV1DatasourceConfigSchema() *apiv1.DatasourceConfigSchema {
+	fields := make([]*apiv1.DatasourceConfigField, 0, len(b.Fields))
+	for _, f := range b.Fields {
+		fields = append(fields, f.ToAPIV1DatasourceConfigField())
+	}
+	return &apiv1.DatasourceConfigSchema{
+		Driver: b.Driver,
+		Type:   b.Type,
+		Fields: fields,
+	}
+}
+
+func newDatasourceConfigSchema(driver enum.DatasourceDriver, datasourceType enum.DatasourceType, authModes []apiv1.DatasourceAuthMode, scrapeInterval bool) *DatasourceConfigSchemaBo {
+	options := make([]string, 0, len(authModes))
+	for _, mode := range authModes {
+		options = append(options, mode.String())
+	}
+	fields := []*DatasourceConfigFieldBo{
+		{Name: DatasourceConfigFieldURL, Type: datasourceConfigFieldTypeString, Required: true, Description: "base url of the http api, e.g. http://prometheus:9090"},
+		{Name: DatasourceConfigFieldAuthMode, Type: datasourceConfigFieldTypeEnum, Options: options, DefaultValue: apiv1.DatasourceAuthMode_AUTH_NONE.String(), Description: "how requests are authenticated"},
+	}
+	if slices.Contains(authModes, apiv1.DatasourceAuthMode_AUTH_BASIC) {
+		fields = append(fields,
+			&DatasourceConfigFieldBo{Name: DatasourceConfigFieldUsername, Type: datasourceConfigFieldTypeString, Description: "basic auth username"},
+			&DatasourceConfigFieldBo{Name: DatasourceConfigFieldPassword, Type: datasourceConfigFieldTypeString, Secret: true, Description: "basic auth password"},
+		)
+	}
+	if slices.Contains(authModes, apiv1.DatasourceAuthMode_AUTH_BEARER) {
+		fields = append(fields, &DatasourceConfigFieldBo{Name: DatasourceConfigFieldBearerToken, Type: datasourceConfigFieldTypeString, Secret: true, Description: "bearer token sent in the Authorization header"})
+	}
+	fields = append(fields,
+		&DatasourceConfigFieldBo{Name: DatasourceConfigFieldHeaders, Type: datasourceConfigFieldTypeMap, Secret: true, Description: "extra http headers sent with every request, their values are kept secret"},
+		&DatasourceConfigFieldBo{Name: DatasourceConfigFieldTLSCACert, Type: datasourceConfigFieldTypeText, Description: "PEM encoded CA certificates to verify the server with"},
+		&DatasourceConfigFieldBo{Name: DatasourceConfigFieldTLSInsecure, Type: datasourceConfigFieldTypeBool, DefaultValue: "false", Description: "skip verifying the server certificate"},
+		&DatasourceConfigFieldBo{Name: DatasourceConfigFieldTLSServerName, Type: datasourceConfigFieldTypeString, Description: "server name used to verify the certificate"},
+		&DatasourceConfigFieldBo{Name: DatasourceConfigFieldTimeout, Type: datasourceConfigFieldTypeDuration, DefaultValue: DefaultDatasourceTimeout.String(), Description: "timeout of a single request"},
+	)
+	if scrapeInterval {
+		fields = append(fields, &DatasourceConfigFieldBo{Name: DatasourceConfigFieldScrapeInterval, Type: datasourceConfigFieldTypeDuration, DefaultValue: "15s", Description: "scrape interval of the datasource, used as the query resolution"})
+	}
+	return &DatasourceConfigSchemaBo{Driver: driver, Type: datasourceType, AuthModes: authModes, Fields: fields}
+}
+
+var (
+	allDatasourceAuthModes = []apiv1.DatasourceAuthMode{
+		apiv1.DatasourceAuthMode_AUTH_NONE,
+		apiv1.DatasourceAuthMode_AUTH_BASIC,
+		apiv1.DatasourceAuthMode_AUTH_BEARER,
+	}
+
+	datasourceConfigSchemas = []*DatasourceConfigSchemaBo{
+		newDatasourceConfigSchema(enum.DatasourceDriver_METRICS_PROMETHEUS, enum.DatasourceType_METRICS, allDatasourceAuthModes, true),
+		newDatasourceConfigSchema(enum.DatasourceDriver_METRICS_VICTORIA_METRICS, enum.DatasourceType_METRICS, allDatasourceAuthModes, true),
+		newDatasourceConfigSchema(enum.DatasourceDriver_LOGS_ELASTICSEARCH, enum.DatasourceType_LOGS, allDatasourceAuthModes, false),
+		newDatasourceConfigSchema(enum.DatasourceDriver_TRACE_JAEGER, enum.DatasourceType_TRACE, []apiv1.DatasourceAuthMode{
+			apiv1.DatasourceAuthMode_AUTH_NONE,
+			apiv1.DatasourceAuthMode_AUTH_BEARER,
+		}, false),
+	}
+)
+
+// GetDatasourceConfigSchema returns the config schema of driver, nil if the driver is unknown.
+func GetDatasourceConfigSchema(driver enum.DatasourceDriver) *DatasourceConfigSchemaBo {
+	for _, schema := range datasourceConfigSchemas {
+		if schema.Driver == driver {
+			return schema
+		}
+	}
+	return nil
+}
+
+// ListDatasourceConfigSchema returns the schema of driver, or of all drivers if it is unknown.
+func ListDatasourceConfigSchema(driver enum.DatasourceDriver) []*DatasourceConfigSchemaBo {
+	if driver == enum.DatasourceDriver_DatasourceDriver_UNKNOWN {
+		return datasourceConfigSchemas
+	}
+	if schema := GetDatasourceConfigSchema(driver); schema != nil {
+		return []*DatasourceConfigSchemaBo{schema}
+	}
+	return nil
+}
+
+func ToAPIV1ListDatasourceConfigSchemaReply(schemas []*DatasourceConfigSchemaBo) *apiv1.ListDatasourceConfigSchemaReply {
+	items := make([]*apiv1.DatasourceConfigSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		items = append(items, schema.ToAPIV1DatasourceConfigSchema())
+	}
+	return &apiv1.ListDatasourceConfigSchemaReply{Items: items}
+}
+
+// validateDatasourceConfig checks config against the schema of driver and fills in the
+// default auth mode.
+func validateDatasourceConfig(datasourceType enum.DatasourceType, driver enum.DatasourceDriver, config *DatasourceConfigBo) error {
+	schema := GetDatasourceConfigSchema(driver)
+	if schema == nil {
+		return merr.ErrorParams("datasource driver %s is not supported", driver)
+	}
+	if schema.Type != datasourceType {
+		return merr.ErrorParams("datasource driver %s requires type %s", driver, schema.Type)
+	}
+	if config == nil {
+		return merr.ErrorParams("datasource config is required")
+	}
+	for _, name := range config.setFields() {
+		if schema.field(name) == nil {
+			return merr.ErrorParams("config field %s is not supported by driver %s", name, driver)
+		}
+	}
+
+	u, err := url.Parse(config.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return merr.ErrorParams("config url %q must be an absolute http(s) url", config.URL)
+	}
+	if config.AuthMode == apiv1.DatasourceAuthMode_DatasourceAuthMode_UNKNOWN {
+		config.AuthMode = apiv1.DatasourceAuthMode_AUTH_NONE
+	}
+	if !slices.Contains(schema.AuthModes, config.AuthMode) {
+		return merr.ErrorParams("auth mode %s is not supported by driver %s", config.AuthMode, driver)
+	}
+	switch config.AuthMode {
+	case apiv1.DatasourceAuthMode_AUTH_BASIC:
+		if config.Username == "" {
+			return merr.ErrorParams("config username is required for basic auth")
+		}
+	case apiv1.DatasourceAuthMode_AUTH_BEARER:
+		if config.BearerToken == "" {
+			return merr.ErrorParams("config bearerToken is required for bearer auth")
+		}
+	}
+	if config.AuthMode != apiv1.DatasourceAuthMode_AUTH_BASIC && (config.Username != "" || config.Password != "") {
+		return merr.ErrorParams("config username and password require basic auth")
+	}
+	if config.AuthMode != apiv1.DatasourceAuthMode_AUTH_BEARER && config.BearerToken != "" {
+		return merr.ErrorParams("config bearerToken requires bearer auth")
+	}
+
+	for name, value := range config.Headers {
+		if !validHeaderName(name) || strings.ContainsAny(value, "\r\n") {
+			return merr.ErrorParams("config header %q is invalid", name)
+		}
+		if config.AuthMode != apiv1.DatasourceAuthMode_AUTH_NONE && http.CanonicalHeaderKey(name) == "Authorization" {
+			return merr.ErrorParams("config header Authorization conflicts with auth mode %s", config.AuthMode)
+		}
+	}
+	if config.TLS != nil && config.TLS.CACert != "" {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(config.TLS.CACert)) {
+			return merr.ErrorParams("config tls.caCert contains no valid PEM certificate")
+		}
+	}
+	if config.Timeout < 0 || config.Timeout > maxDatasourceTimeout {
+		return merr.ErrorParams("config timeout must be between 0 and %s", maxDatasourceTimeout)
+	}
+	if config.ScrapeInterval < 0 {
+		return merr.ErrorParams("config scrapeInterval must not be negative")
+	}
+	return nil
+}
+
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r > 0x7e || r <= ' ' || strings.ContainsRune(`"(),/:;<=>?@[\]{}`, r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package bo_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func caCert(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour), IsCA: true, BasicConstraintsValid: true}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestDatasourceConfigSchemaValidation(t *testing.T) {
+	valid := func() *apiv1.CreateDatasourceRequest {
+		return &apiv1.CreateDatasourceRequest{
+			Name:   "prom",
+			Type:   enum.DatasourceType_METRICS,
+			Driver: enum.DatasourceDriver_METRICS_PROMETHEUS,
+			Config: &apiv1.DatasourceConfig{Url: "http://prometheus:9090"},
+		}
+	}
+	cert := caCert(t)
+	for name, tc := range map[string]struct {
+		mutate func(req *apiv1.CreateDatasourceRequest)
+		valid  bool
+	}{
+		"defaults": {func(req *apiv1.CreateDatasourceRequest) {}, true},
+		"basic auth": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.AuthMode, req.Config.Username, req.Config.Password = apiv1.DatasourceAuthMode_AUTH_BASIC, "admin", "secret"
+		}, true},
+		"bearer auth with headers, tls and timeouts": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.AuthMode, req.Config.BearerToken = apiv1.DatasourceAuthMode_AUTH_BEARER, "token"
+			req.Config.Headers = map[string]string{"X-Scope-OrgID": "ops"}
+			req.Config.Tls = &apiv1.DatasourceTLSConfig{CaCert: cert, ServerName: "prometheus"}
+			req.Config.Timeout, req.Config.ScrapeInterval = durationpb.New(time.Minute), durationpb.New(30*time.Second)
+		}, true},
+
+		"unknown driver":          {func(req *apiv1.CreateDatasourceRequest) { req.Driver = enum.DatasourceDriver(999) }, false},
+		"type of another driver":  {func(req *apiv1.CreateDatasourceRequest) { req.Type = enum.DatasourceType_LOGS }, false},
+		"no config":               {func(req *apiv1.CreateDatasourceRequest) { req.Config = nil }, false},
+		"relative url":            {func(req *apiv1.CreateDatasourceRequest) { req.Config.Url = "prometheus:9090" }, false},
+		"url of another scheme":   {func(req *apiv1.CreateDatasourceRequest) { req.Config.Url = "ftp://prometheus" }, false},
+		"basic auth without user": {func(req *apiv1.CreateDatasourceRequest) { req.Config.AuthMode = apiv1.DatasourceAuthMode_AUTH_BASIC }, false},
+		"bearer auth without token": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.AuthMode = apiv1.DatasourceAuthMode_AUTH_BEARER
+		}, false},
+		"password without basic auth": {func(req *apiv1.CreateDatasourceRequest) { req.Config.Password = "secret" }, false},
+		"token without bearer auth":   {func(req *apiv1.CreateDatasourceRequest) { req.Config.BearerToken = "token" }, false},
+		"invalid header name":         {func(req *apiv1.CreateDatasourceRequest) { req.Config.Headers = map[string]string{"X Org": "ops"} }, false},
+		"header value with newline": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.Headers = map[string]string{"X-Org": "ops\r\nX-Admin: true"}
+		}, false},
+		"authorization header with auth": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.AuthMode, req.Config.BearerToken = apiv1.DatasourceAuthMode_AUTH_BEARER, "token"
+			req.Config.Headers = map[string]string{"authorization": "Basic x"}
+		}, false},
+		"ca cert without pem": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.Tls = &apiv1.DatasourceTLSConfig{CaCert: "not a certificate"}
+		}, false},
+		"timeout over the max":    {func(req *apiv1.CreateDatasourceRequest) { req.Config.Timeout = durationpb.New(time.Hour) }, false},
+		"negative timeout":        {func(req *apiv1.CreateDatasourceRequest) { req.Config.Timeout = durationpb.New(-time.Second) }, false},
+		"negative scrapeInterval": {func(req *apiv1.CreateDatasourceRequest) { req.Config.ScrapeInterval = durationpb.New(-time.Second) }, false},
+		"password in metadata":    {func(req *apiv1.CreateDatasourceRequest) { req.Metadata = map[string]string{"password": "secret"} }, false},
+		"username in metadata":    {func(req *apiv1.CreateDatasourceRequest) { req.Metadata = map[string]string{"username": "admin"} }, false},
+		"unchanged secret on create": {func(req *apiv1.CreateDatasourceRequest) {
+			req.Config.AuthMode, req.Config.Username, req.Config.Password = apiv1.DatasourceAuthMode_AUTH_BASIC, "admin", bo.SecretUnchanged
+		}, false},
+	} {
+		req := valid()
+		tc.mutate(req)
+		_, err := bo.NewCreateDatasourceBo(req)
+		if tc.valid && err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if !tc.valid && errors.Code(err) != 400 {
+			t.Errorf("%s: got %v, want a params error", name, err)
+		}
+	}
+}
+
+func TestDatasourceConfigSchemaFieldsOfDriver(t *testing.T) {
+	jaeger := func(mutate func(config *apiv1.DatasourceConfig)) error {
+		config := &apiv1.DatasourceConfig{Url: "http://jaeger:16686"}
+		mutate(config)
+		_, err := bo.NewCreateDatasourceBo(&apiv1.CreateDatasourceRequest{Name: "jaeger", Type: enum.DatasourceType_TRACE, Driver: enum.DatasourceDriver_TRACE_JAEGER, Config: config})
+		return err
+	}
+	// jaeger has no basic auth and no scrape interval in its schema
+	for name, mutate := range map[string]func(config *apiv1.DatasourceConfig){
+		"basic auth": func(config *apiv1.DatasourceConfig) {
+			config.AuthMode, config.Username = apiv1.DatasourceAuthMode_AUTH_BASIC, "admin"
+		},
+		"username":       func(config *apiv1.DatasourceConfig) { config.Username = "admin" },
+		"scrapeInterval": func(config *apiv1.DatasourceConfig) { config.ScrapeInterval = durationpb.New(time.Minute) },
+	} {
+		if err := jaeger(mutate); errors.Code(err) != 400 {
+			t.Errorf("jaeger with %s: got %v, want a params error", name, err)
+		}
+	}
+	if err := jaeger(func(config *apiv1.DatasourceConfig) {
+		config.AuthMode, config.BearerToken = apiv1.DatasourceAuthMode_AUTH_BEARER, "token"
+	}); err != nil {
+		t.Fatalf("jaeger with bearer auth: %v", err)
+	}
+
+	// the schema lists the fields the validation accepts
+	schema := bo.GetDatasourceConfigSchema(enum.DatasourceDriver_TRACE_JAEGER)
+	for _, f := range schema.Fields {
+		if f.Name == bo.DatasourceConfigFieldUsername || f.Name == bo.DatasourceConfigFieldScrapeInterval {
+			t.Errorf("jaeger schema has field %s", f.Name)
+		}
+	}
+	if got := bo.ListDatasourceConfigSchema(enum.DatasourceDriver_DatasourceDriver_UNKNOWN); len(got) != 4 {
+		t.Fatalf("got %d schemas, want one per driver", len(got))
+	}
+}
+
+func TestDatasourceConfigKeepSecrets(t *testing.T) {
+	stored := &bo.DatasourceConfigBo{Password: "secret"}
+	config := &bo.DatasourceConfigBo{Password: bo.SecretUnchanged}
+	if err := config.KeepSecrets(stored); err != nil || config.Password != "secret" {
+		t.Fatalf("got %v and password %q, want the stored password", err, config.Password)
+	}
+	stored.Headers = map[string]string{"Authorization": "Basic x"}
+	config = &bo.DatasourceConfigBo{Headers: map[string]string{"Authorization": bo.SecretUnchanged, "X-Org": "ops"}}
+	if err := config.KeepSecrets(stored); err != nil || config.Headers["Authorization"] != "Basic x" || config.Headers["X-Org"] != "ops" {
+		t.Fatalf("got %v and headers %v, want the stored header kept", err, config.Headers)
+	}
+	config = &bo.DatasourceConfigBo{Headers: map[string]string{"X-Api-Key": bo.SecretUnchanged}}
+	if err := config.KeepSecrets(stored); errors.Code(err) != 400 {
+		t.Fatalf("got %v, want a params error with no stored header to keep", err)
+	}
+	config = &bo.DatasourceConfigBo{BearerToken: bo.SecretUnchanged}
+	if err := config.KeepSecrets(stored); errors.Code(err) != 400 {
+		t.Fatalf("got %v, want a params error with no stored token to keep", err)
+	}
+	if got := (&bo.DatasourceConfigBo{URL: "http://prometheus:9090", Password: "secret"}).ToAPIV1DatasourceConfig(); got.GetPassword() != bo.SecretUnchanged {
+		t.Fatalf("got password %q in the reply, want it redacted", got.GetPassword())
+	}
+	if got := (&bo.DatasourceConfigBo{URL: "http://prometheus:9090", Headers: map[string]string{"Authorization": "Basic x"}}).ToAPIV1DatasourceConfig(); got.GetHeaders()["Authorization"] != bo.SecretUnchanged {
+		t.Fatalf("got headers %v in the reply, want them redacted", got.GetHeaders())
+	}
+
+	legacy := map[string]string{"url": "http://prometheus:9090", "username": "admin", "password": "secret"}
+	update := &apiv1.UpdateDatasourceRequest{Uid: 1, Name: "prom", Type: enum.DatasourceType_METRICS, Driver: enum.DatasourceDriver_METRICS_PROMETHEUS, Metadata: legacy, Config: &apiv1.DatasourceConfig{Url: "http://prometheus:9090"}}
+	if _, err := bo.NewUpdateDatasourceBo(update); errors.Code(err) != 400 {
+		t.Fatalf("got %v, want a params error for credentials in the metadata of an update", err)
+	}
+}
@@ -0,0 +1,207 @@
+package bo
+
+import (
+	"slices"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// maxEscalationDelay bounds the wait of a step, an event unacknowledged for a day needs a human anyway.
+const maxEscalationDelay = 24 * time.Hour
+
+type EscalationStepBo struct {
+	Delay        time.Duration
+	ReceiverUIDs []snowflake.ID
+}
+
+func (b *EscalationStepBo) ToAPIV1EscalationStep() *apiv1.EscalationStep {
+	receiverUIDs := make([]int64, 0, len(b.ReceiverUIDs))
+	for _, uid := range b.ReceiverUIDs {
+		receiverUIDs = append(receiverUIDs, uid.Int64())
+	}
+	return &apiv1.EscalationStep{
+		Delay:        durationpb.New(b.Delay),
+		ReceiverUIDs: receiverUIDs,
+	}
+}
+
+// EscalationPolicySpecBo is the part of an escalation policy shared by create and update.
+type EscalationPolicySpecBo struct {
+	Name              string
+	Remark            string
+	Steps             []*EscalationStepBo
+	StrategyGroupUIDs []snowflake.ID
+	LevelUIDs         []snowflake.ID
+}
+
+// ReceiverUIDs returns the receivers of all the steps.
+func (b *EscalationPolicySpecBo) ReceiverUIDs() []snowflake.ID {
+	var uids []snowflake.ID
+	for _, step := range b.Steps {
+		uids = append(uids, step.ReceiverUIDs...)
+	}
+	slices.Sort(uids)
+	return slices.Compact(uids)
+}
+
+func newEscalationPolicySpecBo(name, remark string, steps []*apiv1.EscalationStep, strategyGroupUIDs, levelUIDs []int64) (*EscalationPolicySpecBo, error) {
+	if len(steps) == 0 {
+		return nil, merr.ErrorParams("steps are required")
+	}
+	spec := &EscalationPolicySpecBo{
+		Name:              name,
+		Remark:            remark,
+		Steps:             make([]*EscalationStepBo, 0, len(steps)),
+		StrategyGroupUIDs: toUniqueUIDs(strategyGroupUIDs),
+		LevelUIDs:         toUniqueUIDs(levelUIDs),
+	}
+	for i, step := range steps {
+		delay := step.GetDelay().AsDuration()
+		if delay < 0 || delay > maxEscalationDelay {
+			return nil, merr.ErrorParams("delay of step %d must be between 0 and %s", i+1, maxEscalationDelay)
+		}
+		receiverUIDs := toUniqueUIDs(step.GetReceiverUIDs())
+		if len(receiverUIDs) == 0 {
+			return nil, merr.ErrorParams("receiverUIDs of step %d are required", i+1)
+		}
+		spec.Steps = append(spec.Steps, &EscalationStepBo{Delay: delay, ReceiverUIDs: receiverUIDs})
+	}
+	return spec, nil
+}
+
+// toUniqueUIDs drops the zero and repeated uids, keeping the order.
+func toUniqueUIDs(uids []int64) []snowflake.ID {
+	list := make([]snowflake.ID, 0, len(uids))
+	for _, uid := range uids {
+		id := snowflake.ParseInt64(uid)
+		if uid > 0 && !slices.Contains(list, id) {
+			list = append(list, id)
+		}
+	}
+	return list
+}
+
+type CreateEscalationPolicyBo struct {
+	*EscalationPolicySpecBo
+}
+
+func NewCreateEscalationPolicyBo(req *apiv1.CreateEscalationPolicyRequest) (*CreateEscalationPolicyBo, error) {
+	spec, err := newEscalationPolicySpecBo(req.GetName(), req.GetRemark(), req.GetSteps(), req.GetStrategyGroupUIDs(), req.GetLevelUIDs())
+	if err != nil {
+		return nil, err
+	}
+	return &CreateEscalationPolicyBo{EscalationPolicySpecBo: spec}, nil
+}
+
+type UpdateEscalationPolicyBo struct {
+	UID snowflake.ID
+	*EscalationPolicySpecBo
+}
+
+func NewUpdateEscalationPolicyBo(req *apiv1.UpdateEscalationPolicyRequest) (*UpdateEscalationPolicyBo, error) {
+	spec, err := newEscalationPolicySpecBo(req.GetName(), req.GetRemark(), req.GetSteps(), req.GetStrategyGroupUIDs(), req.GetLevelUIDs())
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateEscalationPolicyBo{UID: snowflake.ParseInt64(req.GetUid()), EscalationPolicySpecBo: spec}, nil
+}
+
+type UpdateEscalationPolicyStatusBo struct {
+	UID    snowflake.ID
+	Status enum.GlobalStatus
+}
+
+func NewUpdateEscalationPolicyStatusBo(req *apiv1.UpdateEscalationPolicyStatusRequest) *UpdateEscalationPolicyStatusBo {
+	return &UpdateEscalationPolicyStatusBo{
+		UID:    snowflake.ParseInt64(req.GetUid()),
+		Status: req.GetStatus(),
+	}
+}
+
+// EscalationPolicyBindingBo attaches a policy to a strategy group or to a level, the other uid is 0.
+type EscalationPolicyBindingBo struct {
+	PolicyUID        snowflake.ID
+	StrategyGroupUID snowflake.ID
+	LevelUID         snowflake.ID
+}
+
+type EscalationPolicyItemBo struct {
+	UID               snowflake.ID
+	NamespaceUID      snowflake.ID
+	Name              string
+	Remark            string
+	Steps             []*EscalationStepBo
+	StrategyGroupUIDs []snowflake.ID
+	LevelUIDs         []snowflake.ID
+	Status            enum.GlobalStatus
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (b *EscalationPolicyItemBo) ToAPIV1EscalationPolicyItem() *apiv1.EscalationPolicyItem {
+	steps := make([]*apiv1.EscalationStep, 0, len(b.Steps))
+	for _, step := range b.Steps {
+		steps = append(steps, step.ToAPIV1EscalationStep())
+	}
+	strategyGroupUIDs := make([]int64, 0, len(b.StrategyGroupUIDs))
+	for _, uid := range b.StrategyGroupUIDs {
+		strategyGroupUIDs = append(strategyGroupUIDs, uid.Int64())
+	}
+	levelUIDs := make([]int64, 0, len(b.LevelUIDs))
+	for _, uid := range b.LevelUIDs {
+		levelUIDs = append(levelUIDs, uid.Int64())
+	}
+	return &apiv1.EscalationPolicyItem{
+		Uid:               b.UID.Int64(),
+		Name:              b.Name,
+		Remark:            b.Remark,
+		Steps:             steps,
+		StrategyGroupUIDs: strategyGroupUIDs,
+		LevelUIDs:         levelUIDs,
+		Status:            b.Status,
+		CreatedAt:         b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:         b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+func (b *EscalationPolicyItemBo) ToEscalatorPolicy() *escalator.Policy {
+	steps := make([]*escalator.Step, 0, len(b.Steps))
+	for _, step := range b.Steps {
+		steps = append(steps, &escalator.Step{Delay: step.Delay, ReceiverUIDs: step.ReceiverUIDs})
+	}
+	return &escalator.Policy{UID: b.UID, Steps: steps}
+}
+
+type ListEscalationPolicyBo struct {
+	*PageRequestBo
+	Keyword string
+	Status  enum.GlobalStatus
+}
+
+func NewListEscalationPolicyBo(req *apiv1.ListEscalationPolicyRequest) *ListEscalationPolicyBo {
+	return &ListEscalationPolicyBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		Keyword:       req.GetKeyword(),
+		Status:        req.GetStatus(),
+	}
+}
+
+func ToAPIV1ListEscalationPolicyReply(pageResponseBo *PageResponseBo[*EscalationPolicyItemBo]) *apiv1.ListEscalationPolicyReply {
+	items := make([]*apiv1.EscalationPolicyItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1EscalationPolicyItem())
+	}
+	return &apiv1.ListEscalationPolicyReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
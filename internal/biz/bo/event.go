@@ -0,0 +1,235 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const (
+	EventAnnotationSummary     = "summary"
+	EventAnnotationDescription = "description"
+
+	defaultEventTimelineLimit = 50
+)
+
+// SaveEventBo is one firing or resolved notification of the evaluator to persist.
+type SaveEventBo struct {
+	NamespaceUID  snowflake.ID
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	DatasourceUID snowflake.ID
+	Fingerprint   uint64
+	Labels        map[string]string
+	Annotations   map[string]string
+	Value         float64
+	State         apiv1.EventState
+	StartsAt      time.Time
+	EndsAt        time.Time
+	EvalAt        time.Time
+	Flapping      bool
+}
+
+func NewSaveEventBo(event *evaluator.Event, evalAt time.Time) *SaveEventBo {
+	annotations := make(map[string]string, 2)
+	if event.Summary != "" {
+		annotations[EventAnnotationSummary] = event.Summary
+	}
+	if event.Description != "" {
+		annotations[EventAnnotationDescription] = event.Description
+	}
+	state := apiv1.EventState_FIRING
+	if event.State == evaluator.StateResolved {
+		state = apiv1.EventState_RESOLVED
+	}
+	return &SaveEventBo{
+		NamespaceUID:  event.NamespaceUID,
+		StrategyUID:   event.StrategyUID,
+		LevelUID:      event.LevelUID,
+		DatasourceUID: event.DatasourceUID,
+		Fingerprint:   event.Fingerprint,
+		Labels:        event.Labels,
+		Annotations:   annotations,
+		Value:         event.Value,
+		State:         state,
+		StartsAt:      event.StartsAt,
+		EndsAt:        event.EndsAt,
+		EvalAt:        evalAt,
+		Flapping:      event.Flapping,
+	}
+}
+
+type EventItemBo struct {
+	UID           snowflake.ID
+	NamespaceUID  snowflake.ID
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	DatasourceUID snowflake.ID
+	Fingerprint   string
+	Labels        map[string]string
+	Annotations   map[string]string
+	Value         float64
+	State         apiv1.EventState
+	StartsAt      time.Time
+	EndsAt        time.Time
+	LastEvalAt    time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	AckedBy       snowflake.ID
+	AckedAt       time.Time
+	Assignee      snowflake.ID
+	Flapping      bool
+}
+
+// Acknowledged reports whether a user acknowledged the event while it fires, the flag ends
+// with the event: a resolved series or a new level opens a new event.
+func (b *EventItemBo) Acknowledged() bool {
+	return b.State == apiv1.EventState_FIRING && b.AckedBy != 0
+}
+
+func (b *EventItemBo) ToAPIV1EventItem() *apiv1.EventItem {
+	item := &apiv1.EventItem{
+		Uid:           b.UID.Int64(),
+		StrategyUID:   b.StrategyUID.Int64(),
+		LevelUID:      b.LevelUID.Int64(),
+		DatasourceUID: b.DatasourceUID.Int64(),
+		Fingerprint:   b.Fingerprint,
+		Labels:        b.Labels,
+		Annotations:   b.Annotations,
+		Value:         b.Value,
+		State:         b.State,
+		StartsAt:      b.StartsAt.Format(time.DateTime),
+		LastEvalAt:    b.LastEvalAt.Format(time.DateTime),
+		CreatedAt:     b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:     b.UpdatedAt.Format(time.DateTime),
+		Acknowledged:  b.Acknowledged(),
+		AckedBy:       b.AckedBy.Int64(),
+		Assignee:      b.Assignee.Int64(),
+		Flapping:      b.Flapping,
+	}
+	if !b.EndsAt.IsZero() {
+		item.EndsAt = b.EndsAt.Format(time.DateTime)
+	}
+	if !b.AckedAt.IsZero() {
+		item.AckedAt = b.AckedAt.Format(time.DateTime)
+	}
+	return item
+}
+
+func (b *EventItemBo) ToNotifierMessage() *notifier.Message {
+	msg := &notifier.Message{
+		Version:       notifier.MessageVersion,
+		Status:        notifier.StatusFiring,
+		EventUID:      b.UID,
+		NamespaceUID:  b.NamespaceUID,
+		StrategyUID:   b.StrategyUID,
+		LevelUID:      b.LevelUID,
+		DatasourceUID: b.DatasourceUID,
+		Fingerprint:   b.Fingerprint,
+		Labels:        b.Labels,
+		Annotations:   b.Annotations,
+		Value:         b.Value,
+		StartsAt:      b.StartsAt,
+	}
+	if b.State == apiv1.EventState_RESOLVED {
+		endsAt := b.EndsAt
+		msg.Status = notifier.StatusResolved
+		msg.EndsAt = &endsAt
+	}
+	return msg
+}
+
+type ListEventBo struct {
+	*PageRequestBo
+	State         apiv1.EventState
+	LevelUID      snowflake.ID
+	StrategyUID   snowflake.ID
+	StartTime     time.Time
+	EndTime       time.Time
+	LabelMatchers LabelMatchers
+	Assignee      snowflake.ID
+}
+
+func NewListEventBo(req *apiv1.ListEventRequest) (*ListEventBo, error) {
+	matchers, err := ParseLabelMatchers(req.GetLabelMatchers())
+	if err != nil {
+		return nil, merr.ErrorParams("invalid labelMatchers: %v", err)
+	}
+	b := &ListEventBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		State:         req.GetState(),
+		LevelUID:      snowflake.ParseInt64(req.GetLevelUID()),
+		StrategyUID:   snowflake.ParseInt64(req.GetStrategyUID()),
+		LabelMatchers: matchers,
+		Assignee:      snowflake.ParseInt64(req.GetAssignee()),
+	}
+	if req.GetStartTime() > 0 {
+		b.StartTime = time.Unix(req.GetStartTime(), 0)
+	}
+	if req.GetEndTime() > 0 {
+		b.EndTime = time.Unix(req.GetEndTime(), 0)
+	}
+	if !b.StartTime.IsZero() && !b.EndTime.IsZero() && b.EndTime.Before(b.StartTime) {
+		return nil, merr.ErrorParams("endTime must not be before startTime")
+	}
+	return b, nil
+}
+
+func ToAPIV1ListEventReply(pageResponseBo *PageResponseBo[*EventItemBo]) *apiv1.ListEventReply {
+	items := make([]*apiv1.EventItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1EventItem())
+	}
+	return &apiv1.ListEventReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
+
+type GetEventTimelineBo struct {
+	UID   snowflake.ID
+	Limit int
+}
+
+func NewGetEventTimelineBo(req *apiv1.GetEventTimelineRequest) *GetEventTimelineBo {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultEventTimelineLimit
+	}
+	return &GetEventTimelineBo{
+		UID:   snowflake.ParseInt64(req.GetUid()),
+		Limit: limit,
+	}
+}
+
+// EventTimelineItemBo is one state change in the history of the series an event belongs to.
+type EventTimelineItemBo struct {
+	EventUID snowflake.ID
+	State    apiv1.EventState
+	Value    float64
+	Time     time.Time
+}
+
+func (b *EventTimelineItemBo) ToAPIV1EventTimelineItem() *apiv1.EventTimelineItem {
+	return &apiv1.EventTimelineItem{
+		EventUID: b.EventUID.Int64(),
+		State:    b.State,
+		Value:    b.Value,
+		Time:     b.Time.Format(time.DateTime),
+	}
+}
+
+func ToAPIV1GetEventTimelineReply(list []*EventTimelineItemBo) *apiv1.GetEventTimelineReply {
+	items := make([]*apiv1.EventTimelineItem, 0, len(list))
+	for _, item := range list {
+		items = append(items, item.ToAPIV1EventTimelineItem())
+	}
+	return &apiv1.GetEventTimelineReply{Items: items}
+}
@@ -0,0 +1,126 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const defaultEventActivityLimit = 50
+
+// EventActivityBo is one action of a user on an event, the actor is the user of the request.
+type EventActivityBo struct {
+	EventUID snowflake.ID
+	Type     apiv1.EventActivityType
+	Assignee snowflake.ID
+	Comment  string
+	Time     time.Time
+}
+
+func NewAckEventActivityBo(req *apiv1.AckEventRequest) *EventActivityBo {
+	return &EventActivityBo{
+		EventUID: snowflake.ParseInt64(req.GetUid()),
+		Type:     apiv1.EventActivityType_ACKNOWLEDGE,
+		Comment:  req.GetComment(),
+		Time:     time.Now(),
+	}
+}
+
+// NewAssignEventActivityBo assigns the event to the assignee, a zero assignee unassigns it.
+func NewAssignEventActivityBo(req *apiv1.AssignEventRequest) *EventActivityBo {
+	return &EventActivityBo{
+		EventUID: snowflake.ParseInt64(req.GetUid()),
+		Type:     apiv1.EventActivityType_ASSIGN,
+		Assignee: snowflake.ParseInt64(req.GetAssignee()),
+		Comment:  req.GetComment(),
+		Time:     time.Now(),
+	}
+}
+
+func NewNoteEventActivityBo(req *apiv1.AddEventNoteRequest) *EventActivityBo {
+	return &EventActivityBo{
+		EventUID: snowflake.ParseInt64(req.GetUid()),
+		Type:     apiv1.EventActivityType_NOTE,
+		Comment:  req.GetComment(),
+		Time:     time.Now(),
+	}
+}
+
+func NewResolveEventActivityBo(req *apiv1.ResolveEventRequest) *EventActivityBo {
+	return &EventActivityBo{
+		EventUID: snowflake.ParseInt64(req.GetUid()),
+		Type:     apiv1.EventActivityType_MANUAL_RESOLVE,
+		Comment:  req.GetComment(),
+		Time:     time.Now(),
+	}
+}
+
+// Check tells whether the activity applies to the event: only a firing event can be
+// acknowledged or resolved, and only once.
+func (b *EventActivityBo) Check(event *EventItemBo) error {
+	switch b.Type {
+	case apiv1.EventActivityType_ACKNOWLEDGE:
+		if event.State != apiv1.EventState_FIRING {
+			return merr.ErrorParams("event %d is not firing", event.UID.Int64())
+		}
+		if event.Acknowledged() {
+			return merr.ErrorParams("event %d is already acknowledged", event.UID.Int64())
+		}
+	case apiv1.EventActivityType_MANUAL_RESOLVE:
+		if event.State != apiv1.EventState_FIRING {
+			return merr.ErrorParams("event %d is not firing", event.UID.Int64())
+		}
+	case apiv1.EventActivityType_NOTE:
+		if b.Comment == "" {
+			return merr.ErrorParams("comment is required")
+		}
+	}
+	return nil
+}
+
+type EventActivityItemBo struct {
+	EventUID snowflake.ID
+	Type     apiv1.EventActivityType
+	Actor    snowflake.ID
+	Assignee snowflake.ID
+	Comment  string
+	Time     time.Time
+}
+
+func (b *EventActivityItemBo) ToAPIV1EventActivityItem() *apiv1.EventActivityItem {
+	return &apiv1.EventActivityItem{
+		EventUID: b.EventUID.Int64(),
+		Type:     b.Type,
+		Actor:    b.Actor.Int64(),
+		Assignee: b.Assignee.Int64(),
+		Comment:  b.Comment,
+		Time:     b.Time.Format(time.DateTime),
+	}
+}
+
+type ListEventActivityBo struct {
+	UID   snowflake.ID
+	Limit int
+}
+
+func NewListEventActivityBo(req *apiv1.ListEventActivityRequest) *ListEventActivityBo {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultEventActivityLimit
+	}
+	return &ListEventActivityBo{
+		UID:   snowflake.ParseInt64(req.GetUid()),
+		Limit: limit,
+	}
+}
+
+func ToAPIV1ListEventActivityReply(list []*EventActivityItemBo) *apiv1.ListEventActivityReply {
+	items := make([]*apiv1.EventActivityItem, 0, len(list))
+	for _, item := range list {
+		items = append(items, item.ToAPIV1EventActivityItem())
+	}
+	return &apiv1.ListEventActivityReply{Items: items}
+}
@@ -0,0 +1,197 @@
+package bo
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/inhibitor"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+var labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// InhibitRuleSpecBo is the part of an inhibit rule shared by create and update.
+type InhibitRuleSpecBo struct {
+	Name           string
+	Remark         string
+	SourceLevelUID snowflake.ID
+	SourceMatchers LabelMatchers
+	TargetLevelUID snowflake.ID
+	TargetMatchers LabelMatchers
+	Equal          []string
+}
+
+func newInhibitRuleSpecBo(name, remark string, sourceLevelUID int64, sourceMatchers []string, targetLevelUID int64, targetMatchers, equal []string) (*InhibitRuleSpecBo, error) {
+	source, err := ParseLabelMatchers(sourceMatchers)
+	if err != nil {
+		return nil, merr.ErrorParams("invalid sourceMatchers: %v", err)
+	}
+	target, err := ParseLabelMatchers(targetMatchers)
+	if err != nil {
+		return nil, merr.ErrorParams("invalid targetMatchers: %v", err)
+	}
+	for _, label := range equal {
+		if !labelNameRegexp.MatchString(label) {
+			return nil, merr.ErrorParams("invalid equal label name %q", label)
+		}
+	}
+	return &InhibitRuleSpecBo{
+		Name:           name,
+		Remark:         remark,
+		SourceLevelUID: snowflake.ParseInt64(sourceLevelUID),
+		SourceMatchers: source,
+		TargetLevelUID: snowflake.ParseInt64(targetLevelUID),
+		TargetMatchers: target,
+		Equal:          equal,
+	}, nil
+}
+
+type CreateInhibitRuleBo struct {
+	*InhibitRuleSpecBo
+}
+
+func NewCreateInhibitRuleBo(req *apiv1.CreateInhibitRuleRequest) (*CreateInhibitRuleBo, error) {
+	spec, err := newInhibitRuleSpecBo(req.GetName(), req.GetRemark(),
+		req.GetSourceLevelUID(), req.GetSourceMatchers(),
+		req.GetTargetLevelUID(), req.GetTargetMatchers(), req.GetEqual())
+	if err != nil {
+		return nil, err
+	}
+	return &CreateInhibitRuleBo{InhibitRuleSpecBo: spec}, nil
+}
+
+type UpdateInhibitRuleBo struct {
+	UID snowflake.ID
+	*InhibitRuleSpecBo
+}
+
+func NewUpdateInhibitRuleBo(req *apiv1.UpdateInhibitRuleRequest) (*UpdateInhibitRuleBo, error) {
+	spec, err := newInhibitRuleSpecBo(req.GetName(), req.GetRemark(),
+		req.GetSourceLevelUID(), req.GetSourceMatchers(),
+		req.GetTargetLevelUID(), req.GetTargetMatchers(), req.GetEqual())
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateInhibitRuleBo{UID: snowflake.ParseInt64(req.GetUid()), InhibitRuleSpecBo: spec}, nil
+}
+
+type UpdateInhibitRuleStatusBo struct {
+	UID    snowflake.ID
+	Status enum.GlobalStatus
+}
+
+func NewUpdateInhibitRuleStatusBo(req *apiv1.UpdateInhibitRuleStatusRequest) *UpdateInhibitRuleStatusBo {
+	return &UpdateInhibitRuleStatusBo{
+		UID:    snowflake.ParseInt64(req.GetUid()),
+		Status: req.GetStatus(),
+	}
+}
+
+type InhibitRuleItemBo struct {
+	UID            snowflake.ID
+	NamespaceUID   snowflake.ID
+	Name           string
+	Remark         string
+	SourceLevelUID snowflake.ID
+	SourceMatchers LabelMatchers
+	TargetLevelUID snowflake.ID
+	TargetMatchers LabelMatchers
+	Equal          []string
+	Status         enum.GlobalStatus
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (b *InhibitRuleItemBo) ToAPIV1InhibitRuleItem() *apiv1.InhibitRuleItem {
+	return &apiv1.InhibitRuleItem{
+		Uid:            b.UID.Int64(),
+		Name:           b.Name,
+		Remark:         b.Remark,
+		SourceLevelUID: b.SourceLevelUID.Int64(),
+		SourceMatchers: labelMatcherStrings(b.SourceMatchers),
+		TargetLevelUID: b.TargetLevelUID.Int64(),
+		TargetMatchers: labelMatcherStrings(b.TargetMatchers),
+		Equal:          b.Equal,
+		Status:         b.Status,
+		CreatedAt:      b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:      b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+func (b *InhibitRuleItemBo) ToInhibitorRule() *inhibitor.Rule {
+	return &inhibitor.Rule{
+		UID:            b.UID,
+		SourceLevelUID: b.SourceLevelUID,
+		SourceMatcher:  b.SourceMatchers,
+		TargetLevelUID: b.TargetLevelUID,
+		TargetMatcher:  b.TargetMatchers,
+		Equal:          b.Equal,
+	}
+}
+
+type ListInhibitRuleBo struct {
+	*PageRequestBo
+	Keyword  string
+	LevelUID snowflake.ID
+	Status   enum.GlobalStatus
+}
+
+func NewListInhibitRuleBo(req *apiv1.ListInhibitRuleRequest) *ListInhibitRuleBo {
+	return &ListInhibitRuleBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		Keyword:       req.GetKeyword(),
+		LevelUID:      snowflake.ParseInt64(req.GetLevelUID()),
+		Status:        req.GetStatus(),
+	}
+}
+
+func ToAPIV1ListInhibitRuleReply(pageResponseBo *PageResponseBo[*InhibitRuleItemBo]) *apiv1.ListInhibitRuleReply {
+	items := make([]*apiv1.InhibitRuleItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1InhibitRuleItem())
+	}
+	return &apiv1.ListInhibitRuleReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
+
+func NewEvaluateInhibitionAlerts(req *apiv1.EvaluateInhibitionRequest) []*inhibitor.Alert {
+	alerts := make([]*inhibitor.Alert, 0, len(req.GetAlerts()))
+	for _, alert := range req.GetAlerts() {
+		alerts = append(alerts, &inhibitor.Alert{
+			Fingerprint: alert.GetFingerprint(),
+			LevelUID:    snowflake.ParseInt64(alert.GetLevelUID()),
+			Labels:      alert.GetLabels(),
+		})
+	}
+	return alerts
+}
+
+func ToAPIV1EvaluateInhibitionReply(results []*inhibitor.Result) *apiv1.EvaluateInhibitionReply {
+	items := make([]*apiv1.InhibitionResult, 0, len(results))
+	for _, result := range results {
+		items = append(items, &apiv1.InhibitionResult{
+			Fingerprint:       result.Alert.Fingerprint,
+			Inhibited:         result.Inhibited,
+			RuleUID:           result.RuleUID.Int64(),
+			SourceFingerprint: result.Source,
+		})
+	}
+	return &apiv1.EvaluateInhibitionReply{Results: items}
+}
+
+// ToInhibitorAlert returns the event as an alert of the inhibitor.
+func (b *EventItemBo) ToInhibitorAlert() *inhibitor.Alert {
+	return &inhibitor.Alert{
+		Fingerprint: b.Fingerprint,
+		LevelUID:    b.LevelUID,
+		Labels:      b.Labels,
+	}
+}
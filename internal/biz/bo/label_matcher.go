@@ -0,0 +1,128 @@
+package bo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type MatchType string
+
+const (
+	MatchEqual     MatchType = "="
+	MatchNotEqual  MatchType = "!="
+	MatchRegexp    MatchType = "=~"
+	MatchNotRegexp MatchType = "!~"
+)
+
+// LabelMatcher matches the value of one label, like a Prometheus selector matcher.
+type LabelMatcher struct {
+	Name  string
+	Type  MatchType
+	Value string
+
+	re *regexp.Regexp
+}
+
+func NewLabelMatcher(name string, matchType MatchType, value string) (*LabelMatcher, error) {
+	if name == "" {
+		return nil, fmt.Errorf("label matcher name is required")
+	}
+	m := &LabelMatcher{Name: name, Type: matchType, Value: value}
+	switch matchType {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegexp, MatchNotRegexp:
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("label matcher %s has invalid regexp: %w", name, err)
+		}
+		m.re = re
+	default:
+		return nil, fmt.Errorf("label matcher %s has unknown type %q", name, matchType)
+	}
+	return m, nil
+}
+
+// ParseLabelMatcher parses a matcher written as name=value, name!=value,
+// name=~regexp or name!~regexp, the value may be double quoted.
+func ParseLabelMatcher(s string) (*LabelMatcher, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexAny(s, "=!")
+	if i <= 0 {
+		return nil, fmt.Errorf("invalid label matcher %q", s)
+	}
+	name, rest := strings.TrimSpace(s[:i]), s[i:]
+	var matchType MatchType
+	switch {
+	case strings.HasPrefix(rest, string(MatchRegexp)):
+		matchType = MatchRegexp
+	case strings.HasPrefix(rest, string(MatchNotRegexp)):
+		matchType = MatchNotRegexp
+	case strings.HasPrefix(rest, string(MatchNotEqual)):
+		matchType = MatchNotEqual
+	case strings.HasPrefix(rest, string(MatchEqual)):
+		matchType = MatchEqual
+	default:
+		return nil, fmt.Errorf("invalid label matcher %q", s)
+	}
+	value := strings.TrimSpace(rest[len(matchType):])
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label matcher %q: %w", s, err)
+		}
+		value = unquoted
+	}
+	return NewLabelMatcher(name, matchType, value)
+}
+
+func (m *LabelMatcher) Matches(value string) bool {
+	switch m.Type {
+	case MatchEqual:
+		return value == m.Value
+	case MatchNotEqual:
+		return value != m.Value
+	case MatchRegexp:
+		return m.re.MatchString(value)
+	case MatchNotRegexp:
+		return !m.re.MatchString(value)
+	}
+	return false
+}
+
+func (m *LabelMatcher) String() string {
+	return m.Name + string(m.Type) + strconv.Quote(m.Value)
+}
+
+type LabelMatchers []*LabelMatcher
+
+func ParseLabelMatchers(list []string) (LabelMatchers, error) {
+	matchers := make(LabelMatchers, 0, len(list))
+	for _, s := range list {
+		m, err := ParseLabelMatcher(s)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
+
+func labelMatcherStrings(matchers LabelMatchers) []string {
+	list := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		list = append(list, m.String())
+	}
+	return list
+}
+
+// Matches reports whether labels satisfy every matcher, a missing label has the empty value.
+func (ms LabelMatchers) Matches(labels map[string]string) bool {
+	for _, m := range ms {
+		if !m.Matches(labels[m.Name]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package bo_test
+
+import (
+	"testing"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+func TestParseLabelMatcher(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+	}{
+		{`job=node`, `job="node"`},
+		{` job = "node" `, `job="node"`},
+		{`job!=node`, `job!="node"`},
+		{`instance=~"a|b"`, `instance=~"a|b"`},
+		{`instance!~a.*`, `instance!~"a.*"`},
+		{`msg="a \"quoted\" value"`, `msg="a \"quoted\" value"`},
+		{`env=`, `env=""`},
+	} {
+		m, err := bo.ParseLabelMatcher(tc.in)
+		if err != nil {
+			t.Errorf("parse %q: %v", tc.in, err)
+			continue
+		}
+		if got := m.String(); got != tc.want {
+			t.Errorf("parse %q: got %s, want %s", tc.in, got, tc.want)
+		}
+	}
+	for _, in := range []string{``, `job`, `=node`, `job~node`, `job="node`, `instance=~"(a"`} {
+		if _, err := bo.ParseLabelMatcher(in); err == nil {
+			t.Errorf("parse %q: want an error", in)
+		}
+	}
+	if _, err := bo.NewLabelMatcher("job", "==", "node"); err == nil {
+		t.Error("want an error for an unknown match type")
+	}
+}
+
+func TestLabelMatchersMatches(t *testing.T) {
+	labels := map[string]string{"job": "node", "instance": "host-1"}
+	for _, tc := range []struct {
+		matchers []string
+		want     bool
+	}{
+		{[]string{`job="node"`}, true},
+		{[]string{`job="node"`, `instance="host-2"`}, false},
+		{[]string{`job!="api"`}, true},
+		// regexps are anchored at both ends
+		{[]string{`instance=~"host-\\d"`}, true},
+		{[]string{`instance=~"host"`}, false},
+		{[]string{`instance=~"ost-1"`}, false},
+		{[]string{`instance!~"db-.*"`}, true},
+		// a missing label has the empty value
+		{[]string{`env=""`}, true},
+		{[]string{`env!=""`}, false},
+		{[]string{`env=~"prod|"`}, true},
+		{[]string{`env="prod"`}, false},
+		{nil, true},
+	} {
+		matchers, err := bo.ParseLabelMatchers(tc.matchers)
+		if err != nil {
+			t.Fatalf("parse %v: %v", tc.matchers, err)
+		}
+		if got := matchers.Matches(labels); got != tc.want {
+			t.Errorf("%v: got %v, want %v", tc.matchers, got, tc.want)
+		}
+	}
+}
@@ -57,6 +57,7 @@ type LevelItemBo struct {
 	Remark    string
 	Status    enum.GlobalStatus
 	Metadata  map[string]string
+	Managed   bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -68,6 +69,7 @@ func (b *LevelItemBo) ToAPIV1LevelItem() *apiv1.LevelItem {
 		Remark:    b.Remark,
 		Status:    b.Status,
 		Metadata:  b.Metadata,
+		Managed:   b.Managed,
 		CreatedAt: b.CreatedAt.Format(time.DateTime),
 		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
 	}
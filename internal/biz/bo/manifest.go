@@ -0,0 +1,354 @@
+package bo
+
+import (
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"buf.build/go/protoyaml"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/aide-family/marksman/internal/biz/manifest"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// maxRemarkLength is the length of the remark columns.
+const maxRemarkLength = 100
+
+// ApplyManifestsBo is the desired state of a namespace, every resource of a kind is
+// matched by name. NamespaceUID and Creator are who the manifests are applied as.
+type ApplyManifestsBo struct {
+	NamespaceUID   snowflake.ID
+	Creator        snowflake.ID
+	Levels         []*ManifestLevelBo
+	Datasources    []*ManifestDatasourceBo
+	StrategyGroups []*ManifestStrategyGroupBo
+	Strategies     []*ManifestStrategyBo
+	DryRun         bool
+	Prune          bool
+}
+
+func NewApplyManifestsBo(req *apiv1.ApplyManifestsRequest) (*ApplyManifestsBo, error) {
+	files := make([]*manifest.File, 0, len(req.GetFiles()))
+	for i, file := range req.GetFiles() {
+		name := file.GetName()
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		files = append(files, &manifest.File{Name: name, Content: []byte(file.GetContent())})
+	}
+	return NewApplyManifestsBoFromFiles(files, req.GetDryRun(), req.GetPrune())
+}
+
+// NewApplyManifestsBoFromFiles parses and validates the manifests of files, the
+// namespace and creator are left to the caller.
+func NewApplyManifestsBoFromFiles(files []*manifest.File, dryRun, prune bool) (*ApplyManifestsBo, error) {
+	var manifests []*manifest.Manifest
+	for _, file := range files {
+		list, err := manifest.Parse(file.Name, file.Content)
+		if err != nil {
+			return nil, merr.ErrorParams("invalid manifest %v", err)
+		}
+		manifests = append(manifests, list...)
+	}
+	if err := manifest.Check(manifests); err != nil {
+		return nil, merr.ErrorParams("%v", err)
+	}
+	b := &ApplyManifestsBo{DryRun: dryRun, Prune: prune}
+	for _, m := range manifests {
+		if err := b.add(m); err != nil {
+			return nil, ManifestError(m.Source, m.Kind, m.Metadata.Name, err)
+		}
+	}
+	return b, nil
+}
+
+func (b *ApplyManifestsBo) add(m *manifest.Manifest) error {
+	switch m.Kind {
+	case manifest.KindLevel:
+		spec := &apiv1.LevelSpec{}
+		if err := unmarshalManifestSpec(m, spec); err != nil {
+			return err
+		}
+		if utf8.RuneCountInString(spec.GetRemark()) > maxRemarkLength {
+			return merr.ErrorParams("remark is longer than %d characters", maxRemarkLength)
+		}
+		b.Levels = append(b.Levels, &ManifestLevelBo{
+			Source:   m.Source,
+			Name:     m.Metadata.Name,
+			Remark:   spec.GetRemark(),
+			Metadata: spec.GetMetadata(),
+			Status:   manifestStatus(spec.GetStatus()),
+		})
+	case manifest.KindDatasource:
+		spec := &apiv1.DatasourceSpec{}
+		if err := unmarshalManifestSpec(m, spec); err != nil {
+			return err
+		}
+		datasource := &ManifestDatasourceBo{
+			Source:   m.Source,
+			Name:     m.Metadata.Name,
+			Type:     spec.GetType(),
+			Driver:   spec.GetDriver(),
+			Metadata: spec.GetMetadata(),
+			Config:   NewDatasourceConfigBo(spec.GetConfig()),
+		}
+		if err := validateDatasourceConfig(datasource.Type, datasource.Driver, datasource.Config); err != nil {
+			return err
+		}
+		b.Datasources = append(b.Datasources, datasource)
+	case manifest.KindStrategyGroup:
+		spec := &apiv1.StrategyGroupSpec{}
+		if err := unmarshalManifestSpec(m, spec); err != nil {
+			return err
+		}
+		if utf8.RuneCountInString(spec.GetRemark()) > maxRemarkLength {
+			return merr.ErrorParams("remark is longer than %d characters", maxRemarkLength)
+		}
+		b.StrategyGroups = append(b.StrategyGroups, &ManifestStrategyGroupBo{
+			Source:   m.Source,
+			Name:     m.Metadata.Name,
+			Remark:   spec.GetRemark(),
+			Metadata: spec.GetMetadata(),
+			Status:   manifestStatus(spec.GetStatus()),
+		})
+	case manifest.KindStrategy:
+		spec := &apiv1.StrategySpec{}
+		if err := unmarshalManifestSpec(m, spec); err != nil {
+			return err
+		}
+		strategy, err := newManifestStrategyBo(m, spec)
+		if err != nil {
+			return err
+		}
+		b.Strategies = append(b.Strategies, strategy)
+	}
+	return nil
+}
+
+func newManifestStrategyBo(m *manifest.Manifest, spec *apiv1.StrategySpec) (*ManifestStrategyBo, error) {
+	if spec.GetStrategyGroup() == "" {
+		return nil, merr.ErrorParams("strategyGroup is required")
+	}
+	if utf8.RuneCountInString(spec.GetRemark()) > maxRemarkLength {
+		return nil, merr.ErrorParams("remark is longer than %d characters", maxRemarkLength)
+	}
+	strategy := &ManifestStrategyBo{
+		Source:        m.Source,
+		Name:          m.Metadata.Name,
+		StrategyGroup: spec.GetStrategyGroup(),
+		Remark:        spec.GetRemark(),
+		Type:          spec.GetType(),
+		Driver:        spec.GetDriver(),
+		Metadata:      spec.GetMetadata(),
+		Status:        manifestStatus(spec.GetStatus()),
+	}
+	metric := spec.GetMetric()
+	if metric == nil {
+		return strategy, nil
+	}
+	// a metric is only evaluated against prometheus compatible datasources
+	if strategy.Type == enum.DatasourceType_DatasourceType_UNKNOWN {
+		strategy.Type = enum.DatasourceType_METRICS
+	}
+	if strategy.Driver == enum.DatasourceDriver_DatasourceDriver_UNKNOWN {
+		strategy.Driver = enum.DatasourceDriver_METRICS_PROMETHEUS
+	}
+	if strategy.Type != enum.DatasourceType_METRICS {
+		return nil, merr.ErrorParams("metric requires type %s, got %s", enum.DatasourceType_METRICS, strategy.Type)
+	}
+	if metric.GetExpr() == "" {
+		return nil, merr.ErrorParams("metric.expr is required")
+	}
+	if _, err := parseExpr(metric.GetExpr()); err != nil {
+		return nil, err
+	}
+	if err := validateTemplate("summary", metric.GetSummary()); err != nil {
+		return nil, err
+	}
+	if err := validateTemplate("description", metric.GetDescription()); err != nil {
+		return nil, err
+	}
+	for i, name := range metric.GetDatasources() {
+		if name == "" || slices.Contains(metric.GetDatasources()[:i], name) {
+			return nil, merr.ErrorParams("metric.datasources[%d] %q is empty or repeated", i, name)
+		}
+	}
+	strategy.Metric = &ManifestStrategyMetricBo{
+		SaveStrategyMetricBo: SaveStrategyMetricBo{
+			Expr:        metric.GetExpr(),
+			Labels:      metric.GetLabels(),
+			Summary:     metric.GetSummary(),
+			Description: metric.GetDescription(),
+			Status:      manifestStatus(metric.GetStatus()),
+		},
+		Datasources: metric.GetDatasources(),
+	}
+	for i, level := range metric.GetLevels() {
+		if level.GetLevel() == "" {
+			return nil, merr.ErrorParams("metric.levels[%d].level is required", i)
+		}
+		for _, l := range strategy.Metric.Levels {
+			if l.Level == level.GetLevel() {
+				return nil, merr.ErrorParams("metric.levels[%d] repeats level %q", i, level.GetLevel())
+			}
+		}
+		item := &ManifestStrategyMetricLevelBo{
+			Level: level.GetLevel(),
+			SaveStrategyMetricLevelBo: SaveStrategyMetricLevelBo{
+				Mode:          level.GetMode(),
+				Condition:     level.GetCondition(),
+				Values:        level.GetValues(),
+				Duration:      level.GetDuration().AsDuration(),
+				FlapWindow:    level.GetFlapWindow().AsDuration(),
+				FlapThreshold: level.GetFlapThreshold(),
+				Status:        manifestStatus(level.GetStatus()),
+			},
+		}
+		if item.Mode == enum.SampleMode_SampleMode_UNKNOWN {
+			item.Mode = enum.SampleMode_SAMPLE_MODE_FOR
+		}
+		if err := item.validate(); err != nil {
+			return nil, err
+		}
+		strategy.Metric.Levels = append(strategy.Metric.Levels, item)
+	}
+	return strategy, nil
+}
+
+func unmarshalManifestSpec(m *manifest.Manifest, spec proto.Message) error {
+	if len(m.Spec) == 0 {
+		return nil
+	}
+	if err := (protoyaml.UnmarshalOptions{Path: "spec"}).Unmarshal(m.Spec, spec); err != nil {
+		return merr.ErrorParams("%v", err)
+	}
+	return nil
+}
+
+// ManifestError prefixes the message of err with the manifest it was found in.
+func ManifestError(source, kind, name string, err error) error {
+	return merr.ErrorParams("%s: %s %q: %s", source, kind, name, errors.FromError(err).GetMessage())
+}
+
+// manifestStatus enables what a manifest leaves without a status.
+func manifestStatus(status enum.GlobalStatus) enum.GlobalStatus {
+	if status == enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		return enum.GlobalStatus_ENABLED
+	}
+	return status
+}
+
+// ManifestLevelBo is a level of the manifests, a zero UID creates it.
+type ManifestLevelBo struct {
+	UID      snowflake.ID
+	Source   string
+	Name     string
+	Remark   string
+	Metadata map[string]string
+	Status   enum.GlobalStatus
+}
+
+// ManifestDatasourceBo is a datasource of the manifests, a zero UID creates it.
+// The status of an existing datasource is kept.
+type ManifestDatasourceBo struct {
+	UID      snowflake.ID
+	Source   string
+	Name     string
+	Type     enum.DatasourceType
+	Driver   enum.DatasourceDriver
+	Metadata map[string]string
+	Config   *DatasourceConfigBo
+}
+
+// ManifestStrategyGroupBo is a strategy group of the manifests, a zero UID creates it.
+type ManifestStrategyGroupBo struct {
+	UID      snowflake.ID
+	Source   string
+	Name     string
+	Remark   string
+	Metadata map[string]string
+	Status   enum.GlobalStatus
+}
+
+// ManifestStrategyBo is a strategy of the manifests, a zero UID creates it. Its group,
+// levels and datasources are referenced by name, they are resolved to UIDs once they
+// are saved. A nil Metric deletes the metric of the strategy.
+type ManifestStrategyBo struct {
+	UID           snowflake.ID
+	Source        string
+	Name          string
+	StrategyGroup string
+	Remark        string
+	Type          enum.DatasourceType
+	Driver        enum.DatasourceDriver
+	Metadata      map[string]string
+	Status        enum.GlobalStatus
+	Metric        *ManifestStrategyMetricBo
+}
+
+type ManifestStrategyMetricBo struct {
+	SaveStrategyMetricBo
+	Datasources []string
+	Levels      []*ManifestStrategyMetricLevelBo
+}
+
+type ManifestStrategyMetricLevelBo struct {
+	SaveStrategyMetricLevelBo
+	Level string
+}
+
+// ManifestStateBo is the namespace manifests are applied to, the metrics are keyed by strategy.
+// Datasource configs are decrypted.
+type ManifestStateBo struct {
+	Levels         []*LevelItemBo
+	Datasources    []*DatasourceItemBo
+	StrategyGroups []*StrategyGroupItemBo
+	Strategies     []*StrategyItemBo
+	Metrics        map[snowflake.ID]*StrategyMetricItemBo
+}
+
+// ManifestPlanBo is what applying manifests saves and deletes, everything saved is
+// marked as managed.
+type ManifestPlanBo struct {
+	NamespaceUID            snowflake.ID
+	Creator                 snowflake.ID
+	Levels                  []*ManifestLevelBo
+	Datasources             []*ManifestDatasourceBo
+	StrategyGroups          []*ManifestStrategyGroupBo
+	Strategies              []*ManifestStrategyBo
+	DeleteLevelUIDs         []snowflake.ID
+	DeleteDatasourceUIDs    []snowflake.ID
+	DeleteStrategyGroupUIDs []snowflake.ID
+	DeleteStrategyUIDs      []snowflake.ID
+}
+
+// IsEmpty reports whether the plan changes nothing.
+func (b *ManifestPlanBo) IsEmpty() bool {
+	return len(b.Levels) == 0 && len(b.Datasources) == 0 && len(b.StrategyGroups) == 0 && len(b.Strategies) == 0 &&
+		len(b.DeleteLevelUIDs) == 0 && len(b.DeleteDatasourceUIDs) == 0 && len(b.DeleteStrategyGroupUIDs) == 0 && len(b.DeleteStrategyUIDs) == 0
+}
+
+// SyncManifestsBo applies the manifests of a directory as a namespace and creator.
+type SyncManifestsBo struct {
+	Dir          string
+	NamespaceUID snowflake.ID
+	Creator      snowflake.ID
+	Prune        bool
+	ExpandEnv    bool
+}
+
+func ToAPIV1ApplyManifestsReply(dryRun bool, changes []*ResourceChangeBo) *apiv1.ApplyManifestsReply {
+	items := make([]*apiv1.ResourceChange, 0, len(changes))
+	for _, change := range changes {
+		items = append(items, change.ToAPIV1ResourceChange())
+	}
+	return &apiv1.ApplyManifestsReply{
+		DryRun:  dryRun,
+		Changes: items,
+	}
+}
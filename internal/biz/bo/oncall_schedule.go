@@ -0,0 +1,333 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/oncall"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const (
+	// handoffTimeLayout is the layout of the handoff time of a schedule.
+	handoffTimeLayout = "15:04"
+	// defaultOnCallShiftsCount is the number of shifts previewed when none is asked.
+	defaultOnCallShiftsCount = 10
+)
+
+// OnCallScheduleSpecBo is the part of an on-call schedule shared by create and update.
+type OnCallScheduleSpecBo struct {
+	Name           string
+	Remark         string
+	TimeZone       string
+	RotationType   apiv1.OnCallRotationType
+	HandoffTime    string
+	HandoffWeekday int32
+	StartsAt       time.Time
+	Members        []snowflake.ID
+}
+
+func newOnCallScheduleSpecBo(name, remark, timeZone string, rotationType apiv1.OnCallRotationType, handoffTime string, handoffWeekday int32, startsAt int64, members []int64) (*OnCallScheduleSpecBo, error) {
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return nil, merr.ErrorParams("invalid timeZone %q", timeZone)
+	}
+	if _, err := time.Parse(handoffTimeLayout, handoffTime); err != nil {
+		return nil, merr.ErrorParams("invalid handoffTime %q, want hh:mm", handoffTime)
+	}
+	if handoffWeekday < int32(time.Sunday) || handoffWeekday > int32(time.Saturday) {
+		return nil, merr.ErrorParams("handoffWeekday must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	memberUIDs := toUniqueUIDs(members)
+	if len(memberUIDs) == 0 {
+		return nil, merr.ErrorParams("members are required")
+	}
+	return &OnCallScheduleSpecBo{
+		Name:           name,
+		Remark:         remark,
+		TimeZone:       timeZone,
+		RotationType:   rotationType,
+		HandoffTime:    handoffTime,
+		HandoffWeekday: handoffWeekday,
+		StartsAt:       time.Unix(startsAt, 0),
+		Members:        memberUIDs,
+	}, nil
+}
+
+type CreateOnCallScheduleBo struct {
+	*OnCallScheduleSpecBo
+}
+
+func NewCreateOnCallScheduleBo(req *apiv1.CreateOnCallScheduleRequest) (*CreateOnCallScheduleBo, error) {
+	spec, err := newOnCallScheduleSpecBo(req.GetName(), req.GetRemark(), req.GetTimeZone(), req.GetRotationType(),
+		req.GetHandoffTime(), req.GetHandoffWeekday(), req.GetStartsAt(), req.GetMembers())
+	if err != nil {
+		return nil, err
+	}
+	return &CreateOnCallScheduleBo{OnCallScheduleSpecBo: spec}, nil
+}
+
+type UpdateOnCallScheduleBo struct {
+	UID snowflake.ID
+	*OnCallScheduleSpecBo
+}
+
+func NewUpdateOnCallScheduleBo(req *apiv1.UpdateOnCallScheduleRequest) (*UpdateOnCallScheduleBo, error) {
+	spec, err := newOnCallScheduleSpecBo(req.GetName(), req.GetRemark(), req.GetTimeZone(), req.GetRotationType(),
+		req.GetHandoffTime(), req.GetHandoffWeekday(), req.GetStartsAt(), req.GetMembers())
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateOnCallScheduleBo{UID: snowflake.ParseInt64(req.GetUid()), OnCallScheduleSpecBo: spec}, nil
+}
+
+type UpdateOnCallScheduleStatusBo struct {
+	UID    snowflake.ID
+	Status enum.GlobalStatus
+}
+
+func NewUpdateOnCallScheduleStatusBo(req *apiv1.UpdateOnCallScheduleStatusRequest) *UpdateOnCallScheduleStatusBo {
+	return &UpdateOnCallScheduleStatusBo{
+		UID:    snowflake.ParseInt64(req.GetUid()),
+		Status: req.GetStatus(),
+	}
+}
+
+type OnCallScheduleItemBo struct {
+	UID            snowflake.ID
+	NamespaceUID   snowflake.ID
+	Name           string
+	Remark         string
+	TimeZone       string
+	RotationType   apiv1.OnCallRotationType
+	HandoffTime    string
+	HandoffWeekday int32
+	StartsAt       time.Time
+	Members        []snowflake.ID
+	Status         enum.GlobalStatus
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	// Overrides are the overrides loaded with the schedule, in the order they were created.
+	Overrides []*OnCallOverrideItemBo
+}
+
+// Location returns the time zone of the schedule, UTC when it is no longer known.
+func (b *OnCallScheduleItemBo) Location() *time.Location {
+	loc, err := time.LoadLocation(b.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (b *OnCallScheduleItemBo) ToAPIV1OnCallScheduleItem() *apiv1.OnCallScheduleItem {
+	members := make([]int64, 0, len(b.Members))
+	for _, uid := range b.Members {
+		members = append(members, uid.Int64())
+	}
+	return &apiv1.OnCallScheduleItem{
+		Uid:            b.UID.Int64(),
+		Name:           b.Name,
+		Remark:         b.Remark,
+		TimeZone:       b.TimeZone,
+		RotationType:   b.RotationType,
+		HandoffTime:    b.HandoffTime,
+		HandoffWeekday: b.HandoffWeekday,
+		StartsAt:       b.StartsAt.In(b.Location()).Format(time.DateTime),
+		Members:        members,
+		Status:         b.Status,
+		CreatedAt:      b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:      b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+// ToOnCallSchedule returns the schedule with its loaded overrides, the later overrides take precedence.
+func (b *OnCallScheduleItemBo) ToOnCallSchedule() *oncall.Schedule {
+	rotationType := oncall.Daily
+	if b.RotationType == apiv1.OnCallRotationType_ROTATION_WEEKLY {
+		rotationType = oncall.Weekly
+	}
+	var handoff time.Duration
+	if t, err := time.Parse(handoffTimeLayout, b.HandoffTime); err == nil {
+		handoff = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	overrides := make([]*oncall.Override, 0, len(b.Overrides))
+	for _, o := range b.Overrides {
+		overrides = append(overrides, &oncall.Override{Start: o.StartsAt, End: o.EndsAt, ReceiverUID: o.ReceiverUID})
+	}
+	return &oncall.Schedule{
+		Location: b.Location(),
+		Rotation: oncall.Rotation{
+			Type:    rotationType,
+			Handoff: handoff,
+			Weekday: time.Weekday(b.HandoffWeekday),
+			Start:   b.StartsAt,
+			Members: b.Members,
+		},
+		Overrides: overrides,
+	}
+}
+
+type ListOnCallScheduleBo struct {
+	*PageRequestBo
+	Keyword string
+	Status  enum.GlobalStatus
+}
+
+func NewListOnCallScheduleBo(req *apiv1.ListOnCallScheduleRequest) *ListOnCallScheduleBo {
+	return &ListOnCallScheduleBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		Keyword:       req.GetKeyword(),
+		Status:        req.GetStatus(),
+	}
+}
+
+func ToAPIV1ListOnCallScheduleReply(pageResponseBo *PageResponseBo[*OnCallScheduleItemBo]) *apiv1.ListOnCallScheduleReply {
+	items := make([]*apiv1.OnCallScheduleItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1OnCallScheduleItem())
+	}
+	return &apiv1.ListOnCallScheduleReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
+
+type CreateOnCallOverrideBo struct {
+	ScheduleUID snowflake.ID
+	ReceiverUID snowflake.ID
+	StartsAt    time.Time
+	EndsAt      time.Time
+}
+
+func NewCreateOnCallOverrideBo(req *apiv1.CreateOnCallOverrideRequest) (*CreateOnCallOverrideBo, error) {
+	startsAt, endsAt := time.Unix(req.GetStartsAt(), 0), time.Unix(req.GetEndsAt(), 0)
+	if !endsAt.After(startsAt) {
+		return nil, merr.ErrorParams("endsAt must be after startsAt")
+	}
+	if !endsAt.After(time.Now()) {
+		return nil, merr.ErrorParams("endsAt must be in the future")
+	}
+	return &CreateOnCallOverrideBo{
+		ScheduleUID: snowflake.ParseInt64(req.GetScheduleUID()),
+		ReceiverUID: snowflake.ParseInt64(req.GetReceiverUID()),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+	}, nil
+}
+
+type DeleteOnCallOverrideBo struct {
+	ScheduleUID snowflake.ID
+	UID         snowflake.ID
+}
+
+func NewDeleteOnCallOverrideBo(req *apiv1.DeleteOnCallOverrideRequest) *DeleteOnCallOverrideBo {
+	return &DeleteOnCallOverrideBo{
+		ScheduleUID: snowflake.ParseInt64(req.GetScheduleUID()),
+		UID:         snowflake.ParseInt64(req.GetUid()),
+	}
+}
+
+type ListOnCallOverrideBo struct {
+	ScheduleUID snowflake.ID
+	// EndsAfter leaves out the overrides ended at that time, zero lists them all.
+	EndsAfter time.Time
+}
+
+func NewListOnCallOverrideBo(req *apiv1.ListOnCallOverrideRequest) *ListOnCallOverrideBo {
+	b := &ListOnCallOverrideBo{ScheduleUID: snowflake.ParseInt64(req.GetScheduleUID())}
+	if !req.GetIncludeExpired() {
+		b.EndsAfter = time.Now()
+	}
+	return b
+}
+
+type OnCallOverrideItemBo struct {
+	UID         snowflake.ID
+	ScheduleUID snowflake.ID
+	ReceiverUID snowflake.ID
+	StartsAt    time.Time
+	EndsAt      time.Time
+	Creator     snowflake.ID
+	CreatedAt   time.Time
+}
+
+func (b *OnCallOverrideItemBo) ToAPIV1OnCallOverrideItem(loc *time.Location) *apiv1.OnCallOverrideItem {
+	return &apiv1.OnCallOverrideItem{
+		Uid:         b.UID.Int64(),
+		ScheduleUID: b.ScheduleUID.Int64(),
+		ReceiverUID: b.ReceiverUID.Int64(),
+		StartsAt:    b.StartsAt.In(loc).Format(time.DateTime),
+		EndsAt:      b.EndsAt.In(loc).Format(time.DateTime),
+		Creator:     b.Creator.Int64(),
+		CreatedAt:   b.CreatedAt.Format(time.DateTime),
+	}
+}
+
+func ToAPIV1ListOnCallOverrideReply(schedule *OnCallScheduleItemBo, items []*OnCallOverrideItemBo) *apiv1.ListOnCallOverrideReply {
+	list := make([]*apiv1.OnCallOverrideItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, item.ToAPIV1OnCallOverrideItem(schedule.Location()))
+	}
+	return &apiv1.ListOnCallOverrideReply{Items: list}
+}
+
+type GetOnCallBo struct {
+	UID  snowflake.ID
+	Time time.Time
+}
+
+// NewGetOnCallBo asks who is on call at the time of req, now when it is zero.
+func NewGetOnCallBo(req *apiv1.GetOnCallRequest) *GetOnCallBo {
+	t := time.Now()
+	if req.GetTime() > 0 {
+		t = time.Unix(req.GetTime(), 0)
+	}
+	return &GetOnCallBo{UID: snowflake.ParseInt64(req.GetUid()), Time: t}
+}
+
+type PreviewOnCallShiftsBo struct {
+	UID   snowflake.ID
+	From  time.Time
+	Count int
+}
+
+func NewPreviewOnCallShiftsBo(req *apiv1.PreviewOnCallShiftsRequest) *PreviewOnCallShiftsBo {
+	b := &PreviewOnCallShiftsBo{
+		UID:   snowflake.ParseInt64(req.GetUid()),
+		From:  time.Now(),
+		Count: int(req.GetCount()),
+	}
+	if req.GetFrom() > 0 {
+		b.From = time.Unix(req.GetFrom(), 0)
+	}
+	if b.Count <= 0 {
+		b.Count = defaultOnCallShiftsCount
+	}
+	return b
+}
+
+// ToAPIV1OnCallShift returns the shift with its times in the time zone of the schedule.
+func ToAPIV1OnCallShift(shift *oncall.Shift, loc *time.Location) *apiv1.OnCallShift {
+	if shift == nil {
+		return nil
+	}
+	return &apiv1.OnCallShift{
+		ReceiverUID: shift.ReceiverUID.Int64(),
+		StartsAt:    shift.Start.In(loc).Format(time.DateTime),
+		EndsAt:      shift.End.In(loc).Format(time.DateTime),
+		Override:    shift.Override,
+	}
+}
+
+func ToAPIV1PreviewOnCallShiftsReply(shifts []*oncall.Shift, loc *time.Location) *apiv1.PreviewOnCallShiftsReply {
+	items := make([]*apiv1.OnCallShift, 0, len(shifts))
+	for _, shift := range shifts {
+		items = append(items, ToAPIV1OnCallShift(shift, loc))
+	}
+	return &apiv1.PreviewOnCallShiftsReply{Items: items}
+}
@@ -0,0 +1,229 @@
+package bo
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+type WebhookConfigBo struct {
+	URL     string
+	Headers map[string]string
+}
+
+func NewWebhookConfigBo(config *apiv1.WebhookConfig) *WebhookConfigBo {
+	if config == nil {
+		return nil
+	}
+	return &WebhookConfigBo{
+		URL:     config.GetUrl(),
+		Headers: config.GetHeaders(),
+	}
+}
+
+func (b *WebhookConfigBo) ToAPIV1WebhookConfig() *apiv1.WebhookConfig {
+	if b == nil {
+		return nil
+	}
+	return &apiv1.WebhookConfig{
+		Url:     b.URL,
+		Headers: b.Headers,
+	}
+}
+
+func validateReceiverConfig(receiverType apiv1.ReceiverType, webhook *WebhookConfigBo) error {
+	switch receiverType {
+	case apiv1.ReceiverType_WEBHOOK:
+		if webhook == nil || webhook.URL == "" {
+			return merr.ErrorParams("webhook url is required")
+		}
+		u, err := url.Parse(webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return merr.ErrorParams("webhook url %q must be an absolute http(s) url", webhook.URL)
+		}
+		return nil
+	default:
+		return merr.ErrorParams("receiver type %s is not supported", receiverType)
+	}
+}
+
+type CreateReceiverBo struct {
+	Name    string
+	Remark  string
+	Type    apiv1.ReceiverType
+	Webhook *WebhookConfigBo
+}
+
+func NewCreateReceiverBo(req *apiv1.CreateReceiverRequest) (*CreateReceiverBo, error) {
+	b := &CreateReceiverBo{
+		Name:    req.GetName(),
+		Remark:  req.GetRemark(),
+		Type:    req.GetType(),
+		Webhook: NewWebhookConfigBo(req.GetWebhook()),
+	}
+	if err := validateReceiverConfig(b.Type, b.Webhook); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+type UpdateReceiverBo struct {
+	UID     snowflake.ID
+	Name    string
+	Remark  string
+	Type    apiv1.ReceiverType
+	Webhook *WebhookConfigBo
+}
+
+func NewUpdateReceiverBo(req *apiv1.UpdateReceiverRequest) (*UpdateReceiverBo, error) {
+	b := &UpdateReceiverBo{
+		UID:     snowflake.ParseInt64(req.GetUid()),
+		Name:    req.GetName(),
+		Remark:  req.GetRemark(),
+		Type:    req.GetType(),
+		Webhook: NewWebhookConfigBo(req.GetWebhook()),
+	}
+	if err := validateReceiverConfig(b.Type, b.Webhook); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+type UpdateReceiverStatusBo struct {
+	UID    snowflake.ID
+	Status enum.GlobalStatus
+}
+
+func NewUpdateReceiverStatusBo(req *apiv1.UpdateReceiverStatusRequest) *UpdateReceiverStatusBo {
+	return &UpdateReceiverStatusBo{
+		UID:    snowflake.ParseInt64(req.GetUid()),
+		Status: req.GetStatus(),
+	}
+}
+
+type ReceiverItemBo struct {
+	UID          snowflake.ID
+	NamespaceUID snowflake.ID
+	Name         string
+	Remark       string
+	Type         apiv1.ReceiverType
+	Webhook      *WebhookConfigBo
+	Status       enum.GlobalStatus
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (b *ReceiverItemBo) ToAPIV1ReceiverItem() *apiv1.ReceiverItem {
+	return &apiv1.ReceiverItem{
+		Uid:       b.UID.Int64(),
+		Name:      b.Name,
+		Remark:    b.Remark,
+		Type:      b.Type,
+		Webhook:   b.Webhook.ToAPIV1WebhookConfig(),
+		Status:    b.Status,
+		CreatedAt: b.CreatedAt.Format(time.DateTime),
+		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+type ListReceiverBo struct {
+	*PageRequestBo
+	Keyword string
+	Type    apiv1.ReceiverType
+	Status  enum.GlobalStatus
+}
+
+func NewListReceiverBo(req *apiv1.ListReceiverRequest) *ListReceiverBo {
+	return &ListReceiverBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		Keyword:       req.GetKeyword(),
+		Type:          req.GetType(),
+		Status:        req.GetStatus(),
+	}
+}
+
+func ToAPIV1ListReceiverReply(pageResponseBo *PageResponseBo[*ReceiverItemBo]) *apiv1.ListReceiverReply {
+	items := make([]*apiv1.ReceiverItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1ReceiverItem())
+	}
+	return &apiv1.ListReceiverReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
+
+// CreateReceiverDeliveryBo records one delivery attempt of an event to a receiver.
+type CreateReceiverDeliveryBo struct {
+	NamespaceUID snowflake.ID
+	ReceiverUID  snowflake.ID
+	EventUID     snowflake.ID
+	EventState   apiv1.EventState
+	Attempt      int32
+	StatusCode   int32
+	Success      bool
+	Error        string
+	Latency      time.Duration
+}
+
+type ReceiverDeliveryItemBo struct {
+	ID          uint32
+	ReceiverUID snowflake.ID
+	EventUID    snowflake.ID
+	EventState  apiv1.EventState
+	Attempt     int32
+	StatusCode  int32
+	Success     bool
+	Error       string
+	Latency     time.Duration
+	CreatedAt   time.Time
+}
+
+func (b *ReceiverDeliveryItemBo) ToAPIV1ReceiverDeliveryItem() *apiv1.ReceiverDeliveryItem {
+	return &apiv1.ReceiverDeliveryItem{
+		Id:          int64(b.ID),
+		ReceiverUID: b.ReceiverUID.Int64(),
+		EventUID:    b.EventUID.Int64(),
+		EventState:  b.EventState,
+		Attempt:     b.Attempt,
+		StatusCode:  b.StatusCode,
+		Success:     b.Success,
+		Error:       b.Error,
+		LatencyMs:   b.Latency.Milliseconds(),
+		CreatedAt:   b.CreatedAt.Format(time.DateTime),
+	}
+}
+
+type ListReceiverDeliveryBo struct {
+	*PageRequestBo
+	ReceiverUID snowflake.ID
+	EventUID    snowflake.ID
+}
+
+func NewListReceiverDeliveryBo(req *apiv1.ListReceiverDeliveryRequest) *ListReceiverDeliveryBo {
+	return &ListReceiverDeliveryBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		ReceiverUID:   snowflake.ParseInt64(req.GetReceiverUID()),
+		EventUID:      snowflake.ParseInt64(req.GetEventUID()),
+	}
+}
+
+func ToAPIV1ListReceiverDeliveryReply(pageResponseBo *PageResponseBo[*ReceiverDeliveryItemBo]) *apiv1.ListReceiverDeliveryReply {
+	items := make([]*apiv1.ReceiverDeliveryItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1ReceiverDeliveryItem())
+	}
+	return &apiv1.ListReceiverDeliveryReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
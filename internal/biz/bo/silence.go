@@ -0,0 +1,190 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// parseSilenceMatchers parses the matchers of a silence, at least one of them must not match
+// the empty value, otherwise the silence would mute every event of the namespace.
+func parseSilenceMatchers(list []string) (LabelMatchers, error) {
+	matchers, err := ParseLabelMatchers(list)
+	if err != nil {
+		return nil, merr.ErrorParams("invalid matchers: %v", err)
+	}
+	for _, m := range matchers {
+		if !m.Matches("") {
+			return matchers, nil
+		}
+	}
+	return nil, merr.ErrorParams("at least one matcher must not match the empty value")
+}
+
+// silenceTimeRange returns the time range of a silence, a zero startsAt starts it now.
+func silenceTimeRange(startsAt, endsAt int64) (time.Time, time.Time, error) {
+	now := time.Now()
+	start := now
+	if startsAt > 0 {
+		start = time.Unix(startsAt, 0)
+	}
+	end := time.Unix(endsAt, 0)
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, merr.ErrorParams("endsAt must be after startsAt")
+	}
+	if !end.After(now) {
+		return time.Time{}, time.Time{}, merr.ErrorParams("endsAt must be in the future")
+	}
+	return start, end, nil
+}
+
+type CreateSilenceBo struct {
+	Matchers LabelMatchers
+	StartsAt time.Time
+	EndsAt   time.Time
+	Comment  string
+}
+
+func NewCreateSilenceBo(req *apiv1.CreateSilenceRequest) (*CreateSilenceBo, error) {
+	matchers, err := parseSilenceMatchers(req.GetMatchers())
+	if err != nil {
+		return nil, err
+	}
+	startsAt, endsAt, err := silenceTimeRange(req.GetStartsAt(), req.GetEndsAt())
+	if err != nil {
+		return nil, err
+	}
+	return &CreateSilenceBo{
+		Matchers: matchers,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Comment:  req.GetComment(),
+	}, nil
+}
+
+type UpdateSilenceBo struct {
+	UID      snowflake.ID
+	Matchers LabelMatchers
+	StartsAt time.Time
+	EndsAt   time.Time
+	Comment  string
+}
+
+func NewUpdateSilenceBo(req *apiv1.UpdateSilenceRequest) (*UpdateSilenceBo, error) {
+	matchers, err := parseSilenceMatchers(req.GetMatchers())
+	if err != nil {
+		return nil, err
+	}
+	startsAt, endsAt, err := silenceTimeRange(req.GetStartsAt(), req.GetEndsAt())
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateSilenceBo{
+		UID:      snowflake.ParseInt64(req.GetUid()),
+		Matchers: matchers,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Comment:  req.GetComment(),
+	}, nil
+}
+
+type SilenceItemBo struct {
+	UID          snowflake.ID
+	NamespaceUID snowflake.ID
+	Matchers     LabelMatchers
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Comment      string
+	Creator      snowflake.ID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// State returns the state of the silence at t.
+func (b *SilenceItemBo) State(t time.Time) apiv1.SilenceState {
+	switch {
+	case t.Before(b.StartsAt):
+		return apiv1.SilenceState_SILENCE_PENDING
+	case t.Before(b.EndsAt):
+		return apiv1.SilenceState_SILENCE_ACTIVE
+	default:
+		return apiv1.SilenceState_SILENCE_EXPIRED
+	}
+}
+
+// Matches reports whether the silence mutes labels, a silence without matchers mutes nothing.
+func (b *SilenceItemBo) Matches(labels map[string]string) bool {
+	return len(b.Matchers) > 0 && b.Matchers.Matches(labels)
+}
+
+func (b *SilenceItemBo) ToAPIV1SilenceItem() *apiv1.SilenceItem {
+	return &apiv1.SilenceItem{
+		Uid:       b.UID.Int64(),
+		Matchers:  labelMatcherStrings(b.Matchers),
+		StartsAt:  b.StartsAt.Format(time.DateTime),
+		EndsAt:    b.EndsAt.Format(time.DateTime),
+		Comment:   b.Comment,
+		Creator:   b.Creator.Int64(),
+		State:     b.State(time.Now()),
+		CreatedAt: b.CreatedAt.Format(time.DateTime),
+		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+type ListSilenceBo struct {
+	*PageRequestBo
+	Keyword string
+	State   apiv1.SilenceState
+	// Now is the time the state filter is evaluated at.
+	Now time.Time
+}
+
+func NewListSilenceBo(req *apiv1.ListSilenceRequest) *ListSilenceBo {
+	return &ListSilenceBo{
+		PageRequestBo: NewPageRequestBo(req.GetPage(), req.GetPageSize()),
+		Keyword:       req.GetKeyword(),
+		State:         req.GetState(),
+		Now:           time.Now(),
+	}
+}
+
+func ToAPIV1ListSilenceReply(pageResponseBo *PageResponseBo[*SilenceItemBo]) *apiv1.ListSilenceReply {
+	items := make([]*apiv1.SilenceItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1SilenceItem())
+	}
+	return &apiv1.ListSilenceReply{
+		Total:    pageResponseBo.GetTotal(),
+		Page:     pageResponseBo.GetPage(),
+		PageSize: pageResponseBo.GetPageSize(),
+		Items:    items,
+	}
+}
+
+// MatchSilencesBo asks which silences mute an event, given by its uid or by its labels.
+type MatchSilencesBo struct {
+	EventUID snowflake.ID
+	Labels   map[string]string
+}
+
+func NewMatchSilencesBo(req *apiv1.MatchSilencesRequest) (*MatchSilencesBo, error) {
+	b := &MatchSilencesBo{
+		EventUID: snowflake.ParseInt64(req.GetEventUID()),
+		Labels:   req.GetLabels(),
+	}
+	if b.EventUID == 0 && len(b.Labels) == 0 {
+		return nil, merr.ErrorParams("eventUID or labels is required")
+	}
+	return b, nil
+}
+
+func ToAPIV1MatchSilencesReply(list []*SilenceItemBo) *apiv1.MatchSilencesReply {
+	items := make([]*apiv1.SilenceItem, 0, len(list))
+	for _, item := range list {
+		items = append(items, item.ToAPIV1SilenceItem())
+	}
+	return &apiv1.MatchSilencesReply{Items: items}
+}
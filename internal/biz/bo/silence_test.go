@@ -0,0 +1,77 @@
+package bo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func TestSilenceState(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	silence := &bo.SilenceItemBo{StartsAt: start, EndsAt: start.Add(time.Hour)}
+	for _, tc := range []struct {
+		at   time.Time
+		want apiv1.SilenceState
+	}{
+		{start.Add(-time.Second), apiv1.SilenceState_SILENCE_PENDING},
+		{start, apiv1.SilenceState_SILENCE_ACTIVE},
+		{start.Add(time.Hour - time.Second), apiv1.SilenceState_SILENCE_ACTIVE},
+		// a silence expires at its end
+		{start.Add(time.Hour), apiv1.SilenceState_SILENCE_EXPIRED},
+		{start.Add(2 * time.Hour), apiv1.SilenceState_SILENCE_EXPIRED},
+	} {
+		if got := silence.State(tc.at); got != tc.want {
+			t.Errorf("at %s: got %s, want %s", tc.at.Sub(start), got, tc.want)
+		}
+	}
+}
+
+func TestSilenceMatches(t *testing.T) {
+	matchers, err := bo.ParseLabelMatchers([]string{`job="node"`, `instance=~"host-.*"`})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	silence := &bo.SilenceItemBo{Matchers: matchers}
+	if !silence.Matches(map[string]string{"job": "node", "instance": "host-1", "env": "prod"}) {
+		t.Error("want the silence to mute labels matching every matcher")
+	}
+	if silence.Matches(map[string]string{"job": "node", "instance": "db-1"}) {
+		t.Error("want the silence not to mute labels missing a matcher")
+	}
+	if (&bo.SilenceItemBo{}).Matches(map[string]string{"job": "node"}) {
+		t.Error("want a silence without matchers to mute nothing")
+	}
+}
+
+func TestNewCreateSilenceBo(t *testing.T) {
+	now := time.Now()
+	valid := func() *apiv1.CreateSilenceRequest {
+		return &apiv1.CreateSilenceRequest{Matchers: []string{`job="node"`}, EndsAt: now.Add(time.Hour).Unix(), Comment: "maintenance"}
+	}
+	b, err := bo.NewCreateSilenceBo(valid())
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if b.StartsAt.Before(now.Add(-time.Second)) || b.StartsAt.After(time.Now()) {
+		t.Fatalf("got startsAt %s, want a silence without startsAt to start now", b.StartsAt)
+	}
+	for name, mutate := range map[string]func(req *apiv1.CreateSilenceRequest){
+		"no matchers":                      func(req *apiv1.CreateSilenceRequest) { req.Matchers = nil },
+		"only matchers of the empty value": func(req *apiv1.CreateSilenceRequest) { req.Matchers = []string{`env=""`, `job=~".*"`, `x!="y"`} },
+		"invalid matcher":                  func(req *apiv1.CreateSilenceRequest) { req.Matchers = []string{`job`} },
+		"end before start":                 func(req *apiv1.CreateSilenceRequest) { req.StartsAt = now.Add(2 * time.Hour).Unix() },
+		"end in the past": func(req *apiv1.CreateSilenceRequest) {
+			req.StartsAt, req.EndsAt = now.Add(-2*time.Hour).Unix(), now.Add(-time.Hour).Unix()
+		},
+	} {
+		req := valid()
+		mutate(req)
+		if _, err := bo.NewCreateSilenceBo(req); errors.Code(err) != 400 {
+			t.Errorf("%s: got %v, want a params error", name, err)
+		}
+	}
+}
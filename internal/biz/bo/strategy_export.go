@@ -0,0 +1,111 @@
+package bo
+
+import (
+	"github.com/bwmarrin/snowflake"
+	"github.com/prometheus/common/model"
+
+	"github.com/aide-family/marksman/internal/biz/promrule"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const (
+	prometheusRuleAPIVersion  = "monitoring.coreos.com/v1"
+	prometheusRuleKind        = "PrometheusRule"
+	defaultPrometheusRuleName = "marksman"
+)
+
+type ExportPrometheusRulesBo struct {
+	StrategyGroupUID snowflake.ID
+	Format           apiv1.ExportFormat
+	Name             string
+	Namespace        string
+	Labels           map[string]string
+	SeverityLabel    string
+}
+
+func NewExportPrometheusRulesBo(req *apiv1.ExportPrometheusRulesRequest) *ExportPrometheusRulesBo {
+	format := req.GetFormat()
+	if format == apiv1.ExportFormat_ExportFormat_UNKNOWN {
+		format = apiv1.ExportFormat_EXPORT_RULE_FILE
+	}
+	name := req.GetName()
+	if name == "" {
+		name = defaultPrometheusRuleName
+	}
+	severityLabel := req.GetSeverityLabel()
+	if severityLabel == "" {
+		severityLabel = defaultSeverityLabel
+	}
+	return &ExportPrometheusRulesBo{
+		StrategyGroupUID: snowflake.ParseInt64(req.GetStrategyGroupUID()),
+		Format:           format,
+		Name:             name,
+		Namespace:        req.GetNamespace(),
+		Labels:           req.GetLabels(),
+		SeverityLabel:    severityLabel,
+	}
+}
+
+// StrategyMetricExportBo is an enabled metric strategy of a namespace together
+// with its enabled levels, as loaded for export.
+type StrategyMetricExportBo struct {
+	StrategyGroupUID  snowflake.ID
+	StrategyGroupName string
+	StrategyUID       snowflake.ID
+	StrategyName      string
+	StrategyMetadata  map[string]string
+	Expr              string
+	Labels            map[string]string
+	Summary           string
+	Description       string
+	Levels            []*StrategyMetricLevelItemBo
+}
+
+// ToAPIV1ExportPrometheusRulesReply fills either the groups of a rule file or the
+// fields of a PrometheusRule manifest, so that the reply encodes as one of them.
+func ToAPIV1ExportPrometheusRulesReply(req *ExportPrometheusRulesBo, file *promrule.File) *apiv1.ExportPrometheusRulesReply {
+	groups := make([]*apiv1.PrometheusRuleGroupItem, 0, len(file.Groups))
+	for _, group := range file.Groups {
+		groups = append(groups, toAPIV1PrometheusRuleGroupItem(group))
+	}
+	if req.Format == apiv1.ExportFormat_EXPORT_RULE_FILE {
+		return &apiv1.ExportPrometheusRulesReply{Groups: groups}
+	}
+	return &apiv1.ExportPrometheusRulesReply{
+		ApiVersion: prometheusRuleAPIVersion,
+		Kind:       prometheusRuleKind,
+		Metadata: &apiv1.PrometheusRuleMetadata{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+			Labels:    req.Labels,
+		},
+		Spec: &apiv1.PrometheusRuleSpec{Groups: groups},
+	}
+}
+
+func toAPIV1PrometheusRuleGroupItem(group *promrule.Group) *apiv1.PrometheusRuleGroupItem {
+	rules := make([]*apiv1.PrometheusAlertingRuleItem, 0, len(group.Rules))
+	for _, rule := range group.Rules {
+		rules = append(rules, &apiv1.PrometheusAlertingRuleItem{
+			Alert:         rule.Alert,
+			Expr:          rule.Expr,
+			For:           formatModelDuration(rule.For),
+			KeepFiringFor: formatModelDuration(rule.KeepFiringFor),
+			Labels:        rule.Labels,
+			Annotations:   rule.Annotations,
+		})
+	}
+	return &apiv1.PrometheusRuleGroupItem{
+		Name:     group.Name,
+		Interval: formatModelDuration(group.Interval),
+		Rules:    rules,
+	}
+}
+
+// formatModelDuration formats d the way Prometheus writes durations, empty for zero.
+func formatModelDuration(d model.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return d.String()
+}
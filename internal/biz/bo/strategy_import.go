@@ -0,0 +1,117 @@
+package bo
+
+import (
+	"fmt"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/promrule"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// defaultSeverityLabel is the rule label naming the level when the request does not set one.
+const defaultSeverityLabel = "severity"
+
+type PrometheusRuleFileBo struct {
+	Name string
+	File *promrule.File
+}
+
+type ImportPrometheusRulesBo struct {
+	Files          []*PrometheusRuleFileBo
+	DatasourceUIDs []snowflake.ID
+	DryRun         bool
+	SeverityLabel  string
+	DefaultLevel   string
+}
+
+func NewImportPrometheusRulesBo(req *apiv1.ImportPrometheusRulesRequest) (*ImportPrometheusRulesBo, error) {
+	files := make([]*PrometheusRuleFileBo, 0, len(req.GetFiles()))
+	for i, file := range req.GetFiles() {
+		name := file.GetName()
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		f, err := promrule.Parse([]byte(file.GetContent()))
+		if err != nil {
+			return nil, merr.ErrorParams("invalid rule file %q: %v", name, err)
+		}
+		files = append(files, &PrometheusRuleFileBo{Name: name, File: f})
+	}
+	datasourceUIDs := make([]snowflake.ID, 0, len(req.GetDatasourceUIDs()))
+	for _, uid := range req.GetDatasourceUIDs() {
+		datasourceUIDs = append(datasourceUIDs, snowflake.ParseInt64(uid))
+	}
+	severityLabel := req.GetSeverityLabel()
+	if severityLabel == "" {
+		severityLabel = defaultSeverityLabel
+	}
+	return &ImportPrometheusRulesBo{
+		Files:          files,
+		DatasourceUIDs: datasourceUIDs,
+		DryRun:         req.GetDryRun(),
+		SeverityLabel:  severityLabel,
+		DefaultLevel:   req.GetDefaultLevel(),
+	}, nil
+}
+
+// ImportStrategyGroupBo is a strategy group of an import plan, a zero UID creates the group.
+type ImportStrategyGroupBo struct {
+	UID        snowflake.ID
+	Name       string
+	Strategies []*ImportStrategyBo
+}
+
+// ImportStrategyBo is a metric strategy of an import plan, a zero UID creates the strategy.
+// Levels replace the thresholds of the strategy, levels missing from the list are deleted.
+type ImportStrategyBo struct {
+	UID      snowflake.ID
+	Name     string
+	Type     enum.DatasourceType
+	Driver   enum.DatasourceDriver
+	Metadata map[string]string
+	Metric   *SaveStrategyMetricBo
+	Levels   []*SaveStrategyMetricLevelBo
+}
+
+type ResourceChangeBo struct {
+	Action apiv1.ChangeAction
+	Kind   string
+	Name   string
+	Parent string
+	Diff   []string
+	Reason string
+}
+
+func (b *ResourceChangeBo) ToAPIV1ResourceChange() *apiv1.ResourceChange {
+	return &apiv1.ResourceChange{
+		Action: b.Action,
+		Kind:   b.Kind,
+		Name:   b.Name,
+		Parent: b.Parent,
+		Diff:   b.Diff,
+		Reason: b.Reason,
+	}
+}
+
+func ToAPIV1ImportPrometheusRulesReply(dryRun bool, changes []*ResourceChangeBo) *apiv1.ImportPrometheusRulesReply {
+	items := make([]*apiv1.ResourceChange, 0, len(changes))
+	for _, change := range changes {
+		items = append(items, change.ToAPIV1ResourceChange())
+	}
+	return &apiv1.ImportPrometheusRulesReply{
+		DryRun:  dryRun,
+		Changes: items,
+	}
+}
+
+// CheckStrategyMetricTemplates checks the summary and description templates
+// the same way as saving a strategy metric does.
+func CheckStrategyMetricTemplates(summary, description string) error {
+	if err := checkTemplate("summary", summary); err != nil {
+		return err
+	}
+	return checkTemplate("description", description)
+}
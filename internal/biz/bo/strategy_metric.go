@@ -0,0 +1,276 @@
+package bo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	"github.com/aide-family/marksman/internal/biz/flapper"
+	"github.com/aide-family/marksman/internal/biz/promql"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+type SaveStrategyMetricBo struct {
+	StrategyUID    snowflake.ID
+	Expr           string
+	Labels         map[string]string
+	Summary        string
+	Description    string
+	DatasourceUIDs []snowflake.ID
+	Status         enum.GlobalStatus
+	// Lints are the likely mistakes in Expr, they are returned but do not stop the save
+	Lints []*promql.Lint
+}
+
+func NewSaveStrategyMetricBo(req *apiv1.SaveStrategyMetricRequest) (*SaveStrategyMetricBo, error) {
+	if req.GetExpr() == "" {
+		return nil, merr.ErrorParams("expr is required")
+	}
+	if err := validateTemplate("summary", req.GetSummary()); err != nil {
+		return nil, err
+	}
+	if err := validateTemplate("description", req.GetDescription()); err != nil {
+		return nil, err
+	}
+	expr, err := parseExpr(req.GetExpr())
+	if err != nil {
+		return nil, err
+	}
+	datasourceUIDs := make([]snowflake.ID, 0, len(req.GetDatasourceUIDs()))
+	for _, uid := range req.GetDatasourceUIDs() {
+		datasourceUIDs = append(datasourceUIDs, snowflake.ParseInt64(uid))
+	}
+	return &SaveStrategyMetricBo{
+		StrategyUID:    snowflake.ParseInt64(req.GetStrategyUID()),
+		Expr:           req.GetExpr(),
+		Labels:         req.GetLabels(),
+		Summary:        req.GetSummary(),
+		Description:    req.GetDescription(),
+		DatasourceUIDs: datasourceUIDs,
+		Status:         req.GetStatus(),
+		Lints:          lintExpr(expr, req.GetSummary(), req.GetDescription()),
+	}, nil
+}
+
+func (b *SaveStrategyMetricBo) ToAPIV1SaveStrategyMetricReply() *apiv1.SaveStrategyMetricReply {
+	return &apiv1.SaveStrategyMetricReply{Lints: toAPIV1ExprLints(b.Lints)}
+}
+
+type StrategyMetricItemBo struct {
+	StrategyUID    snowflake.ID
+	Expr           string
+	Labels         map[string]string
+	Summary        string
+	Description    string
+	DatasourceUIDs []snowflake.ID
+	Status         enum.GlobalStatus
+	Levels         []*StrategyMetricLevelItemBo
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (b *StrategyMetricItemBo) ToAPIV1StrategyMetricItem() *apiv1.StrategyMetricItem {
+	datasourceUIDs := make([]int64, 0, len(b.DatasourceUIDs))
+	for _, uid := range b.DatasourceUIDs {
+		datasourceUIDs = append(datasourceUIDs, uid.Int64())
+	}
+	levels := make([]*apiv1.StrategyMetricLevelItem, 0, len(b.Levels))
+	for _, level := range b.Levels {
+		levels = append(levels, level.ToAPIV1StrategyMetricLevelItem())
+	}
+	return &apiv1.StrategyMetricItem{
+		StrategyUID:    b.StrategyUID.Int64(),
+		Expr:           b.Expr,
+		Labels:         b.Labels,
+		Summary:        b.Summary,
+		Description:    b.Description,
+		Status:         b.Status,
+		DatasourceUIDs: datasourceUIDs,
+		Levels:         levels,
+		CreatedAt:      b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:      b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+type SaveStrategyMetricLevelBo struct {
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	Mode          enum.SampleMode
+	Condition     enum.ConditionMetric
+	Values        []int64
+	Duration      time.Duration
+	FlapWindow    time.Duration
+	FlapThreshold int32
+	Status        enum.GlobalStatus
+}
+
+func NewSaveStrategyMetricLevelBo(req *apiv1.SaveStrategyMetricLevelRequest) (*SaveStrategyMetricLevelBo, error) {
+	if req.GetLevelUID() <= 0 {
+		return nil, merr.ErrorParams("levelUID is required")
+	}
+	b := &SaveStrategyMetricLevelBo{
+		StrategyUID:   snowflake.ParseInt64(req.GetStrategyUID()),
+		LevelUID:      snowflake.ParseInt64(req.GetLevelUID()),
+		Mode:          req.GetMode(),
+		Condition:     req.GetCondition(),
+		Values:        req.GetValues(),
+		Duration:      req.GetDuration().AsDuration(),
+		FlapWindow:    req.GetFlapWindow().AsDuration(),
+		FlapThreshold: req.GetFlapThreshold(),
+		Status:        req.GetStatus(),
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *SaveStrategyMetricLevelBo) validate() error {
+	if err := validateConditionValues(b.Condition, b.Values); err != nil {
+		return err
+	}
+	if b.Duration < 0 {
+		return merr.ErrorParams("duration must not be negative")
+	}
+	if b.FlapWindow < 0 || b.FlapThreshold < 0 {
+		return merr.ErrorParams("flapWindow and flapThreshold must not be negative")
+	}
+	if (b.FlapWindow > 0) != (b.FlapThreshold > 0) {
+		return merr.ErrorParams("flapWindow and flapThreshold must be set together")
+	}
+	return nil
+}
+
+// validateConditionValues checks the number of thresholds a condition needs:
+// IN and NOT_IN take a closed [min, max] range, the others a single value.
+func validateConditionValues(condition enum.ConditionMetric, values []int64) error {
+	switch condition {
+	case enum.ConditionMetric_CONDITION_METRIC_IN, enum.ConditionMetric_CONDITION_METRIC_NOT_IN:
+		if len(values) != 2 {
+			return merr.ErrorParams("condition %s requires 2 values, got %d", condition, len(values))
+		}
+		if values[0] > values[1] {
+			return merr.ErrorParams("condition %s requires values[0] <= values[1]", condition)
+		}
+	case enum.ConditionMetric_CONDITION_METRIC_EQ,
+		enum.ConditionMetric_CONDITION_METRIC_NE,
+		enum.ConditionMetric_CONDITION_METRIC_GT,
+		enum.ConditionMetric_CONDITION_METRIC_GTE,
+		enum.ConditionMetric_CONDITION_METRIC_LT,
+		enum.ConditionMetric_CONDITION_METRIC_LTE:
+		if len(values) != 1 {
+			return merr.ErrorParams("condition %s requires 1 value, got %d", condition, len(values))
+		}
+	default:
+		return merr.ErrorParams("condition %s is not supported", condition)
+	}
+	return nil
+}
+
+type UpdateStrategyMetricLevelStatusBo struct {
+	UID         snowflake.ID
+	StrategyUID snowflake.ID
+	Status      enum.GlobalStatus
+}
+
+func NewUpdateStrategyMetricLevelStatusBo(req *apiv1.UpdateStrategyMetricLevelStatusRequest) *UpdateStrategyMetricLevelStatusBo {
+	return &UpdateStrategyMetricLevelStatusBo{
+		UID:         snowflake.ParseInt64(req.GetUid()),
+		StrategyUID: snowflake.ParseInt64(req.GetStrategyUID()),
+		Status:      req.GetStatus(),
+	}
+}
+
+type StrategyMetricLevelItemBo struct {
+	UID           snowflake.ID
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	Level         *LevelItemBo
+	Mode          enum.SampleMode
+	Condition     enum.ConditionMetric
+	Values        []int64
+	Duration      time.Duration
+	FlapWindow    time.Duration
+	FlapThreshold int32
+	Status        enum.GlobalStatus
+}
+
+// FlapConfig returns the flap detection of the level, a zero config disables it.
+func (b *StrategyMetricLevelItemBo) FlapConfig() flapper.Config {
+	return flapper.Config{Window: b.FlapWindow, Threshold: int(b.FlapThreshold)}
+}
+
+func (b *StrategyMetricLevelItemBo) ToAPIV1StrategyMetricLevelItem() *apiv1.StrategyMetricLevelItem {
+	item := &apiv1.StrategyMetricLevelItem{
+		Uid:           b.UID.Int64(),
+		StrategyUID:   b.StrategyUID.Int64(),
+		Mode:          b.Mode,
+		Condition:     b.Condition,
+		Values:        b.Values,
+		Duration:      durationpb.New(b.Duration),
+		FlapWindow:    durationpb.New(b.FlapWindow),
+		FlapThreshold: b.FlapThreshold,
+		Status:        b.Status,
+	}
+	if b.Level != nil {
+		item.Level = b.Level.ToAPIV1LevelItem()
+	}
+	return item
+}
+
+type BindStrategyMetricReceiversBo struct {
+	StrategyUID  snowflake.ID
+	LevelUID     snowflake.ID
+	ReceiverUIDs []snowflake.ID
+}
+
+func NewBindStrategyMetricReceiversBo(req *apiv1.StrategyMetricBindReceiversRequest) *BindStrategyMetricReceiversBo {
+	receiverUIDs := make([]snowflake.ID, 0, len(req.GetReceiverUIDs()))
+	for _, uid := range req.GetReceiverUIDs() {
+		receiverUIDs = append(receiverUIDs, snowflake.ParseInt64(uid))
+	}
+	return &BindStrategyMetricReceiversBo{
+		StrategyUID:  snowflake.ParseInt64(req.GetStrategyUID()),
+		LevelUID:     snowflake.ParseInt64(req.GetLevelUID()),
+		ReceiverUIDs: receiverUIDs,
+	}
+}
+
+// StrategyMetricRuleBo is an enabled metric strategy together with its enabled
+// levels and datasources, as loaded for evaluation across all namespaces.
+type StrategyMetricRuleBo struct {
+	NamespaceUID     snowflake.ID
+	StrategyUID      snowflake.ID
+	StrategyMetadata map[string]string
+	Expr             string
+	Labels           map[string]string
+	Summary          string
+	Description      string
+	Datasources      []*DatasourceItemBo
+	Levels           []*StrategyMetricLevelItemBo
+}
+
+// validateTemplate parses text and renders it once against sample data, so
+// that unknown functions or fields are rejected on save.
+func validateTemplate(name, text string) error {
+	if err := checkTemplate(name, text); err != nil {
+		return merr.ErrorParams("%v", err)
+	}
+	return nil
+}
+
+func checkTemplate(name, text string) error {
+	tmpl, err := evaluator.ParseTemplate(name, text)
+	if err != nil {
+		return fmt.Errorf("invalid %s template: %v", name, err)
+	}
+	if _, err := evaluator.ExecuteTemplate(tmpl, &evaluator.TemplateData{Labels: map[string]string{}}); err != nil {
+		return fmt.Errorf("invalid %s template: %v", name, err)
+	}
+	return nil
+}
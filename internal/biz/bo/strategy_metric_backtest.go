@@ -0,0 +1,150 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// maxBacktestSteps bounds the points of every series the way Prometheus bounds range queries.
+const maxBacktestSteps = 11000
+
+// BacktestStrategyMetricBo replays a saved strategy when Expr is empty, else the inline
+// strategy given by Expr, Labels, DatasourceUIDs and Levels.
+type BacktestStrategyMetricBo struct {
+	StrategyUID    snowflake.ID
+	Expr           string
+	Labels         map[string]string
+	DatasourceUIDs []snowflake.ID
+	Levels         []*StrategyMetricLevelItemBo
+	StartTime      time.Time
+	EndTime        time.Time
+	Step           time.Duration
+}
+
+func NewBacktestStrategyMetricBo(req *apiv1.BacktestStrategyMetricRequest) (*BacktestStrategyMetricBo, error) {
+	b := &BacktestStrategyMetricBo{
+		StrategyUID: snowflake.ParseInt64(req.GetStrategyUID()),
+		Expr:        req.GetExpr(),
+		Labels:      req.GetLabels(),
+		StartTime:   time.Unix(req.GetStartTime(), 0),
+		EndTime:     time.Unix(req.GetEndTime(), 0),
+		Step:        req.GetStep().AsDuration(),
+	}
+	if b.Step <= 0 {
+		return nil, merr.ErrorParams("step must be greater than 0")
+	}
+	if !b.EndTime.After(b.StartTime) {
+		return nil, merr.ErrorParams("endTime must be after startTime")
+	}
+	if steps := b.EndTime.Sub(b.StartTime)/b.Step + 1; steps > maxBacktestSteps {
+		return nil, merr.ErrorParams("the range has %d steps, at most %d are allowed, use a larger step", steps, maxBacktestSteps)
+	}
+	if b.Expr == "" {
+		if b.StrategyUID <= 0 {
+			return nil, merr.ErrorParams("strategyUID or expr is required")
+		}
+		return b, nil
+	}
+	if _, err := parseExpr(b.Expr); err != nil {
+		return nil, err
+	}
+	if len(req.GetDatasourceUIDs()) == 0 || len(req.GetLevels()) == 0 {
+		return nil, merr.ErrorParams("datasourceUIDs and levels are required with expr")
+	}
+	for _, uid := range req.GetDatasourceUIDs() {
+		b.DatasourceUIDs = append(b.DatasourceUIDs, snowflake.ParseInt64(uid))
+	}
+	for i, level := range req.GetLevels() {
+		item := &StrategyMetricLevelItemBo{
+			StrategyUID: b.StrategyUID,
+			LevelUID:    snowflake.ParseInt64(level.GetLevelUID()),
+			Mode:        level.GetMode(),
+			Condition:   level.GetCondition(),
+			Values:      level.GetValues(),
+			Duration:    level.GetDuration().AsDuration(),
+		}
+		for _, prev := range b.Levels {
+			if prev.LevelUID == item.LevelUID {
+				return nil, merr.ErrorParams("levels[%d] repeats level %d", i, item.LevelUID.Int64())
+			}
+		}
+		if err := validateConditionValues(item.Condition, item.Values); err != nil {
+			return nil, err
+		}
+		if item.Duration < 0 {
+			return nil, merr.ErrorParams("levels[%d].duration must not be negative", i)
+		}
+		b.Levels = append(b.Levels, item)
+	}
+	return b, nil
+}
+
+type BacktestStrategyMetricResultBo struct {
+	// Levels are the replayed levels, in the order of the summaries
+	Levels  []snowflake.ID
+	EndTime time.Time
+	Result  *evaluator.BacktestResult
+	// Errors are the datasources that could not be queried
+	Errors []string
+}
+
+func (b *BacktestStrategyMetricResultBo) ToAPIV1BacktestStrategyMetricReply() *apiv1.BacktestStrategyMetricReply {
+	summaries := make(map[snowflake.ID]*apiv1.BacktestLevelSummary, len(b.Levels))
+	reply := &apiv1.BacktestStrategyMetricReply{
+		Steps:  int32(b.Result.Steps),
+		Series: make([]*apiv1.BacktestSeries, 0, len(b.Result.Series)),
+		Levels: make([]*apiv1.BacktestLevelSummary, 0, len(b.Levels)),
+		Errors: b.Errors,
+	}
+	firing := make(map[snowflake.ID]time.Duration, len(b.Levels))
+	for _, levelUID := range b.Levels {
+		summaries[levelUID] = &apiv1.BacktestLevelSummary{LevelUID: levelUID.Int64()}
+		reply.Levels = append(reply.Levels, summaries[levelUID])
+	}
+	for _, series := range b.Result.Series {
+		item := &apiv1.BacktestSeries{
+			DatasourceUID: series.DatasourceUID.Int64(),
+			Labels:        series.Labels,
+		}
+		for _, levelUID := range b.Levels {
+			intervals := series.Intervals[levelUID]
+			if len(intervals) == 0 {
+				continue
+			}
+			level := &apiv1.BacktestSeriesLevel{LevelUID: levelUID.Int64()}
+			var total time.Duration
+			for _, interval := range intervals {
+				endsAt, ended := interval.EndsAt, ""
+				if endsAt.IsZero() {
+					// still firing at the end of the range, it counts up to the end
+					endsAt = b.EndTime
+				} else {
+					ended = endsAt.Format(time.DateTime)
+				}
+				total += endsAt.Sub(interval.StartsAt)
+				level.Intervals = append(level.Intervals, &apiv1.BacktestInterval{
+					ActiveAt: interval.ActiveAt.Format(time.DateTime),
+					StartsAt: interval.StartsAt.Format(time.DateTime),
+					EndsAt:   ended,
+				})
+			}
+			level.FiringDuration = durationpb.New(total)
+			item.Levels = append(item.Levels, level)
+			summary := summaries[levelUID]
+			summary.Series++
+			summary.Intervals += int32(len(intervals))
+			firing[levelUID] += total
+		}
+		reply.Series = append(reply.Series, item)
+	}
+	for levelUID, summary := range summaries {
+		summary.FiringDuration = durationpb.New(firing[levelUID])
+	}
+	return reply
+}
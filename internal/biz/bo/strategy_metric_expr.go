@@ -0,0 +1,172 @@
+package bo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	"github.com/aide-family/marksman/internal/biz/promql"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+type ParseStrategyMetricExprBo struct {
+	Expr        string
+	Ast         parser.Expr
+	MetricNames []string
+	Lints       []*promql.Lint
+}
+
+// NewParseStrategyMetricExprBo parses and lints the expression, a parse error is
+// returned as a params error with its position as metadata.
+func NewParseStrategyMetricExprBo(req *apiv1.ParseStrategyMetricExprRequest) (*ParseStrategyMetricExprBo, error) {
+	if err := validateTemplate("summary", req.GetSummary()); err != nil {
+		return nil, err
+	}
+	if err := validateTemplate("description", req.GetDescription()); err != nil {
+		return nil, err
+	}
+	expr, err := parseExpr(req.GetExpr())
+	if err != nil {
+		return nil, err
+	}
+	return &ParseStrategyMetricExprBo{
+		Expr:        req.GetExpr(),
+		Ast:         expr,
+		MetricNames: promql.MetricNames(expr),
+		Lints:       lintExpr(expr, req.GetSummary(), req.GetDescription()),
+	}, nil
+}
+
+func (b *ParseStrategyMetricExprBo) ToAPIV1ParseStrategyMetricExprReply() *apiv1.ParseStrategyMetricExprReply {
+	return &apiv1.ParseStrategyMetricExprReply{
+		Ast:         toAPIV1ExprNode(b.Expr, b.Ast),
+		MetricNames: b.MetricNames,
+		Lints:       toAPIV1ExprLints(b.Lints),
+	}
+}
+
+// CheckStrategyMetricExpr checks expr the same way as saving a strategy metric does.
+func CheckStrategyMetricExpr(expr string) error {
+	_, err := checkExpr(expr)
+	return err
+}
+
+func checkExpr(text string) (parser.Expr, error) {
+	expr, err := promql.ParseExpr(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expr: %w", err)
+	}
+	// the levels compare the value of every series, or of the one scalar
+	if t := expr.Type(); t != parser.ValueTypeVector && t != parser.ValueTypeScalar {
+		return nil, fmt.Errorf("invalid expr: expr must return an instant vector or a scalar, got %s", t)
+	}
+	return expr, nil
+}
+
+func parseExpr(text string) (parser.Expr, error) {
+	expr, err := checkExpr(text)
+	if err == nil {
+		return expr, nil
+	}
+	var parseErr *promql.ParseError
+	if !errors.As(err, &parseErr) {
+		return nil, merr.ErrorParams("%v", err)
+	}
+	return nil, merr.ErrorParams("%v", err).WithMetadata(map[string]string{
+		"line":   strconv.Itoa(parseErr.Line),
+		"column": strconv.Itoa(parseErr.Column),
+		"start":  strconv.Itoa(int(parseErr.Start)),
+		"end":    strconv.Itoa(int(parseErr.End)),
+	})
+}
+
+// lintExpr lints expr against the labels the summary and description read, both
+// templates are already validated.
+func lintExpr(expr parser.Expr, summary, description string) []*promql.Lint {
+	templateLabels := make(map[string][]string, 2)
+	for name, text := range map[string]string{"summary": summary, "description": description} {
+		if tmpl, err := evaluator.ParseTemplate(name, text); err == nil {
+			templateLabels[name] = evaluator.TemplateLabels(tmpl)
+		}
+	}
+	return promql.LintExpr(expr, templateLabels)
+}
+
+func toAPIV1ExprLints(lints []*promql.Lint) []*apiv1.ExprLint {
+	items := make([]*apiv1.ExprLint, 0, len(lints))
+	for _, lint := range lints {
+		items = append(items, &apiv1.ExprLint{
+			Code:    lint.Code,
+			Message: lint.Message,
+			Start:   int32(lint.Start),
+			End:     int32(lint.End),
+		})
+	}
+	return items
+}
+
+func toAPIV1ExprNode(text string, expr parser.Expr) *apiv1.ExprNode {
+	r := expr.PositionRange()
+	node := &apiv1.ExprNode{
+		ValueType: string(expr.Type()),
+		Text:      text[r.Start:r.End],
+		Start:     int32(r.Start),
+		End:       int32(r.End),
+	}
+	switch e := expr.(type) {
+	case *parser.NumberLiteral:
+		node.Kind = "number"
+	case *parser.StringLiteral:
+		node.Kind = "string"
+	case *parser.VectorSelector:
+		node.Kind = "vectorSelector"
+		node.Name = e.Name
+		for _, m := range e.LabelMatchers {
+			// the parser adds the name as a __name__ matcher
+			if e.Name != "" && m.Name == labels.MetricName {
+				continue
+			}
+			node.Matchers = append(node.Matchers, m.Name+m.Type.String()+strconv.Quote(m.Value))
+		}
+	case *parser.MatrixSelector:
+		node.Kind = "matrixSelector"
+		node.Range = model.Duration(e.Range).String()
+	case *parser.SubqueryExpr:
+		node.Kind = "subquery"
+		node.Range = model.Duration(e.Range).String() + ":"
+		if e.Step > 0 {
+			node.Range += model.Duration(e.Step).String()
+		}
+	case *parser.Call:
+		node.Kind = "call"
+		node.Name = e.Func.Name
+	case *parser.AggregateExpr:
+		node.Kind = "aggregation"
+		node.Name = e.Op.String()
+		node.Grouping = e.Grouping
+		node.Without = e.Without
+	case *parser.BinaryExpr:
+		node.Kind = "binary"
+		node.Name = e.Op.String()
+		// the matching labels of on, or of ignoring as without
+		if e.VectorMatching != nil {
+			node.Grouping = e.VectorMatching.MatchingLabels
+			node.Without = !e.VectorMatching.On && len(e.VectorMatching.MatchingLabels) > 0
+		}
+	case *parser.UnaryExpr:
+		node.Kind = "unary"
+		node.Name = e.Op.String()
+	case *parser.ParenExpr:
+		node.Kind = "paren"
+	}
+	for _, child := range promql.Children(expr) {
+		node.Children = append(node.Children, toAPIV1ExprNode(text, child))
+	}
+	return node
+}
@@ -0,0 +1,92 @@
+package bo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func TestNewSaveStrategyMetricLevelBoValues(t *testing.T) {
+	single := []enum.ConditionMetric{
+		enum.ConditionMetric_CONDITION_METRIC_EQ,
+		enum.ConditionMetric_CONDITION_METRIC_NE,
+		enum.ConditionMetric_CONDITION_METRIC_GT,
+		enum.ConditionMetric_CONDITION_METRIC_GTE,
+		enum.ConditionMetric_CONDITION_METRIC_LT,
+		enum.ConditionMetric_CONDITION_METRIC_LTE,
+	}
+	ranges := []enum.ConditionMetric{
+		enum.ConditionMetric_CONDITION_METRIC_IN,
+		enum.ConditionMetric_CONDITION_METRIC_NOT_IN,
+	}
+	type testCase struct {
+		condition enum.ConditionMetric
+		values    []int64
+		valid     bool
+	}
+	var cases []testCase
+	for _, condition := range single {
+		cases = append(cases,
+			testCase{condition, []int64{80}, true},
+			testCase{condition, nil, false},
+			testCase{condition, []int64{80, 90}, false},
+		)
+	}
+	for _, condition := range ranges {
+		cases = append(cases,
+			testCase{condition, []int64{80, 90}, true},
+			testCase{condition, []int64{80, 80}, true},
+			testCase{condition, []int64{90, 80}, false},
+			testCase{condition, []int64{80}, false},
+			testCase{condition, []int64{70, 80, 90}, false},
+		)
+	}
+	cases = append(cases, testCase{enum.ConditionMetric(0), []int64{80}, false})
+
+	for _, tc := range cases {
+		_, err := bo.NewSaveStrategyMetricLevelBo(&apiv1.SaveStrategyMetricLevelRequest{
+			StrategyUID: 1,
+			LevelUID:    2,
+			Condition:   tc.condition,
+			Values:      tc.values,
+		})
+		if tc.valid && err != nil {
+			t.Errorf("%s %v: %v", tc.condition, tc.values, err)
+		}
+		if !tc.valid && errors.Code(err) != 400 {
+			t.Errorf("%s %v: got %v, want a params error", tc.condition, tc.values, err)
+		}
+	}
+}
+
+func TestNewSaveStrategyMetricLevelBoInvalid(t *testing.T) {
+	valid := func() *apiv1.SaveStrategyMetricLevelRequest {
+		return &apiv1.SaveStrategyMetricLevelRequest{
+			StrategyUID: 1,
+			LevelUID:    2,
+			Condition:   enum.ConditionMetric_CONDITION_METRIC_GT,
+			Values:      []int64{80},
+		}
+	}
+	for name, mutate := range map[string]func(req *apiv1.SaveStrategyMetricLevelRequest){
+		"no level":           func(req *apiv1.SaveStrategyMetricLevelRequest) { req.LevelUID = 0 },
+		"negative duration":  func(req *apiv1.SaveStrategyMetricLevelRequest) { req.Duration = durationpb.New(-1) },
+		"flap window only":   func(req *apiv1.SaveStrategyMetricLevelRequest) { req.FlapWindow = durationpb.New(time.Minute) },
+		"negative threshold": func(req *apiv1.SaveStrategyMetricLevelRequest) { req.FlapThreshold = -1 },
+	} {
+		req := valid()
+		mutate(req)
+		if _, err := bo.NewSaveStrategyMetricLevelBo(req); errors.Code(err) != 400 {
+			t.Errorf("%s: got %v, want a params error", name, err)
+		}
+	}
+	if _, err := bo.NewSaveStrategyMetricLevelBo(valid()); err != nil {
+		t.Fatalf("valid level: %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package bo
+
+import (
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+type RenderPreviewBo struct {
+	Summary       string
+	Description   string
+	Labels        map[string]string
+	Value         float64
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	DatasourceUID snowflake.ID
+}
+
+func NewRenderPreviewBo(req *apiv1.RenderPreviewRequest) *RenderPreviewBo {
+	return &RenderPreviewBo{
+		Summary:       req.GetSummary(),
+		Description:   req.GetDescription(),
+		Labels:        req.GetLabels(),
+		Value:         req.GetValue(),
+		StrategyUID:   snowflake.ParseInt64(req.GetStrategyUID()),
+		LevelUID:      snowflake.ParseInt64(req.GetLevelUID()),
+		DatasourceUID: snowflake.ParseInt64(req.GetDatasourceUID()),
+	}
+}
+
+type TemplateErrorBo struct {
+	Field   string
+	Message string
+}
+
+type RenderPreviewResultBo struct {
+	Summary     string
+	Description string
+	Errors      []*TemplateErrorBo
+}
+
+func (b *RenderPreviewResultBo) ToAPIV1RenderPreviewReply() *apiv1.RenderPreviewReply {
+	errs := make([]*apiv1.TemplateError, 0, len(b.Errors))
+	for _, e := range b.Errors {
+		errs = append(errs, &apiv1.TemplateError{Field: e.Field, Message: e.Message})
+	}
+	return &apiv1.RenderPreviewReply{
+		Summary:     b.Summary,
+		Description: b.Description,
+		Errors:      errs,
+	}
+}
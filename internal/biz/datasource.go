@@ -2,15 +2,30 @@ package biz
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
 
+	"github.com/aide-family/magicbox/enum"
 	"github.com/aide-family/magicbox/merr"
 	"github.com/bwmarrin/snowflake"
 	klog "github.com/go-kratos/kratos/v2/log"
 
 	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/evaluator"
 	"github.com/aide-family/marksman/internal/biz/repository"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
 )
 
+const datasourceTestTimeout = 10 * time.Second
+
+var errUnsupportedDriver = errors.New("unsupported datasource driver")
+
 func NewDatasource(
 	datasourceRepo repository.Datasource,
 	helper *klog.Helper,
@@ -34,7 +49,17 @@ func (d *DatasourceBiz) CreateDatasource(ctx context.Context, req *bo.CreateData
 	return nil
 }
 
+// UpdateDatasource keeps the stored secrets that were sent back as bo.SecretUnchanged.
 func (d *DatasourceBiz) UpdateDatasource(ctx context.Context, req *bo.UpdateDatasourceBo) error {
+	if req.Config.HasUnchangedSecrets() {
+		stored, err := d.GetDatasource(ctx, req.UID)
+		if err != nil {
+			return err
+		}
+		if err := req.Config.KeepSecrets(stored.Config); err != nil {
+			return err
+		}
+	}
 	if err := d.datasourceRepo.UpdateDatasource(ctx, req); err != nil {
 		if merr.IsNotFound(err) {
 			return merr.ErrorNotFound("datasource %d not found", req.UID.Int64())
@@ -76,3 +101,126 @@ func (d *DatasourceBiz) ListDatasource(ctx context.Context, req *bo.ListDatasour
 	}
 	return result, nil
 }
+
+// RotateSecrets re-encrypts the secrets of all datasources with the primary encryption key.
+func (d *DatasourceBiz) RotateSecrets(ctx context.Context) (int, error) {
+	rotated, err := d.datasourceRepo.RotateDatasourceSecrets(ctx)
+	if err != nil {
+		d.helper.Errorw("msg", "rotate datasource secrets failed", "error", err)
+		return 0, merr.ErrorInternalServer("rotate datasource secrets failed").WithCause(err)
+	}
+	return rotated, nil
+}
+
+// TestDatasource probes a saved or unsaved datasource, connection problems are reported in
+// the result rather than as an error.
+func (d *DatasourceBiz) TestDatasource(ctx context.Context, req *bo.TestDatasourceBo) (*bo.TestDatasourceResultBo, error) {
+	datasource := req.Datasource
+	if datasource == nil {
+		item, err := d.GetDatasource(ctx, req.UID)
+		if err != nil {
+			return nil, err
+		}
+		datasource = &bo.CreateDatasourceBo{Name: item.Name, Type: item.Type, Driver: item.Driver, Metadata: item.Metadata, Config: item.Config}
+	}
+	prober, err := newDatasourceProber(datasource.Driver, datasource.Config)
+	if err != nil {
+		code := apiv1.DatasourceTestErrorCode_TEST_ERROR_INVALID_CONFIG
+		if errors.Is(err, errUnsupportedDriver) {
+			code = apiv1.DatasourceTestErrorCode_TEST_ERROR_UNSUPPORTED_DRIVER
+		}
+		return &bo.TestDatasourceResultBo{ErrorCode: code, ErrorMessage: err.Error()}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, datasourceTestTimeout)
+	defer cancel()
+	start := time.Now()
+	info, err := prober.Probe(ctx)
+	result := &bo.TestDatasourceResultBo{
+		Success:   err == nil,
+		Latency:   time.Since(start),
+		BuildInfo: info,
+	}
+	if err != nil {
+		d.helper.Debugw("msg", "test datasource failed", "error", err, "uid", req.UID, "driver", datasource.Driver)
+		result.ErrorCode = classifyDatasourceError(err)
+		result.ErrorMessage = err.Error()
+	}
+	return result, nil
+}
+
+// newDatasourceProber dispatches on the driver, VictoriaMetrics serves the Prometheus API.
+func newDatasourceProber(driver enum.DatasourceDriver, config *bo.DatasourceConfigBo) (evaluator.Prober, error) {
+	switch driver {
+	case enum.DatasourceDriver_METRICS_PROMETHEUS, enum.DatasourceDriver_METRICS_VICTORIA_METRICS:
+		return newPrometheusQuerier(config)
+	default:
+		return nil, fmt.Errorf("%w %s", errUnsupportedDriver, driver)
+	}
+}
+
+func newPrometheusQuerier(config *bo.DatasourceConfigBo) (*evaluator.PrometheusQuerier, error) {
+	if config == nil || config.URL == "" {
+		return nil, errors.New("datasource url is required")
+	}
+	client, err := newDatasourceHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string, len(config.Headers)+1)
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+	opts := []evaluator.PrometheusOption{evaluator.WithHTTPClient(client)}
+	switch config.AuthMode {
+	case apiv1.DatasourceAuthMode_AUTH_BASIC:
+		opts = append(opts, evaluator.WithBasicAuth(config.Username, config.Password))
+	case apiv1.DatasourceAuthMode_AUTH_BEARER:
+		headers["Authorization"] = "Bearer " + config.BearerToken
+	}
+	opts = append(opts, evaluator.WithHeaders(headers))
+	return evaluator.NewPrometheusQuerier(config.URL, opts...), nil
+}
+
+func newDatasourceHTTPClient(config *bo.DatasourceConfigBo) (*http.Client, error) {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = bo.DefaultDatasourceTimeout
+	}
+	if config.TLS == nil {
+		return &http.Client{Timeout: timeout}, nil
+	}
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.TLS.InsecureSkipVerify,
+		ServerName:         config.TLS.ServerName,
+	}
+	if config.TLS.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.TLS.CACert)) {
+			return nil, errors.New("datasource tls ca cert contains no valid PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Timeout: timeout, Transport: transport}, nil
+}
+
+func classifyDatasourceError(err error) apiv1.DatasourceTestErrorCode {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return apiv1.DatasourceTestErrorCode_TEST_ERROR_TIMEOUT
+	}
+	var statusErr *evaluator.StatusError
+	if errors.As(err, &statusErr) {
+		if statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden {
+			return apiv1.DatasourceTestErrorCode_TEST_ERROR_UNAUTHORIZED
+		}
+		return apiv1.DatasourceTestErrorCode_TEST_ERROR_BAD_RESPONSE
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return apiv1.DatasourceTestErrorCode_TEST_ERROR_UNREACHABLE
+	}
+	return apiv1.DatasourceTestErrorCode_TEST_ERROR_BAD_RESPONSE
+}
@@ -0,0 +1,150 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewEscalationPolicy(
+	escalationPolicyRepo repository.EscalationPolicy,
+	strategyGroupRepo repository.StrategyGroup,
+	levelRepo repository.Level,
+	receiverRepo repository.Receiver,
+	onCallScheduleRepo repository.OnCallSchedule,
+	helper *klog.Helper,
+) *EscalationPolicyBiz {
+	return &EscalationPolicyBiz{
+		escalationPolicyRepo: escalationPolicyRepo,
+		strategyGroupRepo:    strategyGroupRepo,
+		levelRepo:            levelRepo,
+		receiverRepo:         receiverRepo,
+		onCallScheduleRepo:   onCallScheduleRepo,
+		helper:               klog.NewHelper(klog.With(helper.Logger(), "biz", "escalationPolicy")),
+	}
+}
+
+type EscalationPolicyBiz struct {
+	helper               *klog.Helper
+	escalationPolicyRepo repository.EscalationPolicy
+	strategyGroupRepo    repository.StrategyGroup
+	levelRepo            repository.Level
+	receiverRepo         repository.Receiver
+	onCallScheduleRepo   repository.OnCallSchedule
+}
+
+func (e *EscalationPolicyBiz) CreateEscalationPolicy(ctx context.Context, req *bo.CreateEscalationPolicyBo) error {
+	if err := e.checkSpec(ctx, 0, req.EscalationPolicySpecBo); err != nil {
+		return err
+	}
+	if err := e.escalationPolicyRepo.CreateEscalationPolicy(ctx, req); err != nil {
+		e.helper.Errorw("msg", "create escalation policy failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create escalation policy failed").WithCause(err)
+	}
+	return nil
+}
+
+// UpdateEscalationPolicy changes the policy, the escalations already started keep the steps they started with.
+func (e *EscalationPolicyBiz) UpdateEscalationPolicy(ctx context.Context, req *bo.UpdateEscalationPolicyBo) error {
+	if err := e.checkSpec(ctx, req.UID, req.EscalationPolicySpecBo); err != nil {
+		return err
+	}
+	if err := e.escalationPolicyRepo.UpdateEscalationPolicy(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("escalation policy %d not found", req.UID.Int64())
+		}
+		e.helper.Errorw("msg", "update escalation policy failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update escalation policy failed").WithCause(err)
+	}
+	return nil
+}
+
+func (e *EscalationPolicyBiz) UpdateEscalationPolicyStatus(ctx context.Context, req *bo.UpdateEscalationPolicyStatusBo) error {
+	if err := e.escalationPolicyRepo.UpdateEscalationPolicyStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("escalation policy %d not found", req.UID.Int64())
+		}
+		e.helper.Errorw("msg", "update escalation policy status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update escalation policy status failed").WithCause(err)
+	}
+	return nil
+}
+
+// DeleteEscalationPolicy deletes the policy and cancels the escalations it started.
+func (e *EscalationPolicyBiz) DeleteEscalationPolicy(ctx context.Context, uid snowflake.ID) error {
+	if err := e.escalationPolicyRepo.DeleteEscalationPolicy(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("escalation policy %d not found", uid.Int64())
+		}
+		e.helper.Errorw("msg", "delete escalation policy failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete escalation policy failed").WithCause(err)
+	}
+	return nil
+}
+
+func (e *EscalationPolicyBiz) GetEscalationPolicy(ctx context.Context, uid snowflake.ID) (*bo.EscalationPolicyItemBo, error) {
+	item, err := e.escalationPolicyRepo.GetEscalationPolicy(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("escalation policy %d not found", uid.Int64())
+		}
+		e.helper.Errorw("msg", "get escalation policy failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get escalation policy failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (e *EscalationPolicyBiz) ListEscalationPolicy(ctx context.Context, req *bo.ListEscalationPolicyBo) (*bo.PageResponseBo[*bo.EscalationPolicyItemBo], error) {
+	result, err := e.escalationPolicyRepo.ListEscalationPolicy(ctx, req)
+	if err != nil {
+		e.helper.Errorw("msg", "list escalation policy failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list escalation policy failed").WithCause(err)
+	}
+	return result, nil
+}
+
+// checkSpec makes sure the receivers or on-call schedules, strategy groups and levels exist in the
+// current namespace, and that no strategy group or level is attached to another policy than uid.
+func (e *EscalationPolicyBiz) checkSpec(ctx context.Context, uid snowflake.ID, req *bo.EscalationPolicySpecBo) error {
+	if err := checkReceiverUIDs(ctx, e.helper, e.receiverRepo, e.onCallScheduleRepo, req.ReceiverUIDs()...); err != nil {
+		return err
+	}
+	for _, strategyGroupUID := range req.StrategyGroupUIDs {
+		if _, err := e.strategyGroupRepo.GetStrategyGroup(ctx, strategyGroupUID); err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("strategy group %d not found", strategyGroupUID.Int64())
+			}
+			e.helper.Errorw("msg", "get strategy group failed", "error", err, "strategyGroupUID", strategyGroupUID)
+			return merr.ErrorInternalServer("get strategy group failed").WithCause(err)
+		}
+	}
+	for _, levelUID := range req.LevelUIDs {
+		if _, err := e.levelRepo.GetLevel(ctx, levelUID); err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("level %d not found", levelUID.Int64())
+			}
+			e.helper.Errorw("msg", "get level failed", "error", err, "levelUID", levelUID)
+			return merr.ErrorInternalServer("get level failed").WithCause(err)
+		}
+	}
+	bindings, err := e.escalationPolicyRepo.ListEscalationPolicyBindings(ctx, req.StrategyGroupUIDs, req.LevelUIDs)
+	if err != nil {
+		e.helper.Errorw("msg", "list escalation policy bindings failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("list escalation policy bindings failed").WithCause(err)
+	}
+	for _, binding := range bindings {
+		if binding.PolicyUID == uid {
+			continue
+		}
+		if binding.LevelUID != 0 {
+			return merr.ErrorParams("level %d is attached to escalation policy %d", binding.LevelUID.Int64(), binding.PolicyUID.Int64())
+		}
+		return merr.ErrorParams("strategy group %d is attached to escalation policy %d", binding.StrategyGroupUID.Int64(), binding.PolicyUID.Int64())
+	}
+	return nil
+}
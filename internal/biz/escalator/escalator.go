@@ -0,0 +1,190 @@
+// Package escalator pages the next tiers of receivers while an event stays unacknowledged:
+// every step of a policy waits its Delay after the previous step (the first one after the
+// event fired) and then pages its receivers. The timers are kept by a Store, so that they
+// survive restarts, and are cancelled when the event is acknowledged or resolved.
+package escalator
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+const defaultFireLimit = 100
+
+// Step is one tier of a policy.
+type Step struct {
+	Delay        time.Duration  `json:"delay"`
+	ReceiverUIDs []snowflake.ID `json:"receiverUIDs"`
+}
+
+// Policy is the ordered steps paged for an event.
+type Policy struct {
+	UID   snowflake.ID
+	Steps []*Step
+}
+
+// Timer is the pending step of the escalation of an event, persisted by the Store.
+// The steps are copied from the policy when the escalation starts, a later change
+// of the policy applies to the events firing after it.
+type Timer struct {
+	EventUID     snowflake.ID
+	NamespaceUID snowflake.ID
+	PolicyUID    snowflake.ID
+	Steps        []*Step
+	// Step is the index in Steps of the step paged at FireAt.
+	Step    int
+	FireAt  time.Time
+	Message *notifier.Message
+}
+
+// Page is a step being paged.
+type Page struct {
+	PolicyUID    snowflake.ID
+	NamespaceUID snowflake.ID
+	// Step is the 1-based number of the step in its policy.
+	Step         int
+	ReceiverUIDs []snowflake.ID
+	Message      *notifier.Message
+}
+
+// Store persists the timers, there is at most one timer per event.
+type Store interface {
+	// SaveTimer creates the timer of the event, replacing the earlier one.
+	SaveTimer(ctx context.Context, timer *Timer) error
+	// ListDueTimers returns at most limit timers to fire at now.
+	ListDueTimers(ctx context.Context, now time.Time, limit int) ([]*Timer, error)
+	// CompleteStep moves the timer to next, or deletes it when next is nil. It reports false
+	// when the timer was cancelled or moved in the meantime, the step must not be paged then.
+	// In a job guarded by the leader election it fails once another node took the lease over.
+	CompleteStep(ctx context.Context, timer *Timer, next *Timer) (bool, error)
+	// DeleteTimer cancels the escalation of the event.
+	DeleteTimer(ctx context.Context, eventUID snowflake.ID) error
+}
+
+// Pager pages the receivers of a step.
+type Pager interface {
+	Page(ctx context.Context, page *Page) error
+}
+
+type Option func(*Escalator)
+
+// WithFireLimit bounds the timers fired by one Fire.
+func WithFireLimit(limit int) Option {
+	return func(e *Escalator) {
+		e.fireLimit = limit
+	}
+}
+
+// WithClock sets the clock the timers run on.
+func WithClock(now func() time.Time) Option {
+	return func(e *Escalator) {
+		e.now = now
+	}
+}
+
+// NewEscalator returns an Escalator keeping its timers in store and paging the steps with pager.
+func NewEscalator(store Store, pager Pager, helper *klog.Helper, opts ...Option) *Escalator {
+	e := &Escalator{
+		store:     store,
+		pager:     pager,
+		helper:    helper,
+		fireLimit: defaultFireLimit,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// Escalator starts, fires and cancels escalations. Start and Cancel may be called on every
+// node, Fire must only run on one node at a time, e.g. as a job guarded by the leader election.
+type Escalator struct {
+	store     Store
+	pager     Pager
+	helper    *klog.Helper
+	fireLimit int
+	now       func() time.Time
+}
+
+// Start schedules the first step of the policy for the firing message.
+func (e *Escalator) Start(ctx context.Context, policy *Policy, msg *notifier.Message) error {
+	if len(policy.Steps) == 0 {
+		return nil
+	}
+	return e.store.SaveTimer(ctx, &Timer{
+		EventUID:     msg.EventUID,
+		NamespaceUID: msg.NamespaceUID,
+		PolicyUID:    policy.UID,
+		Steps:        policy.Steps,
+		Step:         0,
+		FireAt:       e.now().Add(policy.Steps[0].Delay),
+		Message:      msg,
+	})
+}
+
+// Cancel stops the escalation of the event, e.g. when it is acknowledged or resolved.
+func (e *Escalator) Cancel(ctx context.Context, eventUID snowflake.ID) error {
+	return e.store.DeleteTimer(ctx, eventUID)
+}
+
+// Fire pages the steps that are due, it returns the number of steps paged. A timer is
+// moved to its next step before the step is paged, so that a crash never pages a step twice.
+func (e *Escalator) Fire(ctx context.Context) (int, error) {
+	timers, err := e.store.ListDueTimers(ctx, e.now(), e.fireLimit)
+	if err != nil {
+		return 0, err
+	}
+	paged := 0
+	for _, timer := range timers {
+		if err := ctx.Err(); err != nil {
+			return paged, err
+		}
+		if timer.Step < 0 || timer.Step >= len(timer.Steps) {
+			// the steps were stored by another version, there is nothing left to page
+			if _, err := e.store.CompleteStep(ctx, timer, nil); err != nil {
+				return paged, err
+			}
+			continue
+		}
+		ok, err := e.store.CompleteStep(ctx, timer, timer.next())
+		if err != nil {
+			return paged, err
+		}
+		if !ok {
+			continue
+		}
+		step := timer.Steps[timer.Step]
+		page := &Page{
+			PolicyUID:    timer.PolicyUID,
+			NamespaceUID: timer.NamespaceUID,
+			Step:         timer.Step + 1,
+			ReceiverUIDs: slices.Clone(step.ReceiverUIDs),
+			Message:      timer.Message,
+		}
+		if err := e.pager.Page(ctx, page); err != nil {
+			e.helper.Warnw("msg", "page escalation step failed", "eventUID", timer.EventUID, "policyUID", timer.PolicyUID, "step", page.Step, "error", err)
+		}
+		paged++
+	}
+	return paged, nil
+}
+
+// next returns the timer of the step following the current one, nil after the last step.
+// The delay counts from when the current step was due, so that a late Fire does not
+// push the following steps back.
+func (t *Timer) next() *Timer {
+	if t.Step+1 >= len(t.Steps) {
+		return nil
+	}
+	next := *t
+	next.Step++
+	next.FireAt = t.FireAt.Add(t.Steps[next.Step].Delay)
+	return &next
+}
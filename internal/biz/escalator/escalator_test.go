@@ -0,0 +1,283 @@
+package escalator_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+// memoryStore keeps the timers the way the database repository does.
+type memoryStore struct {
+	mu     sync.Mutex
+	timers map[snowflake.ID]*escalator.Timer
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{timers: make(map[snowflake.ID]*escalator.Timer)}
+}
+
+func (s *memoryStore) SaveTimer(_ context.Context, timer *escalator.Timer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *timer
+	s.timers[timer.EventUID] = &stored
+	return nil
+}
+
+func (s *memoryStore) ListDueTimers(_ context.Context, now time.Time, limit int) ([]*escalator.Timer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	due := make([]*escalator.Timer, 0, len(s.timers))
+	for _, timer := range s.timers {
+		if !timer.FireAt.After(now) {
+			stored := *timer
+			due = append(due, &stored)
+		}
+	}
+	slices.SortFunc(due, func(a, b *escalator.Timer) int { return a.FireAt.Compare(b.FireAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (s *memoryStore) CompleteStep(_ context.Context, timer *escalator.Timer, next *escalator.Timer) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.timers[timer.EventUID]
+	if !ok || stored.Step != timer.Step || stored.PolicyUID != timer.PolicyUID {
+		return false, nil
+	}
+	if next == nil {
+		delete(s.timers, timer.EventUID)
+		return true, nil
+	}
+	moved := *next
+	s.timers[timer.EventUID] = &moved
+	return true, nil
+}
+
+func (s *memoryStore) DeleteTimer(_ context.Context, eventUID snowflake.ID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.timers, eventUID)
+	return nil
+}
+
+func (s *memoryStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.timers)
+}
+
+// fakeWebhook records the messages it receives.
+type fakeWebhook struct {
+	mu   sync.Mutex
+	msgs []*notifier.Message
+}
+
+func (f *fakeWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var msg notifier.Message
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.msgs = append(f.msgs, &msg)
+}
+
+// take returns the uids of the events received since the last call.
+func (f *fakeWebhook) take() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	uids := make([]int64, 0, len(f.msgs))
+	for _, msg := range f.msgs {
+		uids = append(uids, msg.EventUID.Int64())
+	}
+	f.msgs = nil
+	slices.Sort(uids)
+	return uids
+}
+
+// webhookPager posts the message of a page to the webhook of every receiver of the step.
+type webhookPager struct {
+	urls map[snowflake.ID]string
+}
+
+func (p *webhookPager) Page(ctx context.Context, page *escalator.Page) error {
+	for _, receiverUID := range page.ReceiverUIDs {
+		if _, err := notifier.NewWebhookSender(p.urls[receiverUID], nil).Send(ctx, page.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// tiers starts one local webhook per receiver.
+func tiers(t *testing.T, receiverUIDs ...snowflake.ID) (*webhookPager, map[snowflake.ID]*fakeWebhook) {
+	t.Helper()
+	pager := &webhookPager{urls: make(map[snowflake.ID]string)}
+	hooks := make(map[snowflake.ID]*fakeWebhook)
+	for _, uid := range receiverUIDs {
+		hook := &fakeWebhook{}
+		srv := httptest.NewServer(hook)
+		t.Cleanup(srv.Close)
+		pager.urls[uid], hooks[uid] = srv.URL, hook
+	}
+	return pager, hooks
+}
+
+var policy = &escalator.Policy{
+	UID: 9,
+	Steps: []*escalator.Step{
+		{Delay: 10 * time.Minute, ReceiverUIDs: []snowflake.ID{2}},
+		{Delay: 15 * time.Minute, ReceiverUIDs: []snowflake.ID{3, 4}},
+	},
+}
+
+func message(eventUID int64) *notifier.Message {
+	return &notifier.Message{
+		Version:      notifier.MessageVersion,
+		Status:       notifier.StatusFiring,
+		EventUID:     snowflake.ParseInt64(eventUID),
+		NamespaceUID: 1,
+		Labels:       map[string]string{"alertname": "HighCPU"},
+		StartsAt:     time.Unix(1700000000, 0),
+	}
+}
+
+func fire(t *testing.T, e *escalator.Escalator, want int) {
+	t.Helper()
+	paged, err := e.Fire(context.Background())
+	if err != nil {
+		t.Fatalf("fire: %v", err)
+	}
+	if paged != want {
+		t.Fatalf("fire paged %d steps, want %d", paged, want)
+	}
+}
+
+func TestEscalatorSteps(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	store := newMemoryStore()
+	pager, hooks := tiers(t, 2, 3, 4)
+	e := escalator.NewEscalator(store, pager, klog.NewHelper(klog.DefaultLogger), escalator.WithClock(clock.Now))
+
+	for _, uid := range []int64{100, 101} {
+		if err := e.Start(ctx, policy, message(uid)); err != nil {
+			t.Fatalf("start: %v", err)
+		}
+	}
+
+	clock.Advance(9 * time.Minute)
+	fire(t, e, 0)
+
+	// 101 is acknowledged before it is escalated
+	if err := e.Cancel(ctx, 101); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	clock.Advance(time.Minute)
+	fire(t, e, 1)
+	if got := hooks[2].take(); !slices.Equal(got, []int64{100}) {
+		t.Fatalf("first tier got %v, want [100]", got)
+	}
+	if got := hooks[3].take(); len(got) != 0 {
+		t.Fatalf("second tier paged before its delay: %v", got)
+	}
+
+	// a late fire does not push the second step back
+	clock.Advance(16 * time.Minute)
+	fire(t, e, 1)
+	for _, uid := range []snowflake.ID{3, 4} {
+		if got := hooks[uid].take(); !slices.Equal(got, []int64{100}) {
+			t.Fatalf("second tier receiver %d got %v, want [100]", uid, got)
+		}
+	}
+	if got := hooks[2].take(); len(got) != 0 {
+		t.Fatalf("first tier paged twice: %v", got)
+	}
+
+	// the policy is exhausted
+	clock.Advance(time.Hour)
+	fire(t, e, 0)
+	if store.count() != 0 {
+		t.Fatalf("%d timers left after the last step", store.count())
+	}
+}
+
+func TestEscalatorCancelBetweenSteps(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	store := newMemoryStore()
+	pager, hooks := tiers(t, 2, 3, 4)
+	e := escalator.NewEscalator(store, pager, klog.NewHelper(klog.DefaultLogger), escalator.WithClock(clock.Now))
+
+	if err := e.Start(ctx, policy, message(100)); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	clock.Advance(10 * time.Minute)
+	fire(t, e, 1)
+	hooks[2].take()
+
+	// resolved after the first tier was paged
+	if err := e.Cancel(ctx, 100); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	clock.Advance(time.Hour)
+	fire(t, e, 0)
+	for _, uid := range []snowflake.ID{2, 3, 4} {
+		if got := hooks[uid].take(); len(got) != 0 {
+			t.Fatalf("receiver %d paged after cancel: %v", uid, got)
+		}
+	}
+}
+
+func TestEscalatorSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	store := newMemoryStore()
+	pager, hooks := tiers(t, 2, 3, 4)
+	before := escalator.NewEscalator(store, pager, klog.NewHelper(klog.DefaultLogger), escalator.WithClock(clock.Now))
+	if err := before.Start(ctx, policy, message(100)); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+
+	// the node stops and another one fires the timer from the store
+	clock.Advance(10 * time.Minute)
+	after := escalator.NewEscalator(store, pager, klog.NewHelper(klog.DefaultLogger), escalator.WithClock(clock.Now))
+	fire(t, after, 1)
+	if got := hooks[2].take(); !slices.Equal(got, []int64{100}) {
+		t.Fatalf("first tier got %v, want [100]", got)
+	}
+	fire(t, after, 0)
+}
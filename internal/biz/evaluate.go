@@ -0,0 +1,297 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/hello"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/common/model"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/biz/shard"
+)
+
+const (
+	// strategyMetadataInterval is the strategy metadata key holding the evaluation interval, e.g. "30s" or "1d".
+	strategyMetadataInterval = "interval"
+	// strategyMetadataKeepFiringFor is the strategy metadata key holding how long an alert keeps
+	// firing after its series stopped matching, e.g. "5m".
+	strategyMetadataKeepFiringFor = "keep_firing_for"
+)
+
+func NewEvaluate(
+	strategyMetricRepo repository.StrategyMetric,
+	alertStateRepo repository.AlertState,
+	eventRepo repository.Event,
+	jobNodeRepo repository.JobNode,
+	leaseRepo repository.Lease,
+	notifyBiz *NotifyBiz,
+	jobEngine *job.Engine,
+	helper *klog.Helper,
+) *EvaluateBiz {
+	e := &EvaluateBiz{
+		strategyMetricRepo: strategyMetricRepo,
+		alertStateRepo:     alertStateRepo,
+		eventRepo:          eventRepo,
+		notifyBiz:          notifyBiz,
+		helper:             klog.NewHelper(klog.With(helper.Logger(), "biz", "evaluate")),
+	}
+	e.sharder = shard.NewSharder(hello.ID(), jobNodeRepo, e.helper, shard.WithLeases(leaseRepo))
+	e.manager = evaluator.NewManager(evaluator.HandlerFunc(e.handleEvents), e.helper,
+		evaluator.WithStateStore(e),
+		evaluator.WithEngine(jobEngine),
+		evaluator.WithOwnership(e.owns),
+		evaluator.WithHandoff(e),
+	)
+	return e
+}
+
+type EvaluateBiz struct {
+	helper             *klog.Helper
+	strategyMetricRepo repository.StrategyMetric
+	alertStateRepo     repository.AlertState
+	eventRepo          repository.Event
+	notifyBiz          *NotifyBiz
+	sharder            *shard.Sharder
+	manager            *evaluator.Manager
+}
+
+// LoadAlerts implements evaluator.StateStore.
+func (e *EvaluateBiz) LoadAlerts(ctx context.Context, strategyUID snowflake.ID) ([]*evaluator.Alert, error) {
+	states, err := e.alertStateRepo.ListAlertStates(ctx, strategyUID)
+	if err != nil {
+		return nil, err
+	}
+	alerts := make([]*evaluator.Alert, 0, len(states))
+	for _, state := range states {
+		alerts = append(alerts, state.ToEvaluatorAlert())
+	}
+	return alerts, nil
+}
+
+// SaveAlerts implements evaluator.StateStore.
+func (e *EvaluateBiz) SaveAlerts(ctx context.Context, strategyUID snowflake.ID, alerts []*evaluator.Alert) ([]evaluator.Key, error) {
+	states := make([]*bo.AlertStateBo, 0, len(alerts))
+	for _, alert := range alerts {
+		states = append(states, bo.NewAlertStateBo(alert))
+	}
+	resolved, err := e.alertStateRepo.SaveAlertStates(ctx, strategyUID, states)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]evaluator.Key, 0, len(resolved))
+	for _, state := range resolved {
+		keys = append(keys, state.ToEvaluatorAlert().Key())
+	}
+	return keys, nil
+}
+
+// RefreshJobNodes sends the heartbeat of this node and reloads the job nodes, it reports
+// whether the strategies owned by this node may have changed.
+func (e *EvaluateBiz) RefreshJobNodes(ctx context.Context) (bool, error) {
+	changed, err := e.sharder.Refresh(ctx)
+	if err != nil {
+		e.helper.Errorw("msg", "refresh job nodes failed", "error", err, "nodeID", e.sharder.NodeID())
+		return false, merr.ErrorInternalServer("refresh job nodes failed").WithCause(err)
+	}
+	return changed, nil
+}
+
+// LeaveJobNodes hands the strategies of this node over to the other job nodes.
+func (e *EvaluateBiz) LeaveJobNodes(ctx context.Context) error {
+	if err := e.sharder.Leave(ctx); err != nil {
+		e.helper.Errorw("msg", "leave job nodes failed", "error", err, "nodeID", e.sharder.NodeID())
+		return merr.ErrorInternalServer("leave job nodes failed").WithCause(err)
+	}
+	return nil
+}
+
+// owns reports whether this node evaluates the strategy.
+func (e *EvaluateBiz) owns(strategyUID snowflake.ID) bool {
+	return e.sharder.Owns(strategyUID.String())
+}
+
+// Acquire implements evaluator.Handoff.
+func (e *EvaluateBiz) Acquire(ctx context.Context, strategyUID snowflake.ID, ttl time.Duration) (bool, error) {
+	return e.sharder.Acquire(ctx, strategyUID.String(), ttl)
+}
+
+// Release implements evaluator.Handoff.
+func (e *EvaluateBiz) Release(ctx context.Context, strategyUID snowflake.ID) error {
+	return e.sharder.Release(ctx, strategyUID.String())
+}
+
+// SyncRules reloads the enabled metric strategies and hands them to the evaluator, which
+// evaluates the ones owned by this node.
+func (e *EvaluateBiz) SyncRules(ctx context.Context) error {
+	list, err := e.strategyMetricRepo.ListStrategyMetricRules(ctx)
+	if err != nil {
+		e.helper.Errorw("msg", "list strategy metric rules failed", "error", err)
+		return merr.ErrorInternalServer("list strategy metric rules failed").WithCause(err)
+	}
+	rules := make([]*evaluator.Rule, 0, len(list))
+	for _, item := range list {
+		if rule := e.toEvaluatorRule(item); rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+	e.manager.Sync(rules)
+	return nil
+}
+
+// Stop stops all evaluation loops, then the pending notifications.
+func (e *EvaluateBiz) Stop() {
+	e.manager.Stop()
+	e.notifyBiz.Stop()
+}
+
+func (e *EvaluateBiz) handleEvents(ctx context.Context, events []*evaluator.Event) {
+	evalAt := time.Now()
+	saveEvents := make([]*bo.SaveEventBo, 0, len(events))
+	var summaries []*evaluator.Event
+	for _, event := range events {
+		if event.Flap != nil {
+			summaries = append(summaries, event)
+			continue
+		}
+		saveEvents = append(saveEvents, bo.NewSaveEventBo(event, evalAt))
+		e.helper.Debugw("msg", "strategy event",
+			"state", event.State.String(),
+			"namespaceUID", event.NamespaceUID,
+			"strategyUID", event.StrategyUID,
+			"levelUID", event.LevelUID,
+			"datasourceUID", event.DatasourceUID,
+			"fingerprint", event.Fingerprint,
+			"labels", event.Labels,
+			"value", event.Value,
+			"flapping", event.Flapping,
+		)
+	}
+	changed, err := e.eventRepo.SaveEvents(ctx, saveEvents)
+	if err != nil {
+		e.helper.Errorw("msg", "save strategy events failed", "error", err, "count", len(saveEvents))
+		return
+	}
+	e.notifyBiz.Notify(ctx, changed)
+	for _, summary := range summaries {
+		e.handleFlapSummary(ctx, summary, evalAt)
+	}
+}
+
+// handleFlapSummary clears the flapping flag of the latest event of a series that stopped
+// flapping and sends the summary of the flapping period instead of the held back events.
+func (e *EvaluateBiz) handleFlapSummary(ctx context.Context, summary *evaluator.Event, evalAt time.Time) {
+	event, err := e.eventRepo.StabilizeEvent(ctx, bo.NewSaveEventBo(summary, evalAt))
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return
+		}
+		e.helper.Errorw("msg", "stabilize strategy event failed", "error", err,
+			"strategyUID", summary.StrategyUID, "levelUID", summary.LevelUID, "fingerprint", summary.Fingerprint)
+		return
+	}
+	e.helper.Debugw("msg", "strategy event stopped flapping",
+		"eventUID", event.UID,
+		"since", summary.Flap.Since,
+		"until", summary.Flap.Until,
+		"transitions", summary.Flap.Transitions,
+	)
+	e.notifyBiz.NotifyFlapSummary(ctx, event, summary.Flap)
+}
+
+func (e *EvaluateBiz) toEvaluatorRule(item *bo.StrategyMetricRuleBo) *evaluator.Rule {
+	datasources := make([]*evaluator.Datasource, 0, len(item.Datasources))
+	for _, datasource := range item.Datasources {
+		if datasource.Driver != enum.DatasourceDriver_METRICS_PROMETHEUS {
+			e.helper.Debugw("msg", "skip unsupported datasource driver", "datasourceUID", datasource.UID, "driver", datasource.Driver)
+			continue
+		}
+		item := &evaluator.Datasource{UID: datasource.UID, Name: datasource.Name}
+		querier, err := newPrometheusQuerier(datasource.Config)
+		if err != nil {
+			// the rule keeps the datasource, dropping either would resolve its alerts when the
+			// config is only unusable for a while, e.g. until it decrypts again
+			e.helper.Warnw("msg", "datasource unavailable, keep its alerts", "datasourceUID", datasource.UID, "error", err)
+			item.Querier = unavailableQuerier{err: err}
+		} else {
+			item.Querier = querier
+		}
+		datasources = append(datasources, item)
+	}
+	if len(datasources) == 0 {
+		return nil
+	}
+	levels := make([]*evaluator.Level, 0, len(item.Levels))
+	for _, level := range item.Levels {
+		var name string
+		if level.Level != nil {
+			name = level.Level.Name
+		}
+		levels = append(levels, &evaluator.Level{
+			LevelUID:  level.LevelUID,
+			Name:      name,
+			Mode:      level.Mode,
+			Condition: level.Condition,
+			Values:    level.Values,
+			Duration:  level.Duration,
+			Flap:      level.FlapConfig(),
+		})
+	}
+	interval := evaluator.DefaultInterval
+	if value := item.StrategyMetadata[strategyMetadataInterval]; value != "" {
+		parsed, err := parseMetadataDuration(value)
+		if err != nil || parsed <= 0 {
+			e.helper.Warnw("msg", "invalid strategy interval, use default", "strategyUID", item.StrategyUID, "interval", value)
+		} else {
+			interval = parsed
+		}
+	}
+	var keepFiringFor time.Duration
+	if value := item.StrategyMetadata[strategyMetadataKeepFiringFor]; value != "" {
+		parsed, err := parseMetadataDuration(value)
+		if err != nil || parsed < 0 {
+			e.helper.Warnw("msg", "invalid strategy keep firing for, ignore it", "strategyUID", item.StrategyUID, "keepFiringFor", value)
+		} else {
+			keepFiringFor = parsed
+		}
+	}
+	return &evaluator.Rule{
+		NamespaceUID:  item.NamespaceUID,
+		StrategyUID:   item.StrategyUID,
+		Expr:          item.Expr,
+		Labels:        item.Labels,
+		Summary:       item.Summary,
+		Description:   item.Description,
+		Interval:      interval,
+		KeepFiringFor: keepFiringFor,
+		Datasources:   datasources,
+		Levels:        levels,
+	}
+}
+
+// parseMetadataDuration parses a duration of the strategy metadata the way Prometheus does, e.g.
+// "1d" or "1h30m", values such as "1.5s" written before are still read.
+func parseMetadataDuration(value string) (time.Duration, error) {
+	d, err := model.ParseDuration(value)
+	if err != nil {
+		return time.ParseDuration(value)
+	}
+	return time.Duration(d), nil
+}
+
+// unavailableQuerier fails every query of a datasource whose config can not be used, the
+// evaluator keeps the alerts of a datasource whose query failed as they are.
+type unavailableQuerier struct {
+	err error
+}
+
+func (q unavailableQuerier) Query(context.Context, string, time.Time) ([]*evaluator.Series, error) {
+	return nil, q.err
+}
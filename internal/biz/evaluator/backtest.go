@@ -0,0 +1,147 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/prometheus/common/model"
+)
+
+// BacktestInterval is one period a series fired for a level, EndsAt is zero when the
+// series still fired at the end of the backtest.
+type BacktestInterval struct {
+	ActiveAt time.Time
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// BacktestSeries is a series that fired at least once, with its firing intervals by level.
+type BacktestSeries struct {
+	DatasourceUID snowflake.ID
+	Labels        map[string]string
+	Fingerprint   uint64
+	Intervals     map[snowflake.ID][]*BacktestInterval
+}
+
+type BacktestResult struct {
+	// Steps is the number of evaluations replayed per series
+	Steps  int
+	Series []*BacktestSeries
+	// Errors are the failed queries, the series of their datasources are left out
+	Errors []error
+}
+
+// Backtest runs the expression of rule as a range query over [start, end] and replays
+// the evaluations Eval would have made every step: a series of a level goes pending at
+// the first step its value matches, fires once it kept matching for the level duration
+// and resolves at the first step it no longer matches or is missing once the KeepFiringFor
+// of the rule passed since it last matched. Flap detection is
+// not replayed. The error is only returned for an invalid range.
+func Backtest(ctx context.Context, rule *Rule, start, end time.Time, step time.Duration) (*BacktestResult, error) {
+	if step <= 0 || end.Before(start) {
+		return nil, fmt.Errorf("invalid range %s to %s with step %s", start, end, step)
+	}
+	result := &BacktestResult{Steps: int(end.Sub(start)/step) + 1}
+	for _, datasource := range rule.Datasources {
+		querier, ok := datasource.Querier.(RangeQuerier)
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Errorf("datasource %d: range queries are not supported", datasource.UID.Int64()))
+			continue
+		}
+		list, err := querier.QueryRange(ctx, rule.Expr, start, end, step)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("datasource %d: %w", datasource.UID.Int64(), err))
+			continue
+		}
+		for _, series := range list {
+			labels, fingerprint := seriesLabels(rule, datasource.UID, series)
+			replayed := &BacktestSeries{
+				DatasourceUID: datasource.UID,
+				Labels:        labels,
+				Fingerprint:   fingerprint,
+				Intervals:     make(map[snowflake.ID][]*BacktestInterval),
+			}
+			points := alignPoints(series.Points, start, step, result.Steps)
+			for _, level := range rule.Levels {
+				if intervals := replayLevel(level, points, start, step, rule.KeepFiringFor); len(intervals) > 0 {
+					replayed.Intervals[level.LevelUID] = intervals
+				}
+			}
+			if len(replayed.Intervals) > 0 {
+				result.Series = append(result.Series, replayed)
+			}
+		}
+	}
+	sort.Slice(result.Series, func(i, j int) bool {
+		a, b := result.Series[i], result.Series[j]
+		if a.DatasourceUID != b.DatasourceUID {
+			return a.DatasourceUID < b.DatasourceUID
+		}
+		return labelsString(a.Labels) < labelsString(b.Labels)
+	})
+	return result, nil
+}
+
+// alignPoints places every point at its step, a nil entry is a step without a point.
+func alignPoints(points []Point, start time.Time, step time.Duration, steps int) []*Point {
+	aligned := make([]*Point, steps)
+	for i := range points {
+		offset := points[i].Timestamp.Sub(start)
+		if offset < 0 {
+			continue
+		}
+		// round to the nearest step, timestamps come back with millisecond precision
+		index := int((offset + step/2) / step)
+		if index < steps {
+			aligned[index] = &points[i]
+		}
+	}
+	return aligned
+}
+
+// replayLevel moves one series through pending, firing and resolved for level, a firing
+// interval is kept for keepFiringFor after the series last matched.
+func replayLevel(level *Level, points []*Point, start time.Time, step time.Duration, keepFiringFor time.Duration) []*BacktestInterval {
+	var (
+		intervals []*BacktestInterval
+		firing    *BacktestInterval
+		activeAt  time.Time
+		active    bool
+		matchedAt time.Time
+	)
+	for i, point := range points {
+		ts := start.Add(time.Duration(i) * step)
+		matched := false
+		if point != nil {
+			_, matched = Sample(level.Mode, level.Condition, level.Values, []Point{*point})
+		}
+		if !matched {
+			if firing != nil && ts.Sub(matchedAt) >= keepFiringFor {
+				firing.EndsAt = ts
+				firing = nil
+			}
+			active = false
+			continue
+		}
+		matchedAt = ts
+		if !active {
+			active, activeAt = true, ts
+		}
+		if firing == nil && ts.Sub(activeAt) >= level.Duration {
+			firing = &BacktestInterval{ActiveAt: activeAt, StartsAt: ts}
+			intervals = append(intervals, firing)
+		}
+	}
+	return intervals
+}
+
+func labelsString(labels map[string]string) string {
+	set := make(model.LabelSet, len(labels))
+	for name, value := range labels {
+		set[model.LabelName(name)] = model.LabelValue(value)
+	}
+	return set.String()
+}
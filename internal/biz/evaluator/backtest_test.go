@@ -0,0 +1,161 @@
+package evaluator_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+)
+
+// matrix returns a range query response, a nil value leaves the step out.
+func matrix(start float64, step float64, series map[string][]*string) map[string]any {
+	result := make([]map[string]any, 0, len(series))
+	for instance, values := range series {
+		pairs := make([]any, 0, len(values))
+		for i, value := range values {
+			if value != nil {
+				pairs = append(pairs, []any{start + float64(i)*step, *value})
+			}
+		}
+		result = append(result, map[string]any{"metric": map[string]string{"__name__": "up", "instance": instance}, "values": pairs})
+	}
+	return map[string]any{
+		"status": "success",
+		"data":   map[string]any{"resultType": "matrix", "result": result},
+	}
+}
+
+func values(list ...string) []*string {
+	out := make([]*string, 0, len(list))
+	for _, value := range list {
+		if value == "_" {
+			out = append(out, nil)
+			continue
+		}
+		out = append(out, &value)
+	}
+	return out
+}
+
+// instantOnly is a datasource that cannot run range queries.
+type instantOnly struct{}
+
+func (instantOnly) Query(context.Context, string, time.Time) ([]*evaluator.Series, error) {
+	return nil, nil
+}
+
+func TestBacktest(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	var form map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" || r.ParseForm() != nil {
+			http.NotFound(w, r)
+			return
+		}
+		form = map[string]string{"query": r.Form.Get("query"), "start": r.Form.Get("start"), "end": r.Form.Get("end"), "step": r.Form.Get("step")}
+		_ = json.NewEncoder(w).Encode(matrix(1700000000, 60, map[string][]*string{
+			"a": values("0", "1", "1", "1", "0", "1", "1"),
+			"b": values("1", "1", "1", "_", "1"),
+			"c": values("0", "0", "0"),
+		}))
+	}))
+	defer srv.Close()
+
+	gt := func(uid snowflake.ID, duration time.Duration) *evaluator.Level {
+		return &evaluator.Level{
+			LevelUID:  uid,
+			Mode:      enum.SampleMode_SAMPLE_MODE_FOR,
+			Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+			Values:    []int64{0},
+			Duration:  duration,
+		}
+	}
+	rule := &evaluator.Rule{
+		StrategyUID: 10,
+		Expr:        `up`,
+		Labels:      map[string]string{"team": "ops"},
+		Datasources: []*evaluator.Datasource{{UID: 100, Querier: evaluator.NewPrometheusQuerier(srv.URL)}},
+		Levels:      []*evaluator.Level{gt(1, 0), gt(2, time.Minute)},
+	}
+	result, err := evaluator.Backtest(context.Background(), rule, start, start.Add(6*time.Minute), time.Minute)
+	if err != nil || len(result.Errors) > 0 {
+		t.Fatalf("backtest: %v %v", err, result.Errors)
+	}
+	if form["query"] != "up" || form["start"] != "1700000000" || form["end"] != "1700000360" || form["step"] != "60" {
+		t.Fatalf("unexpected range query %v", form)
+	}
+	if result.Steps != 7 || len(result.Series) != 2 {
+		t.Fatalf("got %d steps and %d series, want 7 steps and the 2 series that fired", result.Steps, len(result.Series))
+	}
+
+	format := func(intervals []*evaluator.BacktestInterval) string {
+		var parts []string
+		for _, interval := range intervals {
+			part := interval.ActiveAt.Sub(start).String() + "/" + interval.StartsAt.Sub(start).String() + "-"
+			if !interval.EndsAt.IsZero() {
+				part += interval.EndsAt.Sub(start).String()
+			}
+			parts = append(parts, part)
+		}
+		return strings.Join(parts, " ")
+	}
+	a, b := result.Series[0], result.Series[1]
+	if a.Labels["instance"] != "a" || a.Labels["team"] != "ops" || a.DatasourceUID != 100 || a.Labels["__name__"] != "" {
+		t.Fatalf("unexpected first series %+v", a)
+	}
+	for _, tc := range []struct {
+		series *evaluator.BacktestSeries
+		level  snowflake.ID
+		want   string
+	}{
+		// level 1 fires at once, level 2 after matching for a minute
+		{a, 1, "1m0s/1m0s-4m0s 5m0s/5m0s-"},
+		{a, 2, "1m0s/2m0s-4m0s 5m0s/6m0s-"},
+		// a missing point resolves the series, as does the end of its points
+		{b, 1, "0s/0s-3m0s 4m0s/4m0s-5m0s"},
+		{b, 2, "0s/1m0s-3m0s"},
+	} {
+		if got := format(tc.series.Intervals[tc.level]); got != tc.want {
+			t.Errorf("series %s level %d: got intervals %q, want %q", tc.series.Labels["instance"], tc.level, got, tc.want)
+		}
+	}
+	// a firing series is kept for KeepFiringFor after it last matched
+	rule.KeepFiringFor = 2 * time.Minute
+	result, err = evaluator.Backtest(context.Background(), rule, start, start.Add(6*time.Minute), time.Minute)
+	if err != nil || len(result.Series) != 2 {
+		t.Fatalf("backtest: %v", err)
+	}
+	a, b = result.Series[0], result.Series[1]
+	for _, tc := range []struct {
+		series *evaluator.BacktestSeries
+		level  snowflake.ID
+		want   string
+	}{
+		{a, 1, "1m0s/1m0s-"},
+		{a, 2, "1m0s/2m0s-"},
+		{b, 1, "0s/0s-6m0s"},
+		{b, 2, "0s/1m0s-6m0s"},
+	} {
+		if got := format(tc.series.Intervals[tc.level]); got != tc.want {
+			t.Errorf("keep firing, series %s level %d: got intervals %q, want %q", tc.series.Labels["instance"], tc.level, got, tc.want)
+		}
+	}
+	rule.KeepFiringFor = 0
+
+	rule.Datasources = append(rule.Datasources, &evaluator.Datasource{UID: 200, Querier: instantOnly{}})
+	result, err = evaluator.Backtest(context.Background(), rule, start, start.Add(6*time.Minute), time.Minute)
+	if err != nil || len(result.Errors) != 1 || len(result.Series) != 2 {
+		t.Fatalf("got %v, errors %v and %d series, want the error of the datasource without range queries", err, result.Errors, len(result.Series))
+	}
+	if _, err := evaluator.Backtest(context.Background(), rule, start, start.Add(-time.Minute), time.Minute); err == nil {
+		t.Fatal("expected an error for an end before the start")
+	}
+}
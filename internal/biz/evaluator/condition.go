@@ -0,0 +1,66 @@
+package evaluator
+
+import (
+	"math"
+
+	"github.com/aide-family/magicbox/enum"
+)
+
+// Judge reports whether value satisfies condition against the thresholds in values.
+// IN and NOT_IN treat values as a closed [min, max] range.
+func Judge(condition enum.ConditionMetric, values []int64, value float64) bool {
+	if math.IsNaN(value) || len(values) == 0 {
+		return false
+	}
+	threshold := float64(values[0])
+	switch condition {
+	case enum.ConditionMetric_CONDITION_METRIC_EQ:
+		return value == threshold
+	case enum.ConditionMetric_CONDITION_METRIC_NE:
+		return value != threshold
+	case enum.ConditionMetric_CONDITION_METRIC_GT:
+		return value > threshold
+	case enum.ConditionMetric_CONDITION_METRIC_GTE:
+		return value >= threshold
+	case enum.ConditionMetric_CONDITION_METRIC_LT:
+		return value < threshold
+	case enum.ConditionMetric_CONDITION_METRIC_LTE:
+		return value <= threshold
+	case enum.ConditionMetric_CONDITION_METRIC_IN:
+		return len(values) == 2 && value >= threshold && value <= float64(values[1])
+	case enum.ConditionMetric_CONDITION_METRIC_NOT_IN:
+		return len(values) == 2 && (value < threshold || value > float64(values[1]))
+	default:
+		return false
+	}
+}
+
+// Sample reduces the points of a series according to mode and judges the result.
+// FOR requires every point to satisfy the condition and reports the latest value,
+// MAX and MIN judge the largest and the smallest point.
+func Sample(mode enum.SampleMode, condition enum.ConditionMetric, values []int64, points []Point) (float64, bool) {
+	if len(points) == 0 {
+		return 0, false
+	}
+	switch mode {
+	case enum.SampleMode_SAMPLE_MODE_MAX:
+		value := points[0].Value
+		for _, point := range points[1:] {
+			value = math.Max(value, point.Value)
+		}
+		return value, Judge(condition, values, value)
+	case enum.SampleMode_SAMPLE_MODE_MIN:
+		value := points[0].Value
+		for _, point := range points[1:] {
+			value = math.Min(value, point.Value)
+		}
+		return value, Judge(condition, values, value)
+	default:
+		for _, point := range points {
+			if !Judge(condition, values, point.Value) {
+				return point.Value, false
+			}
+		}
+		return points[len(points)-1].Value, true
+	}
+}
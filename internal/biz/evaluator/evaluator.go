@@ -0,0 +1,468 @@
+// Package evaluator evaluates metric strategies against their datasources.
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"github.com/prometheus/common/model"
+
+	"github.com/aide-family/marksman/internal/biz/flapper"
+)
+
+// DefaultInterval is used when a rule does not define its own interval.
+const DefaultInterval = time.Minute
+
+// datasourceLabel is mixed into the fingerprint so that the same series coming
+// from two datasources is tracked separately, it never shows up in event labels.
+const datasourceLabel = "__datasource_uid__"
+
+// Rule is a metric strategy ready to be evaluated.
+type Rule struct {
+	NamespaceUID snowflake.ID
+	StrategyUID  snowflake.ID
+	Expr         string
+	Labels       map[string]string
+	Summary      string
+	Description  string
+	Interval     time.Duration
+	// KeepFiringFor keeps a firing alert firing for this long after its series last matched.
+	KeepFiringFor time.Duration
+	Datasources   []*Datasource
+	Levels        []*Level
+
+	templatesOnce   sync.Once
+	summaryTmpl     *template.Template
+	descriptionTmpl *template.Template
+	templateErr     error
+}
+
+type Datasource struct {
+	UID     snowflake.ID
+	Name    string
+	Querier Querier
+}
+
+type Level struct {
+	LevelUID  snowflake.ID
+	Name      string
+	Mode      enum.SampleMode
+	Condition enum.ConditionMetric
+	Values    []int64
+	Duration  time.Duration
+	Flap      flapper.Config
+}
+
+// State is the state of one alert, inactive alerts are not kept at all.
+type State uint8
+
+const (
+	StateInactive State = iota
+	StatePending
+	StateFiring
+	StateResolved
+)
+
+func (s State) String() string {
+	switch s {
+	case StateInactive:
+		return "inactive"
+	case StatePending:
+		return "pending"
+	case StateFiring:
+		return "firing"
+	case StateResolved:
+		return "resolved"
+	default:
+		return "unknown"
+	}
+}
+
+// Key identifies one alert of a rule.
+type Key struct {
+	StrategyUID snowflake.ID
+	LevelUID    snowflake.ID
+	Fingerprint uint64
+}
+
+// Alert is the tracked state of one series for one level of a rule.
+// ActiveAt is when the condition started to hold, StartsAt when the alert
+// went firing and LastEvalAt when it was last seen matching.
+// A firing alert resolved by hand is tracked until its series stops matching,
+// but sends no events, see ResolveManually.
+type Alert struct {
+	NamespaceUID  snowflake.ID
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	DatasourceUID snowflake.ID
+	Fingerprint   uint64
+	Labels        map[string]string
+	Value         float64
+	State         State
+	ActiveAt      time.Time
+	StartsAt      time.Time
+	LastEvalAt    time.Time
+
+	ManuallyResolved bool
+}
+
+func (a *Alert) Key() Key {
+	return Key{StrategyUID: a.StrategyUID, LevelUID: a.LevelUID, Fingerprint: a.Fingerprint}
+}
+
+type Event struct {
+	NamespaceUID  snowflake.ID
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	DatasourceUID snowflake.ID
+	Fingerprint   uint64
+	Labels        map[string]string
+	Summary       string
+	Description   string
+	Value         float64
+	State         State
+	StartsAt      time.Time
+	EndsAt        time.Time
+	// Flapping is set while the series flaps, its events should not be notified.
+	Flapping bool
+	// Flap is set on the summary event of a flapping period that ended, the event is
+	// the last one of the series and is not a state change of its own.
+	Flap *flapper.Summary
+}
+
+func (e *Event) Key() Key {
+	return Key{StrategyUID: e.StrategyUID, LevelUID: e.LevelUID, Fingerprint: e.Fingerprint}
+}
+
+// NewEvaluator returns an Evaluator for rule, the rule may be replaced later by SetRule.
+func NewEvaluator(rule *Rule) *Evaluator {
+	return &Evaluator{
+		rule:    rule,
+		alerts:  make(map[Key]*Alert),
+		flaps:   flapper.NewDetector(),
+		flapped: make(map[Key]*Event),
+	}
+}
+
+// Evaluator runs one rule and moves every series through
+// inactive -> pending -> firing -> resolved, honouring the level duration
+// the same way the "for" clause of a Prometheus alerting rule does.
+// The firing and resolving of a series are counted by the flap detection of
+// its level, the flap history is not part of the checkpoint.
+type Evaluator struct {
+	mu     sync.Mutex
+	rule   *Rule
+	alerts map[Key]*Alert
+	flaps  *flapper.Detector
+	// flapped is the last event of each flapping series, the base of its summary.
+	flapped map[Key]*Event
+}
+
+func (e *Evaluator) Rule() *Rule {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.rule
+}
+
+func (e *Evaluator) SetRule(rule *Rule) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rule = rule
+}
+
+// Alerts returns a snapshot of the pending and firing alerts.
+func (e *Evaluator) Alerts() []*Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	alerts := make([]*Alert, 0, len(e.alerts))
+	for _, alert := range e.alerts {
+		cp := *alert
+		alerts = append(alerts, &cp)
+	}
+	return alerts
+}
+
+// Restore replaces the tracked alerts, it is used to resume from a checkpoint.
+func (e *Evaluator) Restore(alerts []*Alert) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.alerts = make(map[Key]*Alert, len(alerts))
+	for _, alert := range alerts {
+		if alert.State != StatePending && alert.State != StateFiring {
+			continue
+		}
+		cp := *alert
+		e.alerts[cp.Key()] = &cp
+	}
+}
+
+// Eval queries every datasource of the rule at ts and advances the alerts.
+// It returns a firing event for each firing alert and a resolved event for
+// each firing alert that no longer matches, pending alerts produce no events.
+// A firing alert that no longer matches keeps firing for the KeepFiringFor
+// of the rule.
+// Alerts of a datasource whose query failed are kept as they are, the
+// returned error joins all query errors.
+// The events of a flapping series are flagged, and a summary event is added
+// for each series that stopped flapping.
+func (e *Evaluator) Eval(ctx context.Context, ts time.Time) ([]*Event, error) {
+	// the queries run without the lock, SetRule must not wait for a slow datasource
+	rule := e.Rule()
+	results := make(map[snowflake.ID][]*Series, len(rule.Datasources))
+	var errs []error
+	for _, datasource := range rule.Datasources {
+		list, err := datasource.Querier.Query(ctx, rule.Expr, ts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("datasource %d: %w", datasource.UID.Int64(), err))
+			continue
+		}
+		results[datasource.UID] = list
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	summaries := e.flaps.Stabilize(ts)
+	flaps := make(map[snowflake.ID]flapper.Config, len(rule.Levels))
+	for _, level := range rule.Levels {
+		flaps[level.LevelUID] = level.Flap
+	}
+	// a pending alert that missed evaluations, e.g. across a restart, starts
+	// counting its duration again instead of firing on stale history
+	staleAfter := 2 * ruleInterval(rule)
+	events := make([]*Event, 0, len(e.alerts))
+	seen := make(map[Key]struct{}, len(e.alerts))
+	for _, datasource := range rule.Datasources {
+		list, queried := results[datasource.UID]
+		if !queried {
+			continue
+		}
+		for _, series := range list {
+			labels, fingerprint := seriesLabels(rule, datasource.UID, series)
+			for _, level := range rule.Levels {
+				value, ok := Sample(level.Mode, level.Condition, level.Values, series.Points)
+				if !ok {
+					continue
+				}
+				key := Key{StrategyUID: rule.StrategyUID, LevelUID: level.LevelUID, Fingerprint: fingerprint}
+				alert, exists := e.alerts[key]
+				if !exists {
+					alert = &Alert{
+						NamespaceUID:  rule.NamespaceUID,
+						StrategyUID:   rule.StrategyUID,
+						LevelUID:      level.LevelUID,
+						DatasourceUID: datasource.UID,
+						Fingerprint:   fingerprint,
+						State:         StatePending,
+						ActiveAt:      ts,
+					}
+					e.alerts[key] = alert
+				} else if alert.State == StatePending && ts.Sub(alert.LastEvalAt) > staleAfter {
+					alert.ActiveAt = ts
+				}
+				alert.Labels = labels
+				alert.Value = value
+				alert.LastEvalAt = ts
+				changed := false
+				if alert.State == StatePending && ts.Sub(alert.ActiveAt) >= level.Duration {
+					alert.State = StateFiring
+					alert.StartsAt = ts
+					changed = true
+				}
+				seen[key] = struct{}{}
+				if alert.State == StateFiring && !alert.ManuallyResolved {
+					events = append(events, e.flap(rule.event(alert, StateFiring, time.Time{}), level.Flap, changed, ts))
+				}
+			}
+		}
+	}
+
+	datasources := make(map[snowflake.ID]struct{}, len(rule.Datasources))
+	for _, datasource := range rule.Datasources {
+		datasources[datasource.UID] = struct{}{}
+	}
+	for key, alert := range e.alerts {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		_, stillConfigured := datasources[alert.DatasourceUID]
+		_, queried := results[alert.DatasourceUID]
+		if !queried && stillConfigured {
+			continue
+		}
+		if queried && alert.State == StateFiring && ts.Sub(alert.LastEvalAt) < rule.KeepFiringFor {
+			if !alert.ManuallyResolved {
+				events = append(events, e.flap(rule.event(alert, StateFiring, time.Time{}), flaps[alert.LevelUID], false, ts))
+			}
+			continue
+		}
+		delete(e.alerts, key)
+		if alert.State == StateFiring && !alert.ManuallyResolved {
+			events = append(events, e.flap(rule.event(alert, StateResolved, ts), flaps[alert.LevelUID], true, ts))
+		}
+	}
+
+	for _, summary := range summaries {
+		key := Key(summary.Key)
+		event, ok := e.flapped[key]
+		delete(e.flapped, key)
+		alert, tracked := e.alerts[key]
+		if tracked && alert.ManuallyResolved {
+			continue
+		}
+		if tracked && alert.State == StateFiring {
+			event, ok = rule.event(alert, StateFiring, time.Time{}), true
+		}
+		if !ok {
+			continue
+		}
+		cp := *event
+		cp.Flapping = false
+		cp.Flap = summary
+		events = append(events, &cp)
+	}
+	return events, errors.Join(errs...)
+}
+
+// flap flags event when its series is flapping, changed tells whether the event is a state
+// change to count in the flap history rather than a repeated firing event.
+func (e *Evaluator) flap(event *Event, config flapper.Config, changed bool, ts time.Time) *Event {
+	key := flapper.Key(event.Key())
+	if changed {
+		event.Flapping = e.flaps.Transition(key, config, ts)
+	} else {
+		event.Flapping = e.flaps.Flapping(key)
+	}
+	if event.Flapping {
+		e.flapped[event.Key()] = event
+	} else {
+		delete(e.flapped, event.Key())
+	}
+	return event
+}
+
+// Resolve drops every alert and returns a resolved event for each firing one,
+// it is used when a rule is removed. The flap history is dropped with the alerts.
+func (e *Evaluator) Resolve(ts time.Time) []*Event {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.flaps = flapper.NewDetector()
+	e.flapped = make(map[Key]*Event)
+	events := make([]*Event, 0, len(e.alerts))
+	for key, alert := range e.alerts {
+		delete(e.alerts, key)
+		if alert.State == StateFiring && !alert.ManuallyResolved {
+			events = append(events, e.rule.event(alert, StateResolved, ts))
+		}
+	}
+	return events
+}
+
+// ResolveManually marks the firing alerts of keys resolved by hand. They send no more events,
+// the series fires a new alert once it stopped matching and matches again.
+func (e *Evaluator) ResolveManually(keys []Key) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, key := range keys {
+		if alert, ok := e.alerts[key]; ok && alert.State == StateFiring {
+			alert.ManuallyResolved = true
+		}
+	}
+}
+
+func (r *Rule) event(alert *Alert, state State, endsAt time.Time) *Event {
+	summary, description := r.render(alert, state)
+	return &Event{
+		NamespaceUID:  alert.NamespaceUID,
+		StrategyUID:   alert.StrategyUID,
+		LevelUID:      alert.LevelUID,
+		DatasourceUID: alert.DatasourceUID,
+		Fingerprint:   alert.Fingerprint,
+		Labels:        alert.Labels,
+		Summary:       summary,
+		Description:   description,
+		Value:         alert.Value,
+		State:         state,
+		StartsAt:      alert.StartsAt,
+		EndsAt:        endsAt,
+	}
+}
+
+// render expands the summary and description templates for alert, a broken
+// template renders as the error so that the event still goes out.
+func (r *Rule) render(alert *Alert, state State) (string, string) {
+	r.templatesOnce.Do(func() {
+		var errs []error
+		var err error
+		if r.summaryTmpl, err = ParseTemplate("summary", r.Summary); err != nil {
+			errs = append(errs, err)
+		}
+		if r.descriptionTmpl, err = ParseTemplate("description", r.Description); err != nil {
+			errs = append(errs, err)
+		}
+		r.templateErr = errors.Join(errs...)
+	})
+	data := &TemplateData{
+		Labels:        alert.Labels,
+		Value:         alert.Value,
+		State:         state.String(),
+		StrategyUID:   alert.StrategyUID,
+		LevelUID:      alert.LevelUID,
+		DatasourceUID: alert.DatasourceUID,
+		StartsAt:      alert.StartsAt,
+	}
+	for _, level := range r.Levels {
+		if level.LevelUID == alert.LevelUID {
+			data.Level = level.Name
+			break
+		}
+	}
+	for _, datasource := range r.Datasources {
+		if datasource.UID == alert.DatasourceUID {
+			data.Datasource = datasource.Name
+			break
+		}
+	}
+	return renderTemplate(r.summaryTmpl, r.Summary, r.templateErr, data),
+		renderTemplate(r.descriptionTmpl, r.Description, r.templateErr, data)
+}
+
+func renderTemplate(tmpl *template.Template, text string, parseErr error, data *TemplateData) string {
+	if tmpl == nil {
+		if parseErr != nil {
+			return fmt.Sprintf("<error expanding template: %v>", parseErr)
+		}
+		return text
+	}
+	out, err := ExecuteTemplate(tmpl, data)
+	if err != nil {
+		return fmt.Sprintf("<error expanding template: %v>", err)
+	}
+	return out
+}
+
+// seriesLabels merges the rule labels over the series labels the way
+// Prometheus does for alerting rules and fingerprints the result.
+func seriesLabels(rule *Rule, datasourceUID snowflake.ID, series *Series) (map[string]string, uint64) {
+	labels := make(map[string]string, len(series.Labels)+len(rule.Labels))
+	for name, value := range series.Labels {
+		if name == model.MetricNameLabel {
+			continue
+		}
+		labels[name] = value
+	}
+	for name, value := range rule.Labels {
+		labels[name] = value
+	}
+	set := make(model.LabelSet, len(labels)+1)
+	for name, value := range labels {
+		set[model.LabelName(name)] = model.LabelValue(value)
+	}
+	set[datasourceLabel] = model.LabelValue(datasourceUID.String())
+	return labels, uint64(set.Fingerprint())
+}
@@ -0,0 +1,1045 @@
+package evaluator_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	"github.com/aide-family/marksman/internal/biz/flapper"
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/shard"
+)
+
+// fakePrometheus is an httptest stand-in for the Prometheus query API.
+type fakePrometheus struct {
+	mu      sync.Mutex
+	status  int
+	body    any
+	queries []string
+}
+
+func (f *fakePrometheus) set(status int, body any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+	f.body = body
+}
+
+func (f *fakePrometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.URL.Path != "/api/v1/query" {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.queries = append(f.queries, r.Form.Get("query"))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(f.status)
+	_ = json.NewEncoder(w).Encode(f.body)
+}
+
+func vector(samples ...map[string]any) map[string]any {
+	return map[string]any{
+		"status": "success",
+		"data":   map[string]any{"resultType": "vector", "result": samples},
+	}
+}
+
+func sample(labels map[string]string, value string) map[string]any {
+	return map[string]any{"metric": labels, "value": []any{1700000000.0, value}}
+}
+
+func newServer(t *testing.T) (*fakePrometheus, *httptest.Server) {
+	t.Helper()
+	fake := &fakePrometheus{status: http.StatusOK, body: vector()}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return fake, srv
+}
+
+func newRule(endpoint string, levels ...*evaluator.Level) *evaluator.Rule {
+	return &evaluator.Rule{
+		NamespaceUID: 1,
+		StrategyUID:  10,
+		Expr:         `up == 0`,
+		Labels:       map[string]string{"team": "ops"},
+		Summary:      "instance down",
+		Interval:     10 * time.Millisecond,
+		Datasources: []*evaluator.Datasource{
+			{UID: 100, Querier: evaluator.NewPrometheusQuerier(endpoint)},
+		},
+		Levels: levels,
+	}
+}
+
+func TestEvaluatorFiringAndResolved(t *testing.T) {
+	fake, srv := newServer(t)
+	level := &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+		Values:    []int64{80},
+	}
+	e := evaluator.NewEvaluator(newRule(srv.URL, level))
+	labels := map[string]string{"__name__": "cpu_usage", "instance": "a"}
+
+	fake.set(http.StatusOK, vector(sample(labels, "91"), sample(map[string]string{"instance": "b"}, "10")))
+	start := time.Unix(1700000000, 0)
+	events, err := e.Eval(context.Background(), start)
+	if err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 firing event, got %d", len(events))
+	}
+	firing := events[0]
+	if firing.State != evaluator.StateFiring || firing.Value != 91 || firing.LevelUID != 20 {
+		t.Fatalf("unexpected firing event: %+v", firing)
+	}
+	if _, ok := firing.Labels["__name__"]; ok {
+		t.Fatalf("metric name should be dropped: %v", firing.Labels)
+	}
+	if firing.Labels["instance"] != "a" || firing.Labels["team"] != "ops" {
+		t.Fatalf("unexpected labels: %v", firing.Labels)
+	}
+	if fake.queries[0] != `up == 0` {
+		t.Fatalf("unexpected query %q", fake.queries[0])
+	}
+
+	events, err = e.Eval(context.Background(), start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+	if len(events) != 1 || !events[0].StartsAt.Equal(start) {
+		t.Fatalf("firing alert should keep its start time: %+v", events)
+	}
+
+	fake.set(http.StatusOK, vector(sample(labels, "42")))
+	end := start.Add(2 * time.Minute)
+	events, err = e.Eval(context.Background(), end)
+	if err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+	if len(events) != 1 || events[0].State != evaluator.StateResolved || !events[0].EndsAt.Equal(end) {
+		t.Fatalf("expected a resolved event, got %+v", events)
+	}
+	if events[0].Key() != firing.Key() {
+		t.Fatalf("resolved key %v does not match firing key %v", events[0].Key(), firing.Key())
+	}
+
+	events, err = e.Eval(context.Background(), end.Add(time.Minute))
+	if err != nil || len(events) != 0 {
+		t.Fatalf("expected no events, got %+v, %v", events, err)
+	}
+}
+
+func TestEvaluatorKeepFiringFor(t *testing.T) {
+	fake, srv := newServer(t)
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+		Values:    []int64{80},
+	})
+	rule.KeepFiringFor = 2 * time.Minute
+	e := evaluator.NewEvaluator(rule)
+	labels := map[string]string{"instance": "a"}
+	start := time.Unix(1700000000, 0)
+	for minute, tc := range []struct {
+		value string
+		state evaluator.State
+	}{
+		{"91", evaluator.StateFiring},
+		// the series stopped matching less than KeepFiringFor ago
+		{"", evaluator.StateFiring},
+		{"91", evaluator.StateFiring},
+		{"42", evaluator.StateFiring},
+		{"", evaluator.StateResolved},
+		{"", 0},
+	} {
+		if tc.value == "" {
+			fake.set(http.StatusOK, vector())
+		} else {
+			fake.set(http.StatusOK, vector(sample(labels, tc.value)))
+		}
+		ts := start.Add(time.Duration(minute) * time.Minute)
+		events, err := e.Eval(context.Background(), ts)
+		if err != nil {
+			t.Fatalf("minute %d: eval: %v", minute, err)
+		}
+		if tc.state == 0 {
+			if len(events) != 0 {
+				t.Fatalf("minute %d: expected no events, got %+v", minute, events)
+			}
+			continue
+		}
+		if len(events) != 1 || events[0].State != tc.state || !events[0].StartsAt.Equal(start) {
+			t.Fatalf("minute %d: expected a %s event of the alert, got %+v", minute, tc.state, events)
+		}
+		if tc.state == evaluator.StateResolved && !events[0].EndsAt.Equal(ts) {
+			t.Fatalf("minute %d: unexpected end %v", minute, events[0].EndsAt)
+		}
+	}
+}
+
+func TestEvaluatorQueryErrorKeepsAlerts(t *testing.T) {
+	fake, srv := newServer(t)
+	e := evaluator.NewEvaluator(newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_EQ,
+		Values:    []int64{0},
+	}))
+
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "0")))
+	if events, err := e.Eval(context.Background(), time.Now()); err != nil || len(events) != 1 {
+		t.Fatalf("expected 1 firing event, got %+v, %v", events, err)
+	}
+
+	fake.set(http.StatusBadRequest, map[string]any{"status": "error", "errorType": "bad_data", "error": "parse error"})
+	events, err := e.Eval(context.Background(), time.Now())
+	if err == nil {
+		t.Fatal("expected the query error to be returned")
+	}
+	if len(events) != 0 {
+		t.Fatalf("a failed query must not resolve alerts, got %+v", events)
+	}
+}
+
+func TestEvaluatorSampleMode(t *testing.T) {
+	points := []evaluator.Point{{Value: 70}, {Value: 95}, {Value: 85}}
+	tests := []struct {
+		mode  enum.SampleMode
+		value float64
+		ok    bool
+	}{
+		{enum.SampleMode_SAMPLE_MODE_FOR, 70, false},
+		{enum.SampleMode_SAMPLE_MODE_MAX, 95, true},
+		{enum.SampleMode_SAMPLE_MODE_MIN, 70, false},
+	}
+	for _, tt := range tests {
+		value, ok := evaluator.Sample(tt.mode, enum.ConditionMetric_CONDITION_METRIC_GT, []int64{80}, points)
+		if value != tt.value || ok != tt.ok {
+			t.Errorf("%s: got (%v, %v), want (%v, %v)", tt.mode, value, ok, tt.value, tt.ok)
+		}
+	}
+}
+
+func TestJudge(t *testing.T) {
+	tests := []struct {
+		condition enum.ConditionMetric
+		values    []int64
+		value     float64
+		want      bool
+	}{
+		{enum.ConditionMetric_CONDITION_METRIC_EQ, []int64{1}, 1, true},
+		{enum.ConditionMetric_CONDITION_METRIC_NE, []int64{1}, 1, false},
+		{enum.ConditionMetric_CONDITION_METRIC_GT, []int64{1}, 1, false},
+		{enum.ConditionMetric_CONDITION_METRIC_GTE, []int64{1}, 1, true},
+		{enum.ConditionMetric_CONDITION_METRIC_LT, []int64{1}, 0.5, true},
+		{enum.ConditionMetric_CONDITION_METRIC_LTE, []int64{1}, 2, false},
+		{enum.ConditionMetric_CONDITION_METRIC_IN, []int64{1, 3}, 3, true},
+		{enum.ConditionMetric_CONDITION_METRIC_IN, []int64{1, 3}, 4, false},
+		{enum.ConditionMetric_CONDITION_METRIC_NOT_IN, []int64{1, 3}, 4, true},
+		{enum.ConditionMetric_CONDITION_METRIC_NOT_IN, []int64{1, 3}, 2, false},
+	}
+	for _, tt := range tests {
+		if got := evaluator.Judge(tt.condition, tt.values, tt.value); got != tt.want {
+			t.Errorf("Judge(%s, %v, %v) = %v, want %v", tt.condition, tt.values, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestManager(t *testing.T) {
+	testManager(t)
+}
+
+func TestManagerOnEngine(t *testing.T) {
+	engine := job.NewEngine(klog.NewHelper(klog.DefaultLogger), job.WithWorkers(1))
+	defer engine.Stop()
+	testManager(t, evaluator.WithEngine(engine))
+}
+
+func testManager(t *testing.T, opts ...evaluator.ManagerOption) {
+	t.Helper()
+	fake, srv := newServer(t)
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "1")))
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_EQ,
+		Values:    []int64{1},
+	})
+
+	received := make(chan *evaluator.Event, 64)
+	handler := evaluator.HandlerFunc(func(_ context.Context, events []*evaluator.Event) {
+		for _, event := range events {
+			received <- event
+		}
+	})
+	m := evaluator.NewManager(handler, klog.NewHelper(klog.DefaultLogger), opts...)
+	defer m.Stop()
+
+	m.Sync([]*evaluator.Rule{rule})
+	select {
+	case event := <-received:
+		if event.State != evaluator.StateFiring || event.StrategyUID != snowflake.ID(10) {
+			t.Fatalf("unexpected event %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+	}
+
+	m.Sync(nil)
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case event := <-received:
+			if event.State == evaluator.StateResolved {
+				return
+			}
+		case <-deadline:
+			t.Fatal("removing a rule should resolve its alerts")
+		}
+	}
+}
+
+// blockingQuerier blocks every query until release is closed.
+type blockingQuerier struct {
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func (q *blockingQuerier) Query(ctx context.Context, _ string, _ time.Time) ([]*evaluator.Series, error) {
+	q.once.Do(func() { close(q.started) })
+	select {
+	case <-q.release:
+	case <-ctx.Done():
+	}
+	return nil, ctx.Err()
+}
+
+func TestManagerSyncIntervalsWhileEvaluating(t *testing.T) {
+	querier := &blockingQuerier{started: make(chan struct{}), release: make(chan struct{})}
+	rule := func(interval time.Duration) *evaluator.Rule {
+		return &evaluator.Rule{
+			StrategyUID: 10,
+			Expr:        `up == 0`,
+			Interval:    interval,
+			Datasources: []*evaluator.Datasource{{UID: 100, Querier: querier}},
+		}
+	}
+	m := evaluator.NewManager(evaluator.HandlerFunc(func(context.Context, []*evaluator.Event) {}), klog.NewHelper(klog.DefaultLogger))
+	m.Sync([]*evaluator.Rule{rule(time.Hour)})
+	<-querier.started
+
+	// the loop is stuck in its first evaluation, changing the interval again and
+	// again must neither block Sync nor Stop
+	synced := make(chan struct{})
+	go func() {
+		defer close(synced)
+		for i := 1; i <= 5; i++ {
+			m.Sync([]*evaluator.Rule{rule(time.Duration(i) * time.Minute)})
+		}
+	}()
+	select {
+	case <-synced:
+	case <-time.After(time.Second):
+		t.Fatal("Sync blocked on the interval of a busy loop")
+	}
+	close(querier.release)
+	stopped := make(chan struct{})
+	go func() {
+		m.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("Stop blocked")
+	}
+}
+
+func TestEvaluatorPendingForDuration(t *testing.T) {
+	fake, srv := newServer(t)
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+		Values:    []int64{80},
+		Duration:  2 * time.Minute,
+	})
+	rule.Interval = time.Minute
+	e := evaluator.NewEvaluator(rule)
+	labels := map[string]string{"instance": "a"}
+	start := time.Unix(1700000000, 0)
+
+	fake.set(http.StatusOK, vector(sample(labels, "90")))
+	for i, state := range []evaluator.State{evaluator.StatePending, evaluator.StatePending} {
+		events, err := e.Eval(context.Background(), start.Add(time.Duration(i)*time.Minute))
+		if err != nil || len(events) != 0 {
+			t.Fatalf("pending alerts must not emit events, got %+v, %v", events, err)
+		}
+		alerts := e.Alerts()
+		if len(alerts) != 1 || alerts[0].State != state || !alerts[0].ActiveAt.Equal(start) {
+			t.Fatalf("round %d: unexpected alerts %+v", i, alerts)
+		}
+	}
+
+	firedAt := start.Add(2 * time.Minute)
+	events, err := e.Eval(context.Background(), firedAt)
+	if err != nil || len(events) != 1 || events[0].State != evaluator.StateFiring || !events[0].StartsAt.Equal(firedAt) {
+		t.Fatalf("expected the alert to fire after its duration, got %+v, %v", events, err)
+	}
+
+	fake.set(http.StatusOK, vector())
+	events, err = e.Eval(context.Background(), firedAt.Add(time.Minute))
+	if err != nil || len(events) != 1 || events[0].State != evaluator.StateResolved {
+		t.Fatalf("expected a resolved event, got %+v, %v", events, err)
+	}
+	if alerts := e.Alerts(); len(alerts) != 0 {
+		t.Fatalf("resolved alerts should be dropped, got %+v", alerts)
+	}
+
+	fake.set(http.StatusOK, vector(sample(labels, "90")))
+	if _, err := e.Eval(context.Background(), firedAt.Add(2*time.Minute)); err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+	fake.set(http.StatusOK, vector())
+	events, err = e.Eval(context.Background(), firedAt.Add(3*time.Minute))
+	if err != nil || len(events) != 0 || len(e.Alerts()) != 0 {
+		t.Fatalf("a pending alert should go back to inactive silently, got %+v, %v", events, err)
+	}
+}
+
+func TestEvaluatorRestore(t *testing.T) {
+	fake, srv := newServer(t)
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+		Values:    []int64{80},
+		Duration:  2 * time.Minute,
+	})
+	rule.Interval = time.Minute
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "90")))
+	start := time.Unix(1700000000, 0)
+
+	before := evaluator.NewEvaluator(rule)
+	if _, err := before.Eval(context.Background(), start); err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+	if _, err := before.Eval(context.Background(), start.Add(time.Minute)); err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+
+	after := evaluator.NewEvaluator(rule)
+	after.Restore(before.Alerts())
+	events, err := after.Eval(context.Background(), start.Add(2*time.Minute))
+	if err != nil || len(events) != 1 || events[0].State != evaluator.StateFiring {
+		t.Fatalf("a restored pending alert should keep counting its duration, got %+v, %v", events, err)
+	}
+
+	stale := evaluator.NewEvaluator(rule)
+	stale.Restore(before.Alerts())
+	events, err = stale.Eval(context.Background(), start.Add(time.Hour))
+	if err != nil || len(events) != 0 {
+		t.Fatalf("a stale pending alert should start over, got %+v, %v", events, err)
+	}
+	if alerts := stale.Alerts(); len(alerts) != 1 || !alerts[0].ActiveAt.Equal(start.Add(time.Hour)) {
+		t.Fatalf("unexpected alerts %+v", alerts)
+	}
+}
+
+type memoryStore struct {
+	mu     sync.Mutex
+	alerts map[snowflake.ID][]*evaluator.Alert
+	saves  int
+	// resolved are the alerts resolved by hand, the mark is dropped with the alert.
+	resolved map[evaluator.Key]struct{}
+}
+
+func (s *memoryStore) resolve(key evaluator.Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.resolved == nil {
+		s.resolved = make(map[evaluator.Key]struct{})
+	}
+	s.resolved[key] = struct{}{}
+}
+
+func (s *memoryStore) LoadAlerts(_ context.Context, strategyUID snowflake.ID) ([]*evaluator.Alert, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.alerts[strategyUID], nil
+}
+
+func (s *memoryStore) SaveAlerts(_ context.Context, strategyUID snowflake.ID, alerts []*evaluator.Alert) ([]evaluator.Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.alerts[strategyUID] = alerts
+	s.saves++
+	saved := make(map[evaluator.Key]struct{}, len(alerts))
+	var resolved []evaluator.Key
+	for _, alert := range alerts {
+		saved[alert.Key()] = struct{}{}
+		if _, ok := s.resolved[alert.Key()]; ok {
+			resolved = append(resolved, alert.Key())
+		}
+	}
+	for key := range s.resolved {
+		if _, ok := saved[key]; !ok && key.StrategyUID == strategyUID {
+			delete(s.resolved, key)
+		}
+	}
+	return resolved, nil
+}
+
+func TestManagerCheckpoint(t *testing.T) {
+	fake, srv := newServer(t)
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "1")))
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_EQ,
+		Values:    []int64{1},
+		Duration:  time.Hour,
+	})
+	store := &memoryStore{alerts: map[snowflake.ID][]*evaluator.Alert{
+		rule.StrategyUID: {{
+			StrategyUID:   rule.StrategyUID,
+			LevelUID:      20,
+			DatasourceUID: 100,
+			State:         evaluator.StateFiring,
+			StartsAt:      time.Unix(1700000000, 0),
+		}},
+	}}
+
+	received := make(chan *evaluator.Event, 64)
+	handler := evaluator.HandlerFunc(func(_ context.Context, events []*evaluator.Event) {
+		for _, event := range events {
+			received <- event
+		}
+	})
+	m := evaluator.NewManager(handler, klog.NewHelper(klog.DefaultLogger), evaluator.WithStateStore(store))
+	defer m.Stop()
+	m.Sync([]*evaluator.Rule{rule})
+
+	// the restored firing alert has a different fingerprint than the live
+	// series, so it resolves while the live series stays pending for an hour
+	select {
+	case event := <-received:
+		if event.State != evaluator.StateResolved || !event.StartsAt.Equal(time.Unix(1700000000, 0)) {
+			t.Fatalf("unexpected event %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("restored alert was not resolved")
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	alerts := store.alerts[rule.StrategyUID]
+	if store.saves == 0 || len(alerts) != 1 || alerts[0].State != evaluator.StatePending {
+		t.Fatalf("expected the pending alert to be checkpointed, got %+v", alerts)
+	}
+}
+
+func TestManagerHonoursManualResolve(t *testing.T) {
+	fake, srv := newServer(t)
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "1")))
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_EQ,
+		Values:    []int64{1},
+	})
+	store := &memoryStore{alerts: map[snowflake.ID][]*evaluator.Alert{}}
+	received := make(chan *evaluator.Event, 256)
+	handler := evaluator.HandlerFunc(func(_ context.Context, events []*evaluator.Event) {
+		for _, event := range events {
+			received <- event
+		}
+	})
+	m := evaluator.NewManager(handler, klog.NewHelper(klog.DefaultLogger), evaluator.WithStateStore(store))
+	defer m.Stop()
+	// waitSaves waits for n more checkpoints, the events of a checkpoint are handled before the next one
+	waitSaves := func(n int) {
+		t.Helper()
+		store.mu.Lock()
+		want := store.saves + n
+		store.mu.Unlock()
+		deadline := time.Now().Add(time.Second)
+		for {
+			store.mu.Lock()
+			saves := store.saves
+			store.mu.Unlock()
+			if saves >= want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %d checkpoints", n)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	m.Sync([]*evaluator.Rule{rule})
+	var first *evaluator.Event
+	select {
+	case first = <-received:
+		if first.State != evaluator.StateFiring {
+			t.Fatalf("unexpected event %+v", first)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("alert did not fire")
+	}
+
+	store.resolve(first.Key())
+	waitSaves(1)
+	for len(received) > 0 {
+		<-received
+	}
+	waitSaves(2)
+	select {
+	case event := <-received:
+		t.Fatalf("manually resolved alert sent %+v", event)
+	default:
+	}
+
+	// the series stops matching without a resolved event, then fires a new alert
+	fake.set(http.StatusOK, vector())
+	waitSaves(2)
+	select {
+	case event := <-received:
+		t.Fatalf("manually resolved alert sent %+v", event)
+	default:
+	}
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "1")))
+	select {
+	case event := <-received:
+		if event.State != evaluator.StateFiring || !event.StartsAt.After(first.StartsAt) {
+			t.Fatalf("want a new firing alert, got %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("series did not fire again")
+	}
+}
+
+func TestManagerSyncWhileResolving(t *testing.T) {
+	rule := func(strategyUID snowflake.ID) *evaluator.Rule {
+		return &evaluator.Rule{
+			StrategyUID: strategyUID,
+			Expr:        `up == 1`,
+			Interval:    time.Hour,
+			Datasources: []*evaluator.Datasource{{UID: 100, Querier: firingQuerier{}}},
+			Levels:      []*evaluator.Level{{LevelUID: 20, Condition: enum.ConditionMetric_CONDITION_METRIC_EQ, Values: []int64{1}}},
+		}
+	}
+	fired, resolving, release := make(chan struct{}, 2), make(chan struct{}), make(chan struct{})
+	handler := evaluator.HandlerFunc(func(_ context.Context, events []*evaluator.Event) {
+		for _, event := range events {
+			if event.State != evaluator.StateResolved {
+				fired <- struct{}{}
+				continue
+			}
+			close(resolving)
+			<-release
+		}
+	})
+	m := evaluator.NewManager(handler, klog.NewHelper(klog.DefaultLogger))
+	defer m.Stop()
+	defer close(release)
+	m.Sync([]*evaluator.Rule{rule(10)})
+	<-fired
+
+	// the handler is stuck on the alerts of the removed rule, another Sync must not wait for it
+	go m.Sync(nil)
+	<-resolving
+	synced := make(chan struct{})
+	go func() {
+		defer close(synced)
+		m.Sync([]*evaluator.Rule{rule(11)})
+	}()
+	select {
+	case <-synced:
+	case <-time.After(time.Second):
+		t.Fatal("Sync blocked on the handler of a removed rule")
+	}
+}
+
+func TestManagerHandsOverOwnership(t *testing.T) {
+	fake, srv := newServer(t)
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "1")))
+	rule := newRule(srv.URL, &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_EQ,
+		Values:    []int64{1},
+	})
+	store := &memoryStore{alerts: map[snowflake.ID][]*evaluator.Alert{}}
+	var owner sync.Mutex
+	current := "a"
+	newManager := func(node string, received chan<- *evaluator.Event) *evaluator.Manager {
+		handler := evaluator.HandlerFunc(func(_ context.Context, events []*evaluator.Event) {
+			for _, event := range events {
+				received <- event
+			}
+		})
+		owns := func(snowflake.ID) bool {
+			owner.Lock()
+			defer owner.Unlock()
+			return current == node
+		}
+		return evaluator.NewManager(handler, klog.NewHelper(klog.DefaultLogger), evaluator.WithStateStore(store), evaluator.WithOwnership(owns))
+	}
+	receivedA, receivedB := make(chan *evaluator.Event, 64), make(chan *evaluator.Event, 64)
+	a, b := newManager("a", receivedA), newManager("b", receivedB)
+	defer a.Stop()
+	defer b.Stop()
+
+	a.Sync([]*evaluator.Rule{rule})
+	b.Sync([]*evaluator.Rule{rule})
+	var startsAt time.Time
+	select {
+	case event := <-receivedA:
+		if event.State != evaluator.StateFiring {
+			t.Fatalf("unexpected event %+v", event)
+		}
+		startsAt = event.StartsAt
+	case <-time.After(time.Second):
+		t.Fatal("owner did not fire")
+	}
+
+	owner.Lock()
+	current = "b"
+	owner.Unlock()
+	a.Sync([]*evaluator.Rule{rule})
+	for len(receivedA) > 0 {
+		<-receivedA
+	}
+	b.Sync([]*evaluator.Rule{rule})
+
+	// the previous owner releases without resolving, the new owner continues the firing alert
+	select {
+	case event := <-receivedB:
+		if event.State != evaluator.StateFiring || !event.StartsAt.Equal(startsAt) {
+			t.Fatalf("new owner must continue the firing alert, got %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("new owner did not evaluate")
+	}
+	select {
+	case event := <-receivedA:
+		t.Fatalf("released rule sent %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// memoryMembership is a membership shared by in-process nodes.
+type memoryMembership struct {
+	mu    sync.Mutex
+	nodes []string
+}
+
+func (m *memoryMembership) Heartbeat(_ context.Context, nodeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !slices.Contains(m.nodes, nodeID) {
+		m.nodes = append(m.nodes, nodeID)
+	}
+	return nil
+}
+
+func (m *memoryMembership) Leave(_ context.Context, nodeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes = slices.DeleteFunc(m.nodes, func(node string) bool { return node == nodeID })
+	return nil
+}
+
+func (m *memoryMembership) ListJobNodes(context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.nodes), nil
+}
+
+type memoryLease struct {
+	holder    string
+	token     uint64
+	expiresAt time.Time
+}
+
+// memoryLeases is a lease store shared by in-process nodes.
+type memoryLeases struct {
+	mu     sync.Mutex
+	leases map[string]*memoryLease
+}
+
+func (l *memoryLeases) AcquireLease(_ context.Context, name, holder string, token uint64, ttl time.Duration) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	lease, ok := l.leases[name]
+	switch {
+	case !ok:
+		lease = &memoryLease{}
+		l.leases[name] = lease
+	case lease.holder == holder && lease.token == token && now.Before(lease.expiresAt):
+		lease.expiresAt = now.Add(ttl)
+		return token, nil
+	case lease.holder != holder && now.Before(lease.expiresAt):
+		return 0, nil
+	}
+	lease.holder, lease.token, lease.expiresAt = holder, lease.token+1, now.Add(ttl)
+	return lease.token, nil
+}
+
+func (l *memoryLeases) ReleaseLease(_ context.Context, name, holder string, token uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if lease, ok := l.leases[name]; ok && lease.holder == holder && lease.token == token {
+		lease.expiresAt = time.Now()
+	}
+	return nil
+}
+
+// sharderHandoff hands the rules over with the leases of a sharder.
+type sharderHandoff struct {
+	sharder *shard.Sharder
+}
+
+func (h sharderHandoff) Acquire(ctx context.Context, strategyUID snowflake.ID, ttl time.Duration) (bool, error) {
+	return h.sharder.Acquire(ctx, strategyUID.String(), ttl)
+}
+
+func (h sharderHandoff) Release(ctx context.Context, strategyUID snowflake.ID) error {
+	return h.sharder.Release(ctx, strategyUID.String())
+}
+
+// firingQuerier answers every query with the same series of value 1.
+type firingQuerier struct{}
+
+func (firingQuerier) Query(_ context.Context, _ string, ts time.Time) ([]*evaluator.Series, error) {
+	return []*evaluator.Series{{Labels: map[string]string{"instance": "a"}, Points: []evaluator.Point{{Timestamp: ts, Value: 1}}}}, nil
+}
+
+func TestManagerRebalanceHandsOverOnce(t *testing.T) {
+	ctx := context.Background()
+	rules := make([]*evaluator.Rule, 0, 20)
+	for i := range 20 {
+		rules = append(rules, &evaluator.Rule{
+			NamespaceUID: 1,
+			StrategyUID:  snowflake.ID(1000 + i),
+			Expr:         `up == 1`,
+			Interval:     10 * time.Millisecond,
+			Datasources:  []*evaluator.Datasource{{UID: 100, Querier: firingQuerier{}}},
+			Levels: []*evaluator.Level{{
+				LevelUID:  20,
+				Condition: enum.ConditionMetric_CONDITION_METRIC_EQ,
+				Values:    []int64{1},
+			}},
+		})
+	}
+	store := &memoryStore{alerts: map[snowflake.ID][]*evaluator.Alert{}}
+	membership := &memoryMembership{}
+	leases := &memoryLeases{leases: make(map[string]*memoryLease)}
+	helper := klog.NewHelper(klog.DefaultLogger)
+
+	type received struct {
+		node  string
+		event *evaluator.Event
+	}
+	var mu sync.Mutex
+	var log []received
+	events := func(strategyUID snowflake.ID, node string) []int {
+		mu.Lock()
+		defer mu.Unlock()
+		var at []int
+		for i, r := range log {
+			if r.event.StrategyUID == strategyUID && (node == "" || r.node == node) {
+				at = append(at, i)
+			}
+		}
+		return at
+	}
+	newNode := func(node string) (*shard.Sharder, *evaluator.Manager) {
+		sharder := shard.NewSharder(node, membership, helper, shard.WithLeases(leases))
+		handler := evaluator.HandlerFunc(func(_ context.Context, list []*evaluator.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, event := range list {
+				log = append(log, received{node: node, event: event})
+			}
+		})
+		owns := func(strategyUID snowflake.ID) bool { return sharder.Owns(strategyUID.String()) }
+		return sharder, evaluator.NewManager(handler, helper,
+			evaluator.WithStateStore(store),
+			evaluator.WithOwnership(owns),
+			evaluator.WithHandoff(sharderHandoff{sharder: sharder}),
+		)
+	}
+	waitFor := func(what string, done func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for !done() {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %s", what)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	refresh := func(sharder *shard.Sharder) {
+		if _, err := sharder.Refresh(ctx); err != nil {
+			t.Fatalf("refresh %s: %v", sharder.NodeID(), err)
+		}
+	}
+
+	sharderA, a := newNode("node-a")
+	sharderB, b := newNode("node-b")
+	defer a.Stop()
+	defer b.Stop()
+	refresh(sharderA)
+	a.Sync(rules)
+	waitFor("node-a to fire every rule", func() bool {
+		for _, rule := range rules {
+			if len(events(rule.StrategyUID, "node-a")) == 0 {
+				return false
+			}
+		}
+		return true
+	})
+
+	// node-b joins and sees its rules before node-a refreshed, node-a keeps evaluating them
+	// until it checkpointed and released them
+	refresh(sharderB)
+	b.Sync(rules)
+	time.Sleep(100 * time.Millisecond)
+	refresh(sharderA)
+	a.Sync(rules)
+	var moved []*evaluator.Rule
+	for _, rule := range rules {
+		if sharderB.Owns(rule.StrategyUID.String()) {
+			moved = append(moved, rule)
+		}
+	}
+	if len(moved) == 0 || len(moved) == len(rules) {
+		t.Fatalf("node-b owns %d of %d rules, want a rebalance", len(moved), len(rules))
+	}
+	waitFor("node-b to take over its rules", func() bool {
+		for _, rule := range moved {
+			if len(events(rule.StrategyUID, "node-b")) == 0 {
+				return false
+			}
+		}
+		return true
+	})
+	time.Sleep(50 * time.Millisecond)
+	a.Stop()
+	b.Stop()
+
+	mu.Lock()
+	for _, r := range log {
+		if r.event.State != evaluator.StateFiring {
+			t.Errorf("node %s sent %+v, the alerts must keep firing across the rebalance", r.node, r.event)
+		}
+	}
+	mu.Unlock()
+	for _, rule := range rules {
+		all, fromA, fromB := events(rule.StrategyUID, ""), events(rule.StrategyUID, "node-a"), events(rule.StrategyUID, "node-b")
+		mu.Lock()
+		startsAt := log[all[0]].event.StartsAt
+		for _, i := range all {
+			if !log[i].event.StartsAt.Equal(startsAt) {
+				t.Errorf("strategy %d fired again at %v after %v", rule.StrategyUID, log[i].event.StartsAt, startsAt)
+			}
+		}
+		mu.Unlock()
+		if !slices.Contains(moved, rule) {
+			if len(fromB) != 0 {
+				t.Errorf("strategy %d kept by node-a was evaluated by node-b", rule.StrategyUID)
+			}
+			continue
+		}
+		if fromA[len(fromA)-1] > fromB[0] {
+			t.Errorf("strategy %d was evaluated by both nodes at once", rule.StrategyUID)
+		}
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, rule := range rules {
+		if alerts := store.alerts[rule.StrategyUID]; len(alerts) != 1 || alerts[0].State != evaluator.StateFiring {
+			t.Errorf("strategy %d checkpointed %+v, want its firing alert", rule.StrategyUID, alerts)
+		}
+	}
+}
+
+func TestEvaluatorFlapping(t *testing.T) {
+	fake, srv := newServer(t)
+	level := &evaluator.Level{
+		LevelUID:  20,
+		Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+		Values:    []int64{80},
+		Flap:      flapper.Config{Window: 10 * time.Minute, Threshold: 4},
+	}
+	e := evaluator.NewEvaluator(newRule(srv.URL, level))
+	labels := map[string]string{"instance": "a"}
+	start := time.Unix(1700000000, 0)
+	eval := func(minute int, value string) []*evaluator.Event {
+		t.Helper()
+		fake.set(http.StatusOK, vector(sample(labels, value)))
+		events, err := e.Eval(context.Background(), start.Add(time.Duration(minute)*time.Minute))
+		if err != nil {
+			t.Fatalf("eval at minute %d: %v", minute, err)
+		}
+		return events
+	}
+
+	// fire, resolve, fire, then the 4th state change starts the flapping
+	for minute, want := range []struct {
+		value    string
+		state    evaluator.State
+		flapping bool
+	}{
+		{"91", evaluator.StateFiring, false},
+		{"10", evaluator.StateResolved, false},
+		{"91", evaluator.StateFiring, false},
+		{"10", evaluator.StateResolved, true},
+		{"91", evaluator.StateFiring, true},
+		{"91", evaluator.StateFiring, true},
+	} {
+		events := eval(minute, want.value)
+		if len(events) != 1 {
+			t.Fatalf("minute %d: expected 1 event, got %d", minute, len(events))
+		}
+		if events[0].State != want.state || events[0].Flapping != want.flapping || events[0].Flap != nil {
+			t.Fatalf("minute %d: unexpected event %+v", minute, events[0])
+		}
+	}
+
+	// the state changes of minutes 0 to 3 left the window at minute 13
+	if events := eval(12, "91"); len(events) != 1 || !events[0].Flapping {
+		t.Fatalf("minute 12: expected a flapping firing event, got %+v", events)
+	}
+	events := eval(13, "91")
+	if len(events) != 2 {
+		t.Fatalf("minute 13: expected a firing and a summary event, got %d", len(events))
+	}
+	if events[0].Flapping || events[0].Flap != nil {
+		t.Fatalf("minute 13: unexpected firing event %+v", events[0])
+	}
+	summary := events[1]
+	if summary.State != evaluator.StateFiring || summary.Flapping || summary.Flap == nil {
+		t.Fatalf("minute 13: unexpected summary event %+v", summary)
+	}
+	if summary.Flap.Transitions != 5 || !summary.Flap.Since.Equal(start) || !summary.Flap.Until.Equal(start.Add(13*time.Minute)) {
+		t.Fatalf("minute 13: unexpected summary %+v", *summary.Flap)
+	}
+	if events := eval(14, "91"); len(events) != 1 || events[0].Flapping || events[0].Flap != nil {
+		t.Fatalf("minute 14: expected a single firing event, got %+v", events)
+	}
+}
@@ -0,0 +1,394 @@
+package evaluator
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+)
+
+const (
+	// handoffTTL is how many intervals a rule stays held without being renewed, a node that
+	// stops without releasing its rules hands them over once they expire.
+	handoffTTL = 3
+	// handoffTimeout bounds releasing the rules when the manager stops.
+	handoffTimeout = 5 * time.Second
+)
+
+// Handler receives the events produced by every evaluation round.
+type Handler interface {
+	HandleEvents(ctx context.Context, events []*Event)
+}
+
+type HandlerFunc func(ctx context.Context, events []*Event)
+
+func (f HandlerFunc) HandleEvents(ctx context.Context, events []*Event) {
+	f(ctx, events)
+}
+
+// StateStore checkpoints the alerts of a rule, so that pending and firing
+// alerts survive restarts.
+type StateStore interface {
+	LoadAlerts(ctx context.Context, strategyUID snowflake.ID) ([]*Alert, error)
+	// SaveAlerts checkpoints the alerts, it returns the keys of the saved alerts whose events
+	// were resolved by hand, the manager stops sending their events.
+	SaveAlerts(ctx context.Context, strategyUID snowflake.ID, alerts []*Alert) ([]Key, error)
+}
+
+// Handoff hands a rule over between two nodes, a node evaluates a rule only while it holds it.
+type Handoff interface {
+	// Acquire takes or renews the rule for ttl, it reports false while another node holds it.
+	Acquire(ctx context.Context, strategyUID snowflake.ID, ttl time.Duration) (bool, error)
+	// Release hands the rule over, it is called once the alerts of the rule are checkpointed.
+	Release(ctx context.Context, strategyUID snowflake.ID) error
+}
+
+type ManagerOption func(*Manager)
+
+// WithStateStore makes the manager restore alerts when a loop starts and
+// checkpoint them after every evaluation.
+func WithStateStore(store StateStore) ManagerOption {
+	return func(m *Manager) {
+		m.store = store
+	}
+}
+
+// WithEngine runs the queries of every evaluation on engine, bounding how many
+// rules are evaluated at once.
+func WithEngine(engine *job.Engine) ManagerOption {
+	return func(m *Manager) {
+		m.engine = engine
+	}
+}
+
+// WithOwnership makes the manager evaluate only the rules owns reports, the loops
+// of rules owned by another node are released without resolving their alerts,
+// the new owner restores them from the StateStore.
+func WithOwnership(owns func(strategyUID snowflake.ID) bool) ManagerOption {
+	return func(m *Manager) {
+		m.owns = owns
+	}
+}
+
+// WithHandoff makes a loop wait until it holds its rule before it restores the alerts and
+// evaluates, the previous owner releases the rule only after it checkpointed the alerts.
+// Without it the nodes may both evaluate a rule, or restore a stale checkpoint, while their
+// views of the ownership differ.
+func WithHandoff(handoff Handoff) ManagerOption {
+	return func(m *Manager) {
+		m.handoff = handoff
+	}
+}
+
+// NewManager returns a Manager that hands all events to handler.
+func NewManager(handler Handler, helper *klog.Helper, opts ...ManagerOption) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		ctx:     ctx,
+		cancel:  cancel,
+		handler: handler,
+		helper:  helper,
+		loops:   make(map[snowflake.ID]*loop),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Manager runs one evaluation loop per rule on the rule's interval.
+type Manager struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	handler Handler
+	helper  *klog.Helper
+	store   StateStore
+	engine  *job.Engine
+	owns    func(strategyUID snowflake.ID) bool
+	handoff Handoff
+
+	mu    sync.Mutex
+	loops map[snowflake.ID]*loop
+}
+
+type loop struct {
+	evaluator *Evaluator
+	interval  time.Duration
+	reset     chan time.Duration
+	cancel    context.CancelFunc
+	done      chan struct{}
+	// held is set while the loop holds its rule, it is only touched by the loop until done is closed.
+	held bool
+}
+
+// Sync starts a loop for every new rule, hands updated rules to the running
+// loops and stops the loops of rules that are gone, resolving their alerts.
+// Rules owned by another node are released, see WithOwnership.
+func (m *Manager) Sync(rules []*Rule) {
+	// changed intervals are handed over and removed loops are stopped after unlocking, so that
+	// a loop busy evaluating, or a slow handler, store or handoff, does not hold up the callers of m.mu
+	resets, stopped, released := m.sync(rules)
+	for l, interval := range resets {
+		l.setInterval(interval)
+	}
+	for _, l := range released {
+		m.release(l)
+	}
+	for _, l := range stopped {
+		m.stop(l)
+	}
+}
+
+// sync updates the loops to rules, it returns the loops whose interval changed, the loops of
+// the rules that are gone and the loops of the rules owned by another node.
+func (m *Manager) sync(rules []*Rule) (resets map[*loop]time.Duration, stopped, released []*loop) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ctx.Err() != nil {
+		return nil, nil, nil
+	}
+
+	resets = make(map[*loop]time.Duration)
+	keep := make(map[snowflake.ID]struct{}, len(rules))
+	for _, rule := range rules {
+		keep[rule.StrategyUID] = struct{}{}
+		if m.owns != nil && !m.owns(rule.StrategyUID) {
+			if l, ok := m.loops[rule.StrategyUID]; ok {
+				l.cancel()
+				released = append(released, l)
+				delete(m.loops, rule.StrategyUID)
+			}
+			continue
+		}
+		interval := ruleInterval(rule)
+		if l, ok := m.loops[rule.StrategyUID]; ok {
+			l.evaluator.SetRule(rule)
+			if l.interval != interval {
+				l.interval = interval
+				resets[l] = interval
+			}
+			continue
+		}
+		m.loops[rule.StrategyUID] = m.start(rule, interval)
+	}
+	for strategyUID, l := range m.loops {
+		if _, ok := keep[strategyUID]; ok {
+			continue
+		}
+		l.cancel()
+		stopped = append(stopped, l)
+		delete(m.loops, strategyUID)
+	}
+	return resets, stopped, released
+}
+
+// Stop stops all loops without resolving their alerts, the rules are handed over with the
+// alerts of their last evaluation.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), handoffTimeout)
+	defer cancel()
+	for strategyUID, l := range m.loops {
+		<-l.done
+		m.handOver(ctx, l)
+		delete(m.loops, strategyUID)
+	}
+}
+
+func (m *Manager) start(rule *Rule, interval time.Duration) *loop {
+	ctx, cancel := context.WithCancel(m.ctx)
+	l := &loop{
+		evaluator: NewEvaluator(rule),
+		interval:  interval,
+		reset:     make(chan time.Duration, 1),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	go m.run(ctx, l)
+	return l
+}
+
+func (m *Manager) stop(l *loop) {
+	l.cancel()
+	<-l.done
+	if !l.held {
+		return
+	}
+	rule := l.evaluator.Rule()
+	if events := l.evaluator.Resolve(time.Now()); len(events) > 0 {
+		m.handler.HandleEvents(m.ctx, events)
+	}
+	m.save(m.ctx, rule.StrategyUID, nil)
+	m.handOver(m.ctx, l)
+}
+
+// release stops the loop of a rule another node took over, its alerts are checkpointed
+// for the new owner.
+func (m *Manager) release(l *loop) {
+	l.cancel()
+	<-l.done
+	if !l.held {
+		return
+	}
+	m.save(m.ctx, l.evaluator.Rule().StrategyUID, l.evaluator.Alerts())
+	m.handOver(m.ctx, l)
+}
+
+// handOver releases the rule of a stopped loop to the next owner.
+func (m *Manager) handOver(ctx context.Context, l *loop) {
+	if m.handoff == nil || !l.held {
+		return
+	}
+	strategyUID := l.evaluator.Rule().StrategyUID
+	if err := m.handoff.Release(ctx, strategyUID); err != nil {
+		m.helper.Warnw("msg", "release strategy failed", "strategyUID", strategyUID, "error", err)
+	}
+}
+
+// setInterval hands interval to the loop without blocking, an interval the loop has not
+// picked up yet is replaced.
+func (l *loop) setInterval(interval time.Duration) {
+	for {
+		select {
+		case l.reset <- interval:
+			return
+		default:
+		}
+		select {
+		case <-l.reset:
+		default:
+		}
+	}
+}
+
+func (m *Manager) run(ctx context.Context, l *loop) {
+	defer close(l.done)
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+	for {
+		if m.acquire(ctx, l) {
+			m.eval(ctx, l)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case interval := <-l.reset:
+			ticker.Reset(interval)
+		case <-ticker.C:
+		}
+	}
+}
+
+// acquire reports whether the loop holds its rule, the alerts are restored whenever the loop
+// takes the rule over. A loop that fails to renew its rule keeps it, but skips the evaluation.
+func (m *Manager) acquire(ctx context.Context, l *loop) bool {
+	if m.handoff == nil {
+		if !l.held {
+			m.restore(ctx, l)
+			l.held = true
+		}
+		return true
+	}
+	rule := l.evaluator.Rule()
+	ok, err := m.handoff.Acquire(ctx, rule.StrategyUID, handoffTTL*ruleInterval(rule))
+	if err != nil {
+		if ctx.Err() != nil {
+			return false
+		}
+		m.helper.Warnw("msg", "acquire strategy failed", "strategyUID", rule.StrategyUID, "error", err)
+		return false
+	}
+	if !ok {
+		l.held = false
+		return false
+	}
+	if !l.held {
+		m.restore(ctx, l)
+		l.held = true
+	}
+	return true
+}
+
+func (m *Manager) eval(ctx context.Context, l *loop) {
+	rule := l.evaluator.Rule()
+	var events []*Event
+	err := m.do(ctx, func(ctx context.Context) error {
+		evalCtx, cancel := context.WithTimeout(ctx, ruleInterval(rule))
+		defer cancel()
+		var err error
+		events, err = l.evaluator.Eval(evalCtx, time.Now())
+		return err
+	})
+	if err != nil {
+		m.helper.Warnw("msg", "evaluate strategy failed", "strategyUID", rule.StrategyUID, "error", err)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	if resolved := m.save(ctx, rule.StrategyUID, l.evaluator.Alerts()); len(resolved) > 0 {
+		l.evaluator.ResolveManually(resolved)
+		events = withoutKeys(events, resolved)
+	}
+	if len(events) > 0 {
+		m.handler.HandleEvents(ctx, events)
+	}
+}
+
+// do runs the queries of an evaluation on the engine, if there is one.
+func (m *Manager) do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.engine == nil {
+		return fn(ctx)
+	}
+	return m.engine.Run(ctx, &job.Job{Name: "evaluate", Run: fn})
+}
+
+func (m *Manager) restore(ctx context.Context, l *loop) {
+	if m.store == nil {
+		return
+	}
+	strategyUID := l.evaluator.Rule().StrategyUID
+	alerts, err := m.store.LoadAlerts(ctx, strategyUID)
+	if err != nil {
+		m.helper.Warnw("msg", "load alert states failed", "strategyUID", strategyUID, "error", err)
+		return
+	}
+	l.evaluator.Restore(alerts)
+}
+
+// save checkpoints the alerts, it returns the keys of the alerts resolved by hand.
+func (m *Manager) save(ctx context.Context, strategyUID snowflake.ID, alerts []*Alert) []Key {
+	if m.store == nil {
+		return nil
+	}
+	resolved, err := m.store.SaveAlerts(ctx, strategyUID, alerts)
+	if err != nil {
+		m.helper.Warnw("msg", "save alert states failed", "strategyUID", strategyUID, "error", err)
+	}
+	return resolved
+}
+
+// withoutKeys drops the events of the alerts of keys.
+func withoutKeys(events []*Event, keys []Key) []*Event {
+	drop := make(map[Key]struct{}, len(keys))
+	for _, key := range keys {
+		drop[key] = struct{}{}
+	}
+	return slices.DeleteFunc(events, func(event *Event) bool {
+		_, ok := drop[event.Key()]
+		return ok
+	})
+}
+
+func ruleInterval(rule *Rule) time.Duration {
+	if rule.Interval <= 0 {
+		return DefaultInterval
+	}
+	return rule.Interval
+}
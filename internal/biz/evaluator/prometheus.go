@@ -0,0 +1,272 @@
+package evaluator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// DefaultMaxResponseSize bounds the body of a Prometheus response, a query returning more
+// fails instead of being held in memory.
+const DefaultMaxResponseSize = 64 << 20
+
+// Querier runs an instant query and returns the resulting series.
+type Querier interface {
+	Query(ctx context.Context, expr string, ts time.Time) ([]*Series, error)
+}
+
+// RangeQuerier runs a range query, the points of every series are at start, start+step
+// and so on up to end.
+type RangeQuerier interface {
+	QueryRange(ctx context.Context, expr string, start, end time.Time, step time.Duration) ([]*Series, error)
+}
+
+// Series is one labelled result of a query, instant vectors carry a single point.
+type Series struct {
+	Labels map[string]string
+	Points []Point
+}
+
+// StatusError is returned when a datasource answers a request with an error.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// BuildInfo is the version information a datasource reports about itself.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Revision  string `json:"revision"`
+	Branch    string `json:"branch"`
+	BuildUser string `json:"buildUser"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+}
+
+// Prober checks that a datasource is reachable with its configuration.
+type Prober interface {
+	Probe(ctx context.Context) (*BuildInfo, error)
+}
+
+type Point struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+type PrometheusOption func(*PrometheusQuerier)
+
+// WithHTTPClient sets the http client used for queries, http.DefaultClient by default.
+func WithHTTPClient(client *http.Client) PrometheusOption {
+	return func(p *PrometheusQuerier) {
+		p.client = client
+	}
+}
+
+func WithBasicAuth(username, password string) PrometheusOption {
+	return func(p *PrometheusQuerier) {
+		p.username = username
+		p.password = password
+	}
+}
+
+func WithHeaders(headers map[string]string) PrometheusOption {
+	return func(p *PrometheusQuerier) {
+		p.headers = headers
+	}
+}
+
+// WithMaxResponseSize bounds the body of a response to size bytes, DefaultMaxResponseSize by default.
+func WithMaxResponseSize(size int64) PrometheusOption {
+	return func(p *PrometheusQuerier) {
+		p.maxResponseSize = size
+	}
+}
+
+// NewPrometheusQuerier returns a Querier backed by the Prometheus HTTP API at endpoint.
+func NewPrometheusQuerier(endpoint string, opts ...PrometheusOption) *PrometheusQuerier {
+	p := &PrometheusQuerier{
+		endpoint:        strings.TrimRight(endpoint, "/"),
+		client:          http.DefaultClient,
+		maxResponseSize: DefaultMaxResponseSize,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+type PrometheusQuerier struct {
+	endpoint        string
+	client          *http.Client
+	username        string
+	password        string
+	headers         map[string]string
+	maxResponseSize int64
+}
+
+type prometheusResponse struct {
+	Status    string          `json:"status"`
+	Data      json.RawMessage `json:"data"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+}
+
+type prometheusQueryData struct {
+	ResultType model.ValueType `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// Query implements Querier through /api/v1/query.
+func (p *PrometheusQuerier) Query(ctx context.Context, expr string, ts time.Time) ([]*Series, error) {
+	form := url.Values{}
+	form.Set("query", expr)
+	form.Set("time", model.TimeFromUnixNano(ts.UnixNano()).String())
+	var data prometheusQueryData
+	if err := p.do(ctx, http.MethodPost, "/api/v1/query", form, &data); err != nil {
+		return nil, err
+	}
+	return decodeSeries(data.ResultType, data.Result)
+}
+
+// QueryRange implements RangeQuerier through /api/v1/query_range.
+func (p *PrometheusQuerier) QueryRange(ctx context.Context, expr string, start, end time.Time, step time.Duration) ([]*Series, error) {
+	form := url.Values{}
+	form.Set("query", expr)
+	form.Set("start", model.TimeFromUnixNano(start.UnixNano()).String())
+	form.Set("end", model.TimeFromUnixNano(end.UnixNano()).String())
+	form.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+	var data prometheusQueryData
+	if err := p.do(ctx, http.MethodPost, "/api/v1/query_range", form, &data); err != nil {
+		return nil, err
+	}
+	return decodeSeries(data.ResultType, data.Result)
+}
+
+// Probe implements Prober, it reads /api/v1/status/buildinfo and runs a trivial query so
+// that credentials limited to the query API are checked as well.
+func (p *PrometheusQuerier) Probe(ctx context.Context) (*BuildInfo, error) {
+	var info BuildInfo
+	if err := p.do(ctx, http.MethodGet, "/api/v1/status/buildinfo", nil, &info); err != nil {
+		// Some Prometheus compatible backends do not expose build information.
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+	}
+	if _, err := p.Query(ctx, "vector(1)", time.Now()); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (p *PrometheusQuerier) do(ctx context.Context, method, path string, form url.Values, data any) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+	if p.username != "" || p.password != "" {
+		req.SetBasicAuth(p.username, p.password)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// one byte more than the limit tells a body of exactly the limit from a larger one
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, p.maxResponseSize+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(raw)) > p.maxResponseSize {
+		return fmt.Errorf("prometheus response exceeds %d bytes", p.maxResponseSize)
+	}
+	var result prometheusResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return &StatusError{StatusCode: resp.StatusCode, Err: fmt.Errorf("prometheus responded with status %d: %w", resp.StatusCode, err)}
+	}
+	if result.Status != "success" {
+		return &StatusError{StatusCode: resp.StatusCode, Err: fmt.Errorf("prometheus query failed: %s: %s", result.ErrorType, result.Error)}
+	}
+	return json.Unmarshal(result.Data, data)
+}
+
+func decodeSeries(resultType model.ValueType, raw json.RawMessage) ([]*Series, error) {
+	switch resultType {
+	case model.ValVector:
+		var vector model.Vector
+		if err := json.Unmarshal(raw, &vector); err != nil {
+			return nil, err
+		}
+		list := make([]*Series, 0, len(vector))
+		for _, sample := range vector {
+			if sample.Histogram != nil {
+				continue
+			}
+			list = append(list, &Series{
+				Labels: metricToLabels(sample.Metric),
+				Points: []Point{{Timestamp: sample.Timestamp.Time(), Value: float64(sample.Value)}},
+			})
+		}
+		return list, nil
+	case model.ValMatrix:
+		var matrix model.Matrix
+		if err := json.Unmarshal(raw, &matrix); err != nil {
+			return nil, err
+		}
+		list := make([]*Series, 0, len(matrix))
+		for _, stream := range matrix {
+			points := make([]Point, 0, len(stream.Values))
+			for _, pair := range stream.Values {
+				points = append(points, Point{Timestamp: pair.Timestamp.Time(), Value: float64(pair.Value)})
+			}
+			list = append(list, &Series{Labels: metricToLabels(stream.Metric), Points: points})
+		}
+		return list, nil
+	case model.ValScalar:
+		var scalar model.Scalar
+		if err := json.Unmarshal(raw, &scalar); err != nil {
+			return nil, err
+		}
+		return []*Series{{
+			Labels: map[string]string{},
+			Points: []Point{{Timestamp: scalar.Timestamp.Time(), Value: float64(scalar.Value)}},
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result type %q", resultType)
+	}
+}
+
+func metricToLabels(metric model.Metric) map[string]string {
+	labels := make(map[string]string, len(metric))
+	for name, value := range metric {
+		labels[string(name)] = string(value)
+	}
+	return labels
+}
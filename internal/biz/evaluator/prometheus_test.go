@@ -0,0 +1,71 @@
+package evaluator_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+)
+
+func TestPrometheusProbe(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/status/buildinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Write([]byte(`{"status":"success","data":{"version":"2.53.0","revision":"abc","branch":"HEAD","goVersion":"go1.22"}}`))
+	})
+	mux.HandleFunc("/api/v1/query", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, _ := r.BasicAuth(); user != "admin" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	info, err := evaluator.NewPrometheusQuerier(srv.URL, evaluator.WithBasicAuth("admin", "secret")).Probe(context.Background())
+	if err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	if info.Version != "2.53.0" || info.Revision != "abc" || info.GoVersion != "go1.22" {
+		t.Fatalf("unexpected build info %+v", info)
+	}
+
+	_, err = evaluator.NewPrometheusQuerier(srv.URL, evaluator.WithBasicAuth("admin", "wrong")).Probe(context.Background())
+	var statusErr *evaluator.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 status error, got %v", err)
+	}
+}
+
+func TestPrometheusProbeWithoutBuildInfo(t *testing.T) {
+	_, srv := newServer(t)
+	info, err := evaluator.NewPrometheusQuerier(srv.URL).Probe(context.Background())
+	if err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	if info.Version != "" {
+		t.Fatalf("unexpected build info %+v", info)
+	}
+}
+
+func TestPrometheusMaxResponseSize(t *testing.T) {
+	fake, srv := newServer(t)
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": strings.Repeat("a", 1024)}, "1")))
+
+	if _, err := evaluator.NewPrometheusQuerier(srv.URL, evaluator.WithMaxResponseSize(1024)).Query(context.Background(), "up", time.Now()); err == nil || !strings.Contains(err.Error(), "exceeds 1024 bytes") {
+		t.Fatalf("got %v, want the response to exceed the limit", err)
+	}
+	series, err := evaluator.NewPrometheusQuerier(srv.URL, evaluator.WithMaxResponseSize(4096)).Query(context.Background(), "up", time.Now())
+	if err != nil || len(series) != 1 {
+		t.Fatalf("got %v, %v, want the series of a response within the limit", series, err)
+	}
+}
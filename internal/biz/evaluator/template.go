@@ -0,0 +1,320 @@
+package evaluator
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// templateDefs makes the Prometheus style $labels and $value variables, plus
+// $level and $datasource, available to every template.
+const templateDefs = "{{$labels := .Labels}}{{$value := .Value}}{{$level := .Level}}{{$datasource := .Datasource}}"
+
+// TemplateData is what summary and description templates are executed with,
+// e.g. "{{ .Labels.instance }} is at {{ .Value | humanize }} ({{ .Level }})".
+type TemplateData struct {
+	Labels        map[string]string
+	Value         float64
+	State         string
+	StrategyUID   snowflake.ID
+	LevelUID      snowflake.ID
+	Level         string
+	DatasourceUID snowflake.ID
+	Datasource    string
+	StartsAt      time.Time
+}
+
+// ParseTemplate parses text with the template functions, the name shows up in errors.
+func ParseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=zero").Funcs(templateFuncs).Parse(templateDefs + text)
+}
+
+// ExecuteTemplate renders tmpl, a nil template renders the empty string.
+func ExecuteTemplate(tmpl *template.Template, data *TemplateData) (string, error) {
+	if tmpl == nil {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ExpandTemplate parses and renders text, texts without actions are returned as they are.
+func ExpandTemplate(name, text string, data *TemplateData) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := ParseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+	return ExecuteTemplate(tmpl, data)
+}
+
+// TemplateLabels returns the sorted labels tmpl reads, as $labels.x, .Labels.x or
+// index $labels "x".
+func TemplateLabels(tmpl *template.Template) []string {
+	if tmpl == nil || tmpl.Tree == nil {
+		return nil
+	}
+	found := map[string]bool{}
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.IfNode:
+			walk(&n.BranchNode)
+		case *parse.RangeNode:
+			walk(&n.BranchNode)
+		case *parse.WithNode:
+			walk(&n.BranchNode)
+		case *parse.BranchNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			if len(n.Args) == 3 && n.Args[0].String() == "index" && isLabelsNode(n.Args[1]) {
+				if label, ok := n.Args[2].(*parse.StringNode); ok {
+					found[label.Text] = true
+				}
+			}
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.FieldNode:
+			if len(n.Ident) > 1 && n.Ident[0] == "Labels" {
+				found[n.Ident[1]] = true
+			}
+		case *parse.VariableNode:
+			if len(n.Ident) > 1 && n.Ident[0] == "$labels" {
+				found[n.Ident[1]] = true
+			}
+		}
+	}
+	walk(tmpl.Tree.Root)
+	labels := make([]string, 0, len(found))
+	for label := range found {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+func isLabelsNode(node parse.Node) bool {
+	switch n := node.(type) {
+	case *parse.FieldNode:
+		return len(n.Ident) == 1 && n.Ident[0] == "Labels"
+	case *parse.VariableNode:
+		return len(n.Ident) == 1 && n.Ident[0] == "$labels"
+	}
+	return false
+}
+
+var templateFuncs = template.FuncMap{
+	"humanize":           humanize,
+	"humanize1024":       humanize1024,
+	"humanizeDuration":   humanizeDuration,
+	"humanizePercentage": humanizePercentage,
+	"humanizeTimestamp":  humanizeTimestamp,
+	"toUpper":            strings.ToUpper,
+	"toLower":            strings.ToLower,
+	"title":              title,
+	"trimSpace":          strings.TrimSpace,
+	"match":              regexp.MatchString,
+	"reReplaceAll": func(pattern, repl, text string) (string, error) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", err
+		}
+		return re.ReplaceAllString(text, repl), nil
+	},
+}
+
+func toFloat64(v any) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case time.Duration:
+		return v.Seconds(), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to float64", v)
+	}
+}
+
+// humanize formats v with an SI prefix, e.g. 1234567 -> "1.235M".
+func humanize(i any) (string, error) {
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	if math.Abs(v) >= 1 {
+		prefix := ""
+		for _, p := range []string{"k", "M", "G", "T", "P", "E", "Z", "Y"} {
+			if math.Abs(v) < 1000 {
+				break
+			}
+			prefix = p
+			v /= 1000
+		}
+		return fmt.Sprintf("%.4g%s", v, prefix), nil
+	}
+	prefix := ""
+	for _, p := range []string{"m", "u", "n", "p", "f", "a", "z", "y"} {
+		if math.Abs(v) >= 1 {
+			break
+		}
+		prefix = p
+		v *= 1000
+	}
+	return fmt.Sprintf("%.4g%s", v, prefix), nil
+}
+
+// humanize1024 formats v with a binary prefix, e.g. 1048576 -> "1Mi".
+func humanize1024(i any) (string, error) {
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	if math.Abs(v) <= 1 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	prefix := ""
+	for _, p := range []string{"ki", "Mi", "Gi", "Ti", "Pi", "Ei", "Zi", "Yi"} {
+		if math.Abs(v) < 1024 {
+			break
+		}
+		prefix = p
+		v /= 1024
+	}
+	return fmt.Sprintf("%.4g%s", v, prefix), nil
+}
+
+// humanizeDuration formats seconds, e.g. 93784 -> "1d 2h 3m 4s".
+func humanizeDuration(i any) (string, error) {
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	if v == 0 {
+		return "0s", nil
+	}
+	if math.Abs(v) < 1 {
+		return humanizeSubSecond(v), nil
+	}
+	sign := ""
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	seconds := int64(v) % 60
+	minutes := (int64(v) / 60) % 60
+	hours := (int64(v) / 60 / 60) % 24
+	days := int64(v) / 60 / 60 / 24
+	switch {
+	case days != 0:
+		return fmt.Sprintf("%s%dd %dh %dm %ds", sign, days, hours, minutes, seconds), nil
+	case hours != 0:
+		return fmt.Sprintf("%s%dh %dm %ds", sign, hours, minutes, seconds), nil
+	case minutes != 0:
+		return fmt.Sprintf("%s%dm %ds", sign, minutes, seconds), nil
+	}
+	return fmt.Sprintf("%s%.4gs", sign, v), nil
+}
+
+func humanizeSubSecond(v float64) string {
+	prefix := ""
+	for _, p := range []string{"m", "u", "n", "p", "f", "a", "z", "y"} {
+		if math.Abs(v) >= 1 {
+			break
+		}
+		prefix = p
+		v *= 1000
+	}
+	return fmt.Sprintf("%.4g%ss", v, prefix)
+}
+
+// humanizePercentage formats a ratio, e.g. 0.1234 -> "12.34%".
+func humanizePercentage(i any) (string, error) {
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%.4g%%", v*100), nil
+}
+
+// humanizeTimestamp formats unix seconds, or a time, as a UTC time.
+func humanizeTimestamp(i any) (string, error) {
+	if t, ok := i.(time.Time); ok {
+		return t.UTC().String(), nil
+	}
+	v, err := toFloat64(i)
+	if err != nil {
+		return "", err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprintf("%.4g", v), nil
+	}
+	sec, frac := math.Modf(v)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC().String(), nil
+}
+
+// title upper-cases the first letter of every word.
+func title(s string) string {
+	prev := ' '
+	return strings.Map(func(r rune) rune {
+		start := unicode.IsSpace(prev)
+		prev = r
+		if start {
+			return unicode.ToTitle(r)
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,93 @@
+package evaluator_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	data := &evaluator.TemplateData{
+		Labels:     map[string]string{"instance": "a:9100", "job": "node"},
+		Value:      1234567,
+		Level:      "critical",
+		Datasource: "prom",
+	}
+	tests := []struct {
+		text string
+		want string
+	}{
+		{`plain text`, `plain text`},
+		{`{{ .Labels.instance }} at {{ .Value | humanize }}`, `a:9100 at 1.235M`},
+		{`{{ $labels.job | toUpper }} {{ $level | title }} on {{ $datasource }}`, `NODE Critical on prom`},
+		{`{{ .Labels.missing }}|{{ 1048576 | humanize1024 }}`, `|1Mi`},
+		{`{{ 93784 | humanizeDuration }}`, `1d 2h 3m 4s`},
+		{`{{ 0.1234 | humanizePercentage }}`, `12.34%`},
+		{`{{ reReplaceAll ":.*" "" .Labels.instance }}`, `a`},
+	}
+	for _, tt := range tests {
+		got, err := evaluator.ExpandTemplate("test", tt.text, data)
+		if err != nil {
+			t.Fatalf("expand %q: %v", tt.text, err)
+		}
+		if got != tt.want {
+			t.Fatalf("expand %q = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+
+	if _, err := evaluator.ExpandTemplate("test", `{{ .Labels.instance `, data); err == nil {
+		t.Fatal("expected a parse error")
+	}
+	if _, err := evaluator.ExpandTemplate("test", `{{ .Labels.job | humanize }}`, data); err == nil {
+		t.Fatal("expected an execution error")
+	}
+}
+
+func TestTemplateLabels(t *testing.T) {
+	text := `{{ $labels.job }} {{ .Labels.instance | toUpper }} {{ index $labels "pod" }}` +
+		`{{ if .Labels.namespace }}{{ with .Value }}{{ reReplaceAll ":.*" "" $labels.instance }}{{ end }}{{ end }}` +
+		`{{ $value }} {{ $level }}`
+	tmpl, err := evaluator.ParseTemplate("summary", text)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	got := strings.Join(evaluator.TemplateLabels(tmpl), ",")
+	if want := "instance,job,namespace,pod"; got != want {
+		t.Fatalf("got labels %s, want %s", got, want)
+	}
+}
+
+func TestEvaluatorRendersTemplates(t *testing.T) {
+	fake, srv := newServer(t)
+	level := &evaluator.Level{
+		LevelUID:  20,
+		Name:      "critical",
+		Condition: enum.ConditionMetric_CONDITION_METRIC_GT,
+		Values:    []int64{80},
+	}
+	rule := newRule(srv.URL, level)
+	rule.Summary = `{{ .Labels.instance }} is at {{ .Value | humanize }} ({{ .Level }})`
+	rule.Description = `{{ .Labels.instance | nope }}`
+	e := evaluator.NewEvaluator(rule)
+
+	fake.set(http.StatusOK, vector(sample(map[string]string{"instance": "a"}, "91")))
+	events, err := e.Eval(context.Background(), time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatalf("eval: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	if events[0].Summary != "a is at 91 (critical)" {
+		t.Fatalf("unexpected summary %q", events[0].Summary)
+	}
+	if !strings.HasPrefix(events[0].Description, "<error expanding template") {
+		t.Fatalf("expected a template error, got %q", events[0].Description)
+	}
+}
@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewEvent(
+	eventRepo repository.Event,
+	notifyBiz *NotifyBiz,
+	helper *klog.Helper,
+) *EventBiz {
+	return &EventBiz{
+		eventRepo: eventRepo,
+		notifyBiz: notifyBiz,
+		helper:    klog.NewHelper(klog.With(helper.Logger(), "biz", "event")),
+	}
+}
+
+type EventBiz struct {
+	helper    *klog.Helper
+	eventRepo repository.Event
+	notifyBiz *NotifyBiz
+}
+
+func (e *EventBiz) GetEvent(ctx context.Context, uid snowflake.ID) (*bo.EventItemBo, error) {
+	item, err := e.eventRepo.GetEvent(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("event %d not found", uid.Int64())
+		}
+		e.helper.Errorw("msg", "get event failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get event failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (e *EventBiz) ListEvent(ctx context.Context, req *bo.ListEventBo) (*bo.PageResponseBo[*bo.EventItemBo], error) {
+	result, err := e.eventRepo.ListEvent(ctx, req)
+	if err != nil {
+		e.helper.Errorw("msg", "list event failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list event failed").WithCause(err)
+	}
+	return result, nil
+}
+
+func (e *EventBiz) GetEventTimeline(ctx context.Context, req *bo.GetEventTimelineBo) ([]*bo.EventTimelineItemBo, error) {
+	items, err := e.eventRepo.ListEventTimeline(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("event %d not found", req.UID.Int64())
+		}
+		e.helper.Errorw("msg", "get event timeline failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("get event timeline failed").WithCause(err)
+	}
+	return items, nil
+}
+
+// SaveEventActivity applies the activity of the user of ctx to the event. An acknowledged event
+// is no longer notified, a manually resolved event is notified as resolved and its alert fires
+// no new event until its series stops matching.
+func (e *EventBiz) SaveEventActivity(ctx context.Context, req *bo.EventActivityBo) error {
+	event, err := e.GetEvent(ctx, req.EventUID)
+	if err != nil {
+		return err
+	}
+	if err := req.Check(event); err != nil {
+		return err
+	}
+	item, err := e.eventRepo.SaveEventActivity(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			// the event was resolved since it was checked
+			return merr.ErrorNotFound("firing event %d not found", req.EventUID.Int64())
+		}
+		e.helper.Errorw("msg", "save event activity failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("save event activity failed").WithCause(err)
+	}
+	switch req.Type {
+	case apiv1.EventActivityType_ACKNOWLEDGE:
+		e.notifyBiz.Acknowledge(ctx, item)
+	case apiv1.EventActivityType_MANUAL_RESOLVE:
+		e.notifyBiz.Notify(ctx, []*bo.EventItemBo{item})
+	}
+	return nil
+}
+
+func (e *EventBiz) ListEventActivity(ctx context.Context, req *bo.ListEventActivityBo) ([]*bo.EventActivityItemBo, error) {
+	items, err := e.eventRepo.ListEventActivity(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("event %d not found", req.UID.Int64())
+		}
+		e.helper.Errorw("msg", "list event activity failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list event activity failed").WithCause(err)
+	}
+	return items, nil
+}
@@ -0,0 +1,126 @@
+// Package flapper detects the series that flap: a series whose alert changes state (fires or
+// resolves) at least Threshold times within the sliding Window is flapping, and it is stable
+// again once fewer than half as many changes are left in the window. The gap between the two
+// marks keeps a series oscillating around the threshold from toggling in and out of flapping.
+package flapper
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// Config is the flap detection of a level, a zero Window or Threshold disables it.
+type Config struct {
+	Window    time.Duration
+	Threshold int
+}
+
+func (c Config) Enabled() bool {
+	return c.Window > 0 && c.Threshold > 0
+}
+
+// Key identifies a series of a level, by the fingerprint of its label set.
+type Key struct {
+	StrategyUID snowflake.ID
+	LevelUID    snowflake.ID
+	Fingerprint uint64
+}
+
+// Summary describes a flapping period that ended.
+type Summary struct {
+	Key Key
+	// Since is when the series started flapping, Until when it was stable again.
+	Since time.Time
+	Until time.Time
+	// Transitions is the number of state changes from Since to Until.
+	Transitions int
+}
+
+// history is the state changes of a series still in the window.
+type history struct {
+	config      Config
+	transitions []time.Time
+	flapping    bool
+	since       time.Time
+	// flapped counts the state changes of the current flapping period.
+	flapped int
+}
+
+// NewDetector returns a Detector tracking no series.
+func NewDetector() *Detector {
+	return &Detector{series: make(map[Key]*history)}
+}
+
+// Detector tracks the state changes of the series, it is safe for concurrent use.
+// The history is kept in memory only, a restart starts every series as stable.
+type Detector struct {
+	mu     sync.Mutex
+	series map[Key]*history
+}
+
+// Transition records a state change of the series at ts and reports whether the series is flapping.
+func (d *Detector) Transition(key Key, config Config, ts time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !config.Enabled() {
+		delete(d.series, key)
+		return false
+	}
+	h, ok := d.series[key]
+	if !ok {
+		h = &history{}
+		d.series[key] = h
+	}
+	h.config = config
+	h.expire(ts)
+	h.transitions = append(h.transitions, ts)
+	if h.flapping {
+		h.flapped++
+		return true
+	}
+	if len(h.transitions) >= config.Threshold {
+		h.flapping = true
+		h.since = h.transitions[0]
+		h.flapped = len(h.transitions)
+	}
+	return h.flapping
+}
+
+// Flapping reports whether the series is flapping.
+func (d *Detector) Flapping(key Key) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	h, ok := d.series[key]
+	return ok && h.flapping
+}
+
+// Stabilize drops the state changes that left the window at ts and returns the flapping periods
+// that ended, the series without state changes left are forgotten.
+func (d *Detector) Stabilize(ts time.Time) []*Summary {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var summaries []*Summary
+	for key, h := range d.series {
+		h.expire(ts)
+		if h.flapping && 2*len(h.transitions) < h.config.Threshold {
+			summaries = append(summaries, &Summary{Key: key, Since: h.since, Until: ts, Transitions: h.flapped})
+			h.flapping = false
+		}
+		if !h.flapping && len(h.transitions) == 0 {
+			delete(d.series, key)
+		}
+	}
+	return summaries
+}
+
+// expire drops the state changes older than the window.
+func (h *history) expire(ts time.Time) {
+	cutoff := ts.Add(-h.config.Window)
+	i := 0
+	for i < len(h.transitions) && !h.transitions[i].After(cutoff) {
+		i++
+	}
+	h.transitions = h.transitions[i:]
+}
@@ -0,0 +1,69 @@
+package flapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aide-family/marksman/internal/biz/flapper"
+)
+
+func TestDetectorFlapsAndStabilizes(t *testing.T) {
+	d := flapper.NewDetector()
+	key := flapper.Key{StrategyUID: 1, LevelUID: 2, Fingerprint: 3}
+	config := flapper.Config{Window: 10 * time.Minute, Threshold: 4}
+	start := time.Unix(1700000000, 0)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	for i, want := range []bool{false, false, false, true, true} {
+		if got := d.Transition(key, config, at(i)); got != want {
+			t.Fatalf("transition %d: got flapping %v, want %v", i, got, want)
+		}
+	}
+	if !d.Flapping(key) {
+		t.Fatal("series is not flapping after 5 transitions in the window")
+	}
+	// 2 transitions are still in the window at minute 12, not fewer than half the threshold
+	if summaries := d.Stabilize(at(12)); len(summaries) != 0 {
+		t.Fatalf("got %d summaries at minute 12, want the series still flapping", len(summaries))
+	}
+	summaries := d.Stabilize(at(13))
+	if len(summaries) != 1 {
+		t.Fatalf("got %d summaries at minute 13, want 1", len(summaries))
+	}
+	s := summaries[0]
+	if s.Key != key || !s.Since.Equal(at(0)) || !s.Until.Equal(at(13)) || s.Transitions != 5 {
+		t.Fatalf("got summary %+v", *s)
+	}
+	if d.Flapping(key) {
+		t.Fatal("series still flapping after the summary")
+	}
+	if summaries := d.Stabilize(at(30)); len(summaries) != 0 {
+		t.Fatalf("got %d summaries after the series was stable, want none", len(summaries))
+	}
+}
+
+func TestDetectorSlowTransitionsDoNotFlap(t *testing.T) {
+	d := flapper.NewDetector()
+	key := flapper.Key{Fingerprint: 1}
+	config := flapper.Config{Window: 10 * time.Minute, Threshold: 3}
+	start := time.Unix(1700000000, 0)
+	for i := 0; i < 10; i++ {
+		if d.Transition(key, config, start.Add(time.Duration(i)*6*time.Minute)) {
+			t.Fatalf("transition %d every 6 minutes is flapping with a 10 minute window", i)
+		}
+	}
+}
+
+func TestDetectorDisabled(t *testing.T) {
+	d := flapper.NewDetector()
+	key := flapper.Key{Fingerprint: 1}
+	start := time.Unix(1700000000, 0)
+	for i := 0; i < 10; i++ {
+		if d.Transition(key, flapper.Config{}, start.Add(time.Duration(i)*time.Second)) {
+			t.Fatal("series is flapping without a config")
+		}
+	}
+	if summaries := d.Stabilize(start.Add(time.Hour)); len(summaries) != 0 {
+		t.Fatalf("got %d summaries without a config", len(summaries))
+	}
+}
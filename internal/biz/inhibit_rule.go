@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/inhibitor"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewInhibitRule(
+	inhibitRuleRepo repository.InhibitRule,
+	levelRepo repository.Level,
+	helper *klog.Helper,
+) *InhibitRuleBiz {
+	return &InhibitRuleBiz{
+		inhibitRuleRepo: inhibitRuleRepo,
+		levelRepo:       levelRepo,
+		helper:          klog.NewHelper(klog.With(helper.Logger(), "biz", "inhibitRule")),
+	}
+}
+
+type InhibitRuleBiz struct {
+	helper          *klog.Helper
+	inhibitRuleRepo repository.InhibitRule
+	levelRepo       repository.Level
+}
+
+func (i *InhibitRuleBiz) CreateInhibitRule(ctx context.Context, req *bo.CreateInhibitRuleBo) error {
+	if err := i.checkLevels(ctx, req.InhibitRuleSpecBo); err != nil {
+		return err
+	}
+	if err := i.inhibitRuleRepo.CreateInhibitRule(ctx, req); err != nil {
+		i.helper.Errorw("msg", "create inhibit rule failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create inhibit rule failed").WithCause(err)
+	}
+	return nil
+}
+
+func (i *InhibitRuleBiz) UpdateInhibitRule(ctx context.Context, req *bo.UpdateInhibitRuleBo) error {
+	if err := i.checkLevels(ctx, req.InhibitRuleSpecBo); err != nil {
+		return err
+	}
+	if err := i.inhibitRuleRepo.UpdateInhibitRule(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("inhibit rule %d not found", req.UID.Int64())
+		}
+		i.helper.Errorw("msg", "update inhibit rule failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update inhibit rule failed").WithCause(err)
+	}
+	return nil
+}
+
+func (i *InhibitRuleBiz) UpdateInhibitRuleStatus(ctx context.Context, req *bo.UpdateInhibitRuleStatusBo) error {
+	if err := i.inhibitRuleRepo.UpdateInhibitRuleStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("inhibit rule %d not found", req.UID.Int64())
+		}
+		i.helper.Errorw("msg", "update inhibit rule status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update inhibit rule status failed").WithCause(err)
+	}
+	return nil
+}
+
+func (i *InhibitRuleBiz) DeleteInhibitRule(ctx context.Context, uid snowflake.ID) error {
+	if err := i.inhibitRuleRepo.DeleteInhibitRule(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("inhibit rule %d not found", uid.Int64())
+		}
+		i.helper.Errorw("msg", "delete inhibit rule failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete inhibit rule failed").WithCause(err)
+	}
+	return nil
+}
+
+func (i *InhibitRuleBiz) GetInhibitRule(ctx context.Context, uid snowflake.ID) (*bo.InhibitRuleItemBo, error) {
+	item, err := i.inhibitRuleRepo.GetInhibitRule(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("inhibit rule %d not found", uid.Int64())
+		}
+		i.helper.Errorw("msg", "get inhibit rule failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get inhibit rule failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (i *InhibitRuleBiz) ListInhibitRule(ctx context.Context, req *bo.ListInhibitRuleBo) (*bo.PageResponseBo[*bo.InhibitRuleItemBo], error) {
+	result, err := i.inhibitRuleRepo.ListInhibitRule(ctx, req)
+	if err != nil {
+		i.helper.Errorw("msg", "list inhibit rule failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list inhibit rule failed").WithCause(err)
+	}
+	return result, nil
+}
+
+// EvaluateInhibition tells which of the alerts the enabled rules of the namespace inhibit.
+func (i *InhibitRuleBiz) EvaluateInhibition(ctx context.Context, alerts []*inhibitor.Alert) ([]*inhibitor.Result, error) {
+	rules, err := i.inhibitRuleRepo.ListEnabledInhibitRules(ctx, contextx.GetNamespace(ctx))
+	if err != nil {
+		i.helper.Errorw("msg", "list enabled inhibit rules failed", "error", err)
+		return nil, merr.ErrorInternalServer("evaluate inhibition failed").WithCause(err)
+	}
+	return inhibitor.Evaluate(toInhibitorRules(rules), alerts), nil
+}
+
+// checkLevels makes sure both levels exist in the current namespace.
+func (i *InhibitRuleBiz) checkLevels(ctx context.Context, req *bo.InhibitRuleSpecBo) error {
+	for _, levelUID := range []snowflake.ID{req.SourceLevelUID, req.TargetLevelUID} {
+		if _, err := i.levelRepo.GetLevel(ctx, levelUID); err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("level %d not found", levelUID.Int64())
+			}
+			i.helper.Errorw("msg", "get level failed", "error", err, "levelUID", levelUID)
+			return merr.ErrorInternalServer("get level failed").WithCause(err)
+		}
+	}
+	return nil
+}
+
+func toInhibitorRules(rules []*bo.InhibitRuleItemBo) []*inhibitor.Rule {
+	list := make([]*inhibitor.Rule, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, rule.ToInhibitorRule())
+	}
+	return list
+}
@@ -0,0 +1,96 @@
+// Package inhibitor mutes the alerts of a target level while an alert of a source level
+// fires for the same labels, e.g. a firing P0 of a cluster inhibits the P2 alerts of that cluster.
+package inhibitor
+
+import (
+	"slices"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// Matcher matches the labels of an alert.
+type Matcher interface {
+	Matches(labels map[string]string) bool
+}
+
+// Rule inhibits the target alerts while a source alert with the same Equal labels fires.
+type Rule struct {
+	UID            snowflake.ID
+	SourceLevelUID snowflake.ID
+	SourceMatcher  Matcher
+	TargetLevelUID snowflake.ID
+	TargetMatcher  Matcher
+	// Equal are the labels that must have the same value on the source and the target,
+	// a label missing on both counts as equal.
+	Equal []string
+}
+
+// Alert is one firing alert.
+type Alert struct {
+	Fingerprint string
+	LevelUID    snowflake.ID
+	Labels      map[string]string
+}
+
+// Result tells whether an alert is inhibited, and by which rule and source alert.
+type Result struct {
+	Alert     *Alert
+	Inhibited bool
+	RuleUID   snowflake.ID
+	// Source is the fingerprint of the source alert inhibiting the alert.
+	Source string
+}
+
+func (r *Rule) isSource(alert *Alert) bool {
+	return alert.LevelUID == r.SourceLevelUID && matches(r.SourceMatcher, alert.Labels)
+}
+
+func (r *Rule) isTarget(alert *Alert) bool {
+	return alert.LevelUID == r.TargetLevelUID && matches(r.TargetMatcher, alert.Labels)
+}
+
+func (r *Rule) equal(source, target *Alert) bool {
+	for _, name := range r.Equal {
+		if source.Labels[name] != target.Labels[name] {
+			return false
+		}
+	}
+	return true
+}
+
+func matches(m Matcher, labels map[string]string) bool {
+	return m == nil || m.Matches(labels)
+}
+
+// Evaluate returns one result per alert, in the order of alerts, the alerts are the sources
+// of each other. Inhibited alerts still inhibit others, the same as a firing alert that is not notified.
+func Evaluate(rules []*Rule, alerts []*Alert) []*Result {
+	results := make([]*Result, 0, len(alerts))
+	for _, target := range alerts {
+		results = append(results, Inhibit(rules, target, alerts))
+	}
+	return results
+}
+
+// Inhibit tells whether the target is inhibited by the first rule it is a target of with a
+// source among the sources. An alert never inhibits itself, but the alert of another level
+// of the same series does.
+func Inhibit(rules []*Rule, target *Alert, sources []*Alert) *Result {
+	result := &Result{Alert: target}
+	for _, rule := range rules {
+		if !rule.isTarget(target) {
+			continue
+		}
+		i := slices.IndexFunc(sources, func(source *Alert) bool {
+			return source != target && rule.isSource(source) && rule.equal(source, target)
+		})
+		if i < 0 {
+			continue
+		}
+		result.Inhibited = true
+		result.RuleUID = rule.UID
+		result.Source = sources[i].Fingerprint
+		break
+	}
+	return result
+}
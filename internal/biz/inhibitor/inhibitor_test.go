@@ -0,0 +1,79 @@
+package inhibitor_test
+
+import (
+	"testing"
+
+	"github.com/aide-family/marksman/internal/biz/inhibitor"
+)
+
+const (
+	p0 = 100
+	p2 = 102
+)
+
+// labelEquals matches alerts having every label of the map.
+type labelEquals map[string]string
+
+func (m labelEquals) Matches(labels map[string]string) bool {
+	for name, value := range m {
+		if labels[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func TestEvaluate(t *testing.T) {
+	rules := []*inhibitor.Rule{{
+		UID:            1,
+		SourceLevelUID: p0,
+		SourceMatcher:  labelEquals{"alertname": "ClusterDown"},
+		TargetLevelUID: p2,
+		Equal:          []string{"cluster"},
+	}}
+	alerts := []*inhibitor.Alert{
+		{Fingerprint: "down-a", LevelUID: p0, Labels: map[string]string{"alertname": "ClusterDown", "cluster": "a"}},
+		{Fingerprint: "cpu-a", LevelUID: p2, Labels: map[string]string{"alertname": "HighCPU", "cluster": "a"}},
+		{Fingerprint: "cpu-b", LevelUID: p2, Labels: map[string]string{"alertname": "HighCPU", "cluster": "b"}},
+		{Fingerprint: "disk-a", LevelUID: p0, Labels: map[string]string{"alertname": "DiskFull", "cluster": "a"}},
+		// the same series fires at both levels, its P0 alert inhibits its P2 alert
+		{Fingerprint: "down-c", LevelUID: p0, Labels: map[string]string{"alertname": "ClusterDown", "cluster": "c"}},
+		{Fingerprint: "down-c", LevelUID: p2, Labels: map[string]string{"alertname": "ClusterDown", "cluster": "c"}},
+	}
+	results := inhibitor.Evaluate(rules, alerts)
+	if len(results) != len(alerts) {
+		t.Fatalf("got %d results for %d alerts", len(results), len(alerts))
+	}
+	want := map[string]string{"cpu-a": "down-a", "down-c": "down-c"}
+	for _, result := range results {
+		source, inhibited := want[result.Alert.Fingerprint]
+		inhibited = inhibited && result.Alert.LevelUID == p2
+		if !inhibited {
+			source = ""
+		}
+		if result.Inhibited != inhibited || result.Source != source {
+			t.Fatalf("alert %s: inhibited=%v by %q, want inhibited=%v by %q",
+				result.Alert.Fingerprint, result.Inhibited, result.Source, inhibited, source)
+		}
+		if inhibited && result.RuleUID != 1 {
+			t.Fatalf("alert %s inhibited by rule %d", result.Alert.Fingerprint, result.RuleUID)
+		}
+	}
+}
+
+func TestEvaluateNeverInhibitsItself(t *testing.T) {
+	// a rule with the same level on both sides must not mute the only alert matching it
+	rules := []*inhibitor.Rule{{UID: 1, SourceLevelUID: p2, TargetLevelUID: p2, Equal: []string{"cluster"}}}
+	alerts := []*inhibitor.Alert{
+		{Fingerprint: "cpu-a", LevelUID: p2, Labels: map[string]string{"cluster": "a"}},
+	}
+	if result := inhibitor.Evaluate(rules, alerts)[0]; result.Inhibited {
+		t.Fatalf("alert inhibited itself: %+v", result)
+	}
+	alerts = append(alerts, &inhibitor.Alert{Fingerprint: "mem-a", LevelUID: p2, Labels: map[string]string{"cluster": "a"}})
+	for _, result := range inhibitor.Evaluate(rules, alerts) {
+		if !result.Inhibited {
+			t.Fatalf("alert %s was not inhibited by its sibling", result.Alert.Fingerprint)
+		}
+	}
+}
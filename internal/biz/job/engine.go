@@ -0,0 +1,220 @@
+// Package job runs background work, e.g. strategy evaluation and notification,
+// on a bounded pool of workers.
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultWorkers    = 10
+	defaultBufferSize = 1000
+
+	// NoTimeout disables the engine timeout for a job that bounds itself.
+	NoTimeout time.Duration = -1
+)
+
+var (
+	ErrStopped = errors.New("job engine is stopped")
+	ErrFull    = errors.New("job queue is full")
+)
+
+// Job is one unit of work.
+type Job struct {
+	Name string
+	// Timeout overrides the engine timeout, zero uses the engine timeout.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+type EngineOption func(*Engine)
+
+func WithWorkers(workers int) EngineOption {
+	return func(e *Engine) {
+		e.workers = workers
+	}
+}
+
+func WithBufferSize(size int) EngineOption {
+	return func(e *Engine) {
+		e.bufferSize = size
+	}
+}
+
+// WithTimeout bounds every job, zero or less runs jobs without a deadline.
+func WithTimeout(timeout time.Duration) EngineOption {
+	return func(e *Engine) {
+		e.timeout = timeout
+	}
+}
+
+// NewEngine returns an Engine running jobs on a pool of workers, the workers
+// start with the first job.
+func NewEngine(helper *klog.Helper, opts ...EngineOption) *Engine {
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Engine{
+		ctx:        ctx,
+		cancel:     cancel,
+		helper:     helper,
+		workers:    defaultWorkers,
+		bufferSize: defaultBufferSize,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.queue = make(chan *task, max(e.bufferSize, 0))
+	return e
+}
+
+// Engine runs jobs on at most workers goroutines, at most bufferSize jobs wait for a worker.
+type Engine struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	helper     *klog.Helper
+	workers    int
+	bufferSize int
+	timeout    time.Duration
+	queue      chan *task
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	mu        sync.RWMutex
+	stopped   bool
+	wg        sync.WaitGroup
+}
+
+type task struct {
+	ctx  context.Context
+	job  *Job
+	done chan error
+}
+
+// Workers returns the size of the pool.
+func (e *Engine) Workers() int {
+	return max(e.workers, 1)
+}
+
+// Submit queues job without waiting, it returns ErrFull when no worker and
+// no buffer slot is free and ErrStopped after Stop.
+func (e *Engine) Submit(job *Job) error {
+	e.startOnce.Do(e.start)
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.stopped {
+		return ErrStopped
+	}
+	select {
+	case e.queue <- &task{ctx: e.ctx, job: job}:
+		return nil
+	default:
+		return ErrFull
+	}
+}
+
+// Run queues job and waits until it finished, the job is cancelled with ctx.
+// It returns the error of the job.
+func (e *Engine) Run(ctx context.Context, job *Job) error {
+	e.startOnce.Do(e.start)
+	t := &task{ctx: ctx, job: job, done: make(chan error, 1)}
+	if err := e.enqueue(ctx, t); err != nil {
+		return err
+	}
+	return <-t.done
+}
+
+func (e *Engine) enqueue(ctx context.Context, t *task) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.stopped {
+		return ErrStopped
+	}
+	select {
+	case e.queue <- t:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-e.ctx.Done():
+		return ErrStopped
+	}
+}
+
+// Stop cancels the running jobs and waits for the workers to exit, queued jobs are dropped.
+func (e *Engine) Stop() {
+	e.stopOnce.Do(func() {
+		e.cancel()
+		e.mu.Lock()
+		e.stopped = true
+		e.mu.Unlock()
+		e.wg.Wait()
+		for {
+			select {
+			case t := <-e.queue:
+				t.finish(ErrStopped)
+			default:
+				return
+			}
+		}
+	})
+}
+
+func (e *Engine) start() {
+	for i := 0; i < e.Workers(); i++ {
+		e.wg.Add(1)
+		go e.work()
+	}
+}
+
+func (e *Engine) work() {
+	defer e.wg.Done()
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case t := <-e.queue:
+			t.finish(e.run(t))
+		}
+	}
+}
+
+func (e *Engine) run(t *task) (err error) {
+	ctx, cancel := context.WithCancel(t.ctx)
+	defer cancel()
+	stop := context.AfterFunc(e.ctx, cancel)
+	defer stop()
+	if timeout := e.jobTimeout(t.job); timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job %s panic: %v", t.job.Name, r)
+		}
+		if err != nil && t.done == nil {
+			e.helper.Warnw("msg", "job failed", "job", t.job.Name, "error", err)
+		}
+	}()
+	return t.job.Run(ctx)
+}
+
+func (e *Engine) jobTimeout(job *Job) time.Duration {
+	if job.Timeout != 0 {
+		return job.Timeout
+	}
+	return e.timeout
+}
+
+func (t *task) finish(err error) {
+	if t.done != nil {
+		t.done <- err
+	}
+}
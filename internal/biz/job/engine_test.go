@@ -0,0 +1,111 @@
+package job_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+)
+
+func newEngine(opts ...job.EngineOption) *job.Engine {
+	return job.NewEngine(klog.NewHelper(klog.DefaultLogger), opts...)
+}
+
+func TestEngineBoundsWorkers(t *testing.T) {
+	engine := newEngine(job.WithWorkers(2), job.WithBufferSize(10))
+	defer engine.Stop()
+
+	var running, peak atomic.Int32
+	release := make(chan struct{})
+	done := make(chan struct{}, 6)
+	for i := 0; i < 6; i++ {
+		err := engine.Submit(&job.Job{Name: "block", Run: func(ctx context.Context) error {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			<-release
+			running.Add(-1)
+			done <- struct{}{}
+			return nil
+		}})
+		if err != nil {
+			t.Fatalf("submit: %v", err)
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for i := 0; i < 6; i++ {
+		<-done
+	}
+	if got := peak.Load(); got != 2 {
+		t.Fatalf("peak concurrency = %d, want 2", got)
+	}
+}
+
+func TestEngineSubmitFull(t *testing.T) {
+	engine := newEngine(job.WithWorkers(1), job.WithBufferSize(1))
+	defer engine.Stop()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	block := &job.Job{Name: "block", Run: func(ctx context.Context) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}}
+	if err := engine.Submit(block); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	<-started
+	if err := engine.Submit(&job.Job{Name: "queued", Run: func(ctx context.Context) error { return nil }}); err != nil {
+		t.Fatalf("submit to buffer: %v", err)
+	}
+	if err := engine.Submit(&job.Job{Name: "dropped", Run: func(ctx context.Context) error { return nil }}); !errors.Is(err, job.ErrFull) {
+		t.Fatalf("expected full queue, got %v", err)
+	}
+}
+
+func TestEngineRun(t *testing.T) {
+	engine := newEngine(job.WithTimeout(20 * time.Millisecond))
+
+	wantErr := errors.New("boom")
+	if err := engine.Run(context.Background(), &job.Job{Name: "fail", Run: func(ctx context.Context) error { return wantErr }}); !errors.Is(err, wantErr) {
+		t.Fatalf("run = %v, want %v", err, wantErr)
+	}
+	err := engine.Run(context.Background(), &job.Job{Name: "slow", Run: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected engine timeout, got %v", err)
+	}
+	err = engine.Run(context.Background(), &job.Job{Name: "no-timeout", Timeout: job.NoTimeout, Run: func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+			return nil
+		}
+	}})
+	if err != nil {
+		t.Fatalf("job without timeout failed: %v", err)
+	}
+	if err := engine.Run(context.Background(), &job.Job{Name: "panic", Run: func(ctx context.Context) error { panic("oops") }}); err == nil {
+		t.Fatal("expected panic to be returned as error")
+	}
+
+	engine.Stop()
+	if err := engine.Run(context.Background(), &job.Job{Name: "late", Run: func(ctx context.Context) error { return nil }}); !errors.Is(err, job.ErrStopped) {
+		t.Fatalf("expected stopped, got %v", err)
+	}
+}
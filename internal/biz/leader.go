@@ -0,0 +1,19 @@
+package biz
+
+import (
+	"strings"
+
+	"github.com/aide-family/magicbox/hello"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/leader"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+// NewLeaderElector returns the elector of the job nodes, singleton jobs are wrapped with
+// its Guard so that they only run on the leader.
+func NewLeaderElector(serviceName string, leaseRepo repository.Lease, helper *klog.Helper) *leader.Elector {
+	// one lease per service, the job nodes of the service compete for it
+	name := strings.Join([]string{serviceName, "job"}, ".")
+	return leader.NewElector(name, hello.ID(), leaseRepo, klog.NewHelper(klog.With(helper.Logger(), "biz", "leader")))
+}
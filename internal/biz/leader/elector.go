@@ -0,0 +1,256 @@
+// Package leader elects one job node as the leader of a lease, so that singleton work,
+// e.g. retention cleanup, digest flushing or clock aligned schedules, runs on one node only.
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+)
+
+// defaultTTL is how long a lease is held without a renewal.
+const defaultTTL = 15 * time.Second
+
+// ErrNotLeader is returned when the caller's fencing token is no longer the current one.
+var ErrNotLeader = errors.New("not the leader")
+
+var (
+	leaderGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "marksman",
+		Subsystem: "leader",
+		Name:      "is_leader",
+		Help:      "Whether this node holds the lease, 1 for the leader.",
+	}, []string{"lease"})
+	tokenGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "marksman",
+		Subsystem: "leader",
+		Name:      "fencing_token",
+		Help:      "The fencing token of the lease held by this node, 0 when it is not the leader.",
+	}, []string{"lease"})
+)
+
+// Store persists the leases, every node of the cluster shares it.
+type Store interface {
+	// AcquireLease renews the lease when holder holds it with token, otherwise takes it
+	// over when it is free or expired. It returns the fencing token of the holder, which
+	// grows with every new holder, or 0 when another node holds the lease.
+	AcquireLease(ctx context.Context, name, holder string, token uint64, ttl time.Duration) (uint64, error)
+	// ReleaseLease frees the lease when holder still holds it with token.
+	ReleaseLease(ctx context.Context, name, holder string, token uint64) error
+	// GetLeaseToken returns the fencing token of the current holder, 0 when the lease is free or expired.
+	GetLeaseToken(ctx context.Context, name string) (uint64, error)
+}
+
+type ElectorOption func(*Elector)
+
+// WithTTL sets how long the lease is held without a renewal, the leader renews it
+// every third of the ttl.
+func WithTTL(ttl time.Duration) ElectorOption {
+	return func(e *Elector) {
+		e.ttl = ttl
+	}
+}
+
+// NewElector returns the Elector of holder for the lease name, it leads nothing until the first Campaign.
+func NewElector(name, holder string, store Store, helper *klog.Helper, opts ...ElectorOption) *Elector {
+	e := &Elector{
+		name:   name,
+		holder: holder,
+		store:  store,
+		helper: helper,
+		ttl:    defaultTTL,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.setMetrics(0)
+	return e
+}
+
+// Elector campaigns for a lease. The leader keeps it as long as it renews it within the ttl,
+// it steps down on its own once the lease expired locally, so that two nodes never
+// act as leader at the same time while their clocks agree.
+type Elector struct {
+	name   string
+	holder string
+	store  Store
+	helper *klog.Helper
+	ttl    time.Duration
+
+	mu        sync.Mutex
+	token     uint64
+	expiresAt time.Time
+	// lost is closed when the leadership ends, the guarded jobs stop with it.
+	lost chan struct{}
+}
+
+// Name returns the name of the lease.
+func (e *Elector) Name() string {
+	return e.name
+}
+
+// RenewInterval is how often Campaign should be called.
+func (e *Elector) RenewInterval() time.Duration {
+	return e.ttl / 3
+}
+
+// Campaign acquires or renews the lease and reports whether the leadership changed.
+// The leadership is kept on a store error until the lease expires locally.
+func (e *Elector) Campaign(ctx context.Context) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	start := time.Now()
+	token, err := e.store.AcquireLease(ctx, e.name, e.holder, e.token, e.ttl)
+	if err != nil {
+		changed := e.expireLocked(start)
+		return changed, err
+	}
+	if token == 0 {
+		return e.stepDownLocked(), nil
+	}
+	// the lease expires ttl after the request was sent, not after the response arrived
+	e.expiresAt = start.Add(e.ttl)
+	if token == e.token {
+		return false, nil
+	}
+	if e.token != 0 {
+		// the lease expired and was taken over again, the old token is fenced off
+		e.stepDownLocked()
+	}
+	e.token = token
+	e.lost = make(chan struct{})
+	e.setMetrics(token)
+	e.helper.Infow("msg", "became leader", "lease", e.name, "holder", e.holder, "token", token)
+	return true, nil
+}
+
+// Resign releases the lease, so that another node takes over without waiting for the ttl.
+func (e *Elector) Resign(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	token := e.token
+	if token == 0 {
+		return nil
+	}
+	e.stepDownLocked()
+	return e.store.ReleaseLease(ctx, e.name, e.holder, token)
+}
+
+// Token returns the fencing token of the local node, 0 when it is not the leader.
+func (e *Elector) Token() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.expireLocked(time.Now())
+	return e.token
+}
+
+// IsLeader reports whether the local node holds the lease.
+func (e *Elector) IsLeader() bool {
+	return e.Token() != 0
+}
+
+// Fence returns ErrNotLeader unless token is still the fencing token of the lease in the store,
+// singleton work whose writes can not be fenced by the store calls it right before writing.
+func (e *Elector) Fence(ctx context.Context, token uint64) error {
+	if token == 0 {
+		return ErrNotLeader
+	}
+	current, err := e.store.GetLeaseToken(ctx, e.name)
+	if err != nil {
+		return err
+	}
+	if current != token {
+		return ErrNotLeader
+	}
+	return nil
+}
+
+// Guard wraps j so that it only runs on the leader, the job context carries the lease and its
+// fencing token and is cancelled when the leadership ends. The job is skipped on the other nodes.
+// The repositories make the writes of the job conditional on the token, see TokenFromContext.
+func (e *Elector) Guard(j *job.Job) *job.Job {
+	return &job.Job{
+		Name:    j.Name,
+		Timeout: j.Timeout,
+		Run: func(ctx context.Context) error {
+			e.mu.Lock()
+			e.expireLocked(time.Now())
+			token, lost := e.token, e.lost
+			e.mu.Unlock()
+			if token == 0 {
+				return nil
+			}
+			ctx, cancel := context.WithCancel(WithToken(ctx, e.name, token))
+			defer cancel()
+			go func() {
+				select {
+				case <-lost:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			return j.Run(ctx)
+		},
+	}
+}
+
+// expireLocked steps down when the lease expired before now.
+func (e *Elector) expireLocked(now time.Time) bool {
+	if e.token == 0 || now.Before(e.expiresAt) {
+		return false
+	}
+	e.helper.Warnw("msg", "lease expired without renewal", "lease", e.name, "holder", e.holder, "token", e.token)
+	return e.stepDownLocked()
+}
+
+func (e *Elector) stepDownLocked() bool {
+	if e.token == 0 {
+		return false
+	}
+	e.helper.Infow("msg", "lost leadership", "lease", e.name, "holder", e.holder, "token", e.token)
+	close(e.lost)
+	e.token = 0
+	e.expiresAt = time.Time{}
+	e.setMetrics(0)
+	return true
+}
+
+func (e *Elector) setMetrics(token uint64) {
+	isLeader := 0.0
+	if token != 0 {
+		isLeader = 1
+	}
+	leaderGauge.WithLabelValues(e.name).Set(isLeader)
+	tokenGauge.WithLabelValues(e.name).Set(float64(token))
+}
+
+type tokenKey struct{}
+
+type fencing struct {
+	lease string
+	token uint64
+}
+
+// WithToken returns a context carrying the fencing token of lease.
+func WithToken(ctx context.Context, lease string, token uint64) context.Context {
+	return context.WithValue(ctx, tokenKey{}, fencing{lease: lease, token: token})
+}
+
+// TokenFromContext returns the fencing token of a guarded job, 0 outside of one.
+func TokenFromContext(ctx context.Context) uint64 {
+	f, _ := ctx.Value(tokenKey{}).(fencing)
+	return f.token
+}
+
+// LeaseFromContext returns the name of the lease of a guarded job, empty outside of one.
+func LeaseFromContext(ctx context.Context) string {
+	f, _ := ctx.Value(tokenKey{}).(fencing)
+	return f.lease
+}
@@ -0,0 +1,205 @@
+package leader_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/leader"
+)
+
+type memoryLease struct {
+	holder    string
+	token     uint64
+	expiresAt time.Time
+}
+
+// memoryStore is a lease store shared by in-process nodes.
+type memoryStore struct {
+	mu     sync.Mutex
+	leases map[string]*memoryLease
+	err    error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{leases: make(map[string]*memoryLease)}
+}
+
+func (s *memoryStore) AcquireLease(_ context.Context, name, holder string, token uint64, ttl time.Duration) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return 0, s.err
+	}
+	now := time.Now()
+	lease, ok := s.leases[name]
+	if !ok {
+		lease = &memoryLease{}
+		s.leases[name] = lease
+	}
+	switch {
+	case token != 0 && lease.holder == holder && lease.token == token && now.Before(lease.expiresAt):
+	case lease.holder == holder || !now.Before(lease.expiresAt):
+		lease.holder = holder
+		lease.token++
+	default:
+		return 0, nil
+	}
+	lease.expiresAt = now.Add(ttl)
+	return lease.token, nil
+}
+
+func (s *memoryStore) ReleaseLease(_ context.Context, name, holder string, token uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lease, ok := s.leases[name]; ok && lease.holder == holder && lease.token == token {
+		lease.expiresAt = time.Time{}
+	}
+	return nil
+}
+
+func (s *memoryStore) GetLeaseToken(_ context.Context, name string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lease, ok := s.leases[name]
+	if !ok || !time.Now().Before(lease.expiresAt) {
+		return 0, nil
+	}
+	return lease.token, nil
+}
+
+func (s *memoryStore) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func newElector(store leader.Store, holder string, ttl time.Duration) *leader.Elector {
+	return leader.NewElector("marksman.job", holder, store, klog.NewHelper(klog.DefaultLogger), leader.WithTTL(ttl))
+}
+
+func campaign(t *testing.T, electors ...*leader.Elector) {
+	t.Helper()
+	for _, e := range electors {
+		if _, err := e.Campaign(context.Background()); err != nil {
+			t.Fatalf("campaign: %v", err)
+		}
+	}
+}
+
+func leaders(electors ...*leader.Elector) int {
+	n := 0
+	for _, e := range electors {
+		if e.IsLeader() {
+			n++
+		}
+	}
+	return n
+}
+
+func TestElectorSingleLeader(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStore()
+	a, b := newElector(store, "node-a", time.Minute), newElector(store, "node-b", time.Minute)
+	campaign(t, a, b)
+	if !a.IsLeader() || b.IsLeader() {
+		t.Fatalf("leaders: a=%v b=%v, want only a", a.IsLeader(), b.IsLeader())
+	}
+	token := a.Token()
+	if changed, _ := a.Campaign(ctx); changed || a.Token() != token {
+		t.Fatalf("renewal changed the leadership, token %d -> %d", token, a.Token())
+	}
+
+	// a resigning leader hands the lease over without waiting for the ttl
+	if err := a.Resign(ctx); err != nil {
+		t.Fatalf("resign: %v", err)
+	}
+	campaign(t, b, a)
+	if !b.IsLeader() || a.IsLeader() {
+		t.Fatalf("leaders after resign: a=%v b=%v, want only b", a.IsLeader(), b.IsLeader())
+	}
+	if b.Token() <= token {
+		t.Fatalf("fencing token %d did not grow past %d", b.Token(), token)
+	}
+	if err := b.Fence(ctx, token); !errors.Is(err, leader.ErrNotLeader) {
+		t.Fatalf("stale token passed the fence: %v", err)
+	}
+	if err := b.Fence(ctx, b.Token()); err != nil {
+		t.Fatalf("current token failed the fence: %v", err)
+	}
+}
+
+func TestElectorStepsDownWhenRenewalFails(t *testing.T) {
+	store := newMemoryStore()
+	ttl := 50 * time.Millisecond
+	a, b := newElector(store, "node-a", ttl), newElector(store, "node-b", ttl)
+	campaign(t, a, b)
+	if leaders(a, b) != 1 || !a.IsLeader() {
+		t.Fatal("a must lead")
+	}
+
+	// the store is unreachable for a, it keeps leading until the lease expires locally
+	store.setErr(errors.New("database is down"))
+	if _, err := a.Campaign(context.Background()); err == nil || !a.IsLeader() {
+		t.Fatalf("leader stepped down before the lease expired: %v", err)
+	}
+	time.Sleep(ttl)
+	if a.IsLeader() {
+		t.Fatal("leader kept leading after the lease expired")
+	}
+	store.setErr(nil)
+	campaign(t, b, a)
+	if !b.IsLeader() || a.IsLeader() {
+		t.Fatalf("leaders after expiry: a=%v b=%v, want only b", a.IsLeader(), b.IsLeader())
+	}
+}
+
+func TestElectorGuard(t *testing.T) {
+	engine := job.NewEngine(klog.NewHelper(klog.DefaultLogger))
+	defer engine.Stop()
+	ctx := context.Background()
+	store := newMemoryStore()
+	a, b := newElector(store, "node-a", time.Minute), newElector(store, "node-b", time.Minute)
+	campaign(t, a, b)
+
+	var mu sync.Mutex
+	var ran []uint64
+	started := make(chan struct{})
+	singleton := &job.Job{Name: "singleton", Timeout: job.NoTimeout, Run: func(ctx context.Context) error {
+		mu.Lock()
+		ran = append(ran, leader.TokenFromContext(ctx))
+		mu.Unlock()
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+	if err := engine.Run(ctx, b.Guard(singleton)); err != nil {
+		t.Fatalf("guarded job on a follower: %v", err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- engine.Run(ctx, a.Guard(singleton)) }()
+	<-started
+
+	// the job stops as soon as the leadership ends
+	if err := a.Resign(ctx); err != nil {
+		t.Fatalf("resign: %v", err)
+	}
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("guarded job returned %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("guarded job kept running after the leadership ended")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(ran) != 1 || ran[0] == 0 {
+		t.Fatalf("guarded job ran with tokens %v, want once on the leader", ran)
+	}
+}
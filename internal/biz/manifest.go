@@ -0,0 +1,522 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/manifest"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewManifest(manifestRepo repository.Manifest, helper *klog.Helper) *ManifestBiz {
+	return &ManifestBiz{
+		manifestRepo: manifestRepo,
+		helper:       klog.NewHelper(klog.With(helper.Logger(), "biz", "manifest")),
+	}
+}
+
+type ManifestBiz struct {
+	helper       *klog.Helper
+	manifestRepo repository.Manifest
+}
+
+// ApplyManifests brings the namespace of ctx to the state the manifests describe, the
+// changes are returned without being applied on a dry run.
+func (m *ManifestBiz) ApplyManifests(ctx context.Context, req *bo.ApplyManifestsBo) ([]*bo.ResourceChangeBo, error) {
+	req.NamespaceUID = contextx.GetNamespace(ctx)
+	req.Creator = contextx.GetUserUID(ctx)
+	return m.apply(ctx, req)
+}
+
+// SyncManifests applies the manifests of a directory, it is run by the watcher which
+// has no namespace or user in its context.
+func (m *ManifestBiz) SyncManifests(ctx context.Context, req *bo.SyncManifestsBo) error {
+	if req.NamespaceUID == 0 {
+		return merr.ErrorParams("gitops namespace is required")
+	}
+	files, err := manifest.ReadDir(req.Dir)
+	if err != nil {
+		m.helper.Errorw("msg", "read manifests failed", "error", err, "dir", req.Dir)
+		return merr.ErrorInternalServer("sync manifests failed").WithCause(err)
+	}
+	if req.ExpandEnv {
+		for _, file := range files {
+			if file.Content, err = manifest.ExpandEnv(file.Content, os.LookupEnv); err != nil {
+				return merr.ErrorParams("%s: %v", file.Name, err)
+			}
+		}
+	}
+	apply, err := bo.NewApplyManifestsBoFromFiles(files, false, req.Prune)
+	if err != nil {
+		return err
+	}
+	apply.NamespaceUID = req.NamespaceUID
+	apply.Creator = req.Creator
+	changes, err := m.apply(ctx, apply)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if change.Action != apiv1.ChangeAction_CHANGE_UNCHANGED {
+			m.helper.Infow("msg", "manifest applied", "dir", req.Dir, "action", change.Action, "kind", change.Kind, "name", change.Name, "diff", change.Diff, "reason", change.Reason)
+		}
+	}
+	return nil
+}
+
+func (m *ManifestBiz) apply(ctx context.Context, req *bo.ApplyManifestsBo) ([]*bo.ResourceChangeBo, error) {
+	state, err := m.manifestRepo.GetManifestState(ctx, req.NamespaceUID)
+	if err != nil {
+		m.helper.Errorw("msg", "get manifest state failed", "error", err, "namespace", req.NamespaceUID)
+		return nil, merr.ErrorInternalServer("apply manifests failed").WithCause(err)
+	}
+	plan, changes, err := newManifestPlanner(req, state).run()
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun || plan.IsEmpty() {
+		return changes, nil
+	}
+	if err := m.manifestRepo.ApplyManifestPlan(ctx, plan); err != nil {
+		m.helper.Errorw("msg", "apply manifest plan failed", "error", err, "namespace", req.NamespaceUID)
+		return nil, merr.ErrorInternalServer("apply manifests failed").WithCause(err)
+	}
+	return changes, nil
+}
+
+// manifestPlanner diffs the manifests against the state of the namespace. Resources the
+// manifests no longer declare are deleted when they are managed, or always with prune,
+// unless something that is kept still uses them.
+type manifestPlanner struct {
+	req     *bo.ApplyManifestsBo
+	state   *bo.ManifestStateBo
+	plan    *bo.ManifestPlanBo
+	changes []*bo.ResourceChangeBo
+	// names of the existing resources by UID
+	levelNames      map[snowflake.ID]string
+	datasourceNames map[snowflake.ID]string
+	groupNames      map[snowflake.ID]string
+	// names the manifests declare or reference, by kind
+	declared map[string]map[string]bool
+	used     map[string]map[string]string
+}
+
+func newManifestPlanner(req *bo.ApplyManifestsBo, state *bo.ManifestStateBo) *manifestPlanner {
+	p := &manifestPlanner{
+		req:             req,
+		state:           state,
+		plan:            &bo.ManifestPlanBo{NamespaceUID: req.NamespaceUID, Creator: req.Creator},
+		levelNames:      make(map[snowflake.ID]string, len(state.Levels)),
+		datasourceNames: make(map[snowflake.ID]string, len(state.Datasources)),
+		groupNames:      make(map[snowflake.ID]string, len(state.StrategyGroups)),
+		declared:        make(map[string]map[string]bool, len(manifest.Kinds)),
+		used:            make(map[string]map[string]string, len(manifest.Kinds)),
+	}
+	for _, kind := range manifest.Kinds {
+		p.declared[kind] = make(map[string]bool)
+		p.used[kind] = make(map[string]string)
+	}
+	for _, level := range state.Levels {
+		p.levelNames[level.UID] = level.Name
+	}
+	for _, datasource := range state.Datasources {
+		p.datasourceNames[datasource.UID] = datasource.Name
+	}
+	for _, group := range state.StrategyGroups {
+		p.groupNames[group.UID] = group.Name
+	}
+	return p
+}
+
+func (p *manifestPlanner) run() (*bo.ManifestPlanBo, []*bo.ResourceChangeBo, error) {
+	p.planLevels()
+	if err := p.planDatasources(); err != nil {
+		return nil, nil, err
+	}
+	p.planStrategyGroups()
+	if err := p.planStrategies(); err != nil {
+		return nil, nil, err
+	}
+	p.planDeletes()
+	return p.plan, p.changes, nil
+}
+
+func (p *manifestPlanner) planLevels() {
+	existing := make(map[string]*bo.LevelItemBo, len(p.state.Levels))
+	for _, level := range p.state.Levels {
+		existing[level.Name] = level
+	}
+	for _, level := range p.req.Levels {
+		p.declared[manifest.KindLevel][level.Name] = true
+		var diff []string
+		old, ok := existing[level.Name]
+		if ok {
+			level.UID = old.UID
+			diff = appendValueDiff(diff, "remark", old.Remark, level.Remark)
+			diff = appendMapDiff(diff, "metadata", old.Metadata, level.Metadata)
+			diff = appendValueDiff(diff, "status", old.Status.String(), level.Status.String())
+			diff = appendManagedDiff(diff, old.Managed)
+		}
+		if p.addChange(manifest.KindLevel, level.Name, "", ok, diff) {
+			p.plan.Levels = append(p.plan.Levels, level)
+		}
+	}
+}
+
+func (p *manifestPlanner) planDatasources() error {
+	existing := make(map[string]*bo.DatasourceItemBo, len(p.state.Datasources))
+	for _, datasource := range p.state.Datasources {
+		existing[datasource.Name] = datasource
+	}
+	for _, datasource := range p.req.Datasources {
+		p.declared[manifest.KindDatasource][datasource.Name] = true
+		var diff []string
+		old, ok := existing[datasource.Name]
+		if ok {
+			datasource.UID = old.UID
+			if datasource.Config != nil {
+				if err := datasource.Config.KeepSecrets(old.Config); err != nil {
+					return bo.ManifestError(datasource.Source, manifest.KindDatasource, datasource.Name, err)
+				}
+			}
+			diff = appendValueDiff(diff, "type", old.Type.String(), datasource.Type.String())
+			diff = appendValueDiff(diff, "driver", old.Driver.String(), datasource.Driver.String())
+			diff = appendMapDiff(diff, "metadata", old.Metadata, datasource.Metadata)
+			diff = append(diff, datasourceConfigDiff(old.Config, datasource.Config)...)
+			diff = appendManagedDiff(diff, old.Managed)
+		} else if datasource.Config.HasUnchangedSecrets() {
+			return bo.ManifestError(datasource.Source, manifest.KindDatasource, datasource.Name,
+				merr.ErrorParams("%s can only keep the secrets of an existing datasource", bo.SecretUnchanged))
+		}
+		if p.addChange(manifest.KindDatasource, datasource.Name, "", ok, diff) {
+			p.plan.Datasources = append(p.plan.Datasources, datasource)
+		}
+	}
+	return nil
+}
+
+func (p *manifestPlanner) planStrategyGroups() {
+	existing := make(map[string]*bo.StrategyGroupItemBo, len(p.state.StrategyGroups))
+	for _, group := range p.state.StrategyGroups {
+		existing[group.Name] = group
+	}
+	for _, group := range p.req.StrategyGroups {
+		p.declared[manifest.KindStrategyGroup][group.Name] = true
+		var diff []string
+		old, ok := existing[group.Name]
+		if ok {
+			group.UID = old.UID
+			diff = appendValueDiff(diff, "remark", old.Remark, group.Remark)
+			diff = appendMapDiff(diff, "metadata", old.Metadata, group.Metadata)
+			diff = appendValueDiff(diff, "status", old.Status.String(), group.Status.String())
+			diff = appendManagedDiff(diff, old.Managed)
+		}
+		if p.addChange(manifest.KindStrategyGroup, group.Name, "", ok, diff) {
+			p.plan.StrategyGroups = append(p.plan.StrategyGroups, group)
+		}
+	}
+}
+
+func (p *manifestPlanner) planStrategies() error {
+	existing := make(map[string]*bo.StrategyItemBo, len(p.state.Strategies))
+	for _, strategy := range p.state.Strategies {
+		existing[strategy.Name] = strategy
+	}
+	for _, strategy := range p.req.Strategies {
+		p.declared[manifest.KindStrategy][strategy.Name] = true
+		if err := p.reference(strategy, manifest.KindStrategyGroup, strategy.StrategyGroup); err != nil {
+			return err
+		}
+		if strategy.Metric != nil {
+			for _, name := range strategy.Metric.Datasources {
+				if err := p.reference(strategy, manifest.KindDatasource, name); err != nil {
+					return err
+				}
+			}
+			for _, level := range strategy.Metric.Levels {
+				if err := p.reference(strategy, manifest.KindLevel, level.Level); err != nil {
+					return err
+				}
+			}
+		}
+
+		var diff []string
+		old, ok := existing[strategy.Name]
+		if ok {
+			strategy.UID = old.UID
+			// without a metric the manifest may leave the type to the existing strategy
+			if strategy.Type == enum.DatasourceType_DatasourceType_UNKNOWN {
+				strategy.Type = old.Type
+				strategy.Driver = old.Driver
+			}
+			diff = appendValueDiff(diff, "strategyGroup", p.name(p.groupNames, old.StrategyGroupUID), strategy.StrategyGroup)
+			diff = appendValueDiff(diff, "remark", old.Remark, strategy.Remark)
+			diff = appendValueDiff(diff, "type", old.Type.String(), strategy.Type.String())
+			diff = appendValueDiff(diff, "driver", old.Driver.String(), strategy.Driver.String())
+			diff = appendMapDiff(diff, "metadata", old.Metadata, strategy.Metadata)
+			diff = appendValueDiff(diff, "status", old.Status.String(), strategy.Status.String())
+			diff = append(diff, p.metricDiff(p.state.Metrics[old.UID], strategy.Metric)...)
+			diff = appendManagedDiff(diff, old.Managed)
+		}
+		if p.addChange(manifest.KindStrategy, strategy.Name, strategy.StrategyGroup, ok, diff) {
+			p.plan.Strategies = append(p.plan.Strategies, strategy)
+		}
+	}
+	return nil
+}
+
+// reference checks that a strategy references a resource that is declared or exists.
+func (p *manifestPlanner) reference(strategy *bo.ManifestStrategyBo, kind, name string) error {
+	p.used[kind][name] = strategy.Name
+	if p.declared[kind][name] {
+		return nil
+	}
+	var exists bool
+	switch kind {
+	case manifest.KindLevel:
+		exists = slices.ContainsFunc(p.state.Levels, func(l *bo.LevelItemBo) bool { return l.Name == name })
+	case manifest.KindDatasource:
+		exists = slices.ContainsFunc(p.state.Datasources, func(d *bo.DatasourceItemBo) bool { return d.Name == name })
+	case manifest.KindStrategyGroup:
+		exists = slices.ContainsFunc(p.state.StrategyGroups, func(g *bo.StrategyGroupItemBo) bool { return g.Name == name })
+	}
+	if !exists {
+		return bo.ManifestError(strategy.Source, manifest.KindStrategy, strategy.Name, merr.ErrorParams("%s %q not found", kind, name))
+	}
+	return nil
+}
+
+// planDeletes deletes strategies before the resources they use, so that a resource only
+// used by deleted strategies is deleted along with them.
+func (p *manifestPlanner) planDeletes() {
+	kept := make(map[snowflake.ID]bool, len(p.state.Strategies))
+	for _, strategy := range p.state.Strategies {
+		if !p.deletes(manifest.KindStrategy, strategy.Name, strategy.Managed) {
+			kept[strategy.UID] = true
+			continue
+		}
+		p.plan.DeleteStrategyUIDs = append(p.plan.DeleteStrategyUIDs, strategy.UID)
+		p.changes = append(p.changes, &bo.ResourceChangeBo{
+			Action: apiv1.ChangeAction_CHANGE_DELETE,
+			Kind:   manifest.KindStrategy,
+			Name:   strategy.Name,
+			Parent: p.name(p.groupNames, strategy.StrategyGroupUID),
+		})
+	}
+	// the strategies the manifests do not declare keep their group, levels and datasources
+	for _, strategy := range p.state.Strategies {
+		if !kept[strategy.UID] || p.declared[manifest.KindStrategy][strategy.Name] {
+			continue
+		}
+		p.use(manifest.KindStrategyGroup, p.name(p.groupNames, strategy.StrategyGroupUID), strategy.Name)
+		if metric := p.state.Metrics[strategy.UID]; metric != nil {
+			for _, uid := range metric.DatasourceUIDs {
+				p.use(manifest.KindDatasource, p.name(p.datasourceNames, uid), strategy.Name)
+			}
+			for _, level := range metric.Levels {
+				p.use(manifest.KindLevel, p.name(p.levelNames, level.LevelUID), strategy.Name)
+			}
+		}
+	}
+	for _, group := range p.state.StrategyGroups {
+		if p.deletes(manifest.KindStrategyGroup, group.Name, group.Managed) && !p.inUse(manifest.KindStrategyGroup, group.Name) {
+			p.plan.DeleteStrategyGroupUIDs = append(p.plan.DeleteStrategyGroupUIDs, group.UID)
+		}
+	}
+	for _, datasource := range p.state.Datasources {
+		if p.deletes(manifest.KindDatasource, datasource.Name, datasource.Managed) && !p.inUse(manifest.KindDatasource, datasource.Name) {
+			p.plan.DeleteDatasourceUIDs = append(p.plan.DeleteDatasourceUIDs, datasource.UID)
+		}
+	}
+	for _, level := range p.state.Levels {
+		if p.deletes(manifest.KindLevel, level.Name, level.Managed) && !p.inUse(manifest.KindLevel, level.Name) {
+			p.plan.DeleteLevelUIDs = append(p.plan.DeleteLevelUIDs, level.UID)
+		}
+	}
+}
+
+func (p *manifestPlanner) deletes(kind, name string, managed bool) bool {
+	return !p.declared[kind][name] && (managed || p.req.Prune)
+}
+
+func (p *manifestPlanner) use(kind, name, strategy string) {
+	if _, ok := p.used[kind][name]; !ok {
+		p.used[kind][name] = strategy
+	}
+}
+
+// inUse reports whether a resource that is to be deleted is still used, and records the
+// change either way.
+func (p *manifestPlanner) inUse(kind, name string) bool {
+	change := &bo.ResourceChangeBo{
+		Action: apiv1.ChangeAction_CHANGE_DELETE,
+		Kind:   kind,
+		Name:   name,
+	}
+	strategy, ok := p.used[kind][name]
+	if ok {
+		change.Action = apiv1.ChangeAction_CHANGE_SKIP
+		change.Reason = fmt.Sprintf("not declared but still used by strategy %q", strategy)
+	}
+	p.changes = append(p.changes, change)
+	return ok
+}
+
+// addChange records the change of a declared resource and reports whether it is saved.
+func (p *manifestPlanner) addChange(kind, name, parent string, exists bool, diff []string) bool {
+	change := &bo.ResourceChangeBo{
+		Action: apiv1.ChangeAction_CHANGE_CREATE,
+		Kind:   kind,
+		Name:   name,
+		Parent: parent,
+		Diff:   diff,
+	}
+	if exists {
+		change.Action = apiv1.ChangeAction_CHANGE_UPDATE
+		if len(diff) == 0 {
+			change.Action = apiv1.ChangeAction_CHANGE_UNCHANGED
+		}
+	}
+	p.changes = append(p.changes, change)
+	return change.Action != apiv1.ChangeAction_CHANGE_UNCHANGED
+}
+
+func (p *manifestPlanner) name(names map[snowflake.ID]string, uid snowflake.ID) string {
+	if name, ok := names[uid]; ok {
+		return name
+	}
+	return strconv.FormatInt(uid.Int64(), 10)
+}
+
+func (p *manifestPlanner) metricDiff(old *bo.StrategyMetricItemBo, metric *bo.ManifestStrategyMetricBo) []string {
+	if old == nil {
+		old = &bo.StrategyMetricItemBo{}
+	}
+	if metric == nil {
+		metric = &bo.ManifestStrategyMetricBo{}
+	}
+	oldDatasources := make([]string, 0, len(old.DatasourceUIDs))
+	for _, uid := range old.DatasourceUIDs {
+		oldDatasources = append(oldDatasources, p.name(p.datasourceNames, uid))
+	}
+	var diff []string
+	diff = appendValueDiff(diff, "metric.expr", old.Expr, metric.Expr)
+	diff = appendMapDiff(diff, "metric.labels", old.Labels, metric.Labels)
+	diff = appendValueDiff(diff, "metric.summary", old.Summary, metric.Summary)
+	diff = appendValueDiff(diff, "metric.description", old.Description, metric.Description)
+	diff = appendValueDiff(diff, "metric.datasources", strings.Join(oldDatasources, ","), strings.Join(metric.Datasources, ","))
+	diff = appendValueDiff(diff, "metric.status", metricStatus(old.Expr, old.Status), metricStatus(metric.Expr, metric.Status))
+
+	oldLevels := make(map[string]*bo.StrategyMetricLevelItemBo, len(old.Levels))
+	for _, level := range old.Levels {
+		oldLevels[p.name(p.levelNames, level.LevelUID)] = level
+	}
+	for _, level := range metric.Levels {
+		field := "metric.levels." + level.Level
+		o, ok := oldLevels[level.Level]
+		delete(oldLevels, level.Level)
+		threshold := formatLevelThreshold(level.Condition, level.Values, level.Duration)
+		if !ok {
+			diff = appendValueDiff(diff, field, "", threshold)
+			continue
+		}
+		diff = appendValueDiff(diff, field, formatLevelThreshold(o.Condition, o.Values, o.Duration), threshold)
+		diff = appendValueDiff(diff, field+".mode", o.Mode.String(), level.Mode.String())
+		diff = appendValueDiff(diff, field+".flap", formatLevelFlap(o.FlapWindow, o.FlapThreshold), formatLevelFlap(level.FlapWindow, level.FlapThreshold))
+		diff = appendValueDiff(diff, field+".status", o.Status.String(), level.Status.String())
+	}
+	for _, level := range old.Levels {
+		name := p.name(p.levelNames, level.LevelUID)
+		if _, ok := oldLevels[name]; ok {
+			diff = appendValueDiff(diff, "metric.levels."+name, formatLevelThreshold(level.Condition, level.Values, level.Duration), "")
+		}
+	}
+	return diff
+}
+
+// metricStatus leaves the status out of the diff of a metric that is created or deleted.
+func metricStatus(expr string, status enum.GlobalStatus) string {
+	if expr == "" {
+		return ""
+	}
+	return status.String()
+}
+
+func formatLevelFlap(window time.Duration, threshold int32) string {
+	if window == 0 {
+		return "off"
+	}
+	return fmt.Sprintf("%d in %s", threshold, window)
+}
+
+// datasourceConfigDiff does not show secrets and certificates, only whether they change.
+func datasourceConfigDiff(old, new *bo.DatasourceConfigBo) []string {
+	if old == nil {
+		old = &bo.DatasourceConfigBo{}
+	}
+	if new == nil {
+		new = &bo.DatasourceConfigBo{}
+	}
+	var diff []string
+	diff = appendValueDiff(diff, "config.url", old.URL, new.URL)
+	diff = appendValueDiff(diff, "config.authMode", old.AuthMode.String(), new.AuthMode.String())
+	diff = appendValueDiff(diff, "config.username", old.Username, new.Username)
+	diff = appendSecretDiff(diff, "config.password", old.Password, new.Password)
+	diff = appendSecretDiff(diff, "config.bearerToken", old.BearerToken, new.BearerToken)
+	diff = appendSecretMapDiff(diff, "config.headers", old.Headers, new.Headers)
+	oldTLS, newTLS := old.TLS, new.TLS
+	if oldTLS == nil {
+		oldTLS = &bo.DatasourceTLSConfigBo{}
+	}
+	if newTLS == nil {
+		newTLS = &bo.DatasourceTLSConfigBo{}
+	}
+	diff = appendSecretDiff(diff, "config.tls.caCert", oldTLS.CACert, newTLS.CACert)
+	diff = appendValueDiff(diff, "config.tls.insecureSkipVerify", strconv.FormatBool(oldTLS.InsecureSkipVerify), strconv.FormatBool(newTLS.InsecureSkipVerify))
+	diff = appendValueDiff(diff, "config.tls.serverName", oldTLS.ServerName, newTLS.ServerName)
+	diff = appendValueDiff(diff, "config.timeout", old.Timeout.String(), new.Timeout.String())
+	diff = appendValueDiff(diff, "config.scrapeInterval", old.ScrapeInterval.String(), new.ScrapeInterval.String())
+	return diff
+}
+
+func appendSecretDiff(diff []string, field, old, new string) []string {
+	if old == new {
+		return diff
+	}
+	return append(diff, field+": changed")
+}
+
+func appendSecretMapDiff(diff []string, field string, old, new map[string]string) []string {
+	keys := slices.Sorted(maps.Keys(old))
+	for key := range new {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		diff = appendSecretDiff(diff, field+"."+key, old[key], new[key])
+	}
+	return diff
+}
+
+func appendManagedDiff(diff []string, managed bool) []string {
+	if managed {
+		return diff
+	}
+	return append(diff, "managed: false -> true")
+}
@@ -0,0 +1,188 @@
+// Package manifest reads the declarative YAML manifests applied by `marksman apply`,
+// each document describes one resource of a namespace by kind and name.
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"go.yaml.in/yaml/v2"
+)
+
+// APIVersion is the only apiVersion manifests are accepted with.
+const APIVersion = "marksman/v1"
+
+const (
+	KindLevel         = "Level"
+	KindDatasource    = "Datasource"
+	KindStrategyGroup = "StrategyGroup"
+	KindStrategy      = "Strategy"
+
+	maxNameLength = 100
+)
+
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Kinds lists the kinds in the order they are applied, a kind only references the ones before it.
+var Kinds = []string{KindLevel, KindDatasource, KindStrategyGroup, KindStrategy}
+
+// File is a manifest file as read from disk or sent to the server.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+type Metadata struct {
+	Name string `yaml:"name"`
+}
+
+// Manifest is one document of a file, Spec is kept as YAML to be decoded by kind.
+type Manifest struct {
+	APIVersion string
+	Kind       string
+	Metadata   Metadata
+	Spec       []byte
+	// Source locates the document, as file#index
+	Source string
+}
+
+type document struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Kind       string   `yaml:"kind"`
+	Metadata   Metadata `yaml:"metadata"`
+	Spec       any      `yaml:"spec"`
+}
+
+// Parse decodes the documents of a file, empty documents are skipped and unknown
+// top level fields are rejected.
+func Parse(name string, content []byte) ([]*Manifest, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.SetStrict(true)
+	var manifests []*Manifest
+	for i := 1; ; i++ {
+		var doc document
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		source := fmt.Sprintf("%s#%d", name, i)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", source, err)
+		}
+		if doc.APIVersion == "" && doc.Kind == "" && doc.Metadata.Name == "" && doc.Spec == nil {
+			continue
+		}
+		m := &Manifest{
+			APIVersion: doc.APIVersion,
+			Kind:       doc.Kind,
+			Metadata:   doc.Metadata,
+			Source:     source,
+		}
+		if doc.Spec != nil {
+			if m.Spec, err = yaml.Marshal(doc.Spec); err != nil {
+				return nil, fmt.Errorf("%s: %v", source, err)
+			}
+		}
+		if err := m.Validate(); err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+	}
+}
+
+// Validate checks the apiVersion, kind and name of a manifest, the spec is checked by kind.
+func (m *Manifest) Validate() error {
+	switch {
+	case m.APIVersion != APIVersion:
+		return fmt.Errorf("%s: apiVersion must be %s, got %q", m.Source, APIVersion, m.APIVersion)
+	case !slices.Contains(Kinds, m.Kind):
+		return fmt.Errorf("%s: kind must be one of %s, got %q", m.Source, strings.Join(Kinds, ", "), m.Kind)
+	case strings.TrimSpace(m.Metadata.Name) == "":
+		return fmt.Errorf("%s: metadata.name is required", m.Source)
+	case utf8.RuneCountInString(m.Metadata.Name) > maxNameLength:
+		return fmt.Errorf("%s: metadata.name is longer than %d characters", m.Source, maxNameLength)
+	}
+	return nil
+}
+
+// Check rejects resources declared more than once across manifests.
+func Check(manifests []*Manifest) error {
+	var errs []error
+	sources := make(map[[2]string]string, len(manifests))
+	for _, m := range manifests {
+		key := [2]string{m.Kind, m.Metadata.Name}
+		if source, ok := sources[key]; ok {
+			errs = append(errs, fmt.Errorf("%s %q is declared in %s and %s", m.Kind, m.Metadata.Name, source, m.Source))
+			continue
+		}
+		sources[key] = m.Source
+	}
+	return errors.Join(errs...)
+}
+
+// ReadDir reads the .yaml and .yml files below dir sorted by path, hidden files and
+// directories are skipped. Names are relative to dir.
+func ReadDir(dir string) ([]*File, error) {
+	var files []*File
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, &File{Name: filepath.ToSlash(name), Content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// ExpandEnv replaces ${NAME} with the value lookup returns for NAME, a variable lookup
+// does not find is an error. $NAME without braces is kept, templates use it for their variables.
+func ExpandEnv(content []byte, lookup func(name string) (string, bool)) ([]byte, error) {
+	var missing []string
+	expanded := envReference.ReplaceAllFunc(content, func(ref []byte) []byte {
+		name := string(ref[2 : len(ref)-1])
+		value, ok := lookup(name)
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return ref
+		}
+		return []byte(value)
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("environment variables %s are not set", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
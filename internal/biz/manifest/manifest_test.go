@@ -0,0 +1,156 @@
+package manifest_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.yaml.in/yaml/v2"
+
+	"github.com/aide-family/marksman/internal/biz/manifest"
+)
+
+const levels = `
+apiVersion: marksman/v1
+kind: Level
+metadata:
+  name: critical
+spec:
+  remark: pages the on-call
+---
+---
+apiVersion: marksman/v1
+kind: Strategy
+metadata:
+  name: high-cpu
+spec:
+  strategyGroup: node
+  metric:
+    expr: avg by (instance) (rate(cpu[5m]))
+    levels:
+      - level: critical
+        duration: 5m
+`
+
+func TestParse(t *testing.T) {
+	manifests, err := manifest.Parse("levels.yaml", []byte(levels))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("got %d manifests, want the empty document skipped", len(manifests))
+	}
+	level, strategy := manifests[0], manifests[1]
+	if level.Kind != manifest.KindLevel || level.Metadata.Name != "critical" || level.Source != "levels.yaml#1" {
+		t.Fatalf("got level %+v", *level)
+	}
+	if strategy.Kind != manifest.KindStrategy || strategy.Source != "levels.yaml#3" {
+		t.Fatalf("got strategy %+v", *strategy)
+	}
+	var spec struct {
+		StrategyGroup string `yaml:"strategyGroup"`
+		Metric        struct {
+			Expr   string `yaml:"expr"`
+			Levels []struct {
+				Level    string `yaml:"level"`
+				Duration string `yaml:"duration"`
+			} `yaml:"levels"`
+		} `yaml:"metric"`
+	}
+	if err := yaml.UnmarshalStrict(strategy.Spec, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.StrategyGroup != "node" || spec.Metric.Expr != "avg by (instance) (rate(cpu[5m]))" || len(spec.Metric.Levels) != 1 || spec.Metric.Levels[0].Duration != "5m" {
+		t.Fatalf("spec does not round trip: %+v", spec)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	for _, tc := range []struct {
+		content string
+		err     string
+	}{
+		{content: "apiVersion: v1\nkind: Level\nmetadata: {name: a}", err: "a.yaml#1: apiVersion must be marksman/v1"},
+		{content: "apiVersion: marksman/v1\nkind: Receiver\nmetadata: {name: a}", err: "kind must be one of"},
+		{content: "apiVersion: marksman/v1\nkind: Level\nmetadata: {name: ' '}", err: "metadata.name is required"},
+		{content: "apiVersion: marksman/v1\nkind: Level\nmetadata: {name: " + strings.Repeat("a", 101) + "}", err: "longer than 100"},
+		{content: "apiVersion: marksman/v1\nkind: Level\nmetadata: {name: a}\nstatus: {}", err: "a.yaml#1:"},
+		{content: "---\napiVersion: marksman/v1\nkind: Level\nmetadata: {name: a}\n---\nkind: [", err: "a.yaml#2:"},
+	} {
+		_, err := manifest.Parse("a.yaml", []byte(tc.content))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%q: got error %v, want %q", tc.content, err, tc.err)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	a, err := manifest.Parse("a.yaml", []byte(levels))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := manifest.Check(a); err != nil {
+		t.Fatal(err)
+	}
+	b, err := manifest.Parse("b.yaml", []byte("apiVersion: marksman/v1\nkind: Level\nmetadata: {name: critical}\n---\napiVersion: marksman/v1\nkind: StrategyGroup\nmetadata: {name: critical}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = manifest.Check(append(a, b...))
+	if err == nil || err.Error() != `Level "critical" is declared in a.yaml#1 and b.yaml#1` {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestReadDir(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"b.yaml":           "b",
+		"a/c.yml":          "c",
+		"a/readme.md":      "skipped",
+		".git/config.yaml": "skipped",
+		"a/.hidden.yaml":   "skipped",
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := manifest.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range files {
+		got = append(got, f.Name+"="+string(f.Content))
+	}
+	if strings.Join(got, ",") != "a/c.yml=c,b.yaml=b" {
+		t.Fatalf("got %v", got)
+	}
+	if _, err := manifest.ReadDir(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("missing directory is not an error")
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	env := map[string]string{"PASSWORD": "s3cret", "EMPTY": ""}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+	got, err := manifest.ExpandEnv([]byte("password: ${PASSWORD}${EMPTY}\nsummary: '{{ $labels.instance }} $PASSWORD'"), lookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "password: s3cret\nsummary: '{{ $labels.instance }} $PASSWORD'"; string(got) != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	_, err = manifest.ExpandEnv([]byte("${A} ${B} ${A}"), lookup)
+	if err == nil || err.Error() != "environment variables A, B are not set" {
+		t.Fatalf("got %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+)
+
+const (
+	defaultWorkers   = 4
+	defaultQueueSize = 1024
+)
+
+// Task is the delivery of one message to one receiver.
+type Task struct {
+	ReceiverUID snowflake.ID
+	Sender      Sender
+	Message     *Message
+}
+
+// Recorder keeps the audit trail of delivery attempts.
+type Recorder interface {
+	RecordAttempt(ctx context.Context, task *Task, attempt *Attempt)
+}
+
+type DispatcherOption func(*Dispatcher)
+
+func WithWorkers(workers int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.workers = workers
+	}
+}
+
+func WithQueueSize(size int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.queueSize = size
+	}
+}
+
+func WithBackoff(backoff Backoff) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.backoff = backoff
+	}
+}
+
+// WithEngine delivers on the workers of engine instead of the dispatcher's own, every attempt
+// is a job bounded by DefaultAttemptTimeout and the retries wait off the workers. The workers
+// and queue size options are ignored then.
+func WithEngine(engine *job.Engine) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.engine = engine
+	}
+}
+
+// NewDispatcher returns a Dispatcher delivering tasks on a pool of workers,
+// the workers start with the first Dispatch.
+func NewDispatcher(recorder Recorder, helper *klog.Helper, opts ...DispatcherOption) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		ctx:       ctx,
+		cancel:    cancel,
+		recorder:  recorder,
+		helper:    helper,
+		workers:   defaultWorkers,
+		queueSize: defaultQueueSize,
+		backoff:   DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.queue = make(chan *Task, d.queueSize)
+	if d.engine != nil {
+		d.retrier = NewRetrier(d.engine, d.backoff, DefaultAttemptTimeout)
+	}
+	return d
+}
+
+// Dispatcher delivers tasks asynchronously, retrying each one with backoff.
+type Dispatcher struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	recorder  Recorder
+	helper    *klog.Helper
+	workers   int
+	queueSize int
+	backoff   Backoff
+	queue     chan *Task
+	engine    *job.Engine
+	retrier   *Retrier
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	mu        sync.RWMutex
+	stopped   bool
+	wg        sync.WaitGroup
+}
+
+// Dispatch queues task, it returns false when the queue is full or the dispatcher is stopped.
+func (d *Dispatcher) Dispatch(task *Task) bool {
+	d.startOnce.Do(d.start)
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.stopped {
+		return false
+	}
+	if d.retrier != nil {
+		return d.retry(task) == nil
+	}
+	select {
+	case d.queue <- task:
+		return true
+	default:
+		return false
+	}
+}
+
+// Stop cancels pending retries and waits for the workers to exit, queued tasks are dropped.
+// With an engine, the waiting retries are dropped and the engine waits for the running attempts.
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		d.mu.Lock()
+		d.stopped = true
+		d.mu.Unlock()
+		d.cancel()
+		if d.retrier != nil {
+			d.retrier.Stop()
+		}
+		d.wg.Wait()
+	})
+}
+
+func (d *Dispatcher) start() {
+	if d.engine != nil {
+		return
+	}
+	for i := 0; i < max(d.workers, 1); i++ {
+		d.wg.Add(1)
+		go d.work()
+	}
+}
+
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case task := <-d.queue:
+			d.deliver(d.ctx, task)
+		}
+	}
+}
+
+// retry delivers task on the retrier, one attempt per job of the engine.
+func (d *Dispatcher) retry(task *Task) error {
+	// the attempt interrupted by Stop is still recorded
+	recordCtx := context.WithoutCancel(d.ctx)
+	return d.retrier.Retry("notify", func(ctx context.Context) (int, error) {
+		return task.Sender.Send(ctx, task.Message)
+	}, func(attempt *Attempt) {
+		if d.recorder != nil {
+			d.recorder.RecordAttempt(recordCtx, task, attempt)
+		}
+	}, func(err error) {
+		d.warn(task, err)
+	})
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, task *Task) {
+	// the attempt interrupted by Stop is still recorded
+	recordCtx := context.WithoutCancel(ctx)
+	err := Deliver(ctx, task.Sender, task.Message, d.backoff, func(attempt *Attempt) {
+		if d.recorder != nil {
+			d.recorder.RecordAttempt(recordCtx, task, attempt)
+		}
+	})
+	d.warn(task, err)
+}
+
+func (d *Dispatcher) warn(task *Task, err error) {
+	if err != nil {
+		d.helper.Warnw("msg", "deliver message failed",
+			"receiverUID", task.ReceiverUID,
+			"eventUID", task.Message.EventUID,
+			"error", err,
+		)
+	}
+}
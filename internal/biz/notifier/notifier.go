@@ -0,0 +1,144 @@
+// Package notifier delivers strategy events to receivers.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+const MessageVersion = "1"
+
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+)
+
+// Message is the JSON body sent to receivers for one event.
+type Message struct {
+	Version       string            `json:"version"`
+	Status        string            `json:"status"`
+	EventUID      snowflake.ID      `json:"eventUID"`
+	NamespaceUID  snowflake.ID      `json:"namespaceUID"`
+	StrategyUID   snowflake.ID      `json:"strategyUID"`
+	LevelUID      snowflake.ID      `json:"levelUID"`
+	DatasourceUID snowflake.ID      `json:"datasourceUID"`
+	Fingerprint   string            `json:"fingerprint"`
+	Labels        map[string]string `json:"labels"`
+	Annotations   map[string]string `json:"annotations"`
+	Value         float64           `json:"value"`
+	StartsAt      time.Time         `json:"startsAt"`
+	EndsAt        *time.Time        `json:"endsAt,omitempty"`
+	// Flap is set on the summary sent once a flapping series is stable again.
+	Flap *Flap `json:"flap,omitempty"`
+}
+
+// Flap summarizes a flapping period whose notifications were held back.
+type Flap struct {
+	Since       time.Time `json:"since"`
+	Until       time.Time `json:"until"`
+	Transitions int       `json:"transitions"`
+}
+
+// Sender sends a message to one receiver. statusCode is 0 when no response was received.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) (statusCode int, err error)
+}
+
+// Backoff is the retry policy of a delivery, the delay doubles (by Multiplier)
+// after every failed attempt up to Max.
+type Backoff struct {
+	MaxAttempts int
+	Initial     time.Duration
+	Max         time.Duration
+	Multiplier  float64
+}
+
+var DefaultBackoff = Backoff{
+	MaxAttempts: 5,
+	Initial:     time.Second,
+	Max:         time.Minute,
+	Multiplier:  2,
+}
+
+// Delay returns the wait before the attempt following attempt (1-based).
+func (b Backoff) Delay(attempt int) time.Duration {
+	delay := float64(b.Initial)
+	for i := 1; i < attempt; i++ {
+		delay *= b.Multiplier
+		if b.Max > 0 && time.Duration(delay) >= b.Max {
+			return b.Max
+		}
+	}
+	return time.Duration(delay)
+}
+
+// Attempt is the outcome of one try to send a message.
+type Attempt struct {
+	Attempt    int
+	StatusCode int
+	Err        error
+	Latency    time.Duration
+	At         time.Time
+}
+
+func (a *Attempt) Success() bool {
+	return a.Err == nil
+}
+
+// Retryable reports whether another attempt may succeed: transport errors,
+// 429 and 5xx responses are retried, other failures are final.
+func (a *Attempt) Retryable() bool {
+	if a.Err == nil {
+		return false
+	}
+	if a.StatusCode == 0 {
+		return !errors.Is(a.Err, context.Canceled)
+	}
+	return a.StatusCode == http.StatusTooManyRequests || a.StatusCode >= http.StatusInternalServerError
+}
+
+// Deliver sends msg until it succeeds, fails permanently or backoff is
+// exhausted, calling record after every attempt.
+func Deliver(ctx context.Context, sender Sender, msg *Message, backoff Backoff, record func(*Attempt)) error {
+	return Retry(ctx, func(ctx context.Context) (int, error) {
+		return sender.Send(ctx, msg)
+	}, backoff, record)
+}
+
+// Retry calls send the same way Deliver sends a message, for payloads other than a Message.
+func Retry(ctx context.Context, send func(ctx context.Context) (statusCode int, err error), backoff Backoff, record func(*Attempt)) error {
+	maxAttempts := max(backoff.MaxAttempts, 1)
+	for i := 1; ; i++ {
+		attempt := try(ctx, i, send)
+		if record != nil {
+			record(attempt)
+		}
+		if attempt.Success() || !attempt.Retryable() || i >= maxAttempts {
+			return attempt.Err
+		}
+		timer := time.NewTimer(backoff.Delay(i))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// try makes attempt i of send.
+func try(ctx context.Context, i int, send func(ctx context.Context) (int, error)) *Attempt {
+	start := time.Now()
+	statusCode, err := send(ctx)
+	return &Attempt{
+		Attempt:    i,
+		StatusCode: statusCode,
+		Err:        err,
+		Latency:    time.Since(start),
+		At:         start,
+	}
+}
@@ -0,0 +1,244 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+// fakeWebhook answers with the queued status codes, then 200.
+type fakeWebhook struct {
+	mu       sync.Mutex
+	statuses []int
+	messages []*notifier.Message
+	headers  []http.Header
+}
+
+func (f *fakeWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var msg notifier.Message
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.messages = append(f.messages, &msg)
+	f.headers = append(f.headers, r.Header.Clone())
+	status := http.StatusOK
+	if len(f.statuses) > 0 {
+		status, f.statuses = f.statuses[0], f.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (f *fakeWebhook) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.messages)
+}
+
+var fastBackoff = notifier.Backoff{MaxAttempts: 3, Initial: time.Millisecond, Max: 5 * time.Millisecond, Multiplier: 2}
+
+func testMessage() *notifier.Message {
+	return &notifier.Message{
+		Version:     notifier.MessageVersion,
+		Status:      notifier.StatusFiring,
+		EventUID:    1,
+		StrategyUID: 2,
+		LevelUID:    3,
+		Labels:      map[string]string{"instance": "a"},
+		Value:       42,
+		StartsAt:    time.Unix(1700000000, 0),
+	}
+}
+
+func TestDeliverRetriesWithBackoff(t *testing.T) {
+	hook := &fakeWebhook{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	sender := notifier.NewWebhookSender(srv.URL, map[string]string{"Authorization": "Bearer token"})
+	var attempts []*notifier.Attempt
+	err := notifier.Deliver(context.Background(), sender, testMessage(), fastBackoff, func(a *notifier.Attempt) {
+		attempts = append(attempts, a)
+	})
+	if err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	if len(attempts) != 3 {
+		t.Fatalf("attempts = %d, want 3", len(attempts))
+	}
+	wantCodes := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	for i, a := range attempts {
+		if a.Attempt != i+1 || a.StatusCode != wantCodes[i] {
+			t.Fatalf("attempt %d = (%d, %d), want (%d, %d)", i, a.Attempt, a.StatusCode, i+1, wantCodes[i])
+		}
+	}
+	if !attempts[2].Success() || attempts[0].Success() {
+		t.Fatalf("unexpected success flags")
+	}
+	if got := hook.headers[0].Get("Authorization"); got != "Bearer token" {
+		t.Fatalf("authorization header = %q", got)
+	}
+	if msg := hook.messages[0]; msg.EventUID != 1 || msg.Labels["instance"] != "a" || msg.Value != 42 {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+}
+
+func TestDeliverStopsOnClientError(t *testing.T) {
+	hook := &fakeWebhook{statuses: []int{http.StatusBadRequest}}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	var attempts int
+	err := notifier.Deliver(context.Background(), notifier.NewWebhookSender(srv.URL, nil), testMessage(), fastBackoff, func(*notifier.Attempt) {
+		attempts++
+	})
+	if err == nil || attempts != 1 {
+		t.Fatalf("err = %v, attempts = %d, want a single failed attempt", err, attempts)
+	}
+}
+
+func TestDeliverGivesUp(t *testing.T) {
+	hook := &fakeWebhook{statuses: []int{500, 500, 500, 500}}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	err := notifier.Deliver(context.Background(), notifier.NewWebhookSender(srv.URL, nil), testMessage(), fastBackoff, nil)
+	if err == nil || hook.count() != fastBackoff.MaxAttempts {
+		t.Fatalf("err = %v, calls = %d, want %d failed calls", err, hook.count(), fastBackoff.MaxAttempts)
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	b := notifier.Backoff{Initial: time.Second, Max: 5 * time.Second, Multiplier: 2}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := b.Delay(i + 1); got != w {
+			t.Fatalf("Delay(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+}
+
+type memoryRecorder struct {
+	mu       sync.Mutex
+	attempts []*notifier.Attempt
+}
+
+func (m *memoryRecorder) RecordAttempt(_ context.Context, _ *notifier.Task, attempt *notifier.Attempt) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.attempts = append(m.attempts, attempt)
+}
+
+func (m *memoryRecorder) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.attempts)
+}
+
+func TestDispatcherRecordsAttempts(t *testing.T) {
+	hook := &fakeWebhook{statuses: []int{http.StatusBadGateway}}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	recorder := &memoryRecorder{}
+	d := notifier.NewDispatcher(recorder, klog.NewHelper(klog.DefaultLogger), notifier.WithBackoff(fastBackoff), notifier.WithWorkers(2))
+	defer d.Stop()
+	if !d.Dispatch(&notifier.Task{ReceiverUID: 9, Sender: notifier.NewWebhookSender(srv.URL, nil), Message: testMessage()}) {
+		t.Fatal("dispatch rejected")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for recorder.count() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if recorder.count() != 2 {
+		t.Fatalf("recorded %d attempts, want 2", recorder.count())
+	}
+	d.Stop()
+	if d.Dispatch(&notifier.Task{Message: testMessage()}) {
+		t.Fatal("dispatch accepted after stop")
+	}
+}
+
+func TestDispatcherOnEngine(t *testing.T) {
+	hook := &fakeWebhook{}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	helper := klog.NewHelper(klog.DefaultLogger)
+	engine := job.NewEngine(helper, job.WithWorkers(1), job.WithTimeout(time.Nanosecond))
+	defer engine.Stop()
+	recorder := &memoryRecorder{}
+	d := notifier.NewDispatcher(recorder, helper, notifier.WithBackoff(fastBackoff), notifier.WithEngine(engine))
+	if !d.Dispatch(&notifier.Task{ReceiverUID: 9, Sender: notifier.NewWebhookSender(srv.URL, nil), Message: testMessage()}) {
+		t.Fatal("dispatch rejected")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for recorder.count() < 1 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if recorder.count() != 1 || hook.count() != 1 {
+		t.Fatalf("recorded %d attempts and %d messages, want 1, the engine timeout must not apply", recorder.count(), hook.count())
+	}
+	d.Stop()
+	if d.Dispatch(&notifier.Task{Message: testMessage()}) {
+		t.Fatal("dispatch accepted after stop")
+	}
+}
+
+func TestDispatcherRetriesOffTheWorkers(t *testing.T) {
+	hook := &fakeWebhook{statuses: []int{http.StatusServiceUnavailable}}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	helper := klog.NewHelper(klog.DefaultLogger)
+	engine := job.NewEngine(helper, job.WithWorkers(1))
+	defer engine.Stop()
+	recorder := &memoryRecorder{}
+	slow := notifier.Backoff{MaxAttempts: 2, Initial: time.Hour, Max: time.Hour, Multiplier: 2}
+	d := notifier.NewDispatcher(recorder, helper, notifier.WithBackoff(slow), notifier.WithEngine(engine))
+	if !d.Dispatch(&notifier.Task{ReceiverUID: 9, Sender: notifier.NewWebhookSender(srv.URL, nil), Message: testMessage()}) {
+		t.Fatal("dispatch rejected")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for recorder.count() < 1 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if recorder.count() != 1 {
+		t.Fatalf("recorded %d attempts, want the first attempt", recorder.count())
+	}
+
+	// the only worker is free while the delivery waits an hour for its retry
+	ran := make(chan struct{})
+	if err := engine.Submit(&job.Job{Name: "evaluate", Run: func(context.Context) error { close(ran); return nil }}); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("the delivery holds the only worker while it waits for its retry")
+	}
+	stopped := make(chan struct{})
+	go func() {
+		d.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("stop waited for the retry")
+	}
+	if recorder.count() != 1 || hook.count() != 1 {
+		t.Fatalf("recorded %d attempts and %d messages, want the retry dropped by stop", recorder.count(), hook.count())
+	}
+}
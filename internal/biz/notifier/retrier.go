@@ -0,0 +1,111 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aide-family/marksman/internal/biz/job"
+)
+
+// DefaultAttemptTimeout bounds one attempt of a delivery run on an engine.
+const DefaultAttemptTimeout = 30 * time.Second
+
+// NewRetrier returns a Retrier submitting the attempts to engine.
+func NewRetrier(engine *job.Engine, backoff Backoff, attemptTimeout time.Duration) *Retrier {
+	ctx, cancel := context.WithCancel(context.Background())
+	if attemptTimeout <= 0 {
+		attemptTimeout = DefaultAttemptTimeout
+	}
+	return &Retrier{
+		ctx:            ctx,
+		cancel:         cancel,
+		engine:         engine,
+		backoff:        backoff,
+		attemptTimeout: attemptTimeout,
+	}
+}
+
+// Retrier retries the way Retry does, but runs every attempt as its own job of an engine and
+// waits for the next attempt off the workers. A receiver that keeps failing holds a worker
+// only while an attempt runs, never through the backoff, so it can not stall the other jobs.
+type Retrier struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	engine         *job.Engine
+	backoff        Backoff
+	attemptTimeout time.Duration
+
+	mu      sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// Retry submits the first attempt of send, it returns the error of the engine when the attempt
+// can not be queued. done is called with the error of the last attempt, or of the engine when a
+// retry can not be queued.
+func (r *Retrier) Retry(name string, send func(ctx context.Context) (statusCode int, err error), record func(*Attempt), done func(err error)) error {
+	return r.submit(name, 1, send, record, done)
+}
+
+// Stop cancels the running attempts and the waiting retries and waits for the retries to exit.
+func (r *Retrier) Stop() {
+	r.mu.Lock()
+	r.stopped = true
+	r.mu.Unlock()
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *Retrier) submit(name string, i int, send func(ctx context.Context) (int, error), record func(*Attempt), done func(error)) error {
+	return r.engine.Submit(&job.Job{
+		Name:    name,
+		Timeout: r.attemptTimeout,
+		Run: func(ctx context.Context) error {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stop := context.AfterFunc(r.ctx, cancel)
+			defer stop()
+			attempt := try(ctx, i, send)
+			if record != nil {
+				record(attempt)
+			}
+			if attempt.Success() || !attempt.Retryable() || i >= max(r.backoff.MaxAttempts, 1) {
+				done(attempt.Err)
+				return nil
+			}
+			r.wait(r.backoff.Delay(i), func(err error) {
+				if err == nil {
+					err = r.submit(name, i+1, send, record, done)
+				}
+				if err != nil {
+					done(err)
+				}
+			})
+			return nil
+		},
+	})
+}
+
+// wait calls next after delay, or with the error of the context when the retrier is stopped first.
+func (r *Retrier) wait(delay time.Duration, next func(err error)) {
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		next(context.Canceled)
+		return
+	}
+	r.wg.Add(1)
+	r.mu.Unlock()
+	go func() {
+		defer r.wg.Done()
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-r.ctx.Done():
+			next(r.ctx.Err())
+		case <-timer.C:
+			next(nil)
+		}
+	}()
+}
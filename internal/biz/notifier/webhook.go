@@ -0,0 +1,72 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+type WebhookOption func(*WebhookSender)
+
+// WithWebhookClient sets the http client used to post messages.
+func WithWebhookClient(client *http.Client) WebhookOption {
+	return func(w *WebhookSender) {
+		w.client = client
+	}
+}
+
+// NewWebhookSender returns a Sender posting the message as JSON to url.
+func NewWebhookSender(url string, headers map[string]string, opts ...WebhookOption) *WebhookSender {
+	w := &WebhookSender{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: defaultWebhookTimeout},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+type WebhookSender struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (w *WebhookSender) Send(ctx context.Context, msg *Message) (int, error) {
+	return w.Post(ctx, msg)
+}
+
+// Post posts v as JSON to the url of the webhook.
+func (w *WebhookSender) Post(ctx context.Context, v any) (int, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("webhook responded %s: %s", resp.Status, bytes.TrimSpace(data))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
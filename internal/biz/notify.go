@@ -0,0 +1,451 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	"github.com/aide-family/marksman/internal/biz/flapper"
+	"github.com/aide-family/marksman/internal/biz/inhibitor"
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewNotify(
+	receiverRepo repository.Receiver,
+	silenceRepo repository.Silence,
+	inhibitRuleRepo repository.InhibitRule,
+	eventRepo repository.Event,
+	aggregationRepo repository.Aggregation,
+	notifyGroupRepo repository.NotifyGroup,
+	escalationPolicyRepo repository.EscalationPolicy,
+	escalationTimerRepo repository.EscalationTimer,
+	onCallScheduleRepo repository.OnCallSchedule,
+	jobEngine *job.Engine,
+	helper *klog.Helper,
+) *NotifyBiz {
+	n := &NotifyBiz{
+		receiverRepo:         receiverRepo,
+		silenceRepo:          silenceRepo,
+		inhibitRuleRepo:      inhibitRuleRepo,
+		eventRepo:            eventRepo,
+		aggregationRepo:      aggregationRepo,
+		notifyGroupRepo:      notifyGroupRepo,
+		escalationPolicyRepo: escalationPolicyRepo,
+		onCallScheduleRepo:   onCallScheduleRepo,
+		helper:               klog.NewHelper(klog.With(helper.Logger(), "biz", "notify")),
+	}
+	n.dispatcher = notifier.NewDispatcher(n, n.helper, notifier.WithEngine(jobEngine))
+	n.aggregator = aggregator.NewAggregator(notifyGroupRepo, &receiverSink{notify: n}, n.helper,
+		aggregator.WithRecorder(n),
+		aggregator.WithEngine(jobEngine),
+	)
+	n.escalator = escalator.NewEscalator(escalationTimerRepo, &receiverPager{notify: n}, n.helper)
+	return n
+}
+
+type NotifyBiz struct {
+	helper               *klog.Helper
+	receiverRepo         repository.Receiver
+	silenceRepo          repository.Silence
+	inhibitRuleRepo      repository.InhibitRule
+	eventRepo            repository.Event
+	aggregationRepo      repository.Aggregation
+	notifyGroupRepo      repository.NotifyGroup
+	escalationPolicyRepo repository.EscalationPolicy
+	onCallScheduleRepo   repository.OnCallSchedule
+	dispatcher           *notifier.Dispatcher
+	aggregator           *aggregator.Aggregator
+	escalator            *escalator.Escalator
+	flushing             atomic.Bool
+	escalating           atomic.Bool
+}
+
+// Notify queues the delivery of every event to the receivers bound to its
+// strategy level, strategy or strategy group, the most specific binding wins.
+// An on-call schedule bound instead of a receiver delivers to the receiver on call.
+// Events muted by an active silence of their namespace, or inhibited by a firing
+// event of another level, are not delivered. The events of a strategy group with an
+// aggregation are added to the groups of the aggregator instead, their batches are
+// sent by FlushNotifyGroups. Acknowledged events are not delivered until they are resolved.
+// A firing event starts the escalation policy of its level or strategy group, the escalation
+// is cancelled when the event is resolved. The events of a flapping series are not delivered,
+// NotifyFlapSummary sends one summary once the series is stable again.
+func (n *NotifyBiz) Notify(ctx context.Context, events []*bo.EventItemBo) {
+	silenced := n.newSilenceMatcher(time.Now())
+	inhibited := n.newInhibitionMatcher()
+	aggregation := n.newAggregationFinder()
+	escalation := n.newEscalationPolicyFinder()
+	for _, event := range events {
+		if event.State == apiv1.EventState_RESOLVED {
+			n.cancelEscalation(ctx, event)
+		}
+		if event.Flapping {
+			n.helper.Debugw("msg", "event is flapping", "eventUID", event.UID)
+			continue
+		}
+		if event.Acknowledged() {
+			n.helper.Debugw("msg", "event is acknowledged", "eventUID", event.UID, "ackedBy", event.AckedBy)
+			continue
+		}
+		if silence := silenced(ctx, event); silence != nil {
+			n.helper.Debugw("msg", "event is silenced", "eventUID", event.UID, "silenceUID", silence.UID)
+			continue
+		}
+		if result := inhibited(ctx, event); result.Inhibited {
+			n.helper.Debugw("msg", "event is inhibited", "eventUID", event.UID, "ruleUID", result.RuleUID, "source", result.Source)
+			continue
+		}
+		msg := event.ToNotifierMessage()
+		if policy := escalation(ctx, event); policy != nil && event.State == apiv1.EventState_FIRING {
+			if err := n.escalator.Start(ctx, policy.ToEscalatorPolicy(), msg); err != nil {
+				n.helper.Errorw("msg", "start escalation failed", "error", err, "eventUID", event.UID, "policyUID", policy.UID)
+			}
+		}
+		receiverUIDs, err := n.receiverRepo.ResolveReceiverUIDs(ctx, event.NamespaceUID, event.StrategyUID, event.LevelUID)
+		if err != nil {
+			n.helper.Errorw("msg", "resolve receivers failed", "error", err, "eventUID", event.UID)
+			continue
+		}
+		receivers, err := n.resolveReceivers(ctx, event.NamespaceUID, receiverUIDs, time.Now())
+		if err != nil {
+			n.helper.Errorw("msg", "list receivers failed", "error", err, "eventUID", event.UID)
+			continue
+		}
+		item := aggregation(ctx, event)
+		for _, receiver := range receivers {
+			sender := n.newSender(receiver)
+			if sender == nil {
+				continue
+			}
+			if item != nil {
+				if err := n.aggregator.Add(ctx, item.ToAggregatorRoute(event.NamespaceUID, receiver.UID), msg); err != nil {
+					n.helper.Errorw("msg", "add event to notify group failed", "error", err, "receiverUID", receiver.UID, "eventUID", event.UID)
+				}
+				continue
+			}
+			task := &notifier.Task{ReceiverUID: receiver.UID, Sender: sender, Message: msg}
+			if !n.dispatcher.Dispatch(task) {
+				n.helper.Warnw("msg", "notify queue is full, drop message", "receiverUID", receiver.UID, "eventUID", event.UID)
+			}
+		}
+	}
+}
+
+// NotifyFlapSummary delivers the summary of a flapping period to the receivers of the latest
+// event of the series, straight to them rather than through a notify group or an escalation.
+// It is muted the same way as the events are.
+func (n *NotifyBiz) NotifyFlapSummary(ctx context.Context, event *bo.EventItemBo, summary *flapper.Summary) {
+	if event.Acknowledged() {
+		n.helper.Debugw("msg", "event is acknowledged", "eventUID", event.UID, "ackedBy", event.AckedBy)
+		return
+	}
+	if silence := n.newSilenceMatcher(time.Now())(ctx, event); silence != nil {
+		n.helper.Debugw("msg", "event is silenced", "eventUID", event.UID, "silenceUID", silence.UID)
+		return
+	}
+	if result := n.newInhibitionMatcher()(ctx, event); result.Inhibited {
+		n.helper.Debugw("msg", "event is inhibited", "eventUID", event.UID, "ruleUID", result.RuleUID, "source", result.Source)
+		return
+	}
+	receiverUIDs, err := n.receiverRepo.ResolveReceiverUIDs(ctx, event.NamespaceUID, event.StrategyUID, event.LevelUID)
+	if err != nil {
+		n.helper.Errorw("msg", "resolve receivers failed", "error", err, "eventUID", event.UID)
+		return
+	}
+	receivers, err := n.resolveReceivers(ctx, event.NamespaceUID, receiverUIDs, time.Now())
+	if err != nil {
+		n.helper.Errorw("msg", "list receivers failed", "error", err, "eventUID", event.UID)
+		return
+	}
+	msg := event.ToNotifierMessage()
+	msg.Flap = &notifier.Flap{Since: summary.Since, Until: summary.Until, Transitions: summary.Transitions}
+	for _, receiver := range receivers {
+		sender := n.newSender(receiver)
+		if sender == nil {
+			continue
+		}
+		task := &notifier.Task{ReceiverUID: receiver.UID, Sender: sender, Message: msg}
+		if !n.dispatcher.Dispatch(task) {
+			n.helper.Warnw("msg", "notify queue is full, drop message", "receiverUID", receiver.UID, "eventUID", event.UID)
+		}
+	}
+}
+
+// Acknowledge stops the notifications of the acknowledged event, its alerts are removed
+// from the notify groups so that their batches are not repeated, and its escalation is cancelled.
+func (n *NotifyBiz) Acknowledge(ctx context.Context, event *bo.EventItemBo) {
+	if err := n.notifyGroupRepo.DeleteEventAlerts(ctx, event.UID); err != nil {
+		n.helper.Errorw("msg", "delete acknowledged event from notify groups failed", "error", err, "eventUID", event.UID)
+	}
+	n.cancelEscalation(ctx, event)
+}
+
+func (n *NotifyBiz) cancelEscalation(ctx context.Context, event *bo.EventItemBo) {
+	if err := n.escalator.Cancel(ctx, event.UID); err != nil {
+		n.helper.Errorw("msg", "cancel escalation failed", "error", err, "eventUID", event.UID)
+	}
+}
+
+// RecordAttempt implements notifier.Recorder.
+func (n *NotifyBiz) RecordAttempt(ctx context.Context, task *notifier.Task, attempt *notifier.Attempt) {
+	state := apiv1.EventState_FIRING
+	if task.Message.Status == notifier.StatusResolved {
+		state = apiv1.EventState_RESOLVED
+	}
+	req := &bo.CreateReceiverDeliveryBo{
+		NamespaceUID: task.Message.NamespaceUID,
+		ReceiverUID:  task.ReceiverUID,
+		EventUID:     task.Message.EventUID,
+		EventState:   state,
+		Attempt:      int32(attempt.Attempt),
+		StatusCode:   int32(attempt.StatusCode),
+		Success:      attempt.Success(),
+		Latency:      attempt.Latency,
+	}
+	if attempt.Err != nil {
+		req.Error = attempt.Err.Error()
+	}
+	if err := n.receiverRepo.CreateReceiverDelivery(ctx, req); err != nil {
+		n.helper.Errorw("msg", "record receiver delivery failed", "error", err, "req", req)
+	}
+}
+
+// RecordBatchAttempt implements aggregator.Recorder, the attempt is recorded for every event of the batch.
+func (n *NotifyBiz) RecordBatchAttempt(ctx context.Context, batch *aggregator.Batch, attempt *notifier.Attempt) {
+	for _, msg := range batch.Alerts {
+		n.RecordAttempt(ctx, &notifier.Task{ReceiverUID: batch.ReceiverUID, Message: msg}, attempt)
+	}
+}
+
+// FlushNotifyGroups sends the batches of the notify groups that are due. It must only run on the
+// leader of the job nodes, a flush still running is not started again.
+func (n *NotifyBiz) FlushNotifyGroups(ctx context.Context) error {
+	if !n.flushing.CompareAndSwap(false, true) {
+		return nil
+	}
+	defer n.flushing.Store(false)
+	if _, err := n.aggregator.Flush(ctx); err != nil {
+		n.helper.Errorw("msg", "flush notify groups failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FireEscalations pages the escalation steps that are due. It must only run on the leader
+// of the job nodes, the same as FlushNotifyGroups.
+func (n *NotifyBiz) FireEscalations(ctx context.Context) error {
+	if !n.escalating.CompareAndSwap(false, true) {
+		return nil
+	}
+	defer n.escalating.Store(false)
+	if _, err := n.escalator.Fire(ctx); err != nil {
+		n.helper.Errorw("msg", "fire escalations failed", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Stop drops the queued deliveries and waits for the running ones.
+func (n *NotifyBiz) Stop() {
+	n.dispatcher.Stop()
+	n.aggregator.Stop()
+}
+
+// newSilenceMatcher returns a func finding the silence active at t that mutes an event,
+// the silences of each namespace are loaded once. Events are delivered when the silences
+// can not be loaded, a muted notification that was not meant to be muted is worse than a noisy one.
+func (n *NotifyBiz) newSilenceMatcher(t time.Time) func(ctx context.Context, event *bo.EventItemBo) *bo.SilenceItemBo {
+	silences := make(map[snowflake.ID][]*bo.SilenceItemBo)
+	return func(ctx context.Context, event *bo.EventItemBo) *bo.SilenceItemBo {
+		list, ok := silences[event.NamespaceUID]
+		if !ok {
+			var err error
+			list, err = n.silenceRepo.ListUnexpiredSilences(ctx, event.NamespaceUID, t)
+			if err != nil {
+				n.helper.Errorw("msg", "list silences failed", "error", err, "namespaceUID", event.NamespaceUID)
+			}
+			silences[event.NamespaceUID] = list
+		}
+		for _, silence := range list {
+			if silence.State(t) == apiv1.SilenceState_SILENCE_ACTIVE && silence.Matches(event.Labels) {
+				return silence
+			}
+		}
+		return nil
+	}
+}
+
+// newInhibitionMatcher returns a func telling whether a firing event of the same namespace
+// inhibits an event, the rules and firing events of each namespace are loaded once.
+// Events are delivered when they can not be loaded, the same as for silences.
+func (n *NotifyBiz) newInhibitionMatcher() func(ctx context.Context, event *bo.EventItemBo) *inhibitor.Result {
+	type namespaceInhibition struct {
+		rules  []*inhibitor.Rule
+		firing []*bo.EventItemBo
+	}
+	namespaces := make(map[snowflake.ID]*namespaceInhibition)
+	load := func(ctx context.Context, namespaceUID snowflake.ID) *namespaceInhibition {
+		inhibition := &namespaceInhibition{}
+		rules, err := n.inhibitRuleRepo.ListEnabledInhibitRules(ctx, namespaceUID)
+		if err != nil {
+			n.helper.Errorw("msg", "list inhibit rules failed", "error", err, "namespaceUID", namespaceUID)
+			return inhibition
+		}
+		if len(rules) == 0 {
+			return inhibition
+		}
+		firing, err := n.eventRepo.ListFiringEvents(ctx, namespaceUID)
+		if err != nil {
+			n.helper.Errorw("msg", "list firing events failed", "error", err, "namespaceUID", namespaceUID)
+			return inhibition
+		}
+		inhibition.rules, inhibition.firing = toInhibitorRules(rules), firing
+		return inhibition
+	}
+	return func(ctx context.Context, event *bo.EventItemBo) *inhibitor.Result {
+		inhibition, ok := namespaces[event.NamespaceUID]
+		if !ok {
+			inhibition = load(ctx, event.NamespaceUID)
+			namespaces[event.NamespaceUID] = inhibition
+		}
+		target := event.ToInhibitorAlert()
+		if len(inhibition.rules) == 0 {
+			return &inhibitor.Result{Alert: target}
+		}
+		sources := make([]*inhibitor.Alert, 0, len(inhibition.firing))
+		for _, source := range inhibition.firing {
+			if source.UID != event.UID {
+				sources = append(sources, source.ToInhibitorAlert())
+			}
+		}
+		return inhibitor.Inhibit(inhibition.rules, target, sources)
+	}
+}
+
+// newAggregationFinder returns a func finding the aggregation of the strategy group of an event,
+// the aggregation of each strategy is loaded once. Events are delivered one by one when it can not be loaded.
+func (n *NotifyBiz) newAggregationFinder() func(ctx context.Context, event *bo.EventItemBo) *bo.AggregationItemBo {
+	strategies := make(map[snowflake.ID]*bo.AggregationItemBo)
+	return func(ctx context.Context, event *bo.EventItemBo) *bo.AggregationItemBo {
+		item, ok := strategies[event.StrategyUID]
+		if !ok {
+			var err error
+			item, err = n.aggregationRepo.GetStrategyAggregation(ctx, event.NamespaceUID, event.StrategyUID)
+			if err != nil {
+				n.helper.Errorw("msg", "get strategy aggregation failed", "error", err, "strategyUID", event.StrategyUID)
+			}
+			strategies[event.StrategyUID] = item
+		}
+		return item
+	}
+}
+
+// newEscalationPolicyFinder returns a func finding the escalation policy of an event, the policy
+// of each strategy level is loaded once. Events are not escalated when it can not be loaded.
+func (n *NotifyBiz) newEscalationPolicyFinder() func(ctx context.Context, event *bo.EventItemBo) *bo.EscalationPolicyItemBo {
+	type scope struct {
+		strategyUID, levelUID snowflake.ID
+	}
+	policies := make(map[scope]*bo.EscalationPolicyItemBo)
+	return func(ctx context.Context, event *bo.EventItemBo) *bo.EscalationPolicyItemBo {
+		key := scope{strategyUID: event.StrategyUID, levelUID: event.LevelUID}
+		item, ok := policies[key]
+		if !ok {
+			var err error
+			item, err = n.escalationPolicyRepo.GetEventEscalationPolicy(ctx, event.NamespaceUID, event.StrategyUID, event.LevelUID)
+			if err != nil {
+				n.helper.Errorw("msg", "get escalation policy failed", "error", err, "strategyUID", event.StrategyUID, "levelUID", event.LevelUID)
+			}
+			policies[key] = item
+		}
+		return item
+	}
+}
+
+// resolveReceivers returns the enabled receivers among uids, the on-call schedules among them are
+// replaced by the receiver on call at now. The receivers are still notified when the schedules
+// can not be loaded, the same as for silences.
+func (n *NotifyBiz) resolveReceivers(ctx context.Context, namespaceUID snowflake.ID, uids []snowflake.ID, now time.Time) ([]*bo.ReceiverItemBo, error) {
+	receiverUIDs := slices.Clone(uids)
+	schedules, err := n.onCallScheduleRepo.ListEnabledOnCallSchedules(ctx, namespaceUID, uids, now)
+	if err != nil {
+		n.helper.Errorw("msg", "list oncall schedules failed", "error", err, "namespaceUID", namespaceUID)
+	}
+	for _, schedule := range schedules {
+		shift := schedule.ToOnCallSchedule().OnCall(now)
+		if shift == nil {
+			n.helper.Warnw("msg", "nobody is on call", "scheduleUID", schedule.UID)
+			continue
+		}
+		receiverUIDs = append(receiverUIDs, shift.ReceiverUID)
+	}
+	slices.Sort(receiverUIDs)
+	return n.receiverRepo.ListEnabledReceivers(ctx, namespaceUID, slices.Compact(receiverUIDs))
+}
+
+func (n *NotifyBiz) newSender(receiver *bo.ReceiverItemBo) notifier.Sender {
+	switch receiver.Type {
+	case apiv1.ReceiverType_WEBHOOK:
+		if receiver.Webhook == nil || receiver.Webhook.URL == "" {
+			n.helper.Warnw("msg", "skip webhook receiver without url", "receiverUID", receiver.UID)
+			return nil
+		}
+		return notifier.NewWebhookSender(receiver.Webhook.URL, receiver.Webhook.Headers)
+	default:
+		n.helper.Warnw("msg", "skip unsupported receiver type", "receiverUID", receiver.UID, "type", receiver.Type)
+		return nil
+	}
+}
+
+// receiverSink sends the batches of the aggregator to their receivers.
+type receiverSink struct {
+	notify *NotifyBiz
+}
+
+func (r *receiverSink) Send(ctx context.Context, batch *aggregator.Batch) (int, error) {
+	receiver, err := r.notify.receiverRepo.GetEnabledReceiver(ctx, batch.NamespaceUID, batch.ReceiverUID)
+	if err != nil {
+		return 0, err
+	}
+	if receiver.Type != apiv1.ReceiverType_WEBHOOK || receiver.Webhook == nil || receiver.Webhook.URL == "" {
+		return 0, merr.ErrorParams("receiver %d can not receive batches", receiver.UID.Int64())
+	}
+	return aggregator.NewWebhookSink(receiver.Webhook.URL, receiver.Webhook.Headers).Send(ctx, batch)
+}
+
+// receiverPager queues the message of an escalation step to the receivers of the step,
+// the receiver on call is paged for an on-call schedule of the step.
+type receiverPager struct {
+	notify *NotifyBiz
+}
+
+func (r *receiverPager) Page(ctx context.Context, page *escalator.Page) error {
+	receivers, err := r.notify.resolveReceivers(ctx, page.NamespaceUID, page.ReceiverUIDs, time.Now())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, receiver := range receivers {
+		sender := r.notify.newSender(receiver)
+		if sender == nil {
+			continue
+		}
+		task := &notifier.Task{ReceiverUID: receiver.UID, Sender: sender, Message: page.Message}
+		if !r.notify.dispatcher.Dispatch(task) {
+			errs = append(errs, merr.ErrorInternalServer("notify queue is full, drop page of receiver %d", receiver.UID.Int64()))
+		}
+	}
+	return errors.Join(errs...)
+}
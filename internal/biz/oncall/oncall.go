@@ -0,0 +1,254 @@
+// Package oncall tells who is on call in a schedule: a daily or weekly rotation hands the shift
+// over to the next member at the handoff time of the time zone of the schedule, and overrides
+// put another receiver on call for a while, a later override taking precedence over an earlier one.
+package oncall
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+type RotationType int
+
+const (
+	Daily RotationType = iota + 1
+	Weekly
+)
+
+// maxShiftsScan bounds the segments walked by Shifts, in case of a schedule made of
+// many overrides with nobody on call between them.
+const maxShiftsScan = 10000
+
+// never is the end of a segment that does not end.
+var never = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// Rotation hands the shift over to the next member every day or every week.
+type Rotation struct {
+	Type RotationType
+	// Handoff is the time of day of the handoff, as hours and minutes since midnight.
+	Handoff time.Duration
+	// Weekday is the day of the handoff of a weekly rotation.
+	Weekday time.Weekday
+	// Start is when the rotation starts, the shift holding Start is the first member's.
+	Start   time.Time
+	Members []snowflake.ID
+}
+
+// Override puts the receiver on call from Start until End.
+type Override struct {
+	Start       time.Time
+	End         time.Time
+	ReceiverUID snowflake.ID
+}
+
+type Schedule struct {
+	Location *time.Location
+	Rotation Rotation
+	// Overrides are ordered by precedence, the last one wins where they overlap.
+	Overrides []*Override
+}
+
+// Shift is a receiver on call from Start until End.
+type Shift struct {
+	ReceiverUID snowflake.ID
+	Start       time.Time
+	End         time.Time
+	// Override reports whether the shift comes from an override rather than the rotation.
+	Override bool
+}
+
+// segment is who is on call around a time, with where it comes from.
+type segment struct {
+	receiverUID snowflake.ID
+	// source is the index of the override, -1 for the rotation.
+	source int
+	// shift is the number of the shift of the rotation, since its start.
+	shift      int64
+	start, end time.Time
+}
+
+func (s segment) same(o segment) bool {
+	return s.receiverUID == o.receiverUID && s.source == o.source && s.shift == o.shift
+}
+
+// OnCall returns the shift on call at t, nil when nobody is.
+func (s *Schedule) OnCall(t time.Time) *Shift {
+	seg := s.segment(t)
+	if seg.receiverUID == 0 {
+		return nil
+	}
+	return seg.toShift()
+}
+
+// Shifts returns the next n shifts from the one on call at from, the times nobody is on call are skipped.
+func (s *Schedule) Shifts(from time.Time, n int) []*Shift {
+	shifts := make([]*Shift, 0, n)
+	t := from
+	for i := 0; i < maxShiftsScan && len(shifts) < n; i++ {
+		seg := s.segment(t)
+		if seg.receiverUID != 0 {
+			shifts = append(shifts, seg.toShift())
+		}
+		if !seg.end.Before(never) {
+			break
+		}
+		t = seg.end
+	}
+	return shifts
+}
+
+func (seg segment) toShift() *Shift {
+	return &Shift{
+		ReceiverUID: seg.receiverUID,
+		Start:       seg.start,
+		End:         seg.end,
+		Override:    seg.source >= 0,
+	}
+}
+
+// segment returns who is on call at t, from when until when. The effective override or
+// rotation shift is cut where another override starts or ends, the pieces on call the
+// same way are joined back.
+func (s *Schedule) segment(t time.Time) segment {
+	seg := s.effective(t)
+	start, end := seg.start, seg.end
+	if b, ok := s.prevBoundary(t); ok && b.After(start) {
+		start = b
+	}
+	for start.After(seg.start) {
+		before := start.Add(-time.Nanosecond)
+		if !s.effective(before).same(seg) {
+			break
+		}
+		b, ok := s.prevBoundary(before)
+		if !ok || !b.After(seg.start) {
+			start = seg.start
+			break
+		}
+		start = b
+	}
+	if b, ok := s.nextBoundary(t); ok && b.Before(end) {
+		end = b
+	}
+	for end.Before(seg.end) {
+		if !s.effective(end).same(seg) {
+			break
+		}
+		b, ok := s.nextBoundary(end)
+		if !ok || !b.Before(seg.end) {
+			end = seg.end
+			break
+		}
+		end = b
+	}
+	seg.start, seg.end = start, end
+	return seg
+}
+
+// effective returns the override or the rotation shift on call at t, with its own start and end.
+func (s *Schedule) effective(t time.Time) segment {
+	for i := len(s.Overrides) - 1; i >= 0; i-- {
+		o := s.Overrides[i]
+		if !t.Before(o.Start) && t.Before(o.End) {
+			return segment{receiverUID: o.ReceiverUID, source: i, start: o.Start, end: o.End}
+		}
+	}
+	return s.rotationSegment(t)
+}
+
+func (s *Schedule) rotationSegment(t time.Time) segment {
+	r := s.Rotation
+	if len(r.Members) == 0 {
+		return segment{source: -1, end: never}
+	}
+	if t.Before(r.Start) {
+		return segment{source: -1, end: r.Start}
+	}
+	first, _ := s.lastHandoff(r.Start)
+	handoff, next := s.lastHandoff(t)
+	shift := (dayNumber(handoff, s.location()) - dayNumber(first, s.location())) / s.periodDays()
+	start := handoff
+	if start.Before(r.Start) {
+		start = r.Start
+	}
+	return segment{
+		receiverUID: r.Members[shift%int64(len(r.Members))],
+		source:      -1,
+		shift:       shift,
+		start:       start,
+		end:         next,
+	}
+}
+
+// lastHandoff returns the handoff at or before t and the one after it.
+func (s *Schedule) lastHandoff(t time.Time) (time.Time, time.Time) {
+	local := t.In(s.location())
+	y, m, d := local.Date()
+	if s.Rotation.Type == Weekly {
+		d -= (int(local.Weekday()) - int(s.Rotation.Weekday) + 7) % 7
+	}
+	period := int(s.periodDays())
+	handoff := s.handoffOn(y, m, d)
+	if handoff.After(t) {
+		d -= period
+		handoff = s.handoffOn(y, m, d)
+	}
+	return handoff, s.handoffOn(y, m, d+period)
+}
+
+// handoffOn returns the handoff of a day, time.Date normalizes the days out of the month
+// and the times skipped by a daylight saving change.
+func (s *Schedule) handoffOn(y int, m time.Month, d int) time.Time {
+	hour, minute := int(s.Rotation.Handoff/time.Hour), int(s.Rotation.Handoff%time.Hour/time.Minute)
+	return time.Date(y, m, d, hour, minute, 0, 0, s.location())
+}
+
+func (s *Schedule) periodDays() int64 {
+	if s.Rotation.Type == Weekly {
+		return 7
+	}
+	return 1
+}
+
+func (s *Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// dayNumber returns the number of the local day of t since the epoch, so that days are
+// counted the same whatever their length.
+func dayNumber(t time.Time, loc *time.Location) int64 {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// prevBoundary returns the last start or end of an override at or before t.
+func (s *Schedule) prevBoundary(t time.Time) (time.Time, bool) {
+	var last time.Time
+	found := false
+	for _, o := range s.Overrides {
+		for _, b := range []time.Time{o.Start, o.End} {
+			if !b.After(t) && (!found || b.After(last)) {
+				last, found = b, true
+			}
+		}
+	}
+	return last, found
+}
+
+// nextBoundary returns the first start or end of an override after t.
+func (s *Schedule) nextBoundary(t time.Time) (time.Time, bool) {
+	var first time.Time
+	found := false
+	for _, o := range s.Overrides {
+		for _, b := range []time.Time{o.Start, o.End} {
+			if b.After(t) && (!found || b.Before(first)) {
+				first, found = b, true
+			}
+		}
+	}
+	return first, found
+}
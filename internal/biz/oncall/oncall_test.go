@@ -0,0 +1,173 @@
+package oncall_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/oncall"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func onCallUID(s *oncall.Schedule, at time.Time) snowflake.ID {
+	shift := s.OnCall(at)
+	if shift == nil {
+		return 0
+	}
+	return shift.ReceiverUID
+}
+
+func TestDailyRotation(t *testing.T) {
+	s := &oncall.Schedule{
+		Location: time.UTC,
+		Rotation: oncall.Rotation{
+			Type:    oncall.Daily,
+			Handoff: 9 * time.Hour,
+			Start:   time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+			Members: []snowflake.ID{1, 2, 3},
+		},
+	}
+	cases := []struct {
+		at   time.Time
+		want snowflake.ID
+	}{
+		{time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC), 0},
+		{time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC), 1},
+		{time.Date(2026, 3, 3, 8, 59, 0, 0, time.UTC), 1},
+		{time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC), 2},
+		{time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC), 3},
+		{time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC), 1},
+	}
+	for _, c := range cases {
+		if got := onCallUID(s, c.at); got != c.want {
+			t.Errorf("on call at %s: got %d, want %d", c.at, got, c.want)
+		}
+	}
+	first := s.OnCall(time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC))
+	if !first.Start.Equal(s.Rotation.Start) || !first.End.Equal(time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("got first shift %s - %s, want it from the start of the rotation until the handoff", first.Start, first.End)
+	}
+}
+
+func TestWeeklyRotation(t *testing.T) {
+	s := &oncall.Schedule{
+		Location: time.UTC,
+		Rotation: oncall.Rotation{
+			Type:    oncall.Weekly,
+			Handoff: 10 * time.Hour,
+			Weekday: time.Monday,
+			// a Wednesday, the first shift runs until the next Monday
+			Start:   time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+			Members: []snowflake.ID{1, 2},
+		},
+	}
+	cases := []struct {
+		at   time.Time
+		want snowflake.ID
+	}{
+		{time.Date(2026, 3, 8, 23, 0, 0, 0, time.UTC), 1},
+		{time.Date(2026, 3, 9, 9, 59, 0, 0, time.UTC), 1},
+		{time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC), 2},
+		{time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC), 2},
+		{time.Date(2026, 3, 16, 10, 0, 0, 0, time.UTC), 1},
+	}
+	for _, c := range cases {
+		if got := onCallUID(s, c.at); got != c.want {
+			t.Errorf("on call at %s: got %d, want %d", c.at, got, c.want)
+		}
+	}
+}
+
+func TestRotationAcrossDaylightSaving(t *testing.T) {
+	loc := mustLocation(t, "Europe/Berlin")
+	s := &oncall.Schedule{
+		Location: loc,
+		Rotation: oncall.Rotation{
+			Type:    oncall.Daily,
+			Handoff: 9 * time.Hour,
+			Start:   time.Date(2026, 3, 27, 9, 0, 0, 0, loc),
+			Members: []snowflake.ID{1, 2},
+		},
+	}
+	// the clocks go forward on 2026-03-29, the handoff stays at 09:00 local time
+	shifts := s.Shifts(time.Date(2026, 3, 27, 9, 0, 0, 0, loc), 4)
+	if len(shifts) != 4 {
+		t.Fatalf("got %d shifts, want 4", len(shifts))
+	}
+	for i, shift := range shifts {
+		want := time.Date(2026, 3, 27+i, 9, 0, 0, 0, loc)
+		if !shift.Start.Equal(want) {
+			t.Errorf("shift %d starts at %s, want %s", i, shift.Start.In(loc), want)
+		}
+		if wantUID := snowflake.ID(i%2 + 1); shift.ReceiverUID != wantUID {
+			t.Errorf("shift %d is %d's, want %d's", i, shift.ReceiverUID, wantUID)
+		}
+	}
+	if got := shifts[1].End.Sub(shifts[1].Start); got != 23*time.Hour {
+		t.Errorf("shift of the change lasts %s, want 23h", got)
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, time.UTC) }
+	s := &oncall.Schedule{
+		Location: time.UTC,
+		Rotation: oncall.Rotation{
+			Type:    oncall.Daily,
+			Handoff: 9 * time.Hour,
+			Start:   day(2, 9),
+			Members: []snowflake.ID{1, 2},
+		},
+		Overrides: []*oncall.Override{
+			{Start: day(2, 12), End: day(3, 12), ReceiverUID: 7},
+			// a later override wins over the earlier one
+			{Start: day(2, 18), End: day(2, 20), ReceiverUID: 8},
+		},
+	}
+	shifts := s.Shifts(day(2, 9), 6)
+	want := []oncall.Shift{
+		{ReceiverUID: 1, Start: day(2, 9), End: day(2, 12)},
+		{ReceiverUID: 7, Start: day(2, 12), End: day(2, 18), Override: true},
+		{ReceiverUID: 8, Start: day(2, 18), End: day(2, 20), Override: true},
+		{ReceiverUID: 7, Start: day(2, 20), End: day(3, 12), Override: true},
+		{ReceiverUID: 2, Start: day(3, 12), End: day(4, 9)},
+		{ReceiverUID: 1, Start: day(4, 9), End: day(5, 9)},
+	}
+	if len(shifts) != len(want) {
+		t.Fatalf("got %d shifts, want %d", len(shifts), len(want))
+	}
+	for i, shift := range shifts {
+		w := want[i]
+		if shift.ReceiverUID != w.ReceiverUID || !shift.Start.Equal(w.Start) || !shift.End.Equal(w.End) || shift.Override != w.Override {
+			t.Errorf("shift %d: got %+v, want %+v", i, *shift, w)
+		}
+	}
+	// the override shift is the same whatever the time asked within it
+	if got := s.OnCall(day(3, 11)); got == nil || !got.Start.Equal(day(2, 20)) {
+		t.Errorf("got %+v at the end of the override, want the shift from 20:00", got)
+	}
+}
+
+func TestNobodyOnCall(t *testing.T) {
+	s := &oncall.Schedule{
+		Overrides: []*oncall.Override{
+			{Start: time.Unix(1000, 0), End: time.Unix(2000, 0), ReceiverUID: 5},
+		},
+	}
+	if got := s.OnCall(time.Unix(500, 0)); got != nil {
+		t.Errorf("got %+v on call without members, want nobody", got)
+	}
+	shifts := s.Shifts(time.Unix(0, 0), 3)
+	if len(shifts) != 1 || shifts[0].ReceiverUID != 5 {
+		t.Errorf("got %d shifts, want the override only", len(shifts))
+	}
+}
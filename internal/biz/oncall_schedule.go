@@ -0,0 +1,177 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/oncall"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewOnCallSchedule(
+	onCallScheduleRepo repository.OnCallSchedule,
+	receiverRepo repository.Receiver,
+	helper *klog.Helper,
+) *OnCallScheduleBiz {
+	return &OnCallScheduleBiz{
+		onCallScheduleRepo: onCallScheduleRepo,
+		receiverRepo:       receiverRepo,
+		helper:             klog.NewHelper(klog.With(helper.Logger(), "biz", "onCallSchedule")),
+	}
+}
+
+type OnCallScheduleBiz struct {
+	helper             *klog.Helper
+	onCallScheduleRepo repository.OnCallSchedule
+	receiverRepo       repository.Receiver
+}
+
+func (o *OnCallScheduleBiz) CreateOnCallSchedule(ctx context.Context, req *bo.CreateOnCallScheduleBo) error {
+	if err := o.checkReceivers(ctx, req.Members...); err != nil {
+		return err
+	}
+	if err := o.onCallScheduleRepo.CreateOnCallSchedule(ctx, req); err != nil {
+		o.helper.Errorw("msg", "create oncall schedule failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create oncall schedule failed").WithCause(err)
+	}
+	return nil
+}
+
+// UpdateOnCallSchedule changes the rotation of the schedule, the shifts are computed again from its start.
+func (o *OnCallScheduleBiz) UpdateOnCallSchedule(ctx context.Context, req *bo.UpdateOnCallScheduleBo) error {
+	if err := o.checkReceivers(ctx, req.Members...); err != nil {
+		return err
+	}
+	if err := o.onCallScheduleRepo.UpdateOnCallSchedule(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("oncall schedule %d not found", req.UID.Int64())
+		}
+		o.helper.Errorw("msg", "update oncall schedule failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update oncall schedule failed").WithCause(err)
+	}
+	return nil
+}
+
+func (o *OnCallScheduleBiz) UpdateOnCallScheduleStatus(ctx context.Context, req *bo.UpdateOnCallScheduleStatusBo) error {
+	if err := o.onCallScheduleRepo.UpdateOnCallScheduleStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("oncall schedule %d not found", req.UID.Int64())
+		}
+		o.helper.Errorw("msg", "update oncall schedule status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update oncall schedule status failed").WithCause(err)
+	}
+	return nil
+}
+
+// DeleteOnCallSchedule deletes the schedule and its overrides, the strategies it is bound to
+// are no longer notified through it.
+func (o *OnCallScheduleBiz) DeleteOnCallSchedule(ctx context.Context, uid snowflake.ID) error {
+	if err := o.onCallScheduleRepo.DeleteOnCallSchedule(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("oncall schedule %d not found", uid.Int64())
+		}
+		o.helper.Errorw("msg", "delete oncall schedule failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete oncall schedule failed").WithCause(err)
+	}
+	return nil
+}
+
+// GetOnCallSchedule returns the schedule with the overrides not ended at now.
+func (o *OnCallScheduleBiz) GetOnCallSchedule(ctx context.Context, uid snowflake.ID, now time.Time) (*bo.OnCallScheduleItemBo, error) {
+	item, err := o.onCallScheduleRepo.GetOnCallSchedule(ctx, uid, now)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("oncall schedule %d not found", uid.Int64())
+		}
+		o.helper.Errorw("msg", "get oncall schedule failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get oncall schedule failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (o *OnCallScheduleBiz) ListOnCallSchedule(ctx context.Context, req *bo.ListOnCallScheduleBo) (*bo.PageResponseBo[*bo.OnCallScheduleItemBo], error) {
+	result, err := o.onCallScheduleRepo.ListOnCallSchedule(ctx, req)
+	if err != nil {
+		o.helper.Errorw("msg", "list oncall schedule failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list oncall schedule failed").WithCause(err)
+	}
+	return result, nil
+}
+
+// CreateOnCallOverride puts a receiver on call in the schedule for a while, it takes precedence
+// over the rotation and over the overrides created before it.
+func (o *OnCallScheduleBiz) CreateOnCallOverride(ctx context.Context, req *bo.CreateOnCallOverrideBo) error {
+	if _, err := o.GetOnCallSchedule(ctx, req.ScheduleUID, time.Now()); err != nil {
+		return err
+	}
+	if err := o.checkReceivers(ctx, req.ReceiverUID); err != nil {
+		return err
+	}
+	if err := o.onCallScheduleRepo.CreateOnCallOverride(ctx, req); err != nil {
+		o.helper.Errorw("msg", "create oncall override failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create oncall override failed").WithCause(err)
+	}
+	return nil
+}
+
+func (o *OnCallScheduleBiz) DeleteOnCallOverride(ctx context.Context, req *bo.DeleteOnCallOverrideBo) error {
+	if err := o.onCallScheduleRepo.DeleteOnCallOverride(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("oncall override %d not found", req.UID.Int64())
+		}
+		o.helper.Errorw("msg", "delete oncall override failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("delete oncall override failed").WithCause(err)
+	}
+	return nil
+}
+
+func (o *OnCallScheduleBiz) ListOnCallOverride(ctx context.Context, req *bo.ListOnCallOverrideBo) (*bo.OnCallScheduleItemBo, []*bo.OnCallOverrideItemBo, error) {
+	schedule, err := o.GetOnCallSchedule(ctx, req.ScheduleUID, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := o.onCallScheduleRepo.ListOnCallOverride(ctx, req)
+	if err != nil {
+		o.helper.Errorw("msg", "list oncall override failed", "error", err, "req", req)
+		return nil, nil, merr.ErrorInternalServer("list oncall override failed").WithCause(err)
+	}
+	return schedule, items, nil
+}
+
+// GetOnCall returns the schedule and its shift on call at the time of req, nil when nobody is.
+func (o *OnCallScheduleBiz) GetOnCall(ctx context.Context, req *bo.GetOnCallBo) (*bo.OnCallScheduleItemBo, *oncall.Shift, error) {
+	schedule, err := o.GetOnCallSchedule(ctx, req.UID, req.Time)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, schedule.ToOnCallSchedule().OnCall(req.Time), nil
+}
+
+// PreviewOnCallShifts returns the schedule and its next shifts from the one on call at the time of req.
+func (o *OnCallScheduleBiz) PreviewOnCallShifts(ctx context.Context, req *bo.PreviewOnCallShiftsBo) (*bo.OnCallScheduleItemBo, []*oncall.Shift, error) {
+	schedule, err := o.GetOnCallSchedule(ctx, req.UID, req.From)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, schedule.ToOnCallSchedule().Shifts(req.From, req.Count), nil
+}
+
+// checkReceivers makes sure the receivers exist in the current namespace, a schedule can not
+// put another schedule on call.
+func (o *OnCallScheduleBiz) checkReceivers(ctx context.Context, receiverUIDs ...snowflake.ID) error {
+	for _, receiverUID := range receiverUIDs {
+		if _, err := o.receiverRepo.GetReceiver(ctx, receiverUID); err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("receiver %d not found", receiverUID.Int64())
+			}
+			o.helper.Errorw("msg", "get receiver failed", "error", err, "receiverUID", receiverUID)
+			return merr.ErrorInternalServer("get receiver failed").WithCause(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package promql
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/promql/parser/posrange"
+)
+
+const (
+	LintRateOverGauge        = "rate-over-gauge"
+	LintDeltaOverCounter     = "delta-over-counter"
+	LintMissingTemplateLabel = "missing-template-label"
+	LintAbsentWithoutLabels  = "absent-without-labels"
+	LintAbsentMatcherLabels  = "absent-matcher-labels"
+)
+
+// Lint is a likely mistake in an expression that parses.
+type Lint struct {
+	Code    string
+	Message string
+	posrange.PositionRange
+}
+
+// counterSuffixes are the name suffixes of counters and of the counter parts of
+// summaries and histograms.
+var counterSuffixes = []string{"_total", "_count", "_sum", "_bucket"}
+
+// LintExpr looks for common mistakes in expr, templateLabels are the labels each template
+// (by the template name) reads from the series the expression returns.
+func LintExpr(expr parser.Expr, templateLabels map[string][]string) []*Lint {
+	var lints []*Lint
+	Inspect(expr, func(e parser.Expr) bool {
+		call, ok := e.(*parser.Call)
+		if !ok {
+			return true
+		}
+		switch call.Func.Name {
+		case "rate", "irate", "increase":
+			if name, ok := matrixMetricName(call.Args[0]); ok && !isCounterName(name) {
+				lints = append(lints, &Lint{
+					Code:          LintRateOverGauge,
+					Message:       fmt.Sprintf("%s() over %q, which is not named like a counter, use deriv() or delta() for gauges", call.Func.Name, name),
+					PositionRange: call.PosRange,
+				})
+			}
+		case "delta", "idelta", "deriv":
+			if name, ok := matrixMetricName(call.Args[0]); ok && strings.HasSuffix(name, "_total") {
+				lints = append(lints, &Lint{
+					Code:          LintDeltaOverCounter,
+					Message:       fmt.Sprintf("%s() over the counter %q ignores counter resets, use rate() or increase()", call.Func.Name, name),
+					PositionRange: call.PosRange,
+				})
+			}
+		case "absent":
+			lints = append(lints, lintAbsent(call)...)
+		}
+		return true
+	})
+
+	names := make([]string, 0, len(templateLabels))
+	for name := range templateLabels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	output := outputLabels(expr)
+	for _, name := range names {
+		for _, label := range templateLabels[name] {
+			if output.has(label) {
+				continue
+			}
+			at := output.closedBy
+			if dropped, ok := output.dropped[label]; ok {
+				at = dropped
+			}
+			lints = append(lints, &Lint{
+				Code:          LintMissingTemplateLabel,
+				Message:       fmt.Sprintf("the %s reads the label %q, which the expression does not return", name, label),
+				PositionRange: at.PositionRange(),
+			})
+		}
+	}
+	return lints
+}
+
+func lintAbsent(call *parser.Call) []*Lint {
+	switch arg := unwrapParens(call.Args[0]).(type) {
+	case *parser.VectorSelector:
+		var lints []*Lint
+		for _, m := range arg.LabelMatchers {
+			if m.Type == labels.MatchEqual || m.Name == labels.MetricName {
+				continue
+			}
+			lints = append(lints, &Lint{
+				Code:          LintAbsentMatcherLabels,
+				Message:       fmt.Sprintf("absent() only keeps the labels of equality matchers, the series it returns has no %q label", m.Name),
+				PositionRange: call.PosRange,
+			})
+		}
+		return lints
+	case *parser.AggregateExpr, *parser.BinaryExpr, *parser.Call:
+		return []*Lint{{
+			Code:          LintAbsentWithoutLabels,
+			Message:       "absent() over anything but a vector selector returns a series without labels, use absent() on the selector",
+			PositionRange: call.PosRange,
+		}}
+	}
+	return nil
+}
+
+func matrixMetricName(e parser.Expr) (string, bool) {
+	matrix, ok := unwrapParens(e).(*parser.MatrixSelector)
+	if !ok {
+		return "", false
+	}
+	selector, ok := matrix.VectorSelector.(*parser.VectorSelector)
+	if !ok {
+		return "", false
+	}
+	name := selectorName(selector)
+	return name, name != ""
+}
+
+func selectorName(selector *parser.VectorSelector) string {
+	if selector.Name != "" {
+		return selector.Name
+	}
+	for _, m := range selector.LabelMatchers {
+		if m.Name == labels.MetricName && m.Type == labels.MatchEqual {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+func isCounterName(name string) bool {
+	for _, suffix := range counterSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MetricNames returns the sorted names of the metrics expr selects.
+func MetricNames(expr parser.Expr) []string {
+	var names []string
+	Inspect(expr, func(e parser.Expr) bool {
+		if selector, ok := e.(*parser.VectorSelector); ok {
+			if name := selectorName(selector); name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+		return true
+	})
+	sort.Strings(names)
+	return names
+}
+
+// labelSet is what is known of the labels of the series an expression returns: when
+// open it is any label but the dropped ones, else exactly labels.
+type labelSet struct {
+	open   bool
+	labels map[string]bool
+	// closedBy is the node that restricted a closed set to its labels
+	closedBy parser.Expr
+	// dropped are the labels an open set lost, by the node that dropped them
+	dropped map[string]parser.Expr
+}
+
+func openLabels() labelSet {
+	return labelSet{open: true, dropped: map[string]parser.Expr{}}
+}
+
+func closedLabels(by parser.Expr, labels ...string) labelSet {
+	set := labelSet{labels: map[string]bool{}, closedBy: by}
+	for _, label := range labels {
+		set.labels[label] = true
+	}
+	return set
+}
+
+func (s labelSet) has(label string) bool {
+	if s.open {
+		_, dropped := s.dropped[label]
+		return !dropped
+	}
+	return s.labels[label]
+}
+
+func (s labelSet) with(labels ...string) labelSet {
+	next := s.clone()
+	for _, label := range labels {
+		next.labels[label] = true
+		delete(next.dropped, label)
+	}
+	return next
+}
+
+func (s labelSet) without(by parser.Expr, labels ...string) labelSet {
+	next := s.clone()
+	for _, label := range labels {
+		delete(next.labels, label)
+		if next.open {
+			next.dropped[label] = by
+		}
+	}
+	return next
+}
+
+func (s labelSet) union(other labelSet) labelSet {
+	switch {
+	case s.open && other.open:
+		next := openLabels()
+		for label, by := range s.dropped {
+			if _, ok := other.dropped[label]; ok {
+				next.dropped[label] = by
+			}
+		}
+		return next
+	case s.open:
+		return s.with(keys(other.labels)...)
+	case other.open:
+		return other.with(keys(s.labels)...)
+	}
+	return s.with(keys(other.labels)...)
+}
+
+func (s labelSet) clone() labelSet {
+	next := labelSet{open: s.open, closedBy: s.closedBy, labels: map[string]bool{}, dropped: map[string]parser.Expr{}}
+	for label := range s.labels {
+		next.labels[label] = true
+	}
+	for label, by := range s.dropped {
+		next.dropped[label] = by
+	}
+	return next
+}
+
+func keys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+// outputLabels works out the labels of the series expr returns.
+func outputLabels(expr parser.Expr) labelSet {
+	switch e := expr.(type) {
+	case *parser.ParenExpr:
+		return outputLabels(e.Expr)
+	case *parser.UnaryExpr:
+		return outputLabels(e.Expr)
+	case *parser.VectorSelector, *parser.MatrixSelector, *parser.SubqueryExpr:
+		return openLabels()
+	case *parser.AggregateExpr:
+		return aggregateLabels(e)
+	case *parser.Call:
+		return callLabels(e)
+	case *parser.BinaryExpr:
+		return binaryLabels(e)
+	}
+	return closedLabels(expr)
+}
+
+func aggregateLabels(e *parser.AggregateExpr) labelSet {
+	var set labelSet
+	switch {
+	case e.Op == parser.TOPK || e.Op == parser.BOTTOMK || e.Op == parser.LIMITK || e.Op == parser.LIMIT_RATIO:
+		return outputLabels(e.Expr)
+	case e.Without:
+		set = outputLabels(e.Expr).without(e, e.Grouping...)
+	default:
+		set = closedLabels(e, e.Grouping...)
+	}
+	if label, ok := unwrapParens(e.Param).(*parser.StringLiteral); ok && e.Op == parser.COUNT_VALUES {
+		set = set.with(label.Val)
+	}
+	return set
+}
+
+func callLabels(e *parser.Call) labelSet {
+	switch e.Func.Name {
+	case "absent", "absent_over_time":
+		arg := unwrapParens(e.Args[0])
+		if matrix, ok := arg.(*parser.MatrixSelector); ok {
+			arg = matrix.VectorSelector
+		}
+		set := closedLabels(e)
+		if selector, ok := arg.(*parser.VectorSelector); ok {
+			for _, m := range selector.LabelMatchers {
+				if m.Type == labels.MatchEqual && m.Name != labels.MetricName {
+					set.labels[m.Name] = true
+				}
+			}
+		}
+		return set
+	case "label_replace", "label_join":
+		set := outputLabels(e.Args[0])
+		if dst, ok := unwrapParens(e.Args[1]).(*parser.StringLiteral); ok {
+			set = set.with(dst.Val)
+		}
+		return set
+	case "histogram_quantile":
+		return outputLabels(e.Args[1]).without(e, "le")
+	}
+	for _, arg := range e.Args {
+		if t := arg.Type(); t == parser.ValueTypeVector || t == parser.ValueTypeMatrix {
+			return outputLabels(arg)
+		}
+	}
+	return closedLabels(e)
+}
+
+func binaryLabels(e *parser.BinaryExpr) labelSet {
+	switch {
+	case e.LHS.Type() == parser.ValueTypeScalar:
+		return outputLabels(e.RHS)
+	case e.RHS.Type() == parser.ValueTypeScalar:
+		return outputLabels(e.LHS)
+	}
+	matching := e.VectorMatching
+	switch e.Op {
+	case parser.LAND, parser.LUNLESS:
+		return outputLabels(e.LHS)
+	case parser.LOR:
+		return outputLabels(e.LHS).union(outputLabels(e.RHS))
+	}
+	switch matching.Card {
+	case parser.CardManyToOne:
+		return outputLabels(e.LHS).with(matching.Include...)
+	case parser.CardOneToMany:
+		return outputLabels(e.RHS).with(matching.Include...)
+	}
+	if matching.On {
+		return closedLabels(e, matching.MatchingLabels...)
+	}
+	return outputLabels(e.LHS).without(e, matching.MatchingLabels...)
+}
@@ -0,0 +1,118 @@
+package promql_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aide-family/marksman/internal/biz/promql"
+)
+
+func lint(t *testing.T, input string, templateLabels map[string][]string) []*promql.Lint {
+	t.Helper()
+	expr, err := promql.ParseExpr(input)
+	if err != nil {
+		t.Fatalf("parse %s: %v", input, err)
+	}
+	return promql.LintExpr(expr, templateLabels)
+}
+
+func TestLintCounters(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		codes []string
+	}{
+		{`rate(http_requests_total[5m])`, nil},
+		{`increase(http_request_duration_seconds_count[5m])`, nil},
+		{`rate(node_memory_MemFree_bytes[5m])`, []string{promql.LintRateOverGauge}},
+		{`irate({__name__="queue_length"}[1m])`, []string{promql.LintRateOverGauge}},
+		{`deriv(node_memory_MemFree_bytes[5m])`, nil},
+		{`delta(http_requests_total[5m])`, []string{promql.LintDeltaOverCounter}},
+		{`rate(up[5m]) + delta(errors_total[5m])`, []string{promql.LintRateOverGauge, promql.LintDeltaOverCounter}},
+	} {
+		var codes []string
+		for _, l := range lint(t, tc.input, nil) {
+			codes = append(codes, l.Code)
+		}
+		if !slices.Equal(codes, tc.codes) {
+			t.Errorf("%s: got lints %v, want %v", tc.input, codes, tc.codes)
+		}
+	}
+}
+
+func TestLintTemplateLabels(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		missing []string
+		// at is the text the first lint points at
+		at string
+	}{
+		{`up == 0`, nil, ""},
+		{`sum by (job) (up) == 0`, []string{"instance"}, "sum by (job) (up)"},
+		{`sum by (job, instance) (up) == 0`, nil, ""},
+		{`sum without (instance) (up) == 0`, []string{"instance"}, "sum without (instance) (up)"},
+		{`topk(3, up)`, nil, ""},
+		{`count(up) > 0`, []string{"instance", "job"}, "count(up)"},
+		{`a * on (job) b`, []string{"instance"}, "a * on (job) b"},
+		{`a * on (job) group_left (instance) b`, nil, ""},
+		{`a / ignoring (instance) b`, []string{"instance"}, "a / ignoring (instance) b"},
+		{`sum by (job) (a) or up`, nil, ""},
+		{`absent(up{job="api"})`, []string{"instance"}, `absent(up{job="api"})`},
+		{`label_replace(sum by (job) (up), "instance", "$1", "job", "(.*)")`, nil, ""},
+		{`vector(1)`, []string{"instance", "job"}, "vector(1)"},
+	} {
+		lints := lint(t, tc.input, map[string][]string{"summary": {"job", "instance"}})
+		var missing []string
+		for _, l := range lints {
+			if l.Code != promql.LintMissingTemplateLabel {
+				continue
+			}
+			if !strings.Contains(l.Message, "summary") {
+				t.Errorf("%s: got message %q, want it to name the summary", tc.input, l.Message)
+			}
+			if len(missing) == 0 && tc.input[l.Start:l.End] != tc.at {
+				t.Errorf("%s: got lint at %q, want %q", tc.input, tc.input[l.Start:l.End], tc.at)
+			}
+			for _, label := range []string{"job", "instance"} {
+				if strings.Contains(l.Message, `"`+label+`"`) {
+					missing = append(missing, label)
+				}
+			}
+		}
+		slices.Sort(missing)
+		if !slices.Equal(missing, tc.missing) {
+			t.Errorf("%s: got missing labels %v, want %v", tc.input, missing, tc.missing)
+		}
+	}
+}
+
+func TestLintAbsent(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		codes []string
+	}{
+		{`absent(up{job="api"})`, nil},
+		{`absent(up{job=~"api.*"})`, []string{promql.LintAbsentMatcherLabels}},
+		{`absent(sum(up))`, []string{promql.LintAbsentWithoutLabels}},
+		{`absent(up > 0)`, []string{promql.LintAbsentWithoutLabels}},
+		{`absent_over_time(up[5m])`, nil},
+	} {
+		var codes []string
+		for _, l := range lint(t, tc.input, nil) {
+			codes = append(codes, l.Code)
+		}
+		if !slices.Equal(codes, tc.codes) {
+			t.Errorf("%s: got lints %v, want %v", tc.input, codes, tc.codes)
+		}
+	}
+}
+
+func TestMetricNames(t *testing.T) {
+	expr, err := promql.ParseExpr(`sum(rate(b_total[5m])) / sum(rate(a_total[5m])) > on () group_left {__name__="b_total"} or {job="x"}`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got := promql.MetricNames(expr); !slices.Equal(got, []string{"a_total", "b_total"}) {
+		t.Fatalf("got metric names %v", got)
+	}
+}
@@ -0,0 +1,87 @@
+// Package promql checks Prometheus query expressions parsed by the Prometheus parser, so
+// that metric strategies are checked on save and their mistakes are pointed at by position.
+package promql
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/promql/parser/posrange"
+)
+
+// ParseError locates what could not be parsed, Line and Column count from 1 and
+// Column counts characters.
+type ParseError struct {
+	posrange.PositionRange
+	Line   int
+	Column int
+	Err    string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Err)
+}
+
+// ParseExpr parses input with the Prometheus parser, the first error it reports is
+// returned as a *ParseError.
+func ParseExpr(input string) (parser.Expr, error) {
+	expr, err := parser.ParseExpr(input)
+	if err == nil {
+		return expr, nil
+	}
+	var errs parser.ParseErrors
+	if !errors.As(err, &errs) || len(errs) == 0 {
+		return nil, err
+	}
+	r := errs[0].PositionRange
+	start := min(max(int(r.Start), 0), len(input))
+	prefix := input[:start]
+	return nil, &ParseError{
+		PositionRange: r,
+		Line:          strings.Count(prefix, "\n") + 1,
+		Column:        utf8.RuneCountInString(prefix[strings.LastIndexByte(prefix, '\n')+1:]) + 1,
+		Err:           errs[0].Err.Error(),
+	}
+}
+
+// Children returns the direct sub-expressions of e in the order they are written.
+func Children(e parser.Expr) []parser.Expr {
+	var children []parser.Expr
+	for _, node := range parser.Children(e) {
+		if child, ok := node.(parser.Expr); ok {
+			children = append(children, child)
+		}
+	}
+	// the parameter of an aggregation, e.g. the 3 of topk(3, up), comes before its expression
+	slices.SortStableFunc(children, func(a, b parser.Expr) int {
+		return cmp.Compare(a.PositionRange().Start, b.PositionRange().Start)
+	})
+	return children
+}
+
+// Inspect calls f for e and its descendants depth first, the children of a node are
+// skipped when f returns false.
+func Inspect(e parser.Expr, f func(parser.Expr) bool) {
+	if !f(e) {
+		return
+	}
+	for _, child := range Children(e) {
+		Inspect(child, f)
+	}
+}
+
+// unwrapParens returns the expression inside any parentheses.
+func unwrapParens(e parser.Expr) parser.Expr {
+	for {
+		paren, ok := e.(*parser.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = paren.Expr
+	}
+}
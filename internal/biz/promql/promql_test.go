@@ -0,0 +1,53 @@
+package promql_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/aide-family/marksman/internal/biz/promql"
+)
+
+func TestParseExprErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		line   int
+		column int
+		err    string
+	}{
+		{`sum(rate(http_requests_total[5m])`, 1, 34, "unclosed left parenthesis"},
+		{`rate(up)`, 1, 6, `expected type range vector in call to function "rate", got instant vector`},
+		{"sum(up)\n  by (job) $", 2, 12, `unexpected character: '$'`},
+		{`up{job="a"} > ü`, 1, 15, "unexpected character"},
+	} {
+		_, err := promql.ParseExpr(tc.input)
+		var parseErr *promql.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%s: got error %v, want a parse error", tc.input, err)
+			continue
+		}
+		if parseErr.Line != tc.line || parseErr.Column != tc.column || !strings.Contains(parseErr.Err, tc.err) {
+			t.Errorf("%s: got %v, want %d:%d: %s", tc.input, err, tc.line, tc.column, tc.err)
+		}
+	}
+}
+
+func TestChildren(t *testing.T) {
+	input := `topk(3, rate(http_requests_total[5m])) > 1`
+	expr, err := promql.ParseExpr(input)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	var texts []string
+	promql.Inspect(expr, func(e parser.Expr) bool {
+		r := e.PositionRange()
+		texts = append(texts, input[r.Start:r.End])
+		return true
+	})
+	want := []string{input, `topk(3, rate(http_requests_total[5m]))`, "3", `rate(http_requests_total[5m])`, `http_requests_total[5m]`, `http_requests_total`, "1"}
+	if strings.Join(texts, "|") != strings.Join(want, "|") {
+		t.Fatalf("got nodes %q, want them in the order they are written %q", texts, want)
+	}
+}
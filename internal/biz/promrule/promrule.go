@@ -0,0 +1,141 @@
+// Package promrule reads and writes Prometheus rule files, the groups/rules YAML
+// loaded by Prometheus and embedded in the spec of PrometheusRule resources.
+package promrule
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"go.yaml.in/yaml/v2"
+)
+
+// File is a rule file, the spec of a PrometheusRule has the same shape.
+type File struct {
+	Groups []*Group `yaml:"groups"`
+}
+
+type Group struct {
+	Name     string            `yaml:"name"`
+	Interval model.Duration    `yaml:"interval,omitempty"`
+	Labels   map[string]string `yaml:"labels,omitempty"`
+	Rules    []*Rule           `yaml:"rules"`
+}
+
+// Rule is an alerting rule when Alert is set, a recording rule when Record is.
+type Rule struct {
+	Record        string            `yaml:"record,omitempty"`
+	Alert         string            `yaml:"alert,omitempty"`
+	Expr          string            `yaml:"expr"`
+	For           model.Duration    `yaml:"for,omitempty"`
+	KeepFiringFor model.Duration    `yaml:"keep_firing_for,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
+	Annotations   map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Parse decodes and validates a rule file, unknown fields are rejected the same as Prometheus does.
+func Parse(content []byte) (*File, error) {
+	var f File
+	if err := yaml.UnmarshalStrict(content, &f); err != nil {
+		return nil, err
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Validate checks that the groups are named uniquely and that every rule is either
+// an alerting or a recording rule with an expression.
+func (f *File) Validate() error {
+	var errs []error
+	names := make(map[string]struct{}, len(f.Groups))
+	for i, group := range f.Groups {
+		if group.Name == "" {
+			errs = append(errs, fmt.Errorf("group %d: name is required", i))
+			continue
+		}
+		if _, ok := names[group.Name]; ok {
+			errs = append(errs, fmt.Errorf("group %q: name is repeated", group.Name))
+		}
+		names[group.Name] = struct{}{}
+		for j, rule := range group.Rules {
+			switch {
+			case rule.Alert == "" && rule.Record == "":
+				errs = append(errs, fmt.Errorf("group %q: rule %d: one of alert and record is required", group.Name, j))
+			case rule.Alert != "" && rule.Record != "":
+				errs = append(errs, fmt.Errorf("group %q: rule %d: only one of alert and record is allowed", group.Name, j))
+			case strings.TrimSpace(rule.Expr) == "":
+				errs = append(errs, fmt.Errorf("group %q: rule %d: expr is required", group.Name, j))
+			case rule.Record != "" && (rule.For != 0 || rule.KeepFiringFor != 0 || len(rule.Annotations) > 0):
+				errs = append(errs, fmt.Errorf("group %q: rule %d: recording rule %q only takes expr and labels", group.Name, j, rule.Record))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Marshal encodes the file as YAML.
+func (f *File) Marshal() ([]byte, error) {
+	return yaml.Marshal(f)
+}
+
+// Threshold is the trailing comparison of an alerting expression against a constant,
+// e.g. ">" and 80 for `cpu_usage > 80`.
+type Threshold struct {
+	// Expr is the expression on the left of the comparison.
+	Expr  string
+	Op    string
+	Value int64
+}
+
+// SplitThreshold splits the trailing comparison off expr when it compares with an integer,
+// so that the threshold can be evaluated by a level. ok is false when expr does not parse,
+// does not end with such a comparison, or when the split would change its meaning, e.g.
+// because of a set operator (and, or, unless) that binds weaker than the comparison.
+func SplitThreshold(expr string) (threshold *Threshold, ok bool) {
+	root, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, false
+	}
+	binary, ok := root.(*parser.BinaryExpr)
+	if !ok || !binary.Op.IsComparisonOperator() || binary.ReturnBool || binary.LHS.Type() != parser.ValueTypeVector {
+		return nil, false
+	}
+	number, ok := binary.RHS.(*parser.NumberLiteral)
+	if !ok || number.Val != math.Trunc(number.Val) || math.Abs(number.Val) >= math.MaxInt64 {
+		return nil, false
+	}
+	left := strings.TrimSpace(expr[:binary.LHS.PositionRange().End])
+	return &Threshold{Expr: left, Op: binary.Op.String(), Value: int64(number.Val)}, true
+}
+
+// Compare appends the comparison with value to expr, the inverse of SplitThreshold.
+// expr is put in parentheses when the comparison would otherwise bind to a part of it,
+// or to a comment at its end.
+func Compare(expr, op string, value int64) string {
+	expr = strings.TrimSpace(expr)
+	root, err := parser.ParseExpr(expr)
+	switch {
+	case err != nil:
+		expr = "(" + expr + ")"
+	case int(root.PositionRange().End) < len(expr):
+		// only a comment follows the expression
+		expr = "(" + expr + "\n)"
+	default:
+		if binary, ok := root.(*parser.BinaryExpr); ok && binary.Op.IsSetOperator() {
+			expr = "(" + expr + ")"
+		}
+	}
+	return expr + " " + op + " " + strconv.FormatInt(value, 10)
+}
+
+// IsRangeVector reports whether expr evaluates to a range vector, e.g. `up[5m]` or a subquery.
+func IsRangeVector(expr string) bool {
+	root, err := parser.ParseExpr(expr)
+	return err == nil && root.Type() == parser.ValueTypeMatrix
+}
@@ -0,0 +1,158 @@
+package promrule_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aide-family/marksman/internal/biz/promrule"
+)
+
+const ruleFile = `
+groups:
+  - name: node
+    interval: 30s
+    rules:
+      - alert: HighCPU
+        expr: avg by (instance) (rate(node_cpu_seconds_total{mode!="idle"}[5m])) * 100 > 80
+        for: 5m
+        labels:
+          severity: warning
+        annotations:
+          summary: "{{ $labels.instance }} cpu is at {{ $value }}"
+      - record: instance:cpu:rate5m
+        expr: rate(node_cpu_seconds_total[5m])
+`
+
+func TestParse(t *testing.T) {
+	f, err := promrule.Parse([]byte(ruleFile))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(f.Groups) != 1 || len(f.Groups[0].Rules) != 2 {
+		t.Fatalf("unexpected file %+v", f)
+	}
+	group := f.Groups[0]
+	if group.Name != "node" || time.Duration(group.Interval) != 30*time.Second {
+		t.Fatalf("unexpected group %+v", group)
+	}
+	rule := group.Rules[0]
+	if rule.Alert != "HighCPU" || time.Duration(rule.For) != 5*time.Minute || rule.Labels["severity"] != "warning" {
+		t.Fatalf("unexpected rule %+v", rule)
+	}
+
+	out, err := f.Marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	again, err := promrule.Parse(out)
+	if err != nil {
+		t.Fatalf("parse marshalled file: %v\n%s", err, out)
+	}
+	if again.Groups[0].Rules[0].Expr != rule.Expr || again.Groups[0].Rules[1].Record != "instance:cpu:rate5m" {
+		t.Fatalf("marshalled file does not round trip:\n%s", out)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"unknown field":   "groups:\n  - name: a\n    rulez: []\n",
+		"missing name":    "groups:\n  - rules: []\n",
+		"repeated group":  "groups:\n  - name: a\n  - name: a\n",
+		"no alert":        "groups:\n  - name: a\n    rules:\n      - expr: up\n",
+		"no expr":         "groups:\n  - name: a\n    rules:\n      - alert: Down\n",
+		"record with for": "groups:\n  - name: a\n    rules:\n      - record: b\n        expr: up\n        for: 1m\n",
+	} {
+		if _, err := promrule.Parse([]byte(content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSplitThreshold(t *testing.T) {
+	for _, tc := range []struct {
+		expr  string
+		want  *promrule.Threshold
+		split bool
+	}{
+		{expr: "up == 0", want: &promrule.Threshold{Expr: "up", Op: "==", Value: 0}, split: true},
+		{expr: `sum by (job) (rate(http_requests_total{code=~"5.."}[5m])) > 10`, want: &promrule.Threshold{Expr: `sum by (job) (rate(http_requests_total{code=~"5.."}[5m]))`, Op: ">", Value: 10}, split: true},
+		{expr: "a + b >= -3", want: &promrule.Threshold{Expr: "a + b", Op: ">=", Value: -3}, split: true},
+		{expr: "(a > 1) <= 5", want: &promrule.Threshold{Expr: "(a > 1)", Op: "<=", Value: 5}, split: true},
+		{expr: `x{path="a>1"} != 2`, want: &promrule.Threshold{Expr: `x{path="a>1"}`, Op: "!=", Value: 2}, split: true},
+		{expr: "x > 1_000", want: &promrule.Threshold{Expr: "x", Op: ">", Value: 1000}, split: true},
+		{expr: "x > 0x10", want: &promrule.Threshold{Expr: "x", Op: ">", Value: 16}, split: true},
+		{expr: "time() - x > 5m", want: &promrule.Threshold{Expr: "time() - x", Op: ">", Value: 300}, split: true},
+		{expr: `x @ -100 offset 60 < 2`, want: &promrule.Threshold{Expr: "x @ -100 offset 60", Op: "<", Value: 2}, split: true},
+		{expr: "x AND y > 1"},
+		{expr: "1 > 0"},
+		{expr: "x{ > 1"},
+		{expr: "rate(x[5m]) > 0.5"},
+		{expr: "a > 5 and b > 3"},
+		{expr: "a > bool 3"},
+		{expr: "absent(up)"},
+		{expr: "a > b"},
+	} {
+		got, ok := promrule.SplitThreshold(tc.expr)
+		if ok != tc.split {
+			t.Errorf("%s: got split %v, want %v", tc.expr, ok, tc.split)
+			continue
+		}
+		if ok && *got != *tc.want {
+			t.Errorf("%s: got %+v, want %+v", tc.expr, *got, *tc.want)
+		}
+	}
+}
+
+func TestSplitThresholdKeepsComments(t *testing.T) {
+	if _, ok := promrule.SplitThreshold("up # > 3"); ok {
+		t.Fatal("comparison in a comment was split")
+	}
+	got, ok := promrule.SplitThreshold("up # down\n== 0")
+	if !ok || !strings.HasPrefix(got.Expr, "up") || got.Op != "==" {
+		t.Fatalf("got %+v, %v", got, ok)
+	}
+}
+
+func TestIsRangeVector(t *testing.T) {
+	for expr, want := range map[string]bool{
+		"up[5m]":              true,
+		"rate(up[5m])[1h:1m]": true,
+		"up[300] offset 1m":   true,
+		"up # [5m]":           false,
+		"rate(up[5m])":        false,
+		`label_replace(up, "a", "$1", "b", "[x]")`: false,
+	} {
+		if got := promrule.IsRangeVector(expr); got != want {
+			t.Errorf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		expr string
+		op   string
+		want string
+	}{
+		{expr: "up", op: "==", want: "up == 0"},
+		{expr: " a + b ", op: ">=", want: "a + b >= 0"},
+		{expr: "a > 1", op: "<=", want: "a > 1 <= 0"},
+		{expr: "a or b", op: ">", want: "(a or b) > 0"},
+		{expr: "up # comment", op: "<", want: "(up # comment\n) < 0"},
+		{expr: "a # comment\nor b", op: ">", want: "(a # comment\nor b) > 0"},
+		{expr: "a # or b", op: ">", want: "(a # or b\n) > 0"},
+		{expr: "x > 1_000", op: "<", want: "x > 1_000 < 0"},
+		{expr: "x{", op: "<", want: "(x{) < 0"},
+	} {
+		if got := promrule.Compare(tc.expr, tc.op, 0); got != tc.want {
+			t.Errorf("%q: got %q, want %q", tc.expr, got, tc.want)
+		}
+	}
+	for _, expr := range []string{"up", `sum by (job) (rate(x{code=~"5.."}[5m]))`, "a + b"} {
+		got, ok := promrule.SplitThreshold(promrule.Compare(expr, ">", 10))
+		if !ok || got.Expr != expr || got.Op != ">" || got.Value != 10 {
+			t.Errorf("%q does not split back after compare: got %+v, %v", expr, got, ok)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewReceiver(
+	receiverRepo repository.Receiver,
+	helper *klog.Helper,
+) *ReceiverBiz {
+	return &ReceiverBiz{
+		receiverRepo: receiverRepo,
+		helper:       klog.NewHelper(klog.With(helper.Logger(), "biz", "receiver")),
+	}
+}
+
+type ReceiverBiz struct {
+	helper       *klog.Helper
+	receiverRepo repository.Receiver
+}
+
+func (r *ReceiverBiz) CreateReceiver(ctx context.Context, req *bo.CreateReceiverBo) error {
+	if err := r.receiverRepo.CreateReceiver(ctx, req); err != nil {
+		r.helper.Errorw("msg", "create receiver failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create receiver failed").WithCause(err)
+	}
+	return nil
+}
+
+func (r *ReceiverBiz) UpdateReceiver(ctx context.Context, req *bo.UpdateReceiverBo) error {
+	if err := r.receiverRepo.UpdateReceiver(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("receiver %d not found", req.UID.Int64())
+		}
+		r.helper.Errorw("msg", "update receiver failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update receiver failed").WithCause(err)
+	}
+	return nil
+}
+
+func (r *ReceiverBiz) UpdateReceiverStatus(ctx context.Context, req *bo.UpdateReceiverStatusBo) error {
+	if err := r.receiverRepo.UpdateReceiverStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("receiver %d not found", req.UID.Int64())
+		}
+		r.helper.Errorw("msg", "update receiver status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update receiver status failed").WithCause(err)
+	}
+	return nil
+}
+
+func (r *ReceiverBiz) DeleteReceiver(ctx context.Context, uid snowflake.ID) error {
+	if err := r.receiverRepo.DeleteReceiver(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("receiver %d not found", uid.Int64())
+		}
+		r.helper.Errorw("msg", "delete receiver failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete receiver failed").WithCause(err)
+	}
+	return nil
+}
+
+func (r *ReceiverBiz) GetReceiver(ctx context.Context, uid snowflake.ID) (*bo.ReceiverItemBo, error) {
+	item, err := r.receiverRepo.GetReceiver(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("receiver %d not found", uid.Int64())
+		}
+		r.helper.Errorw("msg", "get receiver failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get receiver failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (r *ReceiverBiz) ListReceiver(ctx context.Context, req *bo.ListReceiverBo) (*bo.PageResponseBo[*bo.ReceiverItemBo], error) {
+	result, err := r.receiverRepo.ListReceiver(ctx, req)
+	if err != nil {
+		r.helper.Errorw("msg", "list receiver failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list receiver failed").WithCause(err)
+	}
+	return result, nil
+}
+
+func (r *ReceiverBiz) ListReceiverDelivery(ctx context.Context, req *bo.ListReceiverDeliveryBo) (*bo.PageResponseBo[*bo.ReceiverDeliveryItemBo], error) {
+	result, err := r.receiverRepo.ListReceiverDelivery(ctx, req)
+	if err != nil {
+		r.helper.Errorw("msg", "list receiver delivery failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list receiver delivery failed").WithCause(err)
+	}
+	return result, nil
+}
+
+// checkReceiverUIDs makes sure every uid is a receiver or an on-call schedule of the current
+// namespace, the two are accepted wherever receiver uids are bound.
+func checkReceiverUIDs(ctx context.Context, helper *klog.Helper, receiverRepo repository.Receiver, onCallScheduleRepo repository.OnCallSchedule, receiverUIDs ...snowflake.ID) error {
+	for _, receiverUID := range receiverUIDs {
+		_, err := receiverRepo.GetReceiver(ctx, receiverUID)
+		if err != nil && merr.IsNotFound(err) {
+			_, err = onCallScheduleRepo.GetOnCallSchedule(ctx, receiverUID, time.Now())
+		}
+		if err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("receiver %d not found", receiverUID.Int64())
+			}
+			helper.Errorw("msg", "get receiver failed", "error", err, "receiverUID", receiverUID)
+			return merr.ErrorInternalServer("get receiver failed").WithCause(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type Aggregation interface {
+	SaveAggregation(ctx context.Context, req *bo.SaveAggregationBo) error
+	GetAggregation(ctx context.Context, strategyGroupUID snowflake.ID) (*bo.AggregationItemBo, error)
+	DeleteAggregation(ctx context.Context, strategyGroupUID snowflake.ID) error
+	// GetStrategyAggregation returns the aggregation of the strategy group of a strategy,
+	// nil when the group has none. It is not scoped to the namespace of ctx.
+	GetStrategyAggregation(ctx context.Context, namespaceUID, strategyUID snowflake.ID) (*bo.AggregationItemBo, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+// AlertState checkpoints evaluator alerts, it is not scoped to a namespace.
+type AlertState interface {
+	ListAlertStates(ctx context.Context, strategyUID snowflake.ID) ([]*bo.AlertStateBo, error)
+	// SaveAlertStates upserts the checkpointed alerts of a strategy and deletes the alerts that are gone.
+	// The manual resolve of an alert is kept, the saved states resolved by hand are returned.
+	SaveAlertStates(ctx context.Context, strategyUID snowflake.ID, states []*bo.AlertStateBo) ([]*bo.AlertStateBo, error)
+}
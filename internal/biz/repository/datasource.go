@@ -14,4 +14,6 @@ type Datasource interface {
 	DeleteDatasource(ctx context.Context, uid snowflake.ID) error
 	GetDatasource(ctx context.Context, uid snowflake.ID) (*bo.DatasourceItemBo, error)
 	ListDatasource(ctx context.Context, req *bo.ListDatasourceBo) (*bo.PageResponseBo[*bo.DatasourceItemBo], error)
+	// RotateDatasourceSecrets re-encrypts the secrets of all namespaces and returns the number of changed datasources.
+	RotateDatasourceSecrets(ctx context.Context) (int, error)
 }
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type EscalationPolicy interface {
+	// CreateEscalationPolicy creates the policy and attaches it to its strategy groups and levels.
+	CreateEscalationPolicy(ctx context.Context, req *bo.CreateEscalationPolicyBo) error
+	// UpdateEscalationPolicy updates the policy and replaces its strategy groups and levels.
+	UpdateEscalationPolicy(ctx context.Context, req *bo.UpdateEscalationPolicyBo) error
+	UpdateEscalationPolicyStatus(ctx context.Context, req *bo.UpdateEscalationPolicyStatusBo) error
+	// DeleteEscalationPolicy deletes the policy with its bindings and cancels its pending escalations.
+	DeleteEscalationPolicy(ctx context.Context, uid snowflake.ID) error
+	GetEscalationPolicy(ctx context.Context, uid snowflake.ID) (*bo.EscalationPolicyItemBo, error)
+	ListEscalationPolicy(ctx context.Context, req *bo.ListEscalationPolicyBo) (*bo.PageResponseBo[*bo.EscalationPolicyItemBo], error)
+	// ListEscalationPolicyBindings returns the bindings of the strategy groups and levels to any policy.
+	ListEscalationPolicyBindings(ctx context.Context, strategyGroupUIDs, levelUIDs []snowflake.ID) ([]*bo.EscalationPolicyBindingBo, error)
+	// GetEventEscalationPolicy returns the enabled policy attached to the level of an event, or else
+	// to the strategy group of its strategy, nil when there is none. It is not scoped to the namespace of ctx.
+	GetEventEscalationPolicy(ctx context.Context, namespaceUID, strategyUID, levelUID snowflake.ID) (*bo.EscalationPolicyItemBo, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+)
+
+// EscalationTimer is the store of the timers of the escalator, it is not scoped to a namespace.
+type EscalationTimer interface {
+	// SaveTimer creates the timer of the event, replacing the earlier one.
+	SaveTimer(ctx context.Context, timer *escalator.Timer) error
+	// ListDueTimers returns at most limit timers to fire at now.
+	ListDueTimers(ctx context.Context, now time.Time, limit int) ([]*escalator.Timer, error)
+	// CompleteStep moves the timer to next, or deletes it when next is nil. It reports false
+	// when the timer was cancelled or moved in the meantime.
+	CompleteStep(ctx context.Context, timer *escalator.Timer, next *escalator.Timer) (bool, error)
+	// DeleteTimer cancels the escalation of the event.
+	DeleteTimer(ctx context.Context, eventUID snowflake.ID) error
+}
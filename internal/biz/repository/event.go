@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type Event interface {
+	// SaveEvents opens, refreshes or resolves the events of the series, it is called by the evaluator across namespaces.
+	// It returns the events whose state changed, i.e. the ones just opened or resolved.
+	SaveEvents(ctx context.Context, events []*bo.SaveEventBo) ([]*bo.EventItemBo, error)
+	// StabilizeEvent clears the flapping flag of the latest event of the series of req and returns it,
+	// it is called by the evaluator across namespaces.
+	StabilizeEvent(ctx context.Context, req *bo.SaveEventBo) (*bo.EventItemBo, error)
+	GetEvent(ctx context.Context, uid snowflake.ID) (*bo.EventItemBo, error)
+	ListEvent(ctx context.Context, req *bo.ListEventBo) (*bo.PageResponseBo[*bo.EventItemBo], error)
+	// ListFiringEvents returns the firing events of the namespace, it is not scoped to the namespace of ctx.
+	ListFiringEvents(ctx context.Context, namespaceUID snowflake.ID) ([]*bo.EventItemBo, error)
+	// ListEventTimeline returns the latest state changes of every event of the same series as the event uid.
+	ListEventTimeline(ctx context.Context, req *bo.GetEventTimelineBo) ([]*bo.EventTimelineItemBo, error)
+	// SaveEventActivity applies the activity to the event and appends it to the activities of the event
+	// in one transaction, the actor is the user of ctx. It returns the event after the activity.
+	SaveEventActivity(ctx context.Context, req *bo.EventActivityBo) (*bo.EventItemBo, error)
+	ListEventActivity(ctx context.Context, req *bo.ListEventActivityBo) ([]*bo.EventActivityItemBo, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type InhibitRule interface {
+	CreateInhibitRule(ctx context.Context, req *bo.CreateInhibitRuleBo) error
+	UpdateInhibitRule(ctx context.Context, req *bo.UpdateInhibitRuleBo) error
+	UpdateInhibitRuleStatus(ctx context.Context, req *bo.UpdateInhibitRuleStatusBo) error
+	DeleteInhibitRule(ctx context.Context, uid snowflake.ID) error
+	GetInhibitRule(ctx context.Context, uid snowflake.ID) (*bo.InhibitRuleItemBo, error)
+	ListInhibitRule(ctx context.Context, req *bo.ListInhibitRuleBo) (*bo.PageResponseBo[*bo.InhibitRuleItemBo], error)
+	// ListEnabledInhibitRules returns the enabled rules of the namespace, it is not scoped to the namespace of ctx.
+	ListEnabledInhibitRules(ctx context.Context, namespaceUID snowflake.ID) ([]*bo.InhibitRuleItemBo, error)
+}
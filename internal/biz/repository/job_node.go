@@ -0,0 +1,13 @@
+package repository
+
+import "context"
+
+// JobNode is the membership of the job nodes, it is not scoped to a namespace.
+type JobNode interface {
+	// Heartbeat marks the node alive.
+	Heartbeat(ctx context.Context, nodeID string) error
+	// Leave removes the node, so that the other nodes take over its strategies right away.
+	Leave(ctx context.Context, nodeID string) error
+	// ListJobNodes returns the ids of the live job nodes.
+	ListJobNodes(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Lease is the lease store of the leader election, it is not scoped to a namespace.
+type Lease interface {
+	// AcquireLease renews the lease when holder holds it with token, otherwise takes it over
+	// when it is free, expired or held by holder before. It returns the fencing token of the
+	// holder, or 0 when another node holds the lease.
+	AcquireLease(ctx context.Context, name, holder string, token uint64, ttl time.Duration) (uint64, error)
+	// ReleaseLease frees the lease when holder still holds it with token.
+	ReleaseLease(ctx context.Context, name, holder string, token uint64) error
+	// GetLeaseToken returns the fencing token of the current holder, 0 when the lease is free or expired.
+	GetLeaseToken(ctx context.Context, name string) (uint64, error)
+}
@@ -14,6 +14,7 @@ type Level interface {
 	UpdateLevelStatus(ctx context.Context, req *bo.UpdateLevelStatusBo) error
 	DeleteLevel(ctx context.Context, uid snowflake.ID) error
 	GetLevel(ctx context.Context, uid snowflake.ID) (*bo.LevelItemBo, error)
+	GetLevelByName(ctx context.Context, name string) (*bo.LevelItemBo, error)
 	ListLevel(ctx context.Context, req *bo.ListLevelBo) (*bo.PageResponseBo[*bo.LevelItemBo], error)
 	SelectLevel(ctx context.Context, req *bo.SelectLevelBo) (*bo.SelectLevelBoResult, error)
 }
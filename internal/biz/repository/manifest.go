@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+// Manifest reads and writes the resources manifests declare, it is not scoped to the
+// namespace of ctx so that manifests can be applied without a caller.
+type Manifest interface {
+	// GetManifestState loads the levels, datasources, strategy groups and strategies of a
+	// namespace together with the metrics of the strategies.
+	GetManifestState(ctx context.Context, namespaceUID snowflake.ID) (*bo.ManifestStateBo, error)
+	// ApplyManifestPlan saves and deletes the resources of the plan, all or nothing.
+	ApplyManifestPlan(ctx context.Context, plan *bo.ManifestPlanBo) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+)
+
+// NotifyGroup is the store of the pending groups of the aggregator, it is not scoped to a namespace.
+type NotifyGroup interface {
+	// AddAlerts creates the group unless it exists, scheduling its first flush at group.NextFlushAt,
+	// and saves the messages as not notified, replacing the earlier messages of the same events.
+	AddAlerts(ctx context.Context, group *aggregator.Group, msgs []*notifier.Message) error
+	// ListDueGroups returns at most limit groups to flush at now, with their alerts.
+	ListDueGroups(ctx context.Context, now time.Time, limit int) ([]*aggregator.Group, error)
+	// CompleteFlush marks the sent messages notified unless their event changed in the meantime,
+	// deletes the notified resolved alerts and schedules the group at group.NextFlushAt with
+	// group.LastSentAt. The group is deleted when no alert is left.
+	CompleteFlush(ctx context.Context, group *aggregator.Group, sent []*notifier.Message) error
+	// DeleteEventAlerts removes the alerts of the event from the groups, e.g. when it is acknowledged.
+	DeleteEventAlerts(ctx context.Context, eventUID snowflake.ID) error
+}
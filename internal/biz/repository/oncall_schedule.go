@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type OnCallSchedule interface {
+	CreateOnCallSchedule(ctx context.Context, req *bo.CreateOnCallScheduleBo) error
+	UpdateOnCallSchedule(ctx context.Context, req *bo.UpdateOnCallScheduleBo) error
+	UpdateOnCallScheduleStatus(ctx context.Context, req *bo.UpdateOnCallScheduleStatusBo) error
+	// DeleteOnCallSchedule deletes the schedule with its overrides.
+	DeleteOnCallSchedule(ctx context.Context, uid snowflake.ID) error
+	// GetOnCallSchedule returns the schedule with the overrides not ended at now.
+	GetOnCallSchedule(ctx context.Context, uid snowflake.ID, now time.Time) (*bo.OnCallScheduleItemBo, error)
+	ListOnCallSchedule(ctx context.Context, req *bo.ListOnCallScheduleBo) (*bo.PageResponseBo[*bo.OnCallScheduleItemBo], error)
+	CreateOnCallOverride(ctx context.Context, req *bo.CreateOnCallOverrideBo) error
+	DeleteOnCallOverride(ctx context.Context, req *bo.DeleteOnCallOverrideBo) error
+	// ListOnCallOverride returns the overrides of a schedule in the order they were created.
+	ListOnCallOverride(ctx context.Context, req *bo.ListOnCallOverrideBo) ([]*bo.OnCallOverrideItemBo, error)
+	// ListEnabledOnCallSchedules returns the enabled schedules among uids, with the overrides not ended
+	// at now. The uids that are not schedules are ignored. It is not scoped to the namespace of ctx.
+	ListEnabledOnCallSchedules(ctx context.Context, namespaceUID snowflake.ID, uids []snowflake.ID, now time.Time) ([]*bo.OnCallScheduleItemBo, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type Receiver interface {
+	CreateReceiver(ctx context.Context, req *bo.CreateReceiverBo) error
+	UpdateReceiver(ctx context.Context, req *bo.UpdateReceiverBo) error
+	UpdateReceiverStatus(ctx context.Context, req *bo.UpdateReceiverStatusBo) error
+	DeleteReceiver(ctx context.Context, uid snowflake.ID) error
+	GetReceiver(ctx context.Context, uid snowflake.ID) (*bo.ReceiverItemBo, error)
+	ListReceiver(ctx context.Context, req *bo.ListReceiverBo) (*bo.PageResponseBo[*bo.ReceiverItemBo], error)
+	// ResolveReceiverUIDs returns the uids bound to the level of a strategy, falling back to the ones
+	// bound to the strategy, then to its group. They are receivers or on-call schedules.
+	// It is not scoped to the namespace of ctx.
+	ResolveReceiverUIDs(ctx context.Context, namespaceUID, strategyUID, levelUID snowflake.ID) ([]snowflake.ID, error)
+	// ListEnabledReceivers returns the enabled receivers among uids, the other uids are ignored.
+	// It is not scoped to the namespace of ctx.
+	ListEnabledReceivers(ctx context.Context, namespaceUID snowflake.ID, uids []snowflake.ID) ([]*bo.ReceiverItemBo, error)
+	// GetEnabledReceiver returns the receiver unless it is disabled. It is not scoped to the namespace of ctx.
+	GetEnabledReceiver(ctx context.Context, namespaceUID, uid snowflake.ID) (*bo.ReceiverItemBo, error)
+	CreateReceiverDelivery(ctx context.Context, req *bo.CreateReceiverDeliveryBo) error
+	ListReceiverDelivery(ctx context.Context, req *bo.ListReceiverDeliveryBo) (*bo.PageResponseBo[*bo.ReceiverDeliveryItemBo], error)
+}
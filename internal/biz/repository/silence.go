@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type Silence interface {
+	CreateSilence(ctx context.Context, req *bo.CreateSilenceBo) error
+	UpdateSilence(ctx context.Context, req *bo.UpdateSilenceBo) error
+	DeleteSilence(ctx context.Context, uid snowflake.ID) error
+	GetSilence(ctx context.Context, uid snowflake.ID) (*bo.SilenceItemBo, error)
+	ListSilence(ctx context.Context, req *bo.ListSilenceBo) (*bo.PageResponseBo[*bo.SilenceItemBo], error)
+	// ListUnexpiredSilences returns the silences of the namespace that are active or pending at t.
+	// It is not scoped to the namespace of ctx.
+	ListUnexpiredSilences(ctx context.Context, namespaceUID snowflake.ID, t time.Time) ([]*bo.SilenceItemBo, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type Strategy interface {
+	CreateStrategy(ctx context.Context, req *bo.CreateStrategyBo) error
+	UpdateStrategy(ctx context.Context, req *bo.UpdateStrategyBo) error
+	UpdateStrategyStatus(ctx context.Context, req *bo.UpdateStrategyStatusBo) error
+	DeleteStrategy(ctx context.Context, uid snowflake.ID) error
+	GetStrategy(ctx context.Context, uid snowflake.ID) (*bo.StrategyItemBo, error)
+	GetStrategyByName(ctx context.Context, name string) (*bo.StrategyItemBo, error)
+	ListStrategy(ctx context.Context, req *bo.ListStrategyBo) (*bo.PageResponseBo[*bo.StrategyItemBo], error)
+	CountStrategyByGroup(ctx context.Context, strategyGroupUID snowflake.ID) (int64, error)
+	// ImportStrategies applies an import plan in one transaction.
+	ImportStrategies(ctx context.Context, groups []*bo.ImportStrategyGroupBo) error
+}
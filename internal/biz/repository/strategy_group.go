@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type StrategyGroup interface {
+	CreateStrategyGroup(ctx context.Context, req *bo.CreateStrategyGroupBo) error
+	UpdateStrategyGroup(ctx context.Context, req *bo.UpdateStrategyGroupBo) error
+	UpdateStrategyGroupStatus(ctx context.Context, req *bo.UpdateStrategyGroupStatusBo) error
+	DeleteStrategyGroup(ctx context.Context, uid snowflake.ID) error
+	GetStrategyGroup(ctx context.Context, uid snowflake.ID) (*bo.StrategyGroupItemBo, error)
+	GetStrategyGroupByName(ctx context.Context, name string) (*bo.StrategyGroupItemBo, error)
+	ListStrategyGroup(ctx context.Context, req *bo.ListStrategyGroupBo) (*bo.PageResponseBo[*bo.StrategyGroupItemBo], error)
+	SelectStrategyGroup(ctx context.Context, req *bo.SelectStrategyGroupBo) (*bo.SelectStrategyGroupBoResult, error)
+	BindStrategyGroupReceivers(ctx context.Context, req *bo.BindStrategyGroupReceiversBo) error
+}
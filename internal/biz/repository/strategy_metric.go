@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+)
+
+type StrategyMetric interface {
+	SaveStrategyMetric(ctx context.Context, req *bo.SaveStrategyMetricBo) error
+	GetStrategyMetric(ctx context.Context, strategyUID snowflake.ID) (*bo.StrategyMetricItemBo, error)
+	SaveStrategyMetricLevel(ctx context.Context, req *bo.SaveStrategyMetricLevelBo) error
+	UpdateStrategyMetricLevelStatus(ctx context.Context, req *bo.UpdateStrategyMetricLevelStatusBo) error
+	DeleteStrategyMetricLevel(ctx context.Context, strategyUID, uid snowflake.ID) error
+	GetStrategyMetricLevel(ctx context.Context, strategyUID, uid snowflake.ID) (*bo.StrategyMetricLevelItemBo, error)
+	BindStrategyMetricReceivers(ctx context.Context, req *bo.BindStrategyMetricReceiversBo) error
+	// ListStrategyMetricRules loads every enabled metric strategy of all namespaces.
+	ListStrategyMetricRules(ctx context.Context) ([]*bo.StrategyMetricRuleBo, error)
+	// ListStrategyMetricExports loads the enabled metric strategies of the namespace,
+	// of one strategy group when strategyGroupUID is set.
+	ListStrategyMetricExports(ctx context.Context, strategyGroupUID snowflake.ID) ([]*bo.StrategyMetricExportBo, error)
+}
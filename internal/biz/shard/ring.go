@@ -0,0 +1,80 @@
+// Package shard assigns strategies to job nodes with a consistent hash ring,
+// so that every strategy is evaluated by exactly one live node.
+package shard
+
+import (
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// defaultReplicas is the number of virtual nodes per node, it evens out the load of few nodes.
+const defaultReplicas = 128
+
+// Ring is an immutable consistent hash ring, adding or removing a node only moves
+// the keys of that node.
+type Ring struct {
+	nodes  []string
+	hashes []uint64
+	owners map[uint64]string
+}
+
+// NewRing returns a ring of the unique, non empty nodes.
+func NewRing(nodes ...string) *Ring {
+	r := &Ring{owners: make(map[uint64]string, len(nodes)*defaultReplicas)}
+	for _, node := range nodes {
+		if node == "" || slices.Contains(r.nodes, node) {
+			continue
+		}
+		r.nodes = append(r.nodes, node)
+	}
+	sort.Strings(r.nodes)
+	for _, node := range r.nodes {
+		for i := 0; i < defaultReplicas; i++ {
+			h := hash(node + "#" + strconv.Itoa(i))
+			// on a collision the smaller node wins, independent of the input order
+			if _, ok := r.owners[h]; ok {
+				continue
+			}
+			r.owners[h] = node
+			r.hashes = append(r.hashes, h)
+		}
+	}
+	slices.Sort(r.hashes)
+	return r
+}
+
+// Nodes returns the sorted nodes of the ring.
+func (r *Ring) Nodes() []string {
+	return slices.Clone(r.nodes)
+}
+
+// Owner returns the node owning key, empty for an empty ring.
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.owners[r.hashes[i]]
+}
+
+// Equal reports whether both rings have the same nodes.
+func (r *Ring) Equal(other *Ring) bool {
+	return other != nil && slices.Equal(r.nodes, other.nodes)
+}
+
+func hash(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	// fnv of similar keys differs in the low bits only, mix them into the high bits
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,145 @@
+package shard_test
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/shard"
+)
+
+func keys(n int) []string {
+	list := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		list = append(list, strconv.Itoa(1000000+i*7919))
+	}
+	return list
+}
+
+func TestRingBalance(t *testing.T) {
+	ring := shard.NewRing("node-c", "node-a", "node-b", "node-a", "")
+	if got := ring.Nodes(); !slices.Equal(got, []string{"node-a", "node-b", "node-c"}) {
+		t.Fatalf("nodes = %v", got)
+	}
+	counts := make(map[string]int)
+	for _, key := range keys(3000) {
+		counts[ring.Owner(key)]++
+	}
+	for node, count := range counts {
+		if count < 600 || count > 1400 {
+			t.Fatalf("node %s owns %d of 3000 keys, the ring is unbalanced: %v", node, count, counts)
+		}
+	}
+	if owner := shard.NewRing().Owner("1"); owner != "" {
+		t.Fatalf("empty ring owner = %q", owner)
+	}
+}
+
+func TestRingMovesOnlyKeysOfChangedNode(t *testing.T) {
+	before := shard.NewRing("node-a", "node-b", "node-c")
+	after := shard.NewRing("node-b", "node-c", "node-a", "node-d")
+	for _, key := range keys(2000) {
+		from, to := before.Owner(key), after.Owner(key)
+		if from != to && to != "node-d" {
+			t.Fatalf("key %s moved from %s to %s, only moves to the new node are expected", key, from, to)
+		}
+	}
+	removed := shard.NewRing("node-a", "node-c")
+	for _, key := range keys(2000) {
+		from, to := before.Owner(key), removed.Owner(key)
+		if from != to && from != "node-b" {
+			t.Fatalf("key %s moved from %s to %s, only keys of the removed node may move", key, from, to)
+		}
+	}
+}
+
+// memoryMembership is a membership shared by in-process nodes.
+type memoryMembership struct {
+	mu    sync.Mutex
+	nodes []string
+}
+
+func (m *memoryMembership) Heartbeat(_ context.Context, nodeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !slices.Contains(m.nodes, nodeID) {
+		m.nodes = append(m.nodes, nodeID)
+	}
+	return nil
+}
+
+func (m *memoryMembership) Leave(_ context.Context, nodeID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodes = slices.DeleteFunc(m.nodes, func(node string) bool { return node == nodeID })
+	return nil
+}
+
+func (m *memoryMembership) ListJobNodes(context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.nodes), nil
+}
+
+// assertExactlyOneOwner fails unless every key is owned by exactly one of the sharders.
+func assertExactlyOneOwner(t *testing.T, sharders []*shard.Sharder, keys []string) {
+	t.Helper()
+	for _, key := range keys {
+		owners := 0
+		for _, s := range sharders {
+			if s.Owns(key) {
+				owners++
+			}
+		}
+		if owners != 1 {
+			t.Fatalf("key %s has %d owners", key, owners)
+		}
+	}
+}
+
+func TestSharderRebalance(t *testing.T) {
+	ctx := context.Background()
+	membership := &memoryMembership{}
+	helper := klog.NewHelper(klog.DefaultLogger)
+	sharders := []*shard.Sharder{
+		shard.NewSharder("node-a", membership, helper),
+		shard.NewSharder("node-b", membership, helper),
+		shard.NewSharder("node-c", membership, helper),
+	}
+	if sharders[0].Owns("1") {
+		t.Fatal("a sharder must own nothing before the first refresh")
+	}
+	refresh := func(list []*shard.Sharder) {
+		// the first round registers every node, the second one sees all of them
+		for round := 0; round < 2; round++ {
+			for _, s := range list {
+				if _, err := s.Refresh(ctx); err != nil {
+					t.Fatalf("refresh %s: %v", s.NodeID(), err)
+				}
+			}
+		}
+	}
+	refresh(sharders)
+	all := keys(500)
+	assertExactlyOneOwner(t, sharders, all)
+
+	if changed, _ := sharders[0].Refresh(ctx); changed {
+		t.Fatal("refresh without membership change must not report a change")
+	}
+
+	if err := sharders[1].Leave(ctx); err != nil {
+		t.Fatalf("leave: %v", err)
+	}
+	for _, key := range all {
+		if sharders[1].Owns(key) {
+			t.Fatalf("node-b owns %s after leaving", key)
+		}
+	}
+	remaining := []*shard.Sharder{sharders[0], sharders[2]}
+	refresh(remaining)
+	assertExactlyOneOwner(t, remaining, all)
+}
@@ -0,0 +1,151 @@
+package shard
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+)
+
+// Membership keeps track of the live job nodes.
+type Membership interface {
+	// Heartbeat marks the node alive.
+	Heartbeat(ctx context.Context, nodeID string) error
+	// Leave removes the node, so that the other nodes take over its keys right away.
+	Leave(ctx context.Context, nodeID string) error
+	// ListJobNodes returns the ids of the live job nodes.
+	ListJobNodes(ctx context.Context) ([]string, error)
+}
+
+// Leases keeps one lease per key, the lease hands a key over between its previous and its new owner.
+type Leases interface {
+	// AcquireLease renews the lease when holder holds it with token, otherwise takes it over
+	// when it is free, expired or held by holder before. It returns the token of the holder,
+	// or 0 when another node holds the lease.
+	AcquireLease(ctx context.Context, name, holder string, token uint64, ttl time.Duration) (uint64, error)
+	// ReleaseLease frees the lease when holder still holds it with token.
+	ReleaseLease(ctx context.Context, name, holder string, token uint64) error
+}
+
+type SharderOption func(*Sharder)
+
+// WithLeases makes Acquire hold a lease per key, so that two nodes whose rings disagree
+// during a rebalance never work on the same key at once.
+func WithLeases(leases Leases) SharderOption {
+	return func(s *Sharder) {
+		s.leases = leases
+	}
+}
+
+// NewSharder returns the Sharder of the node nodeID, it owns nothing until the first Refresh.
+func NewSharder(nodeID string, membership Membership, helper *klog.Helper, opts ...SharderOption) *Sharder {
+	s := &Sharder{
+		nodeID:     nodeID,
+		membership: membership,
+		helper:     helper,
+		tokens:     make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Sharder decides which keys the local node owns, every node derives the same ring from the
+// same members, so each key has exactly one owner once the members agree.
+type Sharder struct {
+	nodeID     string
+	membership Membership
+	helper     *klog.Helper
+	leases     Leases
+	ring       atomic.Pointer[Ring]
+
+	mu     sync.Mutex
+	tokens map[string]uint64
+}
+
+// NodeID returns the id of the local node.
+func (s *Sharder) NodeID() string {
+	return s.nodeID
+}
+
+// Refresh sends the heartbeat of the local node and rebuilds the ring from the members,
+// it reports whether the members changed. The ring is kept when the members can not be listed.
+func (s *Sharder) Refresh(ctx context.Context) (bool, error) {
+	if err := s.membership.Heartbeat(ctx, s.nodeID); err != nil {
+		return false, err
+	}
+	nodes, err := s.membership.ListJobNodes(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !slices.Contains(nodes, s.nodeID) {
+		s.helper.Warnw("msg", "local node is not a member yet, it owns no strategies", "nodeID", s.nodeID, "members", nodes)
+	}
+	ring := NewRing(nodes...)
+	old := s.ring.Swap(ring)
+	changed := !ring.Equal(old)
+	if changed {
+		s.helper.Infow("msg", "job nodes changed", "nodeID", s.nodeID, "members", ring.Nodes())
+	}
+	return changed, nil
+}
+
+// Owns reports whether the local node owns key.
+func (s *Sharder) Owns(key string) bool {
+	ring := s.ring.Load()
+	return ring != nil && ring.Owner(key) == s.nodeID
+}
+
+// Leave gives up all keys and removes the local node from the members.
+func (s *Sharder) Leave(ctx context.Context) error {
+	s.ring.Store(NewRing())
+	return s.membership.Leave(ctx, s.nodeID)
+}
+
+// Acquire takes or renews the lease of key for ttl. It reports false while the previous owner
+// still holds the lease, so that a new owner starts only once the previous one is done with the
+// key and released it, see Release. The caller decides about the ownership with Owns, a key is
+// kept until it is released even when the ring moved it to another node.
+func (s *Sharder) Acquire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if s.leases == nil {
+		return true, nil
+	}
+	s.mu.Lock()
+	token := s.tokens[key]
+	s.mu.Unlock()
+	token, err := s.leases.AcquireLease(ctx, leaseName(key), s.nodeID, token, ttl)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token == 0 {
+		delete(s.tokens, key)
+		return false, nil
+	}
+	s.tokens[key] = token
+	return true, nil
+}
+
+// Release frees the lease of key, the next owner takes it over without waiting for it to expire.
+func (s *Sharder) Release(ctx context.Context, key string) error {
+	if s.leases == nil {
+		return nil
+	}
+	s.mu.Lock()
+	token, ok := s.tokens[key]
+	delete(s.tokens, key)
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return s.leases.ReleaseLease(ctx, leaseName(key), s.nodeID, token)
+}
+
+func leaseName(key string) string {
+	return "shard/" + key
+}
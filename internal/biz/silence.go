@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewSilence(
+	silenceRepo repository.Silence,
+	eventRepo repository.Event,
+	helper *klog.Helper,
+) *SilenceBiz {
+	return &SilenceBiz{
+		silenceRepo: silenceRepo,
+		eventRepo:   eventRepo,
+		helper:      klog.NewHelper(klog.With(helper.Logger(), "biz", "silence")),
+	}
+}
+
+type SilenceBiz struct {
+	helper      *klog.Helper
+	silenceRepo repository.Silence
+	eventRepo   repository.Event
+}
+
+func (s *SilenceBiz) CreateSilence(ctx context.Context, req *bo.CreateSilenceBo) error {
+	if err := s.silenceRepo.CreateSilence(ctx, req); err != nil {
+		s.helper.Errorw("msg", "create silence failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create silence failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *SilenceBiz) UpdateSilence(ctx context.Context, req *bo.UpdateSilenceBo) error {
+	if err := s.silenceRepo.UpdateSilence(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("silence %d not found", req.UID.Int64())
+		}
+		s.helper.Errorw("msg", "update silence failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update silence failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *SilenceBiz) DeleteSilence(ctx context.Context, uid snowflake.ID) error {
+	if err := s.silenceRepo.DeleteSilence(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("silence %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "delete silence failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete silence failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *SilenceBiz) GetSilence(ctx context.Context, uid snowflake.ID) (*bo.SilenceItemBo, error) {
+	item, err := s.silenceRepo.GetSilence(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("silence %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "get silence failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get silence failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (s *SilenceBiz) ListSilence(ctx context.Context, req *bo.ListSilenceBo) (*bo.PageResponseBo[*bo.SilenceItemBo], error) {
+	result, err := s.silenceRepo.ListSilence(ctx, req)
+	if err != nil {
+		s.helper.Errorw("msg", "list silence failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list silence failed").WithCause(err)
+	}
+	return result, nil
+}
+
+// MatchSilences returns the active and pending silences muting the labels of the event,
+// the labels of the request are used when no event is given.
+func (s *SilenceBiz) MatchSilences(ctx context.Context, req *bo.MatchSilencesBo) ([]*bo.SilenceItemBo, error) {
+	labels := req.Labels
+	if req.EventUID != 0 {
+		event, err := s.eventRepo.GetEvent(ctx, req.EventUID)
+		if err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorNotFound("event %d not found", req.EventUID.Int64())
+			}
+			s.helper.Errorw("msg", "get event failed", "error", err, "eventUID", req.EventUID)
+			return nil, merr.ErrorInternalServer("get event failed").WithCause(err)
+		}
+		labels = event.Labels
+	}
+	silences, err := s.silenceRepo.ListUnexpiredSilences(ctx, contextx.GetNamespace(ctx), time.Now())
+	if err != nil {
+		s.helper.Errorw("msg", "list silences failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("match silences failed").WithCause(err)
+	}
+	matched := make([]*bo.SilenceItemBo, 0, len(silences))
+	for _, silence := range silences {
+		if silence.Matches(labels) {
+			matched = append(matched, silence)
+		}
+	}
+	return matched, nil
+}
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+func NewStrategy(
+	strategyGroupRepo repository.StrategyGroup,
+	strategyRepo repository.Strategy,
+	strategyMetricRepo repository.StrategyMetric,
+	levelRepo repository.Level,
+	datasourceRepo repository.Datasource,
+	receiverRepo repository.Receiver,
+	onCallScheduleRepo repository.OnCallSchedule,
+	helper *klog.Helper,
+) *StrategyBiz {
+	return &StrategyBiz{
+		strategyGroupRepo:  strategyGroupRepo,
+		strategyRepo:       strategyRepo,
+		strategyMetricRepo: strategyMetricRepo,
+		levelRepo:          levelRepo,
+		datasourceRepo:     datasourceRepo,
+		receiverRepo:       receiverRepo,
+		onCallScheduleRepo: onCallScheduleRepo,
+		helper:             klog.NewHelper(klog.With(helper.Logger(), "biz", "strategy")),
+	}
+}
+
+type StrategyBiz struct {
+	helper             *klog.Helper
+	strategyGroupRepo  repository.StrategyGroup
+	strategyRepo       repository.Strategy
+	strategyMetricRepo repository.StrategyMetric
+	levelRepo          repository.Level
+	datasourceRepo     repository.Datasource
+	receiverRepo       repository.Receiver
+	onCallScheduleRepo repository.OnCallSchedule
+}
+
+func (s *StrategyBiz) CreateStrategyGroup(ctx context.Context, req *bo.CreateStrategyGroupBo) error {
+	if err := s.strategyGroupRepo.CreateStrategyGroup(ctx, req); err != nil {
+		s.helper.Errorw("msg", "create strategy group failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create strategy group failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) UpdateStrategyGroup(ctx context.Context, req *bo.UpdateStrategyGroupBo) error {
+	if err := s.strategyGroupRepo.UpdateStrategyGroup(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy group %d not found", req.UID.Int64())
+		}
+		s.helper.Errorw("msg", "update strategy group failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update strategy group failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) UpdateStrategyGroupStatus(ctx context.Context, req *bo.UpdateStrategyGroupStatusBo) error {
+	if err := s.strategyGroupRepo.UpdateStrategyGroupStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy group %d not found", req.UID.Int64())
+		}
+		s.helper.Errorw("msg", "update strategy group status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update strategy group status failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) DeleteStrategyGroup(ctx context.Context, uid snowflake.ID) error {
+	total, err := s.strategyRepo.CountStrategyByGroup(ctx, uid)
+	if err != nil {
+		s.helper.Errorw("msg", "count strategy by group failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete strategy group failed").WithCause(err)
+	}
+	if total > 0 {
+		return merr.ErrorForbidden("strategy group %d still has %d strategies", uid.Int64(), total)
+	}
+	if err := s.strategyGroupRepo.DeleteStrategyGroup(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy group %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "delete strategy group failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete strategy group failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) GetStrategyGroup(ctx context.Context, uid snowflake.ID) (*bo.StrategyGroupItemBo, error) {
+	item, err := s.strategyGroupRepo.GetStrategyGroup(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("strategy group %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "get strategy group failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get strategy group failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (s *StrategyBiz) ListStrategyGroup(ctx context.Context, req *bo.ListStrategyGroupBo) (*bo.PageResponseBo[*bo.StrategyGroupItemBo], error) {
+	result, err := s.strategyGroupRepo.ListStrategyGroup(ctx, req)
+	if err != nil {
+		s.helper.Errorw("msg", "list strategy group failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list strategy group failed").WithCause(err)
+	}
+	return result, nil
+}
+
+func (s *StrategyBiz) SelectStrategyGroup(ctx context.Context, req *bo.SelectStrategyGroupBo) (*bo.SelectStrategyGroupBoResult, error) {
+	result, err := s.strategyGroupRepo.SelectStrategyGroup(ctx, req)
+	if err != nil {
+		s.helper.Errorw("msg", "select strategy group failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("select strategy group failed").WithCause(err)
+	}
+	return result, nil
+}
+
+func (s *StrategyBiz) BindStrategyGroupReceivers(ctx context.Context, req *bo.BindStrategyGroupReceiversBo) error {
+	if _, err := s.GetStrategyGroup(ctx, req.UID); err != nil {
+		return err
+	}
+	if err := checkReceiverUIDs(ctx, s.helper, s.receiverRepo, s.onCallScheduleRepo, req.ReceiverUIDs...); err != nil {
+		return err
+	}
+	if err := s.strategyGroupRepo.BindStrategyGroupReceivers(ctx, req); err != nil {
+		s.helper.Errorw("msg", "bind strategy group receivers failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("bind strategy group receivers failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) CreateStrategy(ctx context.Context, req *bo.CreateStrategyBo) error {
+	if _, err := s.GetStrategyGroup(ctx, req.StrategyGroupUID); err != nil {
+		return err
+	}
+	if err := s.strategyRepo.CreateStrategy(ctx, req); err != nil {
+		s.helper.Errorw("msg", "create strategy failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("create strategy failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) UpdateStrategy(ctx context.Context, req *bo.UpdateStrategyBo) error {
+	if _, err := s.GetStrategyGroup(ctx, req.StrategyGroupUID); err != nil {
+		return err
+	}
+	if err := s.strategyRepo.UpdateStrategy(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy %d not found", req.UID.Int64())
+		}
+		s.helper.Errorw("msg", "update strategy failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update strategy failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) UpdateStrategyStatus(ctx context.Context, req *bo.UpdateStrategyStatusBo) error {
+	if err := s.strategyRepo.UpdateStrategyStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy %d not found", req.UID.Int64())
+		}
+		s.helper.Errorw("msg", "update strategy status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update strategy status failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) DeleteStrategy(ctx context.Context, uid snowflake.ID) error {
+	if err := s.strategyRepo.DeleteStrategy(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "delete strategy failed", "error", err, "uid", uid)
+		return merr.ErrorInternalServer("delete strategy failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyBiz) GetStrategy(ctx context.Context, uid snowflake.ID) (*bo.StrategyItemBo, error) {
+	item, err := s.strategyRepo.GetStrategy(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("strategy %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "get strategy failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternalServer("get strategy failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (s *StrategyBiz) ListStrategy(ctx context.Context, req *bo.ListStrategyBo) (*bo.PageResponseBo[*bo.StrategyItemBo], error) {
+	result, err := s.strategyRepo.ListStrategy(ctx, req)
+	if err != nil {
+		s.helper.Errorw("msg", "list strategy failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("list strategy failed").WithCause(err)
+	}
+	return result, nil
+}
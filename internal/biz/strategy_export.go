@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"maps"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/prometheus/common/model"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/promrule"
+)
+
+// ExportPrometheusRules renders the enabled metric strategies of the namespace, or of one
+// strategy group, as a rule file: a group per strategy group and an alerting rule per
+// level, named after the strategy and labelled with the level name.
+// It is the inverse of ImportPrometheusRules.
+func (s *StrategyBiz) ExportPrometheusRules(ctx context.Context, req *bo.ExportPrometheusRulesBo) (*promrule.File, error) {
+	if req.StrategyGroupUID > 0 {
+		if _, err := s.GetStrategyGroup(ctx, req.StrategyGroupUID); err != nil {
+			return nil, err
+		}
+	}
+	items, err := s.strategyMetricRepo.ListStrategyMetricExports(ctx, req.StrategyGroupUID)
+	if err != nil {
+		s.helper.Errorw("msg", "list strategy metric exports failed", "error", err, "req", req)
+		return nil, merr.ErrorInternalServer("export prometheus rules failed").WithCause(err)
+	}
+	file := &promrule.File{Groups: []*promrule.Group{}}
+	intervals := make(map[*promrule.Group]map[time.Duration]struct{})
+	var group *promrule.Group
+	for _, item := range items {
+		if group == nil || group.Name != item.StrategyGroupName {
+			group = &promrule.Group{Name: item.StrategyGroupName}
+			file.Groups = append(file.Groups, group)
+			intervals[group] = make(map[time.Duration]struct{})
+		}
+		interval, err := parseMetadataDuration(item.StrategyMetadata[strategyMetadataInterval])
+		if err != nil || interval <= 0 {
+			interval = 0
+		}
+		intervals[group][interval] = struct{}{}
+		for _, level := range item.Levels {
+			rule, ok := exportRule(item, level, req.SeverityLabel)
+			if !ok {
+				s.helper.Warnw("msg", "level cannot be written as an alerting rule", "strategy", item.StrategyUID, "level", level.LevelUID, "mode", level.Mode, "condition", level.Condition)
+				continue
+			}
+			group.Rules = append(group.Rules, rule)
+		}
+	}
+	// Prometheus evaluates a group at one interval, it is only kept when the strategies agree
+	for _, group := range file.Groups {
+		if len(intervals[group]) != 1 {
+			continue
+		}
+		for interval := range intervals[group] {
+			group.Interval = model.Duration(interval)
+		}
+	}
+	return file, nil
+}
+
+func exportRule(item *bo.StrategyMetricExportBo, level *bo.StrategyMetricLevelItemBo, severityLabel string) (*promrule.Rule, bool) {
+	expr, ok := exportExpr(item.Expr, level)
+	if !ok {
+		return nil, false
+	}
+	labels := make(map[string]string, len(item.Labels)+1)
+	maps.Copy(labels, item.Labels)
+	if level.Level != nil {
+		labels[severityLabel] = level.Level.Name
+	}
+	annotations := make(map[string]string, 2)
+	if item.Summary != "" {
+		annotations["summary"] = item.Summary
+	}
+	if item.Description != "" {
+		annotations["description"] = item.Description
+	}
+	rule := &promrule.Rule{
+		Alert:       item.StrategyName,
+		Expr:        expr,
+		For:         model.Duration(level.Duration),
+		Labels:      labels,
+		Annotations: annotations,
+	}
+	for key, value := range item.StrategyMetadata {
+		if name, ok := strings.CutPrefix(key, importAnnotationPrefix); ok {
+			annotations[name] = value
+		}
+	}
+	if value := item.StrategyMetadata[strategyMetadataKeepFiringFor]; value != "" {
+		if d, err := parseMetadataDuration(value); err == nil && d > 0 {
+			rule.KeepFiringFor = model.Duration(d)
+		}
+	}
+	return rule, true
+}
+
+// exportExpr appends the condition of level to expr. A range vector is reduced over
+// time the same way the evaluator samples its points, ok is false when that cannot
+// be written in PromQL, e.g. every point of a range equal to a value.
+func exportExpr(expr string, level *bo.StrategyMetricLevelItemBo) (string, bool) {
+	values := level.Values
+	if promrule.IsRangeVector(expr) {
+		reduce := ""
+		switch level.Mode {
+		case enum.SampleMode_SAMPLE_MODE_MAX:
+			reduce = "max_over_time"
+		case enum.SampleMode_SAMPLE_MODE_MIN:
+			reduce = "min_over_time"
+		default:
+			switch level.Condition {
+			case enum.ConditionMetric_CONDITION_METRIC_GT, enum.ConditionMetric_CONDITION_METRIC_GTE:
+				reduce = "min_over_time"
+			case enum.ConditionMetric_CONDITION_METRIC_LT, enum.ConditionMetric_CONDITION_METRIC_LTE:
+				reduce = "max_over_time"
+			default:
+				return "", false
+			}
+		}
+		expr = reduce + "(" + expr + ")"
+	}
+	switch level.Condition {
+	case enum.ConditionMetric_CONDITION_METRIC_IN:
+		if len(values) != 2 {
+			return "", false
+		}
+		if values[0] != math.MinInt64 {
+			expr = promrule.Compare(expr, ">=", values[0])
+		}
+		if values[1] != math.MaxInt64 {
+			expr = promrule.Compare(expr, "<=", values[1])
+		}
+		return expr, true
+	case enum.ConditionMetric_CONDITION_METRIC_NOT_IN:
+		if len(values) != 2 {
+			return "", false
+		}
+		return promrule.Compare(expr, "<", values[0]) + " or " + promrule.Compare(expr, ">", values[1]), true
+	}
+	for op, condition := range thresholdConditions {
+		if condition == level.Condition && len(values) == 1 {
+			return promrule.Compare(expr, op, values[0]), true
+		}
+	}
+	return "", false
+}
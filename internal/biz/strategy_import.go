@@ -0,0 +1,454 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/promrule"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+const (
+	resourceKindStrategyGroup = "StrategyGroup"
+	resourceKindStrategy      = "Strategy"
+
+	// importAnnotationPrefix prefixes the annotations kept in the strategy metadata,
+	// summary and description are stored on the metric instead.
+	importAnnotationPrefix = "annotations."
+	maxStrategyNameLength  = 100
+)
+
+var thresholdConditions = map[string]enum.ConditionMetric{
+	"==": enum.ConditionMetric_CONDITION_METRIC_EQ,
+	"!=": enum.ConditionMetric_CONDITION_METRIC_NE,
+	">":  enum.ConditionMetric_CONDITION_METRIC_GT,
+	">=": enum.ConditionMetric_CONDITION_METRIC_GTE,
+	"<":  enum.ConditionMetric_CONDITION_METRIC_LT,
+	"<=": enum.ConditionMetric_CONDITION_METRIC_LTE,
+}
+
+// ImportPrometheusRules maps the groups of Prometheus rule files to strategy groups and
+// their alerting rules to metric strategies, matched by name within the namespace.
+// The changes are returned without being applied on a dry run, otherwise all of
+// them are applied in one transaction.
+func (s *StrategyBiz) ImportPrometheusRules(ctx context.Context, req *bo.ImportPrometheusRulesBo) ([]*bo.ResourceChangeBo, error) {
+	for _, uid := range req.DatasourceUIDs {
+		if _, err := s.datasourceRepo.GetDatasource(ctx, uid); err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorParams("datasource %d not found", uid.Int64())
+			}
+			s.helper.Errorw("msg", "get datasource failed", "error", err, "uid", uid)
+			return nil, merr.ErrorInternalServer("import prometheus rules failed").WithCause(err)
+		}
+	}
+	p := &importPlanner{
+		biz:        s,
+		req:        req,
+		levels:     make(map[string]*bo.LevelItemBo),
+		groups:     make(map[string]string),
+		strategies: make(map[string]string),
+	}
+	groups, changes, err := p.plan(ctx)
+	if err != nil {
+		s.helper.Errorw("msg", "plan prometheus rules import failed", "error", err)
+		return nil, merr.ErrorInternalServer("import prometheus rules failed").WithCause(err)
+	}
+	if req.DryRun || len(groups) == 0 {
+		return changes, nil
+	}
+	if err := s.strategyRepo.ImportStrategies(ctx, groups); err != nil {
+		s.helper.Errorw("msg", "import strategies failed", "error", err)
+		return nil, merr.ErrorInternalServer("import prometheus rules failed").WithCause(err)
+	}
+	return changes, nil
+}
+
+type importPlanner struct {
+	biz *StrategyBiz
+	req *bo.ImportPrometheusRulesBo
+	// levels caches the levels by name, nil when there is no such level
+	levels map[string]*bo.LevelItemBo
+	// groups and strategies hold the file and the group each name was planned from
+	groups     map[string]string
+	strategies map[string]string
+}
+
+func (p *importPlanner) plan(ctx context.Context) ([]*bo.ImportStrategyGroupBo, []*bo.ResourceChangeBo, error) {
+	var (
+		groups  []*bo.ImportStrategyGroupBo
+		changes []*bo.ResourceChangeBo
+	)
+	for _, file := range p.req.Files {
+		for _, group := range file.File.Groups {
+			if name, ok := p.groups[group.Name]; ok {
+				changes = append(changes, &bo.ResourceChangeBo{
+					Action: apiv1.ChangeAction_CHANGE_SKIP,
+					Kind:   resourceKindStrategyGroup,
+					Name:   group.Name,
+					Reason: fmt.Sprintf("group is repeated in %s and %s", name, file.Name),
+				})
+				continue
+			}
+			p.groups[group.Name] = file.Name
+			planned, groupChanges, err := p.planGroup(ctx, group)
+			if err != nil {
+				return nil, nil, err
+			}
+			if planned != nil {
+				groups = append(groups, planned)
+			}
+			changes = append(changes, groupChanges...)
+		}
+	}
+	return groups, changes, nil
+}
+
+// planGroup returns nil for the group when none of its strategies changes.
+func (p *importPlanner) planGroup(ctx context.Context, group *promrule.Group) (*bo.ImportStrategyGroupBo, []*bo.ResourceChangeBo, error) {
+	planned := &bo.ImportStrategyGroupBo{Name: group.Name}
+	groupChange := &bo.ResourceChangeBo{
+		Action: apiv1.ChangeAction_CHANGE_UNCHANGED,
+		Kind:   resourceKindStrategyGroup,
+		Name:   group.Name,
+	}
+	existing, err := p.biz.strategyGroupRepo.GetStrategyGroupByName(ctx, group.Name)
+	switch {
+	case err == nil:
+		planned.UID = existing.UID
+	case merr.IsNotFound(err):
+		groupChange.Action = apiv1.ChangeAction_CHANGE_CREATE
+	default:
+		return nil, nil, err
+	}
+
+	changes := []*bo.ResourceChangeBo{groupChange}
+	rules := make(map[string][]*promrule.Rule)
+	var names []string
+	for _, rule := range group.Rules {
+		if rule.Record != "" {
+			changes = append(changes, &bo.ResourceChangeBo{
+				Action: apiv1.ChangeAction_CHANGE_SKIP,
+				Kind:   resourceKindStrategy,
+				Name:   rule.Record,
+				Parent: group.Name,
+				Reason: "recording rules are not imported",
+			})
+			continue
+		}
+		if _, ok := rules[rule.Alert]; !ok {
+			names = append(names, rule.Alert)
+		}
+		rules[rule.Alert] = append(rules[rule.Alert], rule)
+	}
+	for _, name := range names {
+		strategy, change, err := p.planStrategy(ctx, group, name, rules[name], planned.UID)
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, change)
+		if strategy != nil {
+			planned.Strategies = append(planned.Strategies, strategy)
+		}
+	}
+	if len(planned.Strategies) == 0 {
+		if groupChange.Action == apiv1.ChangeAction_CHANGE_CREATE {
+			groupChange.Action = apiv1.ChangeAction_CHANGE_SKIP
+			groupChange.Reason = "group has no alerting rules to import"
+		}
+		return nil, changes, nil
+	}
+	return planned, changes, nil
+}
+
+// planStrategy merges the alerting rules sharing a name into one strategy with a level
+// per rule, it returns nil for the strategy when it is skipped or unchanged.
+func (p *importPlanner) planStrategy(ctx context.Context, group *promrule.Group, name string, rules []*promrule.Rule, groupUID snowflake.ID) (*bo.ImportStrategyBo, *bo.ResourceChangeBo, error) {
+	change := &bo.ResourceChangeBo{
+		Kind:   resourceKindStrategy,
+		Name:   name,
+		Parent: group.Name,
+	}
+	skip := func(format string, args ...any) (*bo.ImportStrategyBo, *bo.ResourceChangeBo, error) {
+		change.Action = apiv1.ChangeAction_CHANGE_SKIP
+		change.Reason = fmt.Sprintf(format, args...)
+		return nil, change, nil
+	}
+	if utf8.RuneCountInString(name) > maxStrategyNameLength {
+		return skip("name is longer than %d characters", maxStrategyNameLength)
+	}
+	if other, ok := p.strategies[name]; ok {
+		return skip("alert is already imported from group %q", other)
+	}
+	p.strategies[name] = group.Name
+
+	var planned *bo.ImportStrategyBo
+	for _, rule := range rules {
+		strategy, reason, err := p.ruleToStrategy(ctx, group, rule)
+		if err != nil {
+			return nil, nil, err
+		}
+		if reason != "" {
+			return skip("%s", reason)
+		}
+		if planned == nil {
+			planned = strategy
+			continue
+		}
+		if !sameImportStrategy(planned, strategy) {
+			return skip("rules named %q differ in more than the threshold and %s", name, p.req.SeverityLabel)
+		}
+		level := strategy.Levels[0]
+		for _, l := range planned.Levels {
+			if l.LevelUID == level.LevelUID {
+				return skip("level %q is repeated", p.levelName(level.LevelUID))
+			}
+		}
+		planned.Levels = append(planned.Levels, level)
+	}
+
+	existing, err := p.biz.strategyRepo.GetStrategyByName(ctx, name)
+	switch {
+	case err == nil:
+		if existing.Type != enum.DatasourceType_METRICS {
+			return skip("strategy exists with type %s", existing.Type)
+		}
+		planned.UID = existing.UID
+		planned.Driver = existing.Driver
+	case merr.IsNotFound(err):
+		change.Action = apiv1.ChangeAction_CHANGE_CREATE
+	default:
+		return nil, nil, err
+	}
+
+	var (
+		oldGroup  string
+		oldMetric *bo.StrategyMetricItemBo
+		oldMeta   map[string]string
+	)
+	if existing != nil {
+		oldMeta = existing.Metadata
+		oldGroup = strconv.FormatInt(existing.StrategyGroupUID.Int64(), 10)
+		if existing.StrategyGroupUID == groupUID {
+			oldGroup = group.Name
+		} else if g, err := p.biz.strategyGroupRepo.GetStrategyGroup(ctx, existing.StrategyGroupUID); err == nil {
+			oldGroup = g.Name
+		}
+		oldMetric, err = p.biz.strategyMetricRepo.GetStrategyMetric(ctx, existing.UID)
+		if err != nil && !merr.IsNotFound(err) {
+			return nil, nil, err
+		}
+	}
+	p.keepExisting(planned, oldMetric)
+
+	var diff []string
+	diff = appendValueDiff(diff, "strategyGroup", oldGroup, group.Name)
+	diff = appendMapDiff(diff, "metadata", oldMeta, planned.Metadata)
+	diff = append(diff, p.metricDiff(oldMetric, planned)...)
+	change.Diff = diff
+	if change.Action == apiv1.ChangeAction_CHANGE_CREATE {
+		return planned, change, nil
+	}
+	if len(diff) == 0 {
+		change.Action = apiv1.ChangeAction_CHANGE_UNCHANGED
+		return nil, change, nil
+	}
+	change.Action = apiv1.ChangeAction_CHANGE_UPDATE
+	return planned, change, nil
+}
+
+// ruleToStrategy converts one alerting rule to a strategy with a single level,
+// reason explains why the rule cannot be imported.
+func (p *importPlanner) ruleToStrategy(ctx context.Context, group *promrule.Group, rule *promrule.Rule) (*bo.ImportStrategyBo, string, error) {
+	labels := make(map[string]string, len(group.Labels)+len(rule.Labels))
+	maps.Copy(labels, group.Labels)
+	maps.Copy(labels, rule.Labels)
+	levelName, ok := labels[p.req.SeverityLabel]
+	delete(labels, p.req.SeverityLabel)
+	if !ok || levelName == "" {
+		if p.req.DefaultLevel == "" {
+			return nil, fmt.Sprintf("label %s is missing and no default level is set", p.req.SeverityLabel), nil
+		}
+		levelName = p.req.DefaultLevel
+	}
+	level, err := p.level(ctx, levelName)
+	if err != nil {
+		return nil, "", err
+	}
+	if level == nil {
+		return nil, fmt.Sprintf("level %q not found", levelName), nil
+	}
+
+	if err := bo.CheckStrategyMetricExpr(rule.Expr); err != nil {
+		return nil, err.Error(), nil
+	}
+	summary := rule.Annotations["summary"]
+	description := rule.Annotations["description"]
+	if err := bo.CheckStrategyMetricTemplates(summary, description); err != nil {
+		return nil, err.Error(), nil
+	}
+	metadata := make(map[string]string, len(rule.Annotations)+2)
+	for key, value := range rule.Annotations {
+		if key != "summary" && key != "description" {
+			metadata[importAnnotationPrefix+key] = value
+		}
+	}
+	if group.Interval > 0 {
+		metadata[strategyMetadataInterval] = group.Interval.String()
+	}
+	if rule.KeepFiringFor > 0 {
+		metadata[strategyMetadataKeepFiringFor] = rule.KeepFiringFor.String()
+	}
+
+	// a level judges the samples against its condition, a rule alerting on any sample, e.g.
+	// absent(up) or rate(errors[5m]) > 0.5, has no condition that matches every value
+	threshold, ok := promrule.SplitThreshold(rule.Expr)
+	if !ok {
+		return nil, "expr is not a comparison to an integer threshold, e.g. up == 0", nil
+	}
+	return &bo.ImportStrategyBo{
+		Name:     rule.Alert,
+		Type:     enum.DatasourceType_METRICS,
+		Driver:   enum.DatasourceDriver_METRICS_PROMETHEUS,
+		Metadata: metadata,
+		Metric: &bo.SaveStrategyMetricBo{
+			Expr:           threshold.Expr,
+			Labels:         labels,
+			Summary:        summary,
+			Description:    description,
+			DatasourceUIDs: p.req.DatasourceUIDs,
+		},
+		Levels: []*bo.SaveStrategyMetricLevelBo{{
+			LevelUID:  level.UID,
+			Mode:      enum.SampleMode_SAMPLE_MODE_FOR,
+			Condition: thresholdConditions[threshold.Op],
+			Values:    []int64{threshold.Value},
+			Duration:  time.Duration(rule.For),
+		}},
+	}, "", nil
+}
+
+func (p *importPlanner) level(ctx context.Context, name string) (*bo.LevelItemBo, error) {
+	if level, ok := p.levels[name]; ok {
+		return level, nil
+	}
+	level, err := p.biz.levelRepo.GetLevelByName(ctx, name)
+	if err != nil {
+		if !merr.IsNotFound(err) {
+			return nil, err
+		}
+		level = nil
+	}
+	p.levels[name] = level
+	return level, nil
+}
+
+func (p *importPlanner) levelName(uid snowflake.ID) string {
+	for name, level := range p.levels {
+		if level != nil && level.UID == uid {
+			return name
+		}
+	}
+	return strconv.FormatInt(uid.Int64(), 10)
+}
+
+// keepExisting carries over what the rule file does not describe: the status of the
+// metric and its datasources when none are given, the sample mode, flap detection
+// and status of the levels.
+func (p *importPlanner) keepExisting(planned *bo.ImportStrategyBo, old *bo.StrategyMetricItemBo) {
+	if old == nil {
+		return
+	}
+	planned.Metric.Status = old.Status
+	if len(planned.Metric.DatasourceUIDs) == 0 {
+		planned.Metric.DatasourceUIDs = old.DatasourceUIDs
+	}
+	for _, level := range planned.Levels {
+		for _, o := range old.Levels {
+			if o.LevelUID != level.LevelUID {
+				continue
+			}
+			level.Mode = o.Mode
+			level.FlapWindow = o.FlapWindow
+			level.FlapThreshold = o.FlapThreshold
+			level.Status = o.Status
+		}
+	}
+}
+
+func (p *importPlanner) metricDiff(old *bo.StrategyMetricItemBo, planned *bo.ImportStrategyBo) []string {
+	if old == nil {
+		old = &bo.StrategyMetricItemBo{}
+	}
+	metric := planned.Metric
+	var diff []string
+	diff = appendValueDiff(diff, "expr", old.Expr, metric.Expr)
+	diff = appendMapDiff(diff, "labels", old.Labels, metric.Labels)
+	diff = appendValueDiff(diff, "summary", old.Summary, metric.Summary)
+	diff = appendValueDiff(diff, "description", old.Description, metric.Description)
+	if !slices.Equal(old.DatasourceUIDs, metric.DatasourceUIDs) {
+		diff = append(diff, fmt.Sprintf("datasourceUIDs: %v -> %v", old.DatasourceUIDs, metric.DatasourceUIDs))
+	}
+	oldLevels := make(map[snowflake.ID]string, len(old.Levels))
+	for _, level := range old.Levels {
+		oldLevels[level.LevelUID] = formatLevelThreshold(level.Condition, level.Values, level.Duration)
+	}
+	for _, level := range planned.Levels {
+		threshold := formatLevelThreshold(level.Condition, level.Values, level.Duration)
+		diff = appendValueDiff(diff, "levels."+p.levelName(level.LevelUID), oldLevels[level.LevelUID], threshold)
+		delete(oldLevels, level.LevelUID)
+	}
+	for _, level := range old.Levels {
+		if threshold, ok := oldLevels[level.LevelUID]; ok {
+			name := strconv.FormatInt(level.LevelUID.Int64(), 10)
+			if level.Level != nil {
+				name = level.Level.Name
+			}
+			diff = appendValueDiff(diff, "levels."+name, threshold, "")
+		}
+	}
+	return diff
+}
+
+func formatLevelThreshold(condition enum.ConditionMetric, values []int64, duration time.Duration) string {
+	return fmt.Sprintf("%s %v for %s", condition, values, duration)
+}
+
+func appendValueDiff(diff []string, field, old, new string) []string {
+	if old == new {
+		return diff
+	}
+	return append(diff, fmt.Sprintf("%s: %q -> %q", field, old, new))
+}
+
+func appendMapDiff(diff []string, field string, old, new map[string]string) []string {
+	keys := slices.Sorted(maps.Keys(old))
+	for key := range new {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		diff = appendValueDiff(diff, field+"."+key, old[key], new[key])
+	}
+	return diff
+}
+
+// sameImportStrategy reports whether two rules of the same name only differ in their level.
+func sameImportStrategy(a, b *bo.ImportStrategyBo) bool {
+	return a.Metric.Expr == b.Metric.Expr &&
+		a.Metric.Summary == b.Metric.Summary &&
+		a.Metric.Description == b.Metric.Description &&
+		maps.Equal(a.Metric.Labels, b.Metric.Labels) &&
+		maps.Equal(a.Metadata, b.Metadata)
+}
@@ -0,0 +1,305 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/evaluator"
+	"github.com/aide-family/marksman/internal/biz/repository"
+)
+
+// backtestTimeout bounds the range queries of a backtest.
+const backtestTimeout = time.Minute
+
+func NewStrategyMetric(
+	strategyRepo repository.Strategy,
+	strategyMetricRepo repository.StrategyMetric,
+	levelRepo repository.Level,
+	datasourceRepo repository.Datasource,
+	receiverRepo repository.Receiver,
+	onCallScheduleRepo repository.OnCallSchedule,
+	helper *klog.Helper,
+) *StrategyMetricBiz {
+	return &StrategyMetricBiz{
+		strategyRepo:       strategyRepo,
+		strategyMetricRepo: strategyMetricRepo,
+		levelRepo:          levelRepo,
+		datasourceRepo:     datasourceRepo,
+		receiverRepo:       receiverRepo,
+		onCallScheduleRepo: onCallScheduleRepo,
+		helper:             klog.NewHelper(klog.With(helper.Logger(), "biz", "strategy_metric")),
+	}
+}
+
+type StrategyMetricBiz struct {
+	helper             *klog.Helper
+	strategyRepo       repository.Strategy
+	strategyMetricRepo repository.StrategyMetric
+	levelRepo          repository.Level
+	datasourceRepo     repository.Datasource
+	receiverRepo       repository.Receiver
+	onCallScheduleRepo repository.OnCallSchedule
+}
+
+func (s *StrategyMetricBiz) SaveStrategyMetric(ctx context.Context, req *bo.SaveStrategyMetricBo) error {
+	if err := s.checkStrategy(ctx, req.StrategyUID); err != nil {
+		return err
+	}
+	for _, datasourceUID := range req.DatasourceUIDs {
+		if _, err := s.datasourceRepo.GetDatasource(ctx, datasourceUID); err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("datasource %d not found", datasourceUID.Int64())
+			}
+			s.helper.Errorw("msg", "get datasource failed", "error", err, "uid", datasourceUID)
+			return merr.ErrorInternalServer("save strategy metric failed").WithCause(err)
+		}
+	}
+	if err := s.strategyMetricRepo.SaveStrategyMetric(ctx, req); err != nil {
+		s.helper.Errorw("msg", "save strategy metric failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("save strategy metric failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyMetricBiz) GetStrategyMetric(ctx context.Context, strategyUID snowflake.ID) (*bo.StrategyMetricItemBo, error) {
+	item, err := s.strategyMetricRepo.GetStrategyMetric(ctx, strategyUID)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("strategy metric %d not found", strategyUID.Int64())
+		}
+		s.helper.Errorw("msg", "get strategy metric failed", "error", err, "strategyUID", strategyUID)
+		return nil, merr.ErrorInternalServer("get strategy metric failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (s *StrategyMetricBiz) SaveStrategyMetricLevel(ctx context.Context, req *bo.SaveStrategyMetricLevelBo) error {
+	if err := s.checkStrategy(ctx, req.StrategyUID); err != nil {
+		return err
+	}
+	if err := s.checkLevel(ctx, req.LevelUID); err != nil {
+		return err
+	}
+	if err := s.strategyMetricRepo.SaveStrategyMetricLevel(ctx, req); err != nil {
+		s.helper.Errorw("msg", "save strategy metric level failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("save strategy metric level failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyMetricBiz) UpdateStrategyMetricLevelStatus(ctx context.Context, req *bo.UpdateStrategyMetricLevelStatusBo) error {
+	if err := s.strategyMetricRepo.UpdateStrategyMetricLevelStatus(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy metric level %d not found", req.UID.Int64())
+		}
+		s.helper.Errorw("msg", "update strategy metric level status failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("update strategy metric level status failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyMetricBiz) DeleteStrategyMetricLevel(ctx context.Context, strategyUID, uid snowflake.ID) error {
+	if err := s.strategyMetricRepo.DeleteStrategyMetricLevel(ctx, strategyUID, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy metric level %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "delete strategy metric level failed", "error", err, "strategyUID", strategyUID, "uid", uid)
+		return merr.ErrorInternalServer("delete strategy metric level failed").WithCause(err)
+	}
+	return nil
+}
+
+func (s *StrategyMetricBiz) GetStrategyMetricLevel(ctx context.Context, strategyUID, uid snowflake.ID) (*bo.StrategyMetricLevelItemBo, error) {
+	item, err := s.strategyMetricRepo.GetStrategyMetricLevel(ctx, strategyUID, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("strategy metric level %d not found", uid.Int64())
+		}
+		s.helper.Errorw("msg", "get strategy metric level failed", "error", err, "strategyUID", strategyUID, "uid", uid)
+		return nil, merr.ErrorInternalServer("get strategy metric level failed").WithCause(err)
+	}
+	return item, nil
+}
+
+func (s *StrategyMetricBiz) BindStrategyMetricReceivers(ctx context.Context, req *bo.BindStrategyMetricReceiversBo) error {
+	if err := s.checkStrategy(ctx, req.StrategyUID); err != nil {
+		return err
+	}
+	if req.LevelUID > 0 {
+		if err := s.checkLevel(ctx, req.LevelUID); err != nil {
+			return err
+		}
+	}
+	if err := checkReceiverUIDs(ctx, s.helper, s.receiverRepo, s.onCallScheduleRepo, req.ReceiverUIDs...); err != nil {
+		return err
+	}
+	if err := s.strategyMetricRepo.BindStrategyMetricReceivers(ctx, req); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy %d not found", req.StrategyUID.Int64())
+		}
+		s.helper.Errorw("msg", "bind strategy metric receivers failed", "error", err, "req", req)
+		return merr.ErrorInternalServer("bind strategy metric receivers failed").WithCause(err)
+	}
+	return nil
+}
+
+// RenderPreview renders summary and description against sample labels and value, template
+// errors are returned per field instead of failing the request.
+func (s *StrategyMetricBiz) RenderPreview(ctx context.Context, req *bo.RenderPreviewBo) (*bo.RenderPreviewResultBo, error) {
+	if req.StrategyUID > 0 && req.Summary == "" && req.Description == "" {
+		item, err := s.GetStrategyMetric(ctx, req.StrategyUID)
+		if err != nil {
+			return nil, err
+		}
+		req.Summary, req.Description = item.Summary, item.Description
+	}
+	data := &evaluator.TemplateData{
+		Labels:        req.Labels,
+		Value:         req.Value,
+		State:         evaluator.StateFiring.String(),
+		StrategyUID:   req.StrategyUID,
+		LevelUID:      req.LevelUID,
+		DatasourceUID: req.DatasourceUID,
+		StartsAt:      time.Now(),
+	}
+	if data.Labels == nil {
+		data.Labels = map[string]string{}
+	}
+	if req.LevelUID > 0 {
+		level, err := s.levelRepo.GetLevel(ctx, req.LevelUID)
+		if err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorParams("level %d not found", req.LevelUID.Int64())
+			}
+			s.helper.Errorw("msg", "get level failed", "error", err, "levelUID", req.LevelUID)
+			return nil, merr.ErrorInternalServer("render preview failed").WithCause(err)
+		}
+		data.Level = level.Name
+	}
+	if req.DatasourceUID > 0 {
+		datasource, err := s.datasourceRepo.GetDatasource(ctx, req.DatasourceUID)
+		if err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorParams("datasource %d not found", req.DatasourceUID.Int64())
+			}
+			s.helper.Errorw("msg", "get datasource failed", "error", err, "datasourceUID", req.DatasourceUID)
+			return nil, merr.ErrorInternalServer("render preview failed").WithCause(err)
+		}
+		data.Datasource = datasource.Name
+	}
+	result := &bo.RenderPreviewResultBo{}
+	var err error
+	if result.Summary, err = evaluator.ExpandTemplate("summary", req.Summary, data); err != nil {
+		result.Errors = append(result.Errors, &bo.TemplateErrorBo{Field: "summary", Message: err.Error()})
+	}
+	if result.Description, err = evaluator.ExpandTemplate("description", req.Description, data); err != nil {
+		result.Errors = append(result.Errors, &bo.TemplateErrorBo{Field: "description", Message: err.Error()})
+	}
+	return result, nil
+}
+
+// BacktestStrategyMetric replays a saved or an inline strategy against the history of its
+// datasources, datasources that cannot be queried are reported in the result.
+func (s *StrategyMetricBiz) BacktestStrategyMetric(ctx context.Context, req *bo.BacktestStrategyMetricBo) (*bo.BacktestStrategyMetricResultBo, error) {
+	if req.Expr == "" {
+		item, err := s.GetStrategyMetric(ctx, req.StrategyUID)
+		if err != nil {
+			return nil, err
+		}
+		req.Expr, req.Labels, req.DatasourceUIDs = item.Expr, item.Labels, item.DatasourceUIDs
+		for _, level := range item.Levels {
+			if level.Status == enum.GlobalStatus_ENABLED {
+				req.Levels = append(req.Levels, level)
+			}
+		}
+		if len(req.Levels) == 0 {
+			return nil, merr.ErrorParams("strategy %d has no enabled levels", req.StrategyUID.Int64())
+		}
+	} else {
+		for _, level := range req.Levels {
+			if err := s.checkLevel(ctx, level.LevelUID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := &bo.BacktestStrategyMetricResultBo{EndTime: req.EndTime}
+	rule := &evaluator.Rule{StrategyUID: req.StrategyUID, Expr: req.Expr, Labels: req.Labels}
+	for _, datasourceUID := range req.DatasourceUIDs {
+		datasource, err := s.datasourceRepo.GetDatasource(ctx, datasourceUID)
+		if err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorParams("datasource %d not found", datasourceUID.Int64())
+			}
+			s.helper.Errorw("msg", "get datasource failed", "error", err, "uid", datasourceUID)
+			return nil, merr.ErrorInternalServer("backtest strategy metric failed").WithCause(err)
+		}
+		// only the datasources the evaluation would query are replayed
+		if datasource.Driver != enum.DatasourceDriver_METRICS_PROMETHEUS {
+			result.Errors = append(result.Errors, fmt.Sprintf("datasource %d: unsupported driver %s", datasourceUID.Int64(), datasource.Driver))
+			continue
+		}
+		querier, err := newPrometheusQuerier(datasource.Config)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("datasource %d: %v", datasourceUID.Int64(), err))
+			continue
+		}
+		rule.Datasources = append(rule.Datasources, &evaluator.Datasource{UID: datasource.UID, Name: datasource.Name, Querier: querier})
+	}
+	for _, level := range req.Levels {
+		rule.Levels = append(rule.Levels, &evaluator.Level{
+			LevelUID:  level.LevelUID,
+			Mode:      level.Mode,
+			Condition: level.Condition,
+			Values:    level.Values,
+			Duration:  level.Duration,
+		})
+		result.Levels = append(result.Levels, level.LevelUID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, backtestTimeout)
+	defer cancel()
+	backtest, err := evaluator.Backtest(ctx, rule, req.StartTime, req.EndTime, req.Step)
+	if err != nil {
+		return nil, merr.ErrorParams("%v", err)
+	}
+	for _, queryErr := range backtest.Errors {
+		result.Errors = append(result.Errors, queryErr.Error())
+	}
+	result.Result = backtest
+	return result, nil
+}
+
+func (s *StrategyMetricBiz) checkStrategy(ctx context.Context, strategyUID snowflake.ID) error {
+	if _, err := s.strategyRepo.GetStrategy(ctx, strategyUID); err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorNotFound("strategy %d not found", strategyUID.Int64())
+		}
+		s.helper.Errorw("msg", "get strategy failed", "error", err, "strategyUID", strategyUID)
+		return merr.ErrorInternalServer("get strategy failed").WithCause(err)
+	}
+	return nil
+}
+
+// checkLevel makes sure the level exists in the current namespace and is enabled.
+func (s *StrategyMetricBiz) checkLevel(ctx context.Context, levelUID snowflake.ID) error {
+	level, err := s.levelRepo.GetLevel(ctx, levelUID)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return merr.ErrorParams("level %d not found", levelUID.Int64())
+		}
+		s.helper.Errorw("msg", "get level failed", "error", err, "levelUID", levelUID)
+		return merr.ErrorInternalServer("get level failed").WithCause(err)
+	}
+	if level.Status != enum.GlobalStatus_ENABLED {
+		return merr.ErrorParams("level %d is not enabled", levelUID.Int64())
+	}
+	return nil
+}
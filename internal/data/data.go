@@ -26,6 +26,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/aide-family/marksman/internal/conf"
+	"github.com/aide-family/marksman/internal/data/secret"
 )
 
 // ProviderSetData is a set of data providers.
@@ -57,6 +58,16 @@ func New(c *conf.Bootstrap, helper *klog.Helper) (*Data, func(), error) {
 	d.db = db
 	d.closes.Set("db", close)
 
+	encryption := d.c.GetEncryption()
+	keyring, err := secret.NewKeyring(encryption.GetKey(), encryption.GetPreviousKeys()...)
+	if err != nil {
+		return nil, d.close, err
+	}
+	if !keyring.Enabled() {
+		d.helper.Warnw("msg", "no encryption key configured, datasource secrets are stored in plaintext")
+	}
+	d.keyring = keyring
+
 	node, err := snowflake.NewNode(hello.NodeID())
 	if err != nil {
 		return nil, d.close, err
@@ -73,6 +84,7 @@ type Data struct {
 	cache    cache.Interface
 	db       *gorm.DB
 	node     *snowflake.Node
+	keyring  *secret.Keyring
 	closes   *safety.SyncMap[string, func() error] // 使用SyncMap保证并发安全
 }
 
@@ -113,6 +125,11 @@ func (d *Data) Node() *snowflake.Node {
 	return d.node
 }
 
+// Keyring encrypts secrets before they are written to the database.
+func (d *Data) Keyring() *secret.Keyring {
+	return d.keyring
+}
+
 func (d *Data) DB() *gorm.DB {
 	return d.db
 }
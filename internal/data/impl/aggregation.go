@@ -0,0 +1,98 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewAggregationRepository(d *data.Data) (repository.Aggregation, error) {
+	query.SetDefault(d.DB())
+	return &aggregationRepository{db: d.DB()}, nil
+}
+
+type aggregationRepository struct {
+	db *gorm.DB
+}
+
+func (r *aggregationRepository) SaveAggregation(ctx context.Context, req *bo.SaveAggregationBo) error {
+	m := convert.ToStrategyGroupAggregationDo(ctx, req)
+	return query.Q.Transaction(func(tx *query.Query) error {
+		a := tx.StrategyGroupAggregation
+		wrappers := a.WithContext(ctx).Where(
+			a.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+			a.StrategyGroupUID.Eq(req.StrategyGroupUID.Int64()),
+		)
+		total, err := wrappers.Count()
+		if err != nil {
+			return err
+		}
+		if total == 0 {
+			return a.WithContext(ctx).Create(m)
+		}
+		_, err = wrappers.Select(a.GroupBy, a.GroupWait, a.GroupInterval, a.RepeatInterval).Updates(m)
+		return err
+	})
+}
+
+func (r *aggregationRepository) GetAggregation(ctx context.Context, strategyGroupUID snowflake.ID) (*bo.AggregationItemBo, error) {
+	a := query.StrategyGroupAggregation
+	m, err := a.WithContext(ctx).Where(
+		a.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		a.StrategyGroupUID.Eq(strategyGroupUID.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("aggregation not found")
+		}
+		return nil, err
+	}
+	return convert.ToAggregationItemBo(m), nil
+}
+
+func (r *aggregationRepository) DeleteAggregation(ctx context.Context, strategyGroupUID snowflake.ID) error {
+	a := query.StrategyGroupAggregation
+	info, err := a.WithContext(ctx).Where(
+		a.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		a.StrategyGroupUID.Eq(strategyGroupUID.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("aggregation not found")
+	}
+	return nil
+}
+
+func (r *aggregationRepository) GetStrategyAggregation(ctx context.Context, namespaceUID, strategyUID snowflake.ID) (*bo.AggregationItemBo, error) {
+	s := query.Strategy
+	strategy, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespaceUID.Int64()), s.UID.Eq(strategyUID.Int64())).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	a := query.StrategyGroupAggregation
+	m, err := a.WithContext(ctx).Where(
+		a.NamespaceUID.Eq(namespaceUID.Int64()),
+		a.StrategyGroupUID.Eq(strategy.StrategyGroupUID.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return convert.ToAggregationItemBo(m), nil
+}
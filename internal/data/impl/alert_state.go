@@ -0,0 +1,96 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewAlertStateRepository(d *data.Data) (repository.AlertState, error) {
+	query.SetDefault(d.DB())
+	return &alertStateRepository{db: d.DB()}, nil
+}
+
+type alertStateRepository struct {
+	db *gorm.DB
+}
+
+func (r *alertStateRepository) ListAlertStates(ctx context.Context, strategyUID snowflake.ID) ([]*bo.AlertStateBo, error) {
+	a := query.AlertState
+	list, err := a.WithContext(ctx).Where(a.StrategyUID.Eq(strategyUID.Int64())).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.AlertStateBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToAlertStateBo(m))
+	}
+	return items, nil
+}
+
+// SaveAlertStates upserts the alerts by level and fingerprint and deletes the alerts of the
+// strategy that are gone, the rows of alerts still tracked are kept. The manually resolved
+// flag is never overwritten, it is set by SaveEventActivity and dropped with the row.
+func (r *alertStateRepository) SaveAlertStates(ctx context.Context, strategyUID snowflake.ID, states []*bo.AlertStateBo) ([]*bo.AlertStateBo, error) {
+	list := make([]*do.AlertState, 0, len(states))
+	keep := make(map[alertStateKey]*bo.AlertStateBo, len(states))
+	for _, state := range states {
+		m := convert.ToAlertStateDo(state)
+		list = append(list, m)
+		keep[alertStateKey{levelUID: m.LevelUID, fingerprint: m.Fingerprint}] = state
+	}
+	var resolved []*bo.AlertStateBo
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		a := tx.AlertState
+		existing, err := a.WithContext(ctx).Select(a.ID, a.LevelUID, a.Fingerprint, a.ManuallyResolved).Where(a.StrategyUID.Eq(strategyUID.Int64())).Find()
+		if err != nil {
+			return err
+		}
+		gone := make([]uint32, 0, len(existing))
+		for _, m := range existing {
+			state, ok := keep[alertStateKey{levelUID: m.LevelUID, fingerprint: m.Fingerprint}]
+			if !ok {
+				gone = append(gone, m.ID)
+				continue
+			}
+			if m.ManuallyResolved {
+				resolved = append(resolved, state)
+			}
+		}
+		if len(gone) > 0 {
+			if _, err := a.WithContext(ctx).Where(a.ID.In(gone...)).Delete(); err != nil {
+				return err
+			}
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		return a.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: a.StrategyUID.ColumnName().String()},
+				{Name: a.LevelUID.ColumnName().String()},
+				{Name: a.Fingerprint.ColumnName().String()},
+			},
+			DoUpdates: clause.AssignmentColumns([]string{"namespace_uid", "datasource_uid", "labels", "value", "state", "active_at", "starts_at", "last_eval_at", "updated_at"}),
+		}).Create(list...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// alertStateKey identifies the checkpoint of an alert within its strategy.
+type alertStateKey struct {
+	levelUID    snowflake.ID
+	fingerprint string
+}
@@ -0,0 +1,81 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func TestSaveAlertStatesUpserts(t *testing.T) {
+	ctx := context.Background()
+	repo := &alertStateRepository{db: openTestDB(t, &do.AlertState{})}
+	now := time.Unix(1700000000, 0)
+	state := func(level snowflake.ID, fingerprint uint64, value float64) *bo.AlertStateBo {
+		return &bo.AlertStateBo{
+			NamespaceUID: 1,
+			StrategyUID:  10,
+			LevelUID:     level,
+			Fingerprint:  fingerprint,
+			Labels:       map[string]string{"instance": "a"},
+			Value:        value,
+			State:        1,
+			ActiveAt:     now,
+			LastEvalAt:   now,
+		}
+	}
+	ids := func() map[string]uint32 {
+		t.Helper()
+		a := query.AlertState
+		list, err := a.WithContext(ctx).Where(a.StrategyUID.Eq(10)).Find()
+		if err != nil {
+			t.Fatalf("list alert states: %v", err)
+		}
+		out := make(map[string]uint32, len(list))
+		for _, m := range list {
+			out[m.LevelUID.String()+"/"+m.Fingerprint] = m.ID
+		}
+		return out
+	}
+
+	if _, err := repo.SaveAlertStates(ctx, 10, []*bo.AlertStateBo{state(1, 0xa, 1), state(1, 0xb, 1), state(2, 0xa, 1)}); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	before := ids()
+	if len(before) != 3 {
+		t.Fatalf("got alert states %v, want 3", before)
+	}
+
+	// the same level and fingerprint keeps its row, only the gone alert is deleted
+	if _, err := repo.SaveAlertStates(ctx, 10, []*bo.AlertStateBo{state(1, 0xa, 2), state(2, 0xa, 2), state(2, 0xc, 2)}); err != nil {
+		t.Fatalf("save again: %v", err)
+	}
+	after := ids()
+	if len(after) != 3 || after["1/a"] != before["1/a"] || after["2/a"] != before["2/a"] {
+		t.Fatalf("got alert states %v after %v, want the kept alerts on their rows", after, before)
+	}
+	if _, ok := after["1/b"]; ok {
+		t.Fatalf("got alert states %v, want the gone alert deleted", after)
+	}
+	list, err := repo.ListAlertStates(ctx, 10)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	for _, item := range list {
+		if item.Value != 2 {
+			t.Fatalf("got alert state %+v, want the saved value", item)
+		}
+	}
+
+	if _, err := repo.SaveAlertStates(ctx, 10, nil); err != nil {
+		t.Fatalf("save none: %v", err)
+	}
+	if got := ids(); len(got) != 0 {
+		t.Fatalf("got alert states %v, want none", got)
+	}
+}
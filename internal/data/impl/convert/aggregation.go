@@ -0,0 +1,35 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToAggregationItemBo(m *do.StrategyGroupAggregation) *bo.AggregationItemBo {
+	return &bo.AggregationItemBo{
+		StrategyGroupUID: m.StrategyGroupUID,
+		GroupBy:          m.GroupBy,
+		GroupWait:        m.GroupWait,
+		GroupInterval:    m.GroupInterval,
+		RepeatInterval:   m.RepeatInterval,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+func ToStrategyGroupAggregationDo(ctx context.Context, req *bo.SaveAggregationBo) *do.StrategyGroupAggregation {
+	m := &do.StrategyGroupAggregation{
+		StrategyGroupUID: req.StrategyGroupUID,
+		GroupBy:          req.GroupBy,
+		GroupWait:        req.GroupWait,
+		GroupInterval:    req.GroupInterval,
+		RepeatInterval:   req.RepeatInterval,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
@@ -0,0 +1,52 @@
+package convert
+
+import (
+	"strconv"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToAlertStateBo(m *do.AlertState) *bo.AlertStateBo {
+	fingerprint, _ := strconv.ParseUint(m.Fingerprint, 16, 64)
+	item := &bo.AlertStateBo{
+		NamespaceUID:  m.NamespaceUID,
+		StrategyUID:   m.StrategyUID,
+		LevelUID:      m.LevelUID,
+		DatasourceUID: m.DatasourceUID,
+		Fingerprint:   fingerprint,
+		Labels:        m.Labels,
+		Value:         m.Value,
+		State:         m.State,
+		ActiveAt:      m.ActiveAt,
+		LastEvalAt:    m.LastEvalAt,
+
+		ManuallyResolved: m.ManuallyResolved,
+	}
+	if m.StartsAt != nil {
+		item.StartsAt = *m.StartsAt
+	}
+	return item
+}
+
+func ToAlertStateDo(req *bo.AlertStateBo) *do.AlertState {
+	m := &do.AlertState{
+		NamespaceUID:  req.NamespaceUID,
+		StrategyUID:   req.StrategyUID,
+		LevelUID:      req.LevelUID,
+		DatasourceUID: req.DatasourceUID,
+		Fingerprint:   strconv.FormatUint(req.Fingerprint, 16),
+		Labels:        req.Labels,
+		Value:         req.Value,
+		State:         req.State,
+		ActiveAt:      req.ActiveAt,
+		LastEvalAt:    req.LastEvalAt,
+
+		ManuallyResolved: req.ManuallyResolved,
+	}
+	if !req.StartsAt.IsZero() {
+		startsAt := req.StartsAt
+		m.StartsAt = &startsAt
+	}
+	return m
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/aide-family/marksman/internal/data/impl/do"
 )
 
+// ToDatasourceItemBo leaves out the credentials datasources not yet rotated keep in their
+// metadata, they are read into the config where they are redacted.
 func ToDatasourceItemBo(m *do.Datasource) *bo.DatasourceItemBo {
 	if m == nil {
 		return nil
@@ -19,8 +21,10 @@ func ToDatasourceItemBo(m *do.Datasource) *bo.DatasourceItemBo {
 		Name:      m.Name,
 		Type:      m.Type,
 		Driver:    m.Driver,
-		Metadata:  m.Metadata,
+		Metadata:  bo.WithoutDatasourceMetadataCredentials(m.Metadata),
+		Config:    ToDatasourceConfigBo(m),
 		Status:    m.Status,
+		Managed:   m.Managed,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}
@@ -32,9 +36,60 @@ func ToDatasourceDo(ctx context.Context, req *bo.CreateDatasourceBo) *do.Datasou
 		Type:     req.Type,
 		Driver:   req.Driver,
 		Metadata: req.Metadata,
+		Config:   ToDatasourceConfigDo(req.Config),
 		Status:   enum.GlobalStatus_ENABLED,
 	}
 	m.WithCreator(contextx.GetUserUID(ctx))
 	m.WithNamespace(contextx.GetNamespace(ctx))
 	return m
 }
+
+// ToDatasourceConfigBo falls back to the metadata of datasources saved before they had a typed config.
+func ToDatasourceConfigBo(m *do.Datasource) *bo.DatasourceConfigBo {
+	if m.Config == nil {
+		return bo.NewDatasourceConfigBoFromMetadata(m.Metadata)
+	}
+	c := m.Config
+	b := &bo.DatasourceConfigBo{
+		URL:            c.URL,
+		AuthMode:       c.AuthMode,
+		Username:       c.Username,
+		Password:       c.Password,
+		BearerToken:    c.BearerToken,
+		Headers:        c.Headers,
+		Timeout:        c.Timeout,
+		ScrapeInterval: c.ScrapeInterval,
+	}
+	if c.TLS != nil {
+		b.TLS = &bo.DatasourceTLSConfigBo{
+			CACert:             c.TLS.CACert,
+			InsecureSkipVerify: c.TLS.InsecureSkipVerify,
+			ServerName:         c.TLS.ServerName,
+		}
+	}
+	return b
+}
+
+func ToDatasourceConfigDo(b *bo.DatasourceConfigBo) *do.DatasourceConfig {
+	if b == nil {
+		return nil
+	}
+	c := &do.DatasourceConfig{
+		URL:            b.URL,
+		AuthMode:       b.AuthMode,
+		Username:       b.Username,
+		Password:       b.Password,
+		BearerToken:    b.BearerToken,
+		Headers:        b.Headers,
+		Timeout:        b.Timeout,
+		ScrapeInterval: b.ScrapeInterval,
+	}
+	if b.TLS != nil {
+		c.TLS = &do.DatasourceTLSConfig{
+			CACert:             b.TLS.CACert,
+			InsecureSkipVerify: b.TLS.InsecureSkipVerify,
+			ServerName:         b.TLS.ServerName,
+		}
+	}
+	return c
+}
@@ -0,0 +1,85 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToEscalationPolicyItemBo(m *do.EscalationPolicy, bindings []*do.EscalationPolicyBinding) *bo.EscalationPolicyItemBo {
+	if m == nil {
+		return nil
+	}
+	item := &bo.EscalationPolicyItemBo{
+		UID:          m.UID,
+		NamespaceUID: m.NamespaceUID,
+		Name:         m.Name,
+		Remark:       m.Remark,
+		Steps:        make([]*bo.EscalationStepBo, 0, len(m.Steps)),
+		Status:       m.Status,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+	for _, step := range m.Steps {
+		item.Steps = append(item.Steps, &bo.EscalationStepBo{Delay: step.Delay, ReceiverUIDs: step.ReceiverUIDs})
+	}
+	for _, binding := range bindings {
+		if binding.LevelUID != 0 {
+			item.LevelUIDs = append(item.LevelUIDs, binding.LevelUID)
+			continue
+		}
+		item.StrategyGroupUIDs = append(item.StrategyGroupUIDs, binding.StrategyGroupUID)
+	}
+	return item
+}
+
+func ToEscalationStepsDo(steps []*bo.EscalationStepBo) []*do.EscalationStep {
+	list := make([]*do.EscalationStep, 0, len(steps))
+	for _, step := range steps {
+		list = append(list, &do.EscalationStep{Delay: step.Delay, ReceiverUIDs: step.ReceiverUIDs})
+	}
+	return list
+}
+
+func ToEscalationPolicyDo(ctx context.Context, req *bo.CreateEscalationPolicyBo) *do.EscalationPolicy {
+	m := &do.EscalationPolicy{
+		Name:   req.Name,
+		Remark: req.Remark,
+		Steps:  ToEscalationStepsDo(req.Steps),
+		Status: enum.GlobalStatus_ENABLED,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
+
+// ToEscalationPolicyBindingsDo returns the bindings of the policy to the strategy groups and levels of spec.
+func ToEscalationPolicyBindingsDo(ctx context.Context, policyUID snowflake.ID, spec *bo.EscalationPolicySpecBo) []*do.EscalationPolicyBinding {
+	list := make([]*do.EscalationPolicyBinding, 0, len(spec.StrategyGroupUIDs)+len(spec.LevelUIDs))
+	newBinding := func(strategyGroupUID, levelUID snowflake.ID) *do.EscalationPolicyBinding {
+		m := &do.EscalationPolicyBinding{StrategyGroupUID: strategyGroupUID, LevelUID: levelUID, PolicyUID: policyUID}
+		m.WithCreator(contextx.GetUserUID(ctx))
+		m.WithNamespace(contextx.GetNamespace(ctx))
+		return m
+	}
+	for _, uid := range spec.StrategyGroupUIDs {
+		list = append(list, newBinding(uid, 0))
+	}
+	for _, uid := range spec.LevelUIDs {
+		list = append(list, newBinding(0, uid))
+	}
+	return list
+}
+
+func ToEscalationPolicyBindingBo(m *do.EscalationPolicyBinding) *bo.EscalationPolicyBindingBo {
+	return &bo.EscalationPolicyBindingBo{
+		PolicyUID:        m.PolicyUID,
+		StrategyGroupUID: m.StrategyGroupUID,
+		LevelUID:         m.LevelUID,
+	}
+}
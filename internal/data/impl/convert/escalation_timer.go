@@ -0,0 +1,49 @@
+package convert
+
+import (
+	"encoding/json"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToEscalationTimerDo(timer *escalator.Timer) (*do.EscalationTimer, error) {
+	data, err := json.Marshal(timer.Message)
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]*do.EscalationStep, 0, len(timer.Steps))
+	for _, step := range timer.Steps {
+		steps = append(steps, &do.EscalationStep{Delay: step.Delay, ReceiverUIDs: step.ReceiverUIDs})
+	}
+	return &do.EscalationTimer{
+		EventUID:     timer.EventUID,
+		NamespaceUID: timer.NamespaceUID,
+		PolicyUID:    timer.PolicyUID,
+		Steps:        steps,
+		Step:         int32(timer.Step),
+		FireAt:       timer.FireAt,
+		Message:      string(data),
+	}, nil
+}
+
+func ToEscalatorTimer(m *do.EscalationTimer) (*escalator.Timer, error) {
+	var msg notifier.Message
+	if err := json.Unmarshal([]byte(m.Message), &msg); err != nil {
+		return nil, err
+	}
+	steps := make([]*escalator.Step, 0, len(m.Steps))
+	for _, step := range m.Steps {
+		steps = append(steps, &escalator.Step{Delay: step.Delay, ReceiverUIDs: step.ReceiverUIDs})
+	}
+	return &escalator.Timer{
+		EventUID:     m.EventUID,
+		NamespaceUID: m.NamespaceUID,
+		PolicyUID:    m.PolicyUID,
+		Steps:        steps,
+		Step:         int(m.Step),
+		FireAt:       m.FireAt,
+		Message:      &msg,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package convert
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aide-family/magicbox/contextx"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func ToEventItemBo(m *do.Event) *bo.EventItemBo {
+	if m == nil {
+		return nil
+	}
+	item := &bo.EventItemBo{
+		UID:           m.UID,
+		NamespaceUID:  m.NamespaceUID,
+		StrategyUID:   m.StrategyUID,
+		LevelUID:      m.LevelUID,
+		DatasourceUID: m.DatasourceUID,
+		Fingerprint:   m.Fingerprint,
+		Labels:        m.Labels,
+		Annotations:   m.Annotations,
+		Value:         m.Value,
+		State:         apiv1.EventState(m.State),
+		StartsAt:      m.StartsAt,
+		LastEvalAt:    m.LastEvalAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+		AckedBy:       m.AckedBy,
+		Assignee:      m.Assignee,
+		Flapping:      m.Flapping,
+	}
+	if m.EndsAt != nil {
+		item.EndsAt = *m.EndsAt
+	}
+	if m.AckedAt != nil {
+		item.AckedAt = *m.AckedAt
+	}
+	return item
+}
+
+func ToEventDo(req *bo.SaveEventBo) *do.Event {
+	m := &do.Event{
+		StrategyUID:   req.StrategyUID,
+		LevelUID:      req.LevelUID,
+		DatasourceUID: req.DatasourceUID,
+		Fingerprint:   strconv.FormatUint(req.Fingerprint, 16),
+		Labels:        req.Labels,
+		Annotations:   req.Annotations,
+		Value:         req.Value,
+		State:         int32(req.State),
+		StartsAt:      req.StartsAt,
+		LastEvalAt:    req.EvalAt,
+		Flapping:      req.Flapping,
+	}
+	m.WithNamespace(req.NamespaceUID)
+	return m
+}
+
+func ToEventTimelineDo(m *do.Event, state int32, value float64) *do.EventTimeline {
+	t := &do.EventTimeline{
+		NamespaceUID: m.NamespaceUID,
+		EventUID:     m.UID,
+		State:        state,
+		Value:        value,
+		Time:         m.StartsAt,
+	}
+	if apiv1.EventState(state) == apiv1.EventState_RESOLVED && m.EndsAt != nil {
+		t.Time = *m.EndsAt
+	}
+	return t
+}
+
+func ToEventTimelineItemBo(m *do.EventTimeline) *bo.EventTimelineItemBo {
+	return &bo.EventTimelineItemBo{
+		EventUID: m.EventUID,
+		State:    apiv1.EventState(m.State),
+		Value:    m.Value,
+		Time:     m.Time,
+	}
+}
+
+func ToEventActivityDo(ctx context.Context, m *do.Event, req *bo.EventActivityBo) *do.EventActivity {
+	return &do.EventActivity{
+		NamespaceUID: m.NamespaceUID,
+		EventUID:     m.UID,
+		Type:         int32(req.Type),
+		Actor:        contextx.GetUserUID(ctx),
+		Assignee:     req.Assignee,
+		Comment:      req.Comment,
+		Time:         req.Time,
+	}
+}
+
+func ToEventActivityItemBo(m *do.EventActivity) *bo.EventActivityItemBo {
+	return &bo.EventActivityItemBo{
+		EventUID: m.EventUID,
+		Type:     apiv1.EventActivityType(m.Type),
+		Actor:    m.Actor,
+		Assignee: m.Assignee,
+		Comment:  m.Comment,
+		Time:     m.Time,
+	}
+}
@@ -0,0 +1,50 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToInhibitRuleItemBo(m *do.InhibitRule) *bo.InhibitRuleItemBo {
+	if m == nil {
+		return nil
+	}
+	// the matchers were validated when the rule was saved
+	sourceMatchers, _ := bo.ParseLabelMatchers(m.SourceMatchers)
+	targetMatchers, _ := bo.ParseLabelMatchers(m.TargetMatchers)
+	return &bo.InhibitRuleItemBo{
+		UID:            m.UID,
+		NamespaceUID:   m.NamespaceUID,
+		Name:           m.Name,
+		Remark:         m.Remark,
+		SourceLevelUID: m.SourceLevelUID,
+		SourceMatchers: sourceMatchers,
+		TargetLevelUID: m.TargetLevelUID,
+		TargetMatchers: targetMatchers,
+		Equal:          m.Equal,
+		Status:         m.Status,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func ToInhibitRuleDo(ctx context.Context, req *bo.CreateInhibitRuleBo) *do.InhibitRule {
+	m := &do.InhibitRule{
+		Name:           req.Name,
+		Remark:         req.Remark,
+		SourceLevelUID: req.SourceLevelUID,
+		SourceMatchers: ToLabelMatchersDo(req.SourceMatchers),
+		TargetLevelUID: req.TargetLevelUID,
+		TargetMatchers: ToLabelMatchersDo(req.TargetMatchers),
+		Equal:          req.Equal,
+		Status:         enum.GlobalStatus_ENABLED,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
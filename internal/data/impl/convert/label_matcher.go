@@ -0,0 +1,12 @@
+package convert
+
+import "github.com/aide-family/marksman/internal/biz/bo"
+
+// ToLabelMatchersDo returns the matchers as stored, bo.ParseLabelMatchers reads them back.
+func ToLabelMatchersDo(matchers bo.LabelMatchers) []string {
+	list := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		list = append(list, m.String())
+	}
+	return list
+}
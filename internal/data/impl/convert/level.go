@@ -18,6 +18,7 @@ func ToLevelItemBo(m *do.Level) *bo.LevelItemBo {
 		Remark:    m.Remark,
 		Status:    m.Status,
 		Metadata:  m.Metadata,
+		Managed:   m.Managed,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}
@@ -0,0 +1,98 @@
+package convert
+
+import (
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToManifestLevelDo(namespace, creator snowflake.ID, req *bo.ManifestLevelBo) *do.Level {
+	m := &do.Level{
+		Name:     req.Name,
+		Remark:   req.Remark,
+		Metadata: req.Metadata,
+		Status:   req.Status,
+		Managed:  true,
+	}
+	m.WithCreator(creator)
+	m.WithNamespace(namespace)
+	return m
+}
+
+func ToManifestDatasourceDo(namespace, creator snowflake.ID, req *bo.ManifestDatasourceBo) *do.Datasource {
+	m := &do.Datasource{
+		Name:     req.Name,
+		Type:     req.Type,
+		Driver:   req.Driver,
+		Metadata: req.Metadata,
+		Config:   ToDatasourceConfigDo(req.Config),
+		Status:   enum.GlobalStatus_ENABLED,
+		Managed:  true,
+	}
+	m.WithCreator(creator)
+	m.WithNamespace(namespace)
+	return m
+}
+
+func ToManifestStrategyGroupDo(namespace, creator snowflake.ID, req *bo.ManifestStrategyGroupBo) *do.StrategyGroup {
+	m := &do.StrategyGroup{
+		Name:     req.Name,
+		Remark:   req.Remark,
+		Metadata: req.Metadata,
+		Status:   req.Status,
+		Managed:  true,
+	}
+	m.WithCreator(creator)
+	m.WithNamespace(namespace)
+	return m
+}
+
+func ToManifestStrategyDo(namespace, creator, strategyGroupUID snowflake.ID, req *bo.ManifestStrategyBo) *do.Strategy {
+	m := &do.Strategy{
+		StrategyGroupUID: strategyGroupUID,
+		Name:             req.Name,
+		Remark:           req.Remark,
+		Type:             req.Type,
+		Driver:           req.Driver,
+		Metadata:         req.Metadata,
+		Status:           req.Status,
+		Managed:          true,
+	}
+	m.WithCreator(creator)
+	m.WithNamespace(namespace)
+	return m
+}
+
+func ToManifestStrategyMetricDo(namespace, creator, strategyUID snowflake.ID, datasourceUIDs []snowflake.ID, req *bo.ManifestStrategyMetricBo) *do.StrategyMetric {
+	m := &do.StrategyMetric{
+		StrategyUID:    strategyUID,
+		Expr:           req.Expr,
+		Labels:         req.Labels,
+		Summary:        req.Summary,
+		Description:    req.Description,
+		DatasourceUIDs: datasourceUIDs,
+		Status:         req.Status,
+	}
+	m.WithCreator(creator)
+	m.WithNamespace(namespace)
+	return m
+}
+
+func ToManifestStrategyMetricLevelDo(namespace, creator, strategyUID, levelUID snowflake.ID, req *bo.ManifestStrategyMetricLevelBo) *do.StrategyMetricLevel {
+	m := &do.StrategyMetricLevel{
+		StrategyUID:   strategyUID,
+		LevelUID:      levelUID,
+		Mode:          req.Mode,
+		Condition:     req.Condition,
+		Values:        req.Values,
+		Duration:      req.Duration,
+		FlapWindow:    req.FlapWindow,
+		FlapThreshold: req.FlapThreshold,
+		Status:        req.Status,
+	}
+	m.WithCreator(creator)
+	m.WithNamespace(namespace)
+	return m
+}
@@ -0,0 +1,64 @@
+package convert
+
+import (
+	"encoding/json"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToNotifyGroupDo(group *aggregator.Group) *do.NotifyGroup {
+	m := &do.NotifyGroup{
+		GroupKey:       group.Key,
+		NamespaceUID:   group.NamespaceUID,
+		ReceiverUID:    group.ReceiverUID,
+		Labels:         group.Labels,
+		GroupInterval:  group.GroupInterval,
+		RepeatInterval: group.RepeatInterval,
+		NextFlushAt:    group.NextFlushAt,
+	}
+	if !group.LastSentAt.IsZero() {
+		lastSentAt := group.LastSentAt
+		m.LastSentAt = &lastSentAt
+	}
+	return m
+}
+
+func ToAggregatorGroup(m *do.NotifyGroup, alerts []*aggregator.Alert) *aggregator.Group {
+	group := &aggregator.Group{
+		Key:            m.GroupKey,
+		NamespaceUID:   m.NamespaceUID,
+		ReceiverUID:    m.ReceiverUID,
+		Labels:         m.Labels,
+		GroupInterval:  m.GroupInterval,
+		RepeatInterval: m.RepeatInterval,
+		NextFlushAt:    m.NextFlushAt,
+		Alerts:         alerts,
+	}
+	if m.LastSentAt != nil {
+		group.LastSentAt = *m.LastSentAt
+	}
+	return group
+}
+
+func ToNotifyGroupAlertDo(groupKey string, msg *notifier.Message) (*do.NotifyGroupAlert, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &do.NotifyGroupAlert{
+		GroupKey: groupKey,
+		EventUID: msg.EventUID,
+		Status:   msg.Status,
+		Message:  string(data),
+	}, nil
+}
+
+func ToAggregatorAlert(m *do.NotifyGroupAlert) (*aggregator.Alert, error) {
+	var msg notifier.Message
+	if err := json.Unmarshal([]byte(m.Message), &msg); err != nil {
+		return nil, err
+	}
+	return &aggregator.Alert{Message: &msg, Notified: m.Notified}, nil
+}
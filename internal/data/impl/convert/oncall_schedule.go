@@ -0,0 +1,81 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToOnCallScheduleItemBo(m *do.OnCallSchedule, overrides []*do.OnCallOverride) *bo.OnCallScheduleItemBo {
+	if m == nil {
+		return nil
+	}
+	item := &bo.OnCallScheduleItemBo{
+		UID:            m.UID,
+		NamespaceUID:   m.NamespaceUID,
+		Name:           m.Name,
+		Remark:         m.Remark,
+		TimeZone:       m.TimeZone,
+		RotationType:   m.RotationType,
+		HandoffTime:    m.HandoffTime,
+		HandoffWeekday: m.HandoffWeekday,
+		StartsAt:       m.StartsAt,
+		Members:        m.Members,
+		Status:         m.Status,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		Overrides:      make([]*bo.OnCallOverrideItemBo, 0, len(overrides)),
+	}
+	for _, o := range overrides {
+		item.Overrides = append(item.Overrides, ToOnCallOverrideItemBo(o))
+	}
+	return item
+}
+
+func ToOnCallScheduleDo(ctx context.Context, req *bo.CreateOnCallScheduleBo) *do.OnCallSchedule {
+	m := &do.OnCallSchedule{
+		Name:           req.Name,
+		Remark:         req.Remark,
+		TimeZone:       req.TimeZone,
+		RotationType:   req.RotationType,
+		HandoffTime:    req.HandoffTime,
+		HandoffWeekday: req.HandoffWeekday,
+		StartsAt:       req.StartsAt,
+		Members:        req.Members,
+		Status:         enum.GlobalStatus_ENABLED,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
+
+func ToOnCallOverrideItemBo(m *do.OnCallOverride) *bo.OnCallOverrideItemBo {
+	if m == nil {
+		return nil
+	}
+	return &bo.OnCallOverrideItemBo{
+		UID:         m.UID,
+		ScheduleUID: m.ScheduleUID,
+		ReceiverUID: m.ReceiverUID,
+		StartsAt:    m.StartsAt,
+		EndsAt:      m.EndsAt,
+		Creator:     m.Creator,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func ToOnCallOverrideDo(ctx context.Context, req *bo.CreateOnCallOverrideBo) *do.OnCallOverride {
+	m := &do.OnCallOverride{
+		ScheduleUID: req.ScheduleUID,
+		ReceiverUID: req.ReceiverUID,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
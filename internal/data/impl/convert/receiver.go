@@ -0,0 +1,86 @@
+package convert
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func ToReceiverItemBo(m *do.Receiver) *bo.ReceiverItemBo {
+	if m == nil {
+		return nil
+	}
+	return &bo.ReceiverItemBo{
+		UID:          m.UID,
+		NamespaceUID: m.NamespaceUID,
+		Name:         m.Name,
+		Remark:       m.Remark,
+		Type:         m.Type,
+		Webhook:      ToWebhookConfigBo(m.Webhook),
+		Status:       m.Status,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func ToWebhookConfigBo(m *do.WebhookConfig) *bo.WebhookConfigBo {
+	if m == nil {
+		return nil
+	}
+	return &bo.WebhookConfigBo{URL: m.URL, Headers: m.Headers}
+}
+
+func ToWebhookConfigDo(req *bo.WebhookConfigBo) *do.WebhookConfig {
+	if req == nil {
+		return nil
+	}
+	return &do.WebhookConfig{URL: req.URL, Headers: req.Headers}
+}
+
+func ToReceiverDo(ctx context.Context, req *bo.CreateReceiverBo) *do.Receiver {
+	m := &do.Receiver{
+		Name:    req.Name,
+		Remark:  req.Remark,
+		Type:    req.Type,
+		Webhook: ToWebhookConfigDo(req.Webhook),
+		Status:  enum.GlobalStatus_ENABLED,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
+
+func ToReceiverDeliveryDo(req *bo.CreateReceiverDeliveryBo) *do.ReceiverDelivery {
+	return &do.ReceiverDelivery{
+		NamespaceUID: req.NamespaceUID,
+		ReceiverUID:  req.ReceiverUID,
+		EventUID:     req.EventUID,
+		EventState:   int32(req.EventState),
+		Attempt:      req.Attempt,
+		StatusCode:   req.StatusCode,
+		Success:      req.Success,
+		Error:        req.Error,
+		LatencyMs:    req.Latency.Milliseconds(),
+	}
+}
+
+func ToReceiverDeliveryItemBo(m *do.ReceiverDelivery) *bo.ReceiverDeliveryItemBo {
+	return &bo.ReceiverDeliveryItemBo{
+		ID:          m.ID,
+		ReceiverUID: m.ReceiverUID,
+		EventUID:    m.EventUID,
+		EventState:  apiv1.EventState(m.EventState),
+		Attempt:     m.Attempt,
+		StatusCode:  m.StatusCode,
+		Success:     m.Success,
+		Error:       m.Error,
+		Latency:     time.Duration(m.LatencyMs) * time.Millisecond,
+		CreatedAt:   m.CreatedAt,
+	}
+}
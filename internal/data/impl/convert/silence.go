@@ -0,0 +1,42 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToSilenceItemBo(m *do.Silence) *bo.SilenceItemBo {
+	if m == nil {
+		return nil
+	}
+	// the matchers were validated when the silence was saved, a silence whose matchers
+	// can not be parsed anymore has none, so it mutes nothing
+	matchers, _ := bo.ParseLabelMatchers(m.Matchers)
+	return &bo.SilenceItemBo{
+		UID:          m.UID,
+		NamespaceUID: m.NamespaceUID,
+		Matchers:     matchers,
+		StartsAt:     m.StartsAt,
+		EndsAt:       m.EndsAt,
+		Comment:      m.Comment,
+		Creator:      m.Creator,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func ToSilenceDo(ctx context.Context, req *bo.CreateSilenceBo) *do.Silence {
+	m := &do.Silence{
+		Matchers: ToLabelMatchersDo(req.Matchers),
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Comment:  req.Comment,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
@@ -0,0 +1,46 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToStrategyItemBo(m *do.Strategy) *bo.StrategyItemBo {
+	return &bo.StrategyItemBo{
+		UID:              m.UID,
+		StrategyGroupUID: m.StrategyGroupUID,
+		Name:             m.Name,
+		Remark:           m.Remark,
+		Type:             m.Type,
+		Driver:           m.Driver,
+		Status:           m.Status,
+		Metadata:         m.Metadata,
+		Managed:          m.Managed,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+func ToStrategyDo(ctx context.Context, req *bo.CreateStrategyBo) *do.Strategy {
+	status := req.Status
+	if status == enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		status = enum.GlobalStatus_ENABLED
+	}
+	m := &do.Strategy{
+		StrategyGroupUID: req.StrategyGroupUID,
+		Name:             req.Name,
+		Remark:           req.Remark,
+		Type:             req.Type,
+		Driver:           req.Driver,
+		Metadata:         req.Metadata,
+		Status:           status,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
@@ -0,0 +1,59 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToStrategyGroupItemBo(m *do.StrategyGroup) *bo.StrategyGroupItemBo {
+	return &bo.StrategyGroupItemBo{
+		UID:       m.UID,
+		Name:      m.Name,
+		Remark:    m.Remark,
+		Status:    m.Status,
+		Metadata:  m.Metadata,
+		Managed:   m.Managed,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func ToStrategyGroupItemSelectBo(m *do.StrategyGroup) *bo.StrategyGroupItemSelectBo {
+	return &bo.StrategyGroupItemSelectBo{
+		Value:    m.UID.Int64(),
+		Label:    m.Name,
+		Disabled: m.Status != enum.GlobalStatus_ENABLED || m.DeletedAt.Valid,
+		Tooltip:  m.Remark,
+	}
+}
+
+func ToStrategyGroupDo(ctx context.Context, req *bo.CreateStrategyGroupBo) *do.StrategyGroup {
+	m := &do.StrategyGroup{
+		Name:     req.Name,
+		Remark:   req.Remark,
+		Metadata: req.Metadata,
+		Status:   enum.GlobalStatus_ENABLED,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
+
+func ToStrategyGroupReceiverDos(ctx context.Context, req *bo.BindStrategyGroupReceiversBo) []*do.StrategyReceiver {
+	list := make([]*do.StrategyReceiver, 0, len(req.ReceiverUIDs))
+	for _, receiverUID := range req.ReceiverUIDs {
+		m := &do.StrategyReceiver{
+			StrategyGroupUID: req.UID,
+			ReceiverUID:      receiverUID,
+		}
+		m.WithCreator(contextx.GetUserUID(ctx))
+		m.WithNamespace(contextx.GetNamespace(ctx))
+		list = append(list, m)
+	}
+	return list
+}
@@ -0,0 +1,133 @@
+package convert
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func ToStrategyMetricItemBo(m *do.StrategyMetric, levels []*bo.StrategyMetricLevelItemBo) *bo.StrategyMetricItemBo {
+	return &bo.StrategyMetricItemBo{
+		StrategyUID:    m.StrategyUID,
+		Expr:           m.Expr,
+		Labels:         m.Labels,
+		Summary:        m.Summary,
+		Description:    m.Description,
+		DatasourceUIDs: m.DatasourceUIDs,
+		Status:         m.Status,
+		Levels:         levels,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func ToStrategyMetricDo(ctx context.Context, req *bo.SaveStrategyMetricBo) *do.StrategyMetric {
+	status := req.Status
+	if status == enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		status = enum.GlobalStatus_ENABLED
+	}
+	m := &do.StrategyMetric{
+		StrategyUID:    req.StrategyUID,
+		Expr:           req.Expr,
+		Labels:         req.Labels,
+		Summary:        req.Summary,
+		Description:    req.Description,
+		DatasourceUIDs: req.DatasourceUIDs,
+		Status:         status,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
+
+// ToStrategyMetricLevelItemBo converts a level row, level is the joined
+// do.Level and may be nil when it has been deleted.
+func ToStrategyMetricLevelItemBo(m *do.StrategyMetricLevel, level *do.Level) *bo.StrategyMetricLevelItemBo {
+	item := &bo.StrategyMetricLevelItemBo{
+		UID:           m.UID,
+		StrategyUID:   m.StrategyUID,
+		LevelUID:      m.LevelUID,
+		Mode:          m.Mode,
+		Condition:     m.Condition,
+		Values:        m.Values,
+		Duration:      m.Duration,
+		FlapWindow:    m.FlapWindow,
+		FlapThreshold: m.FlapThreshold,
+		Status:        m.Status,
+	}
+	if level != nil {
+		item.Level = ToLevelItemBo(level)
+	}
+	return item
+}
+
+func ToStrategyMetricLevelDo(ctx context.Context, req *bo.SaveStrategyMetricLevelBo) *do.StrategyMetricLevel {
+	status := req.Status
+	if status == enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		status = enum.GlobalStatus_ENABLED
+	}
+	m := &do.StrategyMetricLevel{
+		StrategyUID:   req.StrategyUID,
+		LevelUID:      req.LevelUID,
+		Mode:          req.Mode,
+		Condition:     req.Condition,
+		Values:        req.Values,
+		Duration:      req.Duration,
+		FlapWindow:    req.FlapWindow,
+		FlapThreshold: req.FlapThreshold,
+		Status:        status,
+	}
+	m.WithCreator(contextx.GetUserUID(ctx))
+	m.WithNamespace(contextx.GetNamespace(ctx))
+	return m
+}
+
+func ToStrategyMetricReceiverDos(ctx context.Context, strategyGroupUID snowflake.ID, req *bo.BindStrategyMetricReceiversBo) []*do.StrategyReceiver {
+	list := make([]*do.StrategyReceiver, 0, len(req.ReceiverUIDs))
+	for _, receiverUID := range req.ReceiverUIDs {
+		m := &do.StrategyReceiver{
+			StrategyGroupUID: strategyGroupUID,
+			StrategyUID:      req.StrategyUID,
+			LevelUID:         req.LevelUID,
+			ReceiverUID:      receiverUID,
+		}
+		m.WithCreator(contextx.GetUserUID(ctx))
+		m.WithNamespace(contextx.GetNamespace(ctx))
+		list = append(list, m)
+	}
+	return list
+}
+
+func ToStrategyMetricRuleBo(strategy *do.Strategy, m *do.StrategyMetric, datasources []*bo.DatasourceItemBo, levels []*bo.StrategyMetricLevelItemBo) *bo.StrategyMetricRuleBo {
+	return &bo.StrategyMetricRuleBo{
+		NamespaceUID:     m.NamespaceUID,
+		StrategyUID:      m.StrategyUID,
+		StrategyMetadata: strategy.Metadata,
+		Expr:             m.Expr,
+		Labels:           m.Labels,
+		Summary:          m.Summary,
+		Description:      m.Description,
+		Datasources:      datasources,
+		Levels:           levels,
+	}
+}
+
+func ToStrategyMetricExportBo(group *do.StrategyGroup, strategy *do.Strategy, m *do.StrategyMetric, levels []*bo.StrategyMetricLevelItemBo) *bo.StrategyMetricExportBo {
+	return &bo.StrategyMetricExportBo{
+		StrategyGroupUID:  group.UID,
+		StrategyGroupName: group.Name,
+		StrategyUID:       strategy.UID,
+		StrategyName:      strategy.Name,
+		StrategyMetadata:  strategy.Metadata,
+		Expr:              m.Expr,
+		Labels:            m.Labels,
+		Summary:           m.Summary,
+		Description:       m.Description,
+		Levels:            levels,
+	}
+}
@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/aide-family/magicbox/contextx"
@@ -9,6 +10,7 @@ import (
 	"github.com/aide-family/magicbox/merr"
 	"github.com/aide-family/magicbox/safety"
 	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gen/field"
 	"gorm.io/gorm"
 
@@ -16,30 +18,46 @@ import (
 	"github.com/aide-family/marksman/internal/biz/repository"
 	"github.com/aide-family/marksman/internal/data"
 	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
 	"github.com/aide-family/marksman/internal/data/impl/query"
+	"github.com/aide-family/marksman/internal/data/secret"
 )
 
 func NewDatasourceRepository(d *data.Data) (repository.Datasource, error) {
 	query.SetDefault(d.DB())
-	return &datasourceRepository{db: d.DB()}, nil
+	return &datasourceRepository{db: d.DB(), keyring: d.Keyring()}, nil
 }
 
 type datasourceRepository struct {
-	db *gorm.DB
+	db      *gorm.DB
+	keyring *secret.Keyring
 }
 
 func (r *datasourceRepository) CreateDatasource(ctx context.Context, req *bo.CreateDatasourceBo) error {
 	m := convert.ToDatasourceDo(ctx, req)
+	uid, err := do.NewUID()
+	if err != nil {
+		return err
+	}
+	m.UID = uid
+	if err := encryptDatasourceConfig(r.keyring, m.UID, m.Config); err != nil {
+		return err
+	}
 	return query.Datasource.WithContext(ctx).Create(m)
 }
 
 func (r *datasourceRepository) UpdateDatasource(ctx context.Context, req *bo.UpdateDatasourceBo) error {
+	config := convert.ToDatasourceConfigDo(req.Config)
+	if err := encryptDatasourceConfig(r.keyring, req.UID, config); err != nil {
+		return err
+	}
 	d := query.Datasource
 	columns := []field.AssignExpr{
 		d.Name.Value(req.Name),
 		d.Type.Value(int32(req.Type)),
 		d.Driver.Value(int32(req.Driver)),
 		d.Metadata.Value(safety.NewMap(req.Metadata)),
+		d.Config.Value(config),
 	}
 	_, err := d.WithContext(ctx).Where(
 		d.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
@@ -75,6 +93,9 @@ func (r *datasourceRepository) GetDatasource(ctx context.Context, uid snowflake.
 		}
 		return nil, err
 	}
+	if err := decryptDatasourceConfig(r.keyring, m.UID, m.Config); err != nil {
+		return nil, err
+	}
 	return convert.ToDatasourceItemBo(m), nil
 }
 
@@ -109,7 +130,83 @@ func (r *datasourceRepository) ListDatasource(ctx context.Context, req *bo.ListD
 	}
 	items := make([]*bo.DatasourceItemBo, 0, len(list))
 	for _, m := range list {
+		if err := decryptDatasourceConfig(r.keyring, m.UID, m.Config); err != nil {
+			klog.Context(ctx).Warnw("msg", "skip datasource, decrypt config failed", "error", err, "datasourceUID", m.UID)
+			continue
+		}
 		items = append(items, convert.ToDatasourceItemBo(m))
 	}
 	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
 }
+
+// RotateDatasourceSecrets re-encrypts the secrets of all datasources, deleted ones included, with
+// the primary key. Credentials still kept in the metadata are moved into the config.
+func (r *datasourceRepository) RotateDatasourceSecrets(ctx context.Context) (int, error) {
+	rotated := 0
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		d := tx.Datasource
+		list, err := d.WithContext(ctx).Unscoped().Find()
+		if err != nil {
+			return err
+		}
+		for _, m := range list {
+			config, legacy := m.Config, false
+			if config == nil {
+				if config = convert.ToDatasourceConfigDo(bo.NewDatasourceConfigBoFromMetadata(m.Metadata)); config == nil {
+					continue
+				}
+				legacy = true
+			}
+			changed, err := rotateDatasourceConfig(r.keyring, m.UID, config)
+			if err != nil {
+				return fmt.Errorf("datasource %d: %w", m.UID.Int64(), err)
+			}
+			if !changed && !legacy {
+				continue
+			}
+			columns := []field.AssignExpr{d.Config.Value(config)}
+			if legacy {
+				columns = append(columns, d.Metadata.Value(safety.NewMap(bo.WithoutDatasourceMetadataCredentials(m.Metadata))))
+			}
+			if _, err := d.WithContext(ctx).Unscoped().Where(d.ID.Eq(m.ID)).UpdateColumnSimple(columns...); err != nil {
+				return err
+			}
+			rotated++
+		}
+		return nil
+	})
+	return rotated, err
+}
+
+func encryptDatasourceConfig(keyring *secret.Keyring, uid snowflake.ID, c *do.DatasourceConfig) error {
+	if c == nil {
+		return nil
+	}
+	return c.MapSecrets(func(field, value string) (string, error) {
+		return keyring.Encrypt(value, datasourceSecretAAD(uid, field))
+	})
+}
+
+func decryptDatasourceConfig(keyring *secret.Keyring, uid snowflake.ID, c *do.DatasourceConfig) error {
+	if c == nil {
+		return nil
+	}
+	return c.MapSecrets(func(field, value string) (string, error) {
+		return keyring.Decrypt(value, datasourceSecretAAD(uid, field))
+	})
+}
+
+func rotateDatasourceConfig(keyring *secret.Keyring, uid snowflake.ID, c *do.DatasourceConfig) (bool, error) {
+	changed := false
+	err := c.MapSecrets(func(field, value string) (string, error) {
+		rotated, ok, err := keyring.Rotate(value, datasourceSecretAAD(uid, field))
+		changed = changed || ok
+		return rotated, err
+	})
+	return changed, err
+}
+
+// datasourceSecretAAD binds a secret to the datasource and the config field it is stored in.
+func datasourceSecretAAD(uid snowflake.ID, field string) string {
+	return "datasource/" + uid.String() + "/" + field
+}
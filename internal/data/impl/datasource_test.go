@@ -0,0 +1,112 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/safety"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	"github.com/aide-family/marksman/internal/data/secret"
+)
+
+func createDatasource(t *testing.T, ctx context.Context, db *gorm.DB, name string) snowflake.ID {
+	t.Helper()
+	req := &bo.CreateDatasourceBo{Name: name, Type: enum.DatasourceType_METRICS, Driver: enum.DatasourceDriver_METRICS_PROMETHEUS, Config: &bo.DatasourceConfigBo{URL: "http://127.0.0.1:9090"}}
+	if err := (&datasourceRepository{db: db}).CreateDatasource(ctx, req); err != nil {
+		t.Fatalf("create datasource %s: %v", name, err)
+	}
+	d := query.Datasource
+	m, err := d.WithContext(ctx).Where(d.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()), d.Name.Eq(name)).First()
+	if err != nil {
+		t.Fatalf("get datasource %s: %v", name, err)
+	}
+	return m.UID
+}
+
+func TestGetDatasourceRedactsMetadataCredentials(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	ctx := namespaceContext(1)
+	uid := createDatasource(t, ctx, db, "legacy")
+	// a datasource saved before the typed config kept its credentials in the metadata
+	d := query.Datasource
+	legacy := map[string]string{"url": "http://prometheus:9090", "username": "admin", "password": "secret", "team": "ops"}
+	if _, err := d.WithContext(ctx).Where(d.UID.Eq(uid.Int64())).UpdateColumnSimple(d.Metadata.Value(safety.NewMap(legacy)), d.Config.Null()); err != nil {
+		t.Fatalf("save legacy datasource: %v", err)
+	}
+	item, err := (&datasourceRepository{db: db}).GetDatasource(ctx, uid)
+	if err != nil {
+		t.Fatalf("get datasource: %v", err)
+	}
+	if _, ok := item.Metadata["password"]; ok || item.Metadata["team"] != "ops" || item.Config.Password != "secret" {
+		t.Fatalf("got metadata %v and config %+v, want the credentials only in the config", item.Metadata, item.Config)
+	}
+	if got := item.ToAPIV1DatasourceItem(); got.GetConfig().GetPassword() != bo.SecretUnchanged {
+		t.Fatalf("got password %q in the reply, want it redacted", got.GetConfig().GetPassword())
+	}
+}
+
+func TestDatasourceHeadersEncrypted(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	ctx := namespaceContext(1)
+	key, err := secret.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	keyring, err := secret.NewKeyring(key)
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	repo := &datasourceRepository{db: db, keyring: keyring}
+	headers := map[string]string{"X-Api-Key": "s3cret"}
+	req := &bo.CreateDatasourceBo{Name: "prom", Type: enum.DatasourceType_METRICS, Driver: enum.DatasourceDriver_METRICS_PROMETHEUS, Config: &bo.DatasourceConfigBo{URL: "http://127.0.0.1:9090", Headers: headers}}
+	if err := repo.CreateDatasource(ctx, req); err != nil {
+		t.Fatalf("create datasource: %v", err)
+	}
+	if headers["X-Api-Key"] != "s3cret" {
+		t.Fatalf("got headers %v, want the headers of the request left as they were", headers)
+	}
+	d := query.Datasource
+	m, err := d.WithContext(ctx).Where(d.Name.Eq("prom")).First()
+	if err != nil {
+		t.Fatalf("get datasource row: %v", err)
+	}
+	if value := m.Config.Headers["X-Api-Key"]; !secret.IsEncrypted(value) || strings.Contains(value, "s3cret") {
+		t.Fatalf("got header %q at rest, want it encrypted", value)
+	}
+	item, err := repo.GetDatasource(ctx, m.UID)
+	if err != nil || item.Config.Headers["X-Api-Key"] != "s3cret" {
+		t.Fatalf("got %v, %v, want the header decrypted", item, err)
+	}
+	if got := item.ToAPIV1DatasourceItem().GetConfig().GetHeaders()["X-Api-Key"]; got != bo.SecretUnchanged {
+		t.Fatalf("got header %q in the reply, want it redacted", got)
+	}
+
+	// a secret copied into another datasource, or another field, does not decrypt
+	req.Name = "copy"
+	if err := repo.CreateDatasource(ctx, req); err != nil {
+		t.Fatalf("create datasource: %v", err)
+	}
+	for name, config := range map[string]*do.DatasourceConfig{
+		"copy": {URL: "http://127.0.0.1:9090", Headers: m.Config.Headers},
+		"prom": {URL: "http://127.0.0.1:9090", Headers: map[string]string{"X-Other-Key": m.Config.Headers["X-Api-Key"]}},
+	} {
+		if _, err := d.WithContext(ctx).Where(d.Name.Eq(name)).UpdateColumnSimple(d.Config.Value(config)); err != nil {
+			t.Fatalf("copy secret: %v", err)
+		}
+		copied, err := d.WithContext(ctx).Where(d.Name.Eq(name)).First()
+		if err != nil {
+			t.Fatalf("get datasource row: %v", err)
+		}
+		if _, err := repo.GetDatasource(ctx, copied.UID); err == nil {
+			t.Fatalf("got the secret copied into %s decrypted, want an error", name)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package impl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+// openTestDB opens a sqlite database of its own for the test, with the tables of models.
+func openTestDB(t *testing.T, models ...any) *gorm.DB {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "marksman.db") + "?_pragma=busy_timeout(5000)"
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	query.SetDefault(db)
+	return db
+}
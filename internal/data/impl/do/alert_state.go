@@ -0,0 +1,33 @@
+package do
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// AlertState is the checkpoint of a pending or firing alert of the evaluator.
+// It is written by the system, so it carries no uid or creator.
+type AlertState struct {
+	ID            uint32            `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt     time.Time         `gorm:"column:created_at;"`
+	UpdatedAt     time.Time         `gorm:"column:updated_at;"`
+	NamespaceUID  snowflake.ID      `gorm:"column:namespace_uid;default:0;index"`
+	StrategyUID   snowflake.ID      `gorm:"column:strategy_uid;default:0;uniqueIndex:idx__alert_states__strategy_uid__level_uid__fingerprint"`
+	LevelUID      snowflake.ID      `gorm:"column:level_uid;default:0;uniqueIndex:idx__alert_states__strategy_uid__level_uid__fingerprint"`
+	Fingerprint   string            `gorm:"column:fingerprint;type:varchar(16);default:'';uniqueIndex:idx__alert_states__strategy_uid__level_uid__fingerprint"`
+	DatasourceUID snowflake.ID      `gorm:"column:datasource_uid;default:0"`
+	Labels        map[string]string `gorm:"column:labels;type:json;serializer:json"`
+	Value         float64           `gorm:"column:value;default:0"`
+	State         uint8             `gorm:"column:state;type:tinyint;default:0"`
+	ActiveAt      time.Time         `gorm:"column:active_at;"`
+	StartsAt      *time.Time        `gorm:"column:starts_at;"`
+	LastEvalAt    time.Time         `gorm:"column:last_eval_at;"`
+	// ManuallyResolved is set when the event of the firing alert is resolved by hand, the
+	// checkpoints of the evaluator keep it.
+	ManuallyResolved bool `gorm:"column:manually_resolved;default:false"`
+}
+
+func (AlertState) TableName() string {
+	return "alert_states"
+}
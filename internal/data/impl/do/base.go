@@ -3,6 +3,7 @@ package do
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/aide-family/magicbox/hello"
@@ -14,6 +15,29 @@ func Models() []any {
 	return []any{
 		&Level{},
 		&Datasource{},
+		&StrategyGroup{},
+		&Strategy{},
+		&StrategyReceiver{},
+		&StrategyMetric{},
+		&StrategyMetricLevel{},
+		&AlertState{},
+		&Event{},
+		&EventTimeline{},
+		&EventActivity{},
+		&Receiver{},
+		&ReceiverDelivery{},
+		&InhibitRule{},
+		&JobNode{},
+		&Lease{},
+		&Silence{},
+		&StrategyGroupAggregation{},
+		&NotifyGroup{},
+		&NotifyGroupAlert{},
+		&EscalationPolicy{},
+		&EscalationPolicyBinding{},
+		&EscalationTimer{},
+		&OnCallSchedule{},
+		&OnCallOverride{},
 	}
 }
 
@@ -25,18 +49,34 @@ type BaseModel struct {
 	Creator   snowflake.ID `gorm:"column:creator;index"`
 }
 
+// BeforeCreate generates the uid, unless it was set with NewUID because a value of the row is
+// bound to it before the row is created.
 func (b *BaseModel) BeforeCreate(tx *gorm.DB) (err error) {
 	if b.Creator == 0 {
 		return errors.New("creator is required")
 	}
-	node, err := snowflake.NewNode(hello.NodeID())
+	if b.UID != 0 {
+		return nil
+	}
+	b.UID, err = NewUID()
+	return err
+}
+
+// NewUID generates the uid of a row.
+func NewUID() (snowflake.ID, error) {
+	node, err := uidNode()
 	if err != nil {
-		return err
+		return 0, err
 	}
-	b.UID = node.Generate()
-	return nil
+	return node.Generate(), nil
 }
 
+// uidNode is shared by all models, a node per row repeats the uid of rows created in the
+// same millisecond, as the rows of a batch insert are.
+var uidNode = sync.OnceValues(func() (*snowflake.Node, error) {
+	return snowflake.NewNode(hello.NodeID())
+})
+
 func (b *BaseModel) WithCreator(creator snowflake.ID) *BaseModel {
 	b.Creator = creator
 	return b
@@ -1,11 +1,17 @@
 package do
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/aide-family/magicbox/enum"
 	"github.com/bwmarrin/snowflake"
 	"gorm.io/gorm"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
 )
 
 type Datasource struct {
@@ -15,8 +21,11 @@ type Datasource struct {
 	Name         string            `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__datasources__namespace_uid__deleted_at__name"`
 	Type         enum.DatasourceType   `gorm:"column:type;type:tinyint;default:0"`
 	Driver       enum.DatasourceDriver `gorm:"column:driver;type:tinyint;default:0"`
-	Metadata     map[string]string `gorm:"column:metadata;type:json;"`
+	Metadata     map[string]string `gorm:"column:metadata;type:json;serializer:json"`
+	Config       *DatasourceConfig `gorm:"column:config;type:json;"`
 	Status       enum.GlobalStatus `gorm:"column:status;type:tinyint;default:0"`
+	// Managed is set while the datasource is applied from manifests.
+	Managed bool `gorm:"column:managed;default:false"`
 }
 
 func (Datasource) TableName() string {
@@ -32,5 +41,65 @@ func (d *Datasource) BeforeCreate(tx *gorm.DB) (err error) {
 	if d.NamespaceUID == 0 {
 		return errors.New("namespace uid is required")
 	}
+	return d.BaseModel.BeforeCreate(tx)
+}
+
+type DatasourceTLSConfig struct {
+	CACert             string `json:"caCert,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	ServerName         string `json:"serverName,omitempty"`
+}
+
+// DatasourceConfig is the typed connection config of a datasource, stored as json.
+type DatasourceConfig struct {
+	URL            string                   `json:"url"`
+	AuthMode       apiv1.DatasourceAuthMode `json:"authMode,omitempty"`
+	Username       string                   `json:"username,omitempty"`
+	Password       string                   `json:"password,omitempty"`
+	BearerToken    string                   `json:"bearerToken,omitempty"`
+	Headers        map[string]string        `json:"headers,omitempty"`
+	TLS            *DatasourceTLSConfig     `json:"tls,omitempty"`
+	Timeout        time.Duration            `json:"timeout,omitempty"`
+	ScrapeInterval time.Duration            `json:"scrapeInterval,omitempty"`
+}
+
+func (c DatasourceConfig) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *DatasourceConfig) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unsupported datasource config type %T", value)
+	}
+}
+
+// MapSecrets replaces every field that is encrypted at rest with the result of fn, called with
+// the name of the field, the header values are secrets too. Headers is replaced by a new map,
+// it may be shared with the caller.
+func (c *DatasourceConfig) MapSecrets(fn func(field, value string) (string, error)) error {
+	var err error
+	if c.Password, err = fn("password", c.Password); err != nil {
+		return err
+	}
+	if c.BearerToken, err = fn("bearerToken", c.BearerToken); err != nil {
+		return err
+	}
+	if len(c.Headers) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(c.Headers))
+	for name, value := range c.Headers {
+		if headers[name], err = fn("headers."+name, value); err != nil {
+			return err
+		}
+	}
+	c.Headers = headers
 	return nil
 }
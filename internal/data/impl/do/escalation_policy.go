@@ -0,0 +1,90 @@
+package do
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// EscalationStep is one tier of an escalation policy.
+type EscalationStep struct {
+	Delay        time.Duration  `json:"delay"`
+	ReceiverUIDs []snowflake.ID `json:"receiverUIDs"`
+}
+
+// EscalationPolicy pages the receivers of its steps, one after the other, while an event
+// of the strategy groups or levels it is attached to stays unacknowledged.
+type EscalationPolicy struct {
+	BaseModel
+	DeletedAt    gorm.DeletedAt    `gorm:"column:deleted_at;uniqueIndex:idx__escalation_policies__namespace_uid__deleted_at__name"`
+	NamespaceUID snowflake.ID      `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__escalation_policies__namespace_uid__deleted_at__name"`
+	Name         string            `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__escalation_policies__namespace_uid__deleted_at__name"`
+	Remark       string            `gorm:"column:remark;type:varchar(100);default:''"`
+	Steps        []*EscalationStep `gorm:"column:steps;type:json;serializer:json"`
+	Status       enum.GlobalStatus `gorm:"column:status;type:tinyint;default:0"`
+}
+
+func (EscalationPolicy) TableName() string {
+	return "escalation_policies"
+}
+
+func (p *EscalationPolicy) WithNamespace(namespace snowflake.ID) *EscalationPolicy {
+	p.NamespaceUID = namespace
+	return p
+}
+
+func (p *EscalationPolicy) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return p.BaseModel.BeforeCreate(tx)
+}
+
+// EscalationPolicyBinding attaches an escalation policy to a strategy group or to a level,
+// the unused scope is left as 0. A strategy group or a level has at most one policy.
+type EscalationPolicyBinding struct {
+	BaseModel
+	NamespaceUID     snowflake.ID `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__escalation_policy_bindings__namespace_uid__scope"`
+	StrategyGroupUID snowflake.ID `gorm:"column:strategy_group_uid;default:0;uniqueIndex:idx__escalation_policy_bindings__namespace_uid__scope"`
+	LevelUID         snowflake.ID `gorm:"column:level_uid;default:0;uniqueIndex:idx__escalation_policy_bindings__namespace_uid__scope"`
+	PolicyUID        snowflake.ID `gorm:"column:policy_uid;default:0;index"`
+}
+
+func (EscalationPolicyBinding) TableName() string {
+	return "escalation_policy_bindings"
+}
+
+func (b *EscalationPolicyBinding) WithNamespace(namespace snowflake.ID) *EscalationPolicyBinding {
+	b.NamespaceUID = namespace
+	return b
+}
+
+func (b *EscalationPolicyBinding) BeforeCreate(tx *gorm.DB) (err error) {
+	if b.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return b.BaseModel.BeforeCreate(tx)
+}
+
+// EscalationTimer is the pending step of the escalation of an event, it is written by the system,
+// so it carries no uid or creator.
+type EscalationTimer struct {
+	ID           uint32            `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt    time.Time         `gorm:"column:created_at;"`
+	UpdatedAt    time.Time         `gorm:"column:updated_at;"`
+	EventUID     snowflake.ID      `gorm:"column:event_uid;default:0;uniqueIndex"`
+	NamespaceUID snowflake.ID      `gorm:"column:namespace_uid;default:0;index"`
+	PolicyUID    snowflake.ID      `gorm:"column:policy_uid;default:0;index"`
+	Steps        []*EscalationStep `gorm:"column:steps;type:json;serializer:json"`
+	Step         int32             `gorm:"column:step;default:0"`
+	FireAt       time.Time         `gorm:"column:fire_at;index"`
+	// Message is the notifier message as JSON.
+	Message string `gorm:"column:message;type:text;"`
+}
+
+func (EscalationTimer) TableName() string {
+	return "escalation_timers"
+}
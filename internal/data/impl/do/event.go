@@ -0,0 +1,92 @@
+package do
+
+import (
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// Event is one firing occurrence of a strategy level for a series, from the
+// first firing evaluation until it resolves. It is written by the evaluator,
+// so it carries no creator.
+type Event struct {
+	ID            uint32            `gorm:"column:id;primaryKey;autoIncrement"`
+	UID           snowflake.ID      `gorm:"column:uid;uniqueIndex"`
+	CreatedAt     time.Time         `gorm:"column:created_at;"`
+	UpdatedAt     time.Time         `gorm:"column:updated_at;"`
+	NamespaceUID  snowflake.ID      `gorm:"column:namespace_uid;default:0;index:idx__events__namespace_uid__starts_at"`
+	StrategyUID   snowflake.ID      `gorm:"column:strategy_uid;default:0;index:idx__events__strategy_uid__level_uid__fingerprint"`
+	LevelUID      snowflake.ID      `gorm:"column:level_uid;default:0;index:idx__events__strategy_uid__level_uid__fingerprint"`
+	Fingerprint   string            `gorm:"column:fingerprint;type:varchar(16);default:'';index:idx__events__strategy_uid__level_uid__fingerprint"`
+	DatasourceUID snowflake.ID      `gorm:"column:datasource_uid;default:0"`
+	Labels        map[string]string `gorm:"column:labels;type:json;serializer:json"`
+	Annotations   map[string]string `gorm:"column:annotations;type:json;serializer:json"`
+	Value         float64           `gorm:"column:value;default:0"`
+	State         int32             `gorm:"column:state;type:tinyint;default:0"`
+	StartsAt      time.Time         `gorm:"column:starts_at;index:idx__events__namespace_uid__starts_at"`
+	EndsAt        *time.Time        `gorm:"column:ends_at;"`
+	LastEvalAt    time.Time         `gorm:"column:last_eval_at;"`
+	// AckedBy is the user acknowledging the event while it fires, 0 until then.
+	AckedBy  snowflake.ID `gorm:"column:acked_by;default:0"`
+	AckedAt  *time.Time   `gorm:"column:acked_at;"`
+	Assignee snowflake.ID `gorm:"column:assignee;default:0;index"`
+	// Flapping is set while the series of the event flaps, its notifications are held back.
+	Flapping bool `gorm:"column:flapping;default:false"`
+}
+
+func (Event) TableName() string {
+	return "events"
+}
+
+func (e *Event) WithNamespace(namespace snowflake.ID) *Event {
+	e.NamespaceUID = namespace
+	return e
+}
+
+func (e *Event) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	node, err := uidNode()
+	if err != nil {
+		return err
+	}
+	e.UID = node.Generate()
+	return nil
+}
+
+// EventTimeline is an append-only record of the state changes of an event.
+type EventTimeline struct {
+	ID           uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt    time.Time    `gorm:"column:created_at;"`
+	UpdatedAt    time.Time    `gorm:"column:updated_at;"`
+	NamespaceUID snowflake.ID `gorm:"column:namespace_uid;default:0;index"`
+	EventUID     snowflake.ID `gorm:"column:event_uid;default:0;index"`
+	State        int32        `gorm:"column:state;type:tinyint;default:0"`
+	Value        float64      `gorm:"column:value;default:0"`
+	Time         time.Time    `gorm:"column:time;"`
+}
+
+func (EventTimeline) TableName() string {
+	return "event_timelines"
+}
+
+// EventActivity is an append-only record of what users did with an event.
+type EventActivity struct {
+	ID           uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt    time.Time    `gorm:"column:created_at;"`
+	UpdatedAt    time.Time    `gorm:"column:updated_at;"`
+	NamespaceUID snowflake.ID `gorm:"column:namespace_uid;default:0;index"`
+	EventUID     snowflake.ID `gorm:"column:event_uid;default:0;index"`
+	Type         int32        `gorm:"column:type;type:tinyint;default:0"`
+	Actor        snowflake.ID `gorm:"column:actor;default:0"`
+	Assignee     snowflake.ID `gorm:"column:assignee;default:0"`
+	Comment      string       `gorm:"column:comment;type:varchar(1024);default:''"`
+	Time         time.Time    `gorm:"column:time;"`
+}
+
+func (EventActivity) TableName() string {
+	return "event_activities"
+}
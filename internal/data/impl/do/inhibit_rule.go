@@ -0,0 +1,41 @@
+package do
+
+import (
+	"errors"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// InhibitRule mutes the events of the target level while an event of the source level fires
+// with the same values of the Equal labels.
+type InhibitRule struct {
+	BaseModel
+	DeletedAt      gorm.DeletedAt    `gorm:"column:deleted_at;uniqueIndex:idx__inhibit_rules__namespace_uid__deleted_at__name"`
+	NamespaceUID   snowflake.ID      `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__inhibit_rules__namespace_uid__deleted_at__name"`
+	Name           string            `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__inhibit_rules__namespace_uid__deleted_at__name"`
+	Remark         string            `gorm:"column:remark;type:varchar(100);default:''"`
+	SourceLevelUID snowflake.ID      `gorm:"column:source_level_uid;default:0"`
+	SourceMatchers []string          `gorm:"column:source_matchers;type:json;serializer:json"`
+	TargetLevelUID snowflake.ID      `gorm:"column:target_level_uid;default:0"`
+	TargetMatchers []string          `gorm:"column:target_matchers;type:json;serializer:json"`
+	Equal          []string          `gorm:"column:equal;type:json;serializer:json"`
+	Status         enum.GlobalStatus `gorm:"column:status;type:tinyint;default:0"`
+}
+
+func (InhibitRule) TableName() string {
+	return "inhibit_rules"
+}
+
+func (r *InhibitRule) WithNamespace(namespace snowflake.ID) *InhibitRule {
+	r.NamespaceUID = namespace
+	return r
+}
+
+func (r *InhibitRule) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return r.BaseModel.BeforeCreate(tx)
+}
@@ -0,0 +1,17 @@
+package do
+
+import "time"
+
+// JobNode is the heartbeat of a job node, it tells the job nodes about each other
+// when no registry is configured. It is written by the system, so it carries no uid or creator.
+type JobNode struct {
+	ID          uint32    `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt   time.Time `gorm:"column:created_at;"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;"`
+	NodeID      string    `gorm:"column:node_id;type:varchar(128);default:'';uniqueIndex"`
+	HeartbeatAt time.Time `gorm:"column:heartbeat_at;index"`
+}
+
+func (JobNode) TableName() string {
+	return "job_nodes"
+}
@@ -0,0 +1,19 @@
+package do
+
+import "time"
+
+// Lease is held by the leader of singleton background work, Token is the fencing token,
+// it grows with every new holder. It is written by the system, so it carries no uid or creator.
+type Lease struct {
+	ID        uint32    `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time `gorm:"column:created_at;"`
+	UpdatedAt time.Time `gorm:"column:updated_at;"`
+	Name      string    `gorm:"column:name;type:varchar(128);default:'';uniqueIndex"`
+	Holder    string    `gorm:"column:holder;type:varchar(128);default:''"`
+	Token     uint64    `gorm:"column:token;default:0"`
+	ExpiresAt time.Time `gorm:"column:expires_at;"`
+}
+
+func (Lease) TableName() string {
+	return "leases"
+}
@@ -14,8 +14,10 @@ type Level struct {
 	NamespaceUID snowflake.ID      `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__levels__namespace_uid__deleted_at__name"`
 	Name         string            `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__levels__namespace_uid__deleted_at__name"`
 	Remark       string            `gorm:"column:remark;type:varchar(100);default:''"`
-	Metadata     map[string]string `gorm:"column:metadata;type:json;"`
+	Metadata     map[string]string `gorm:"column:metadata;type:json;serializer:json"`
 	Status       enum.GlobalStatus `gorm:"column:status;type:tinyint;default:0"`
+	// Managed is set while the level is applied from manifests.
+	Managed bool `gorm:"column:managed;default:false"`
 }
 
 func (Level) TableName() string {
@@ -31,5 +33,5 @@ func (l *Level) BeforeCreate(tx *gorm.DB) (err error) {
 	if l.NamespaceUID == 0 {
 		return errors.New("namespace uid is required")
 	}
-	return nil
+	return l.BaseModel.BeforeCreate(tx)
 }
@@ -0,0 +1,45 @@
+package do
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// NotifyGroup is a pending group of notifications of one receiver, it is written by the system,
+// so it carries no uid or creator.
+type NotifyGroup struct {
+	ID             uint32            `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt      time.Time         `gorm:"column:created_at;"`
+	UpdatedAt      time.Time         `gorm:"column:updated_at;"`
+	GroupKey       string            `gorm:"column:group_key;type:varchar(64);default:'';uniqueIndex"`
+	NamespaceUID   snowflake.ID      `gorm:"column:namespace_uid;default:0;index"`
+	ReceiverUID    snowflake.ID      `gorm:"column:receiver_uid;default:0"`
+	Labels         map[string]string `gorm:"column:labels;type:json;serializer:json"`
+	GroupInterval  time.Duration     `gorm:"column:group_interval;default:0"`
+	RepeatInterval time.Duration     `gorm:"column:repeat_interval;default:0"`
+	NextFlushAt    time.Time         `gorm:"column:next_flush_at;index"`
+	// LastSentAt is nil until the first batch of the group is sent.
+	LastSentAt *time.Time `gorm:"column:last_sent_at;"`
+}
+
+func (NotifyGroup) TableName() string {
+	return "notify_groups"
+}
+
+// NotifyGroupAlert is the last message of an event in a notify group.
+type NotifyGroupAlert struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time    `gorm:"column:created_at;"`
+	UpdatedAt time.Time    `gorm:"column:updated_at;"`
+	GroupKey  string       `gorm:"column:group_key;type:varchar(64);default:'';uniqueIndex:idx__notify_group_alerts__group_key__event_uid"`
+	EventUID  snowflake.ID `gorm:"column:event_uid;default:0;uniqueIndex:idx__notify_group_alerts__group_key__event_uid"`
+	Status    string       `gorm:"column:status;type:varchar(16);default:''"`
+	// Message is the notifier message as JSON.
+	Message  string `gorm:"column:message;type:text;"`
+	Notified bool   `gorm:"column:notified;default:false"`
+}
+
+func (NotifyGroupAlert) TableName() string {
+	return "notify_group_alerts"
+}
@@ -0,0 +1,73 @@
+package do
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// OnCallSchedule hands the shift over to its members in turn, its uid can be bound wherever
+// receiver uids are, the receiver on call is notified.
+type OnCallSchedule struct {
+	BaseModel
+	DeletedAt    gorm.DeletedAt           `gorm:"column:deleted_at;uniqueIndex:idx__oncall_schedules__namespace_uid__deleted_at__name"`
+	NamespaceUID snowflake.ID             `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__oncall_schedules__namespace_uid__deleted_at__name"`
+	Name         string                   `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__oncall_schedules__namespace_uid__deleted_at__name"`
+	Remark       string                   `gorm:"column:remark;type:varchar(100);default:''"`
+	TimeZone     string                   `gorm:"column:time_zone;type:varchar(64);default:''"`
+	RotationType apiv1.OnCallRotationType `gorm:"column:rotation_type;type:tinyint;default:0"`
+	// HandoffTime is the local time of the handoff, as hh:mm.
+	HandoffTime    string            `gorm:"column:handoff_time;type:varchar(5);default:''"`
+	HandoffWeekday int32             `gorm:"column:handoff_weekday;type:tinyint;default:0"`
+	StartsAt       time.Time         `gorm:"column:starts_at;"`
+	Members        []snowflake.ID    `gorm:"column:members;type:json;serializer:json"`
+	Status         enum.GlobalStatus `gorm:"column:status;type:tinyint;default:0"`
+}
+
+func (OnCallSchedule) TableName() string {
+	return "oncall_schedules"
+}
+
+func (s *OnCallSchedule) WithNamespace(namespace snowflake.ID) *OnCallSchedule {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *OnCallSchedule) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
+
+// OnCallOverride puts a receiver on call in a schedule between StartsAt and EndsAt,
+// the overrides created later take precedence.
+type OnCallOverride struct {
+	BaseModel
+	NamespaceUID snowflake.ID `gorm:"column:namespace_uid;default:0;index"`
+	ScheduleUID  snowflake.ID `gorm:"column:schedule_uid;default:0;index:idx__oncall_overrides__schedule_uid__ends_at"`
+	ReceiverUID  snowflake.ID `gorm:"column:receiver_uid;default:0"`
+	StartsAt     time.Time    `gorm:"column:starts_at;"`
+	EndsAt       time.Time    `gorm:"column:ends_at;index:idx__oncall_overrides__schedule_uid__ends_at"`
+}
+
+func (OnCallOverride) TableName() string {
+	return "oncall_overrides"
+}
+
+func (o *OnCallOverride) WithNamespace(namespace snowflake.ID) *OnCallOverride {
+	o.NamespaceUID = namespace
+	return o
+}
+
+func (o *OnCallOverride) BeforeCreate(tx *gorm.DB) (err error) {
+	if o.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return o.BaseModel.BeforeCreate(tx)
+}
@@ -0,0 +1,44 @@
+package do
+
+import (
+	"errors"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+type Receiver struct {
+	BaseModel
+	DeletedAt    gorm.DeletedAt     `gorm:"column:deleted_at;uniqueIndex:idx__receivers__namespace_uid__deleted_at__name"`
+	NamespaceUID snowflake.ID       `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__receivers__namespace_uid__deleted_at__name"`
+	Name         string             `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__receivers__namespace_uid__deleted_at__name"`
+	Remark       string             `gorm:"column:remark;type:varchar(100);default:''"`
+	Type         apiv1.ReceiverType `gorm:"column:type;type:tinyint;default:0"`
+	Webhook      *WebhookConfig     `gorm:"column:webhook;type:json;serializer:json"`
+	Status       enum.GlobalStatus  `gorm:"column:status;type:tinyint;default:0"`
+}
+
+// WebhookConfig is the target of a WEBHOOK receiver.
+type WebhookConfig struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+func (Receiver) TableName() string {
+	return "receivers"
+}
+
+func (r *Receiver) WithNamespace(namespace snowflake.ID) *Receiver {
+	r.NamespaceUID = namespace
+	return r
+}
+
+func (r *Receiver) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return r.BaseModel.BeforeCreate(tx)
+}
@@ -0,0 +1,28 @@
+package do
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// ReceiverDelivery is the audit record of one attempt to deliver an event to
+// a receiver, it is written by the notifier and never updated.
+type ReceiverDelivery struct {
+	ID           uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt    time.Time    `gorm:"column:created_at;"`
+	UpdatedAt    time.Time    `gorm:"column:updated_at;"`
+	NamespaceUID snowflake.ID `gorm:"column:namespace_uid;default:0;index"`
+	ReceiverUID  snowflake.ID `gorm:"column:receiver_uid;default:0;index"`
+	EventUID     snowflake.ID `gorm:"column:event_uid;default:0;index"`
+	EventState   int32        `gorm:"column:event_state;type:tinyint;default:0"`
+	Attempt      int32        `gorm:"column:attempt;default:0"`
+	StatusCode   int32        `gorm:"column:status_code;default:0"`
+	Success      bool         `gorm:"column:success;default:false"`
+	Error        string       `gorm:"column:error;type:text;"`
+	LatencyMs    int64        `gorm:"column:latency_ms;default:0"`
+}
+
+func (ReceiverDelivery) TableName() string {
+	return "receiver_deliveries"
+}
@@ -0,0 +1,36 @@
+package do
+
+import (
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// Silence mutes the notifications of the events matching all of its matchers between StartsAt and EndsAt.
+type Silence struct {
+	BaseModel
+	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	NamespaceUID snowflake.ID   `gorm:"column:namespace_uid;default:0;index:idx__silences__namespace_uid__ends_at"`
+	Matchers     []string       `gorm:"column:matchers;type:json;serializer:json"`
+	StartsAt     time.Time      `gorm:"column:starts_at;"`
+	EndsAt       time.Time      `gorm:"column:ends_at;index:idx__silences__namespace_uid__ends_at"`
+	Comment      string         `gorm:"column:comment;type:varchar(255);default:''"`
+}
+
+func (Silence) TableName() string {
+	return "silences"
+}
+
+func (s *Silence) WithNamespace(namespace snowflake.ID) *Silence {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *Silence) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
@@ -0,0 +1,43 @@
+package do
+
+import (
+	"errors"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+type Strategy struct {
+	BaseModel
+	DeletedAt        gorm.DeletedAt        `gorm:"column:deleted_at;uniqueIndex:idx__strategies__namespace_uid__deleted_at__name"`
+	NamespaceUID     snowflake.ID          `gorm:"column:namespace_uid;default:0;uniqueIndex:idx__strategies__namespace_uid__deleted_at__name"`
+	StrategyGroupUID snowflake.ID          `gorm:"column:strategy_group_uid;default:0;index"`
+	Name             string                `gorm:"column:name;type:varchar(100);default:'';uniqueIndex:idx__strategies__namespace_uid__deleted_at__name"`
+	Remark           string                `gorm:"column:remark;type:varchar(100);default:''"`
+	Type             enum.DatasourceType   `gorm:"column:type;type:tinyint;default:0"`
+	Driver           enum.DatasourceDriver `gorm:"column:driver;type:tinyint;default:0"`
+	Metadata         map[string]string     `gorm:"column:metadata;type:json;serializer:json"`
+	Status           enum.GlobalStatus     `gorm:"column:status;type:tinyint;default:0"`
+	// Managed is set while the strategy is applied from manifests.
+	Managed bool `gorm:"column:managed;default:false"`
+}
+
+func (Strategy) TableName() string {
+	return "strategies"
+}
+
+func (s *Strategy) WithNamespace(namespace snowflake.ID) *Strategy {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *Strategy) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	if s.StrategyGroupUID == 0 {
+		return errors.New("strategy group uid is required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
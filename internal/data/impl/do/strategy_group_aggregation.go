@@ -0,0 +1,40 @@
+package do
+
+import (
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// StrategyGroupAggregation is how the notifications of the events of a strategy group are grouped,
+// one row per strategy group.
+type StrategyGroupAggregation struct {
+	BaseModel
+	NamespaceUID     snowflake.ID  `gorm:"column:namespace_uid;default:0;index"`
+	StrategyGroupUID snowflake.ID  `gorm:"column:strategy_group_uid;default:0;uniqueIndex"`
+	GroupBy          []string      `gorm:"column:group_by;type:json;serializer:json"`
+	GroupWait        time.Duration `gorm:"column:group_wait;default:0"`
+	GroupInterval    time.Duration `gorm:"column:group_interval;default:0"`
+	RepeatInterval   time.Duration `gorm:"column:repeat_interval;default:0"`
+}
+
+func (StrategyGroupAggregation) TableName() string {
+	return "strategy_group_aggregations"
+}
+
+func (s *StrategyGroupAggregation) WithNamespace(namespace snowflake.ID) *StrategyGroupAggregation {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *StrategyGroupAggregation) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	if s.StrategyGroupUID == 0 {
+		return errors.New("strategy group uid is required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
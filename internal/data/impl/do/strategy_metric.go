@@ -0,0 +1,41 @@
+package do
+
+import (
+	"errors"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// StrategyMetric is the metric definition of a strategy, one row per strategy.
+type StrategyMetric struct {
+	BaseModel
+	NamespaceUID   snowflake.ID      `gorm:"column:namespace_uid;default:0;index"`
+	StrategyUID    snowflake.ID      `gorm:"column:strategy_uid;default:0;uniqueIndex"`
+	Expr           string            `gorm:"column:expr;type:text;"`
+	Labels         map[string]string `gorm:"column:labels;type:json;serializer:json"`
+	Summary        string            `gorm:"column:summary;type:text;"`
+	Description    string            `gorm:"column:description;type:text;"`
+	DatasourceUIDs []snowflake.ID    `gorm:"column:datasource_uids;type:json;serializer:json"`
+	Status         enum.GlobalStatus `gorm:"column:status;type:tinyint;default:0"`
+}
+
+func (StrategyMetric) TableName() string {
+	return "strategy_metrics"
+}
+
+func (s *StrategyMetric) WithNamespace(namespace snowflake.ID) *StrategyMetric {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *StrategyMetric) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	if s.StrategyUID == 0 {
+		return errors.New("strategy uid is required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
@@ -0,0 +1,44 @@
+package do
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// StrategyMetricLevel is the threshold of a strategy metric for one level.
+type StrategyMetricLevel struct {
+	BaseModel
+	NamespaceUID  snowflake.ID         `gorm:"column:namespace_uid;default:0;index"`
+	StrategyUID   snowflake.ID         `gorm:"column:strategy_uid;default:0;uniqueIndex:idx__strategy_metric_levels__strategy_uid__level_uid"`
+	LevelUID      snowflake.ID         `gorm:"column:level_uid;default:0;uniqueIndex:idx__strategy_metric_levels__strategy_uid__level_uid"`
+	Mode          enum.SampleMode      `gorm:"column:mode;type:tinyint;default:0"`
+	Condition     enum.ConditionMetric `gorm:"column:condition;type:tinyint;default:0"`
+	Values        []int64              `gorm:"column:values;type:json;serializer:json"`
+	Duration      time.Duration        `gorm:"column:duration;default:0"`
+	FlapWindow    time.Duration        `gorm:"column:flap_window;default:0"`
+	FlapThreshold int32                `gorm:"column:flap_threshold;default:0"`
+	Status        enum.GlobalStatus    `gorm:"column:status;type:tinyint;default:0"`
+}
+
+func (StrategyMetricLevel) TableName() string {
+	return "strategy_metric_levels"
+}
+
+func (s *StrategyMetricLevel) WithNamespace(namespace snowflake.ID) *StrategyMetricLevel {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *StrategyMetricLevel) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	if s.StrategyUID == 0 || s.LevelUID == 0 {
+		return errors.New("strategy uid and level uid are required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
@@ -0,0 +1,35 @@
+package do
+
+import (
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+)
+
+// StrategyReceiver binds a receiver to a strategy group, a strategy, or a
+// single level of a strategy. Unused scopes are left as 0.
+type StrategyReceiver struct {
+	BaseModel
+	NamespaceUID     snowflake.ID `gorm:"column:namespace_uid;default:0;index"`
+	StrategyGroupUID snowflake.ID `gorm:"column:strategy_group_uid;default:0;index"`
+	StrategyUID      snowflake.ID `gorm:"column:strategy_uid;default:0;index"`
+	LevelUID         snowflake.ID `gorm:"column:level_uid;default:0"`
+	ReceiverUID      snowflake.ID `gorm:"column:receiver_uid;default:0"`
+}
+
+func (StrategyReceiver) TableName() string {
+	return "strategy_receivers"
+}
+
+func (s *StrategyReceiver) WithNamespace(namespace snowflake.ID) *StrategyReceiver {
+	s.NamespaceUID = namespace
+	return s
+}
+
+func (s *StrategyReceiver) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.NamespaceUID == 0 {
+		return errors.New("namespace uid is required")
+	}
+	return s.BaseModel.BeforeCreate(tx)
+}
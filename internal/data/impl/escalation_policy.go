@@ -0,0 +1,282 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewEscalationPolicyRepository(d *data.Data) (repository.EscalationPolicy, error) {
+	query.SetDefault(d.DB())
+	return &escalationPolicyRepository{db: d.DB()}, nil
+}
+
+type escalationPolicyRepository struct {
+	db *gorm.DB
+}
+
+func (r *escalationPolicyRepository) CreateEscalationPolicy(ctx context.Context, req *bo.CreateEscalationPolicyBo) error {
+	m := convert.ToEscalationPolicyDo(ctx, req)
+	return query.Q.Transaction(func(tx *query.Query) error {
+		if err := tx.EscalationPolicy.WithContext(ctx).Create(m); err != nil {
+			return err
+		}
+		return r.saveBindings(ctx, tx, m.UID, req.EscalationPolicySpecBo)
+	})
+}
+
+func (r *escalationPolicyRepository) UpdateEscalationPolicy(ctx context.Context, req *bo.UpdateEscalationPolicyBo) error {
+	return query.Q.Transaction(func(tx *query.Query) error {
+		p := tx.EscalationPolicy
+		wrappers := p.WithContext(ctx).Where(
+			p.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+			p.UID.Eq(req.UID.Int64()),
+		)
+		total, err := wrappers.Count()
+		if err != nil {
+			return err
+		}
+		if total == 0 {
+			return merr.ErrorNotFound("escalation policy not found")
+		}
+		m := &do.EscalationPolicy{
+			Name:   req.Name,
+			Remark: req.Remark,
+			Steps:  convert.ToEscalationStepsDo(req.Steps),
+		}
+		if _, err := wrappers.Select(p.Name, p.Remark, p.Steps).Updates(m); err != nil {
+			return err
+		}
+		return r.saveBindings(ctx, tx, req.UID, req.EscalationPolicySpecBo)
+	})
+}
+
+// saveBindings replaces the strategy groups and levels the policy is attached to.
+func (r *escalationPolicyRepository) saveBindings(ctx context.Context, tx *query.Query, policyUID snowflake.ID, spec *bo.EscalationPolicySpecBo) error {
+	b := tx.EscalationPolicyBinding
+	_, err := b.WithContext(ctx).Where(
+		b.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		b.PolicyUID.Eq(policyUID.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	bindings := convert.ToEscalationPolicyBindingsDo(ctx, policyUID, spec)
+	if len(bindings) == 0 {
+		return nil
+	}
+	return b.WithContext(ctx).Create(bindings...)
+}
+
+func (r *escalationPolicyRepository) UpdateEscalationPolicyStatus(ctx context.Context, req *bo.UpdateEscalationPolicyStatusBo) error {
+	p := query.EscalationPolicy
+	info, err := p.WithContext(ctx).Where(
+		p.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		p.UID.Eq(req.UID.Int64()),
+	).Update(p.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("escalation policy not found")
+	}
+	return nil
+}
+
+func (r *escalationPolicyRepository) DeleteEscalationPolicy(ctx context.Context, uid snowflake.ID) error {
+	namespaceUID := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		p := tx.EscalationPolicy
+		info, err := p.WithContext(ctx).Where(p.NamespaceUID.Eq(namespaceUID), p.UID.Eq(uid.Int64())).Delete()
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return merr.ErrorNotFound("escalation policy not found")
+		}
+		b := tx.EscalationPolicyBinding
+		if _, err := b.WithContext(ctx).Where(b.NamespaceUID.Eq(namespaceUID), b.PolicyUID.Eq(uid.Int64())).Delete(); err != nil {
+			return err
+		}
+		t := tx.EscalationTimer
+		_, err = t.WithContext(ctx).Where(t.NamespaceUID.Eq(namespaceUID), t.PolicyUID.Eq(uid.Int64())).Delete()
+		return err
+	})
+}
+
+func (r *escalationPolicyRepository) GetEscalationPolicy(ctx context.Context, uid snowflake.ID) (*bo.EscalationPolicyItemBo, error) {
+	p := query.EscalationPolicy
+	m, err := p.WithContext(ctx).Where(
+		p.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		p.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("escalation policy not found")
+		}
+		return nil, err
+	}
+	bindings, err := r.listBindings(ctx, m.NamespaceUID, m.UID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToEscalationPolicyItemBo(m, bindings[m.UID]), nil
+}
+
+func (r *escalationPolicyRepository) ListEscalationPolicy(ctx context.Context, req *bo.ListEscalationPolicyBo) (*bo.PageResponseBo[*bo.EscalationPolicyItemBo], error) {
+	namespaceUID := contextx.GetNamespace(ctx)
+	p := query.EscalationPolicy
+	wrappers := p.WithContext(ctx)
+	wrappers = wrappers.Where(p.NamespaceUID.Eq(namespaceUID.Int64()))
+	if req.Keyword != "" {
+		k := "%" + strings.TrimSpace(req.Keyword) + "%"
+		wrappers = wrappers.Where(p.Name.Like(k))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(p.Status.Eq(int32(req.Status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Order(p.UID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	policyUIDs := make([]snowflake.ID, 0, len(list))
+	for _, m := range list {
+		policyUIDs = append(policyUIDs, m.UID)
+	}
+	bindings, err := r.listBindings(ctx, namespaceUID, policyUIDs...)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.EscalationPolicyItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToEscalationPolicyItemBo(m, bindings[m.UID]))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// listBindings returns the bindings of the policies by policy uid.
+func (r *escalationPolicyRepository) listBindings(ctx context.Context, namespaceUID snowflake.ID, policyUIDs ...snowflake.ID) (map[snowflake.ID][]*do.EscalationPolicyBinding, error) {
+	bindings := make(map[snowflake.ID][]*do.EscalationPolicyBinding, len(policyUIDs))
+	if len(policyUIDs) == 0 {
+		return bindings, nil
+	}
+	uids := make([]int64, 0, len(policyUIDs))
+	for _, uid := range policyUIDs {
+		uids = append(uids, uid.Int64())
+	}
+	b := query.EscalationPolicyBinding
+	list, err := b.WithContext(ctx).Where(b.NamespaceUID.Eq(namespaceUID.Int64()), b.PolicyUID.In(uids...)).Order(b.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range list {
+		bindings[m.PolicyUID] = append(bindings[m.PolicyUID], m)
+	}
+	return bindings, nil
+}
+
+func (r *escalationPolicyRepository) ListEscalationPolicyBindings(ctx context.Context, strategyGroupUIDs, levelUIDs []snowflake.ID) ([]*bo.EscalationPolicyBindingBo, error) {
+	var conditions []field.Expr
+	b := query.EscalationPolicyBinding
+	if len(strategyGroupUIDs) > 0 {
+		uids := make([]int64, 0, len(strategyGroupUIDs))
+		for _, uid := range strategyGroupUIDs {
+			uids = append(uids, uid.Int64())
+		}
+		conditions = append(conditions, field.And(b.StrategyGroupUID.In(uids...), b.LevelUID.Eq(0)))
+	}
+	if len(levelUIDs) > 0 {
+		uids := make([]int64, 0, len(levelUIDs))
+		for _, uid := range levelUIDs {
+			uids = append(uids, uid.Int64())
+		}
+		conditions = append(conditions, field.And(b.LevelUID.In(uids...), b.StrategyGroupUID.Eq(0)))
+	}
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+	list, err := b.WithContext(ctx).Where(
+		b.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		field.Or(conditions...),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.EscalationPolicyBindingBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToEscalationPolicyBindingBo(m))
+	}
+	return items, nil
+}
+
+func (r *escalationPolicyRepository) GetEventEscalationPolicy(ctx context.Context, namespaceUID, strategyUID, levelUID snowflake.ID) (*bo.EscalationPolicyItemBo, error) {
+	b := query.EscalationPolicyBinding
+	scopes := []field.Expr{field.And(b.LevelUID.Eq(levelUID.Int64()), b.StrategyGroupUID.Eq(0))}
+	s := query.Strategy
+	strategy, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespaceUID.Int64()), s.UID.Eq(strategyUID.Int64())).First()
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if strategy != nil {
+		scopes = append(scopes, field.And(b.StrategyGroupUID.Eq(strategy.StrategyGroupUID.Int64()), b.LevelUID.Eq(0)))
+	}
+	bindings, err := b.WithContext(ctx).Where(b.NamespaceUID.Eq(namespaceUID.Int64()), field.Or(scopes...)).Find()
+	if err != nil || len(bindings) == 0 {
+		return nil, err
+	}
+	policyUIDs := make([]int64, 0, len(bindings))
+	for _, binding := range bindings {
+		policyUIDs = append(policyUIDs, binding.PolicyUID.Int64())
+	}
+	p := query.EscalationPolicy
+	policies, err := p.WithContext(ctx).Where(
+		p.NamespaceUID.Eq(namespaceUID.Int64()),
+		p.UID.In(policyUIDs...),
+		p.Status.Eq(int32(enum.GlobalStatus_ENABLED)),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	enabled := make(map[snowflake.ID]*do.EscalationPolicy, len(policies))
+	for _, m := range policies {
+		enabled[m.UID] = m
+	}
+	// the policy of the level is more specific than the one of the strategy group
+	var found *do.EscalationPolicy
+	for _, binding := range bindings {
+		m, ok := enabled[binding.PolicyUID]
+		if !ok {
+			continue
+		}
+		if binding.LevelUID != 0 {
+			found = m
+			break
+		}
+		found = m
+	}
+	if found == nil {
+		return nil, nil
+	}
+	return convert.ToEscalationPolicyItemBo(found, nil), nil
+}
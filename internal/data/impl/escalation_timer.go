@@ -0,0 +1,95 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewEscalationTimerRepository(d *data.Data) (repository.EscalationTimer, error) {
+	query.SetDefault(d.DB())
+	return &escalationTimerRepository{db: d.DB()}, nil
+}
+
+type escalationTimerRepository struct {
+	db *gorm.DB
+}
+
+func (r *escalationTimerRepository) SaveTimer(ctx context.Context, timer *escalator.Timer) error {
+	m, err := convert.ToEscalationTimerDo(timer)
+	if err != nil {
+		return err
+	}
+	t := query.EscalationTimer
+	return t.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: t.EventUID.ColumnName().String()}},
+		DoUpdates: clause.AssignmentColumns([]string{"namespace_uid", "policy_uid", "steps", "step", "fire_at", "message", "updated_at"}),
+	}).Create(m)
+}
+
+func (r *escalationTimerRepository) ListDueTimers(ctx context.Context, now time.Time, limit int) ([]*escalator.Timer, error) {
+	t := query.EscalationTimer
+	rows, err := t.WithContext(ctx).Where(t.FireAt.Lte(now)).Order(t.FireAt).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*escalator.Timer, 0, len(rows))
+	for _, row := range rows {
+		timer, err := convert.ToEscalatorTimer(row)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, timer)
+	}
+	return list, nil
+}
+
+func (r *escalationTimerRepository) CompleteStep(ctx context.Context, timer *escalator.Timer, next *escalator.Timer) (bool, error) {
+	var completed bool
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		if err := fence(ctx, tx); err != nil {
+			return err
+		}
+		t := tx.EscalationTimer
+		// the step and the policy guard against a cancel or a new escalation of the event since the timer was listed
+		wrappers := t.WithContext(ctx).Where(
+			t.EventUID.Eq(timer.EventUID.Int64()),
+			t.PolicyUID.Eq(timer.PolicyUID.Int64()),
+			t.Step.Eq(int32(timer.Step)),
+		)
+		if next == nil {
+			info, err := wrappers.Delete()
+			if err != nil {
+				return err
+			}
+			completed = info.RowsAffected > 0
+			return nil
+		}
+		info, err := wrappers.UpdateColumnSimple(
+			t.Step.Value(int32(next.Step)),
+			t.FireAt.Value(next.FireAt),
+			t.UpdatedAt.Value(time.Now()),
+		)
+		if err != nil {
+			return err
+		}
+		completed = info.RowsAffected > 0
+		return nil
+	})
+	return completed, err
+}
+
+func (r *escalationTimerRepository) DeleteTimer(ctx context.Context, eventUID snowflake.ID) error {
+	t := query.EscalationTimer
+	_, err := t.WithContext(ctx).Where(t.EventUID.Eq(eventUID.Int64())).Delete()
+	return err
+}
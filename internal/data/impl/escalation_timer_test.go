@@ -0,0 +1,126 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+// pageWebhook records the pages posted to it, by receiver.
+type pageWebhook struct {
+	mu    sync.Mutex
+	pages map[string][]snowflake.ID
+}
+
+func (p *pageWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var msg notifier.Message
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p.mu.Lock()
+	p.pages[r.URL.Path] = append(p.pages[r.URL.Path], msg.EventUID)
+	p.mu.Unlock()
+}
+
+func (p *pageWebhook) take() map[string][]snowflake.ID {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pages := p.pages
+	p.pages = make(map[string][]snowflake.ID)
+	return pages
+}
+
+// webhookPager posts the message of a step to /<receiver uid> of the webhook.
+type webhookPager struct {
+	url string
+}
+
+func (w *webhookPager) Page(ctx context.Context, page *escalator.Page) error {
+	for _, receiverUID := range page.ReceiverUIDs {
+		if _, err := notifier.NewWebhookSender(w.url+"/"+receiverUID.String(), nil).Send(ctx, page.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestEscalationTimerSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, &do.EscalationTimer{})
+	hook := &pageWebhook{pages: make(map[string][]snowflake.ID)}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	now := time.Unix(1700000000, 0)
+	newEscalator := func() *escalator.Escalator {
+		return escalator.NewEscalator(&escalationTimerRepository{db: db}, &webhookPager{url: srv.URL},
+			klog.NewHelper(klog.DefaultLogger),
+			escalator.WithClock(func() time.Time { return now }),
+		)
+	}
+	policy := &escalator.Policy{
+		UID: 9,
+		Steps: []*escalator.Step{
+			{Delay: 10 * time.Minute, ReceiverUIDs: []snowflake.ID{2}},
+			{Delay: 10 * time.Minute, ReceiverUIDs: []snowflake.ID{3}},
+		},
+	}
+	message := func(eventUID int64) *notifier.Message {
+		return &notifier.Message{
+			Version:      notifier.MessageVersion,
+			Status:       notifier.StatusFiring,
+			EventUID:     snowflake.ParseInt64(eventUID),
+			NamespaceUID: 1,
+			StartsAt:     now,
+		}
+	}
+	fire := func(e *escalator.Escalator) map[string][]snowflake.ID {
+		t.Helper()
+		if _, err := e.Fire(ctx); err != nil {
+			t.Fatalf("fire: %v", err)
+		}
+		return hook.take()
+	}
+
+	for _, uid := range []int64{100, 101} {
+		if err := newEscalator().Start(ctx, policy, message(uid)); err != nil {
+			t.Fatalf("start: %v", err)
+		}
+	}
+	now = now.Add(10 * time.Minute)
+	pages := fire(newEscalator())
+	if len(pages["/2"]) != 2 || len(pages["/3"]) != 0 {
+		t.Fatalf("got pages %v, want both events paged to the first tier", pages)
+	}
+
+	// a restarted node does not page the first tier again, and 101 is acknowledged in the meantime
+	if pages := fire(newEscalator()); len(pages) != 0 {
+		t.Fatalf("got duplicate pages %v after restart", pages)
+	}
+	if err := newEscalator().Cancel(ctx, 101); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	now = now.Add(10 * time.Minute)
+	pages = fire(newEscalator())
+	if got := pages["/3"]; len(got) != 1 || got[0] != 100 {
+		t.Fatalf("got pages %v, want 100 paged to the second tier", pages)
+	}
+
+	var timers int64
+	db.Model(&do.EscalationTimer{}).Count(&timers)
+	if timers != 0 {
+		t.Fatalf("%d timers left after the last step", timers)
+	}
+}
@@ -0,0 +1,383 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewEventRepository(d *data.Data) (repository.Event, error) {
+	query.SetDefault(d.DB())
+	return &eventRepository{db: d.DB()}, nil
+}
+
+type eventRepository struct {
+	db *gorm.DB
+}
+
+func (r *eventRepository) SaveEvents(ctx context.Context, events []*bo.SaveEventBo) ([]*bo.EventItemBo, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	changed := make([]*bo.EventItemBo, 0, len(events))
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		for _, req := range events {
+			m, err := r.saveEvent(ctx, tx, req)
+			if err != nil {
+				return err
+			}
+			if m != nil {
+				changed = append(changed, convert.ToEventItemBo(m))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// saveEvent returns the event when its state changed.
+func (r *eventRepository) saveEvent(ctx context.Context, tx *query.Query, req *bo.SaveEventBo) (*do.Event, error) {
+	e := tx.Event
+	open, err := e.WithContext(ctx).Where(
+		e.StrategyUID.Eq(req.StrategyUID.Int64()),
+		e.LevelUID.Eq(req.LevelUID.Int64()),
+		e.Fingerprint.Eq(strconv.FormatUint(req.Fingerprint, 16)),
+		e.State.Eq(int32(apiv1.EventState_FIRING)),
+	).Order(e.ID.Desc()).First()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	switch req.State {
+	case apiv1.EventState_FIRING:
+		if open == nil {
+			m := convert.ToEventDo(req)
+			if err := e.WithContext(ctx).Create(m); err != nil {
+				return nil, err
+			}
+			return m, tx.EventTimeline.WithContext(ctx).Create(convert.ToEventTimelineDo(m, m.State, m.Value))
+		}
+		open.Labels = req.Labels
+		open.Annotations = req.Annotations
+		open.Value = req.Value
+		open.LastEvalAt = req.EvalAt
+		open.Flapping = req.Flapping
+		_, err = e.WithContext(ctx).Where(e.ID.Eq(open.ID)).Select(e.Labels, e.Annotations, e.Value, e.LastEvalAt, e.Flapping).Updates(open)
+		return nil, err
+	case apiv1.EventState_RESOLVED:
+		if open == nil {
+			// the firing event is gone (e.g. the strategy was deleted), nothing to resolve
+			return nil, nil
+		}
+		endsAt := req.EndsAt
+		if endsAt.IsZero() {
+			endsAt = req.EvalAt
+		}
+		open.State = int32(apiv1.EventState_RESOLVED)
+		open.EndsAt = &endsAt
+		open.LastEvalAt = req.EvalAt
+		open.Flapping = req.Flapping
+		if _, err = e.WithContext(ctx).Where(e.ID.Eq(open.ID)).Select(e.State, e.EndsAt, e.LastEvalAt, e.Flapping).Updates(open); err != nil {
+			return nil, err
+		}
+		return open, tx.EventTimeline.WithContext(ctx).Create(convert.ToEventTimelineDo(open, open.State, req.Value))
+	}
+	return nil, nil
+}
+
+func (r *eventRepository) StabilizeEvent(ctx context.Context, req *bo.SaveEventBo) (*bo.EventItemBo, error) {
+	e := query.Event
+	m, err := e.WithContext(ctx).Where(
+		e.NamespaceUID.Eq(req.NamespaceUID.Int64()),
+		e.StrategyUID.Eq(req.StrategyUID.Int64()),
+		e.LevelUID.Eq(req.LevelUID.Int64()),
+		e.Fingerprint.Eq(strconv.FormatUint(req.Fingerprint, 16)),
+	).Order(e.ID.Desc()).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("event not found")
+		}
+		return nil, err
+	}
+	if m.Flapping {
+		m.Flapping = false
+		if _, err := e.WithContext(ctx).Where(e.ID.Eq(m.ID)).Select(e.Flapping).Updates(m); err != nil {
+			return nil, err
+		}
+	}
+	return convert.ToEventItemBo(m), nil
+}
+
+func (r *eventRepository) GetEvent(ctx context.Context, uid snowflake.ID) (*bo.EventItemBo, error) {
+	e := query.Event
+	m, err := e.WithContext(ctx).Where(
+		e.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		e.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("event not found")
+		}
+		return nil, err
+	}
+	return convert.ToEventItemBo(m), nil
+}
+
+func (r *eventRepository) ListEvent(ctx context.Context, req *bo.ListEventBo) (*bo.PageResponseBo[*bo.EventItemBo], error) {
+	e := query.Event
+	wrappers := e.WithContext(ctx)
+	wrappers = wrappers.Where(e.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.State != apiv1.EventState_EventState_UNKNOWN {
+		wrappers = wrappers.Where(e.State.Eq(int32(req.State)))
+	}
+	if req.LevelUID > 0 {
+		wrappers = wrappers.Where(e.LevelUID.Eq(req.LevelUID.Int64()))
+	}
+	if req.StrategyUID > 0 {
+		wrappers = wrappers.Where(e.StrategyUID.Eq(req.StrategyUID.Int64()))
+	}
+	if req.Assignee > 0 {
+		wrappers = wrappers.Where(e.Assignee.Eq(req.Assignee.Int64()))
+	}
+	// keep the events active at some point of the range
+	if !req.StartTime.IsZero() {
+		wrappers = wrappers.Where(field.Or(e.EndsAt.IsNull(), e.EndsAt.Gte(req.StartTime)))
+	}
+	if !req.EndTime.IsZero() {
+		wrappers = wrappers.Where(e.StartsAt.Lte(req.EndTime))
+	}
+	conds, matchers := r.labelMatcherConds(req.LabelMatchers)
+	wrappers = wrappers.Where(conds...).Order(e.StartsAt.Desc(), e.ID.Desc())
+	if len(matchers) > 0 {
+		// the regexp matchers are applied after the other filters, on at most maxEventScan events
+		list, err := wrappers.Limit(maxEventScan + 1).Find()
+		if err != nil {
+			return nil, err
+		}
+		if len(list) > maxEventScan {
+			return nil, merr.ErrorParams("more than %d events to match the label matchers against, narrow the time range or the filters", maxEventScan)
+		}
+		items := make([]*bo.EventItemBo, 0, len(list))
+		for _, m := range list {
+			if matchers.Matches(m.Labels) {
+				items = append(items, convert.ToEventItemBo(m))
+			}
+		}
+		req.WithTotal(int64(len(items)))
+		if req.Page > 0 && req.PageSize > 0 {
+			items = items[min(req.Offset(), len(items)):min(req.Offset()+req.Limit(), len(items))]
+		}
+		return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.EventItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToEventItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// maxEventScan caps the events ListEvent loads to apply the label matchers without sql.
+const maxEventScan = 10000
+
+// labelMatcherConds returns the conditions of the equality matchers and the matchers left to
+// apply in go. A missing label has the empty value, as in bo.LabelMatchers.Matches; regexps are
+// left out as neither database runs Go regexps.
+func (r *eventRepository) labelMatcherConds(matchers bo.LabelMatchers) ([]gen.Condition, bo.LabelMatchers) {
+	label := "COALESCE(json_extract(labels, ?), '')"
+	if r.db.Dialector.Name() == "mysql" {
+		label = "COALESCE(JSON_UNQUOTE(JSON_EXTRACT(labels, ?)), '')"
+	}
+	var conds []gen.Condition
+	var rest bo.LabelMatchers
+	for _, m := range matchers {
+		// the name is a quoted member of the json path, quotes and escapes in it are left to go
+		if strings.ContainsAny(m.Name, `"\`) {
+			rest = append(rest, m)
+			continue
+		}
+		path := `$."` + m.Name + `"`
+		switch m.Type {
+		case bo.MatchEqual:
+			conds = append(conds, field.NewUnsafeFieldRaw(label+" = ?", path, m.Value))
+		case bo.MatchNotEqual:
+			conds = append(conds, field.NewUnsafeFieldRaw(label+" <> ?", path, m.Value))
+		default:
+			rest = append(rest, m)
+		}
+	}
+	return conds, rest
+}
+
+func (r *eventRepository) ListFiringEvents(ctx context.Context, namespaceUID snowflake.ID) ([]*bo.EventItemBo, error) {
+	e := query.Event
+	list, err := e.WithContext(ctx).Where(
+		e.NamespaceUID.Eq(namespaceUID.Int64()),
+		e.State.Eq(int32(apiv1.EventState_FIRING)),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.EventItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToEventItemBo(m))
+	}
+	return items, nil
+}
+
+func (r *eventRepository) ListEventTimeline(ctx context.Context, req *bo.GetEventTimelineBo) ([]*bo.EventTimelineItemBo, error) {
+	e := query.Event
+	namespaceUID := contextx.GetNamespace(ctx).Int64()
+	m, err := e.WithContext(ctx).Where(e.NamespaceUID.Eq(namespaceUID), e.UID.Eq(req.UID.Int64())).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("event not found")
+		}
+		return nil, err
+	}
+	var eventUIDs []int64
+	err = e.WithContext(ctx).Where(
+		e.NamespaceUID.Eq(namespaceUID),
+		e.StrategyUID.Eq(m.StrategyUID.Int64()),
+		e.LevelUID.Eq(m.LevelUID.Int64()),
+		e.Fingerprint.Eq(m.Fingerprint),
+	).Pluck(e.UID, &eventUIDs)
+	if err != nil {
+		return nil, err
+	}
+	t := query.EventTimeline
+	list, err := t.WithContext(ctx).Where(
+		t.NamespaceUID.Eq(namespaceUID),
+		t.EventUID.In(eventUIDs...),
+	).Order(t.Time.Desc(), t.ID.Desc()).Limit(req.Limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.EventTimelineItemBo, 0, len(list))
+	for _, item := range list {
+		items = append(items, convert.ToEventTimelineItemBo(item))
+	}
+	return items, nil
+}
+
+func (r *eventRepository) SaveEventActivity(ctx context.Context, req *bo.EventActivityBo) (*bo.EventItemBo, error) {
+	var item *bo.EventItemBo
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		e := tx.Event
+		m, err := e.WithContext(ctx).Where(
+			e.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+			e.UID.Eq(req.EventUID.Int64()),
+		).First()
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return merr.ErrorNotFound("event not found")
+			}
+			return err
+		}
+		// the state is part of the condition, so that an event resolved in the meantime
+		// is neither acknowledged nor resolved twice
+		firing := e.WithContext(ctx).Where(e.ID.Eq(m.ID), e.State.Eq(int32(apiv1.EventState_FIRING)))
+		var info gen.ResultInfo
+		switch req.Type {
+		case apiv1.EventActivityType_ACKNOWLEDGE:
+			m.AckedBy, m.AckedAt = contextx.GetUserUID(ctx), &req.Time
+			info, err = firing.Where(e.AckedBy.Eq(0)).Select(e.AckedBy, e.AckedAt).Updates(m)
+		case apiv1.EventActivityType_MANUAL_RESOLVE:
+			m.State, m.EndsAt = int32(apiv1.EventState_RESOLVED), &req.Time
+			if info, err = firing.Select(e.State, e.EndsAt).Updates(m); err == nil && info.RowsAffected > 0 {
+				err = resolveManually(ctx, tx, m)
+			}
+		case apiv1.EventActivityType_ASSIGN:
+			m.Assignee = req.Assignee
+			// an unchanged assignee affects no row on MySQL, it is not checked
+			_, err = e.WithContext(ctx).Where(e.ID.Eq(m.ID)).UpdateColumnSimple(e.Assignee.Value(req.Assignee.Int64()))
+			info.RowsAffected = 1
+		default:
+			info.RowsAffected = 1
+		}
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return merr.ErrorNotFound("firing event not found")
+		}
+		if err := tx.EventActivity.WithContext(ctx).Create(convert.ToEventActivityDo(ctx, m, req)); err != nil {
+			return err
+		}
+		item = convert.ToEventItemBo(m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// resolveManually records the manual resolve of event and marks its alert state, the evaluator
+// picks the mark up from its next checkpoint, so that the alert fires no new event while its
+// series keeps matching.
+func resolveManually(ctx context.Context, tx *query.Query, event *do.Event) error {
+	if err := tx.EventTimeline.WithContext(ctx).Create(convert.ToEventTimelineDo(event, event.State, event.Value)); err != nil {
+		return err
+	}
+	a := tx.AlertState
+	_, err := a.WithContext(ctx).Where(
+		a.StrategyUID.Eq(event.StrategyUID.Int64()),
+		a.LevelUID.Eq(event.LevelUID.Int64()),
+		a.Fingerprint.Eq(event.Fingerprint),
+	).UpdateColumnSimple(a.ManuallyResolved.Value(true))
+	return err
+}
+
+func (r *eventRepository) ListEventActivity(ctx context.Context, req *bo.ListEventActivityBo) ([]*bo.EventActivityItemBo, error) {
+	namespaceUID := contextx.GetNamespace(ctx).Int64()
+	e := query.Event
+	if _, err := e.WithContext(ctx).Where(e.NamespaceUID.Eq(namespaceUID), e.UID.Eq(req.UID.Int64())).First(); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("event not found")
+		}
+		return nil, err
+	}
+	a := query.EventActivity
+	list, err := a.WithContext(ctx).Where(
+		a.NamespaceUID.Eq(namespaceUID),
+		a.EventUID.Eq(req.UID.Int64()),
+	).Order(a.Time.Desc(), a.ID.Desc()).Limit(req.Limit).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.EventActivityItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToEventActivityItemBo(m))
+	}
+	return items, nil
+}
@@ -0,0 +1,143 @@
+package impl
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func TestListEventLabelMatchers(t *testing.T) {
+	repo := &eventRepository{db: openTestDB(t, &do.Event{}, &do.EventTimeline{}, &do.EventActivity{}, &do.AlertState{})}
+	ctx := namespaceContext(1)
+	now := time.Unix(1700000000, 0)
+	var events []*bo.SaveEventBo
+	for i, labels := range []map[string]string{
+		{"instance": "a", "job": "node"},
+		{"instance": "b", "job": "node", "env": "prod"},
+		{"instance": "c", "job": "api", "env": "dev"},
+	} {
+		events = append(events, &bo.SaveEventBo{
+			NamespaceUID: 1,
+			StrategyUID:  10,
+			LevelUID:     1,
+			Fingerprint:  uint64(i + 1),
+			Labels:       labels,
+			State:        apiv1.EventState_FIRING,
+			StartsAt:     now.Add(time.Duration(i) * time.Minute),
+			EvalAt:       now,
+		})
+	}
+	if _, err := repo.SaveEvents(ctx, events); err != nil {
+		t.Fatalf("save events: %v", err)
+	}
+
+	for _, tc := range []struct {
+		matchers []string
+		want     []string
+	}{
+		{[]string{`job="node"`}, []string{"b", "a"}},
+		{[]string{`job="node"`, `env!="prod"`}, []string{"a"}},
+		// a missing label has the empty value
+		{[]string{`env=""`}, []string{"a"}},
+		{[]string{`env!=""`, `instance=~"b|c"`}, []string{"c", "b"}},
+		{[]string{`instance!~"a|b"`}, []string{"c"}},
+		{[]string{`job="db"`}, nil},
+	} {
+		matchers, err := bo.ParseLabelMatchers(tc.matchers)
+		if err != nil {
+			t.Fatalf("parse %v: %v", tc.matchers, err)
+		}
+		page, err := repo.ListEvent(ctx, &bo.ListEventBo{PageRequestBo: bo.NewPageRequestBo(1, 10), LabelMatchers: matchers})
+		if err != nil {
+			t.Fatalf("list %v: %v", tc.matchers, err)
+		}
+		var got []string
+		for _, item := range page.GetItems() {
+			got = append(got, item.Labels["instance"])
+		}
+		if !slices.Equal(got, tc.want) || page.GetTotal() != int64(len(tc.want)) {
+			t.Errorf("list %v: got %v of %d, want %v", tc.matchers, got, page.GetTotal(), tc.want)
+		}
+	}
+}
+
+func TestListEventCapsScannedEvents(t *testing.T) {
+	repo := &eventRepository{db: openTestDB(t, &do.Event{}, &do.EventTimeline{}, &do.EventActivity{}, &do.AlertState{})}
+	ctx := namespaceContext(1)
+	list := make([]*do.Event, 0, maxEventScan+1)
+	for i := range maxEventScan + 1 {
+		list = append(list, &do.Event{NamespaceUID: 1, Labels: map[string]string{"instance": "a"}, State: int32(apiv1.EventState_FIRING)})
+		list[i].StartsAt = time.Unix(1700000000, 0)
+	}
+	if err := query.Event.WithContext(ctx).CreateInBatches(list, 500); err != nil {
+		t.Fatalf("create events: %v", err)
+	}
+	regexp, err := bo.ParseLabelMatchers([]string{`instance=~"a.*"`})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if _, err := repo.ListEvent(ctx, &bo.ListEventBo{PageRequestBo: bo.NewPageRequestBo(1, 10), LabelMatchers: regexp}); errors.Code(err) != 400 {
+		t.Fatalf("got %v, want a params error when the regexp matchers would scan too many events", err)
+	}
+	// matchers run in sql are not capped
+	equal, err := bo.ParseLabelMatchers([]string{`instance="a"`})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	page, err := repo.ListEvent(ctx, &bo.ListEventBo{PageRequestBo: bo.NewPageRequestBo(1, 10), LabelMatchers: equal})
+	if err != nil || page.GetTotal() != maxEventScan+1 || len(page.GetItems()) != 10 {
+		t.Fatalf("got %v, want a page of all the events", err)
+	}
+}
+
+func TestManualResolveMarksAlertState(t *testing.T) {
+	db := openTestDB(t, &do.Event{}, &do.EventTimeline{}, &do.EventActivity{}, &do.AlertState{})
+	events := &eventRepository{db: db}
+	states := &alertStateRepository{db: db}
+	ctx := namespaceContext(1)
+	now := time.Unix(1700000000, 0)
+	state := &bo.AlertStateBo{NamespaceUID: 1, StrategyUID: 10, LevelUID: 1, Fingerprint: 0xa, State: 2, ActiveAt: now, StartsAt: now, LastEvalAt: now}
+	if _, err := states.SaveAlertStates(ctx, 10, []*bo.AlertStateBo{state}); err != nil {
+		t.Fatalf("save alert states: %v", err)
+	}
+	changed, err := events.SaveEvents(ctx, []*bo.SaveEventBo{{
+		NamespaceUID: 1,
+		StrategyUID:  10,
+		LevelUID:     1,
+		Fingerprint:  0xa,
+		State:        apiv1.EventState_FIRING,
+		StartsAt:     now,
+		EvalAt:       now,
+	}})
+	if err != nil || len(changed) != 1 {
+		t.Fatalf("save events: %v", err)
+	}
+	if _, err := events.SaveEventActivity(ctx, &bo.EventActivityBo{EventUID: changed[0].UID, Type: apiv1.EventActivityType_MANUAL_RESOLVE, Time: now}); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+
+	// the next checkpoint of the evaluator keeps the mark and reports it
+	resolved, err := states.SaveAlertStates(ctx, 10, []*bo.AlertStateBo{state})
+	if err != nil || len(resolved) != 1 || resolved[0].Fingerprint != 0xa {
+		t.Fatalf("got %v, %v, want the manually resolved alert", resolved, err)
+	}
+	list, err := states.ListAlertStates(ctx, 10)
+	if err != nil || len(list) != 1 || !list[0].ManuallyResolved {
+		t.Fatalf("got %v, %v, want the alert state marked", list, err)
+	}
+
+	// the mark goes with the alert, the series starts over once it stopped matching
+	if _, err := states.SaveAlertStates(ctx, 10, nil); err != nil {
+		t.Fatalf("save none: %v", err)
+	}
+	if resolved, err := states.SaveAlertStates(ctx, 10, []*bo.AlertStateBo{state}); err != nil || len(resolved) != 0 {
+		t.Fatalf("got %v, %v, want the new alert not resolved", resolved, err)
+	}
+}
@@ -9,5 +9,21 @@ var ProviderSetImpl = wire.NewSet(
 	NewNamespaceRepository,
 	NewLevelRepository,
 	NewDatasourceRepository,
+	NewStrategyGroupRepository,
+	NewStrategyRepository,
+	NewStrategyMetricRepository,
+	NewManifestRepository,
+	NewAlertStateRepository,
+	NewEventRepository,
+	NewReceiverRepository,
+	NewSilenceRepository,
+	NewInhibitRuleRepository,
+	NewAggregationRepository,
+	NewNotifyGroupRepository,
+	NewEscalationPolicyRepository,
+	NewOnCallScheduleRepository,
+	NewEscalationTimerRepository,
+	NewJobNodeRepository,
+	NewLeaseRepository,
 	NewLoginRepository,
 )
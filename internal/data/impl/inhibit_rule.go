@@ -0,0 +1,146 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewInhibitRuleRepository(d *data.Data) (repository.InhibitRule, error) {
+	query.SetDefault(d.DB())
+	return &inhibitRuleRepository{db: d.DB()}, nil
+}
+
+type inhibitRuleRepository struct {
+	db *gorm.DB
+}
+
+func (r *inhibitRuleRepository) CreateInhibitRule(ctx context.Context, req *bo.CreateInhibitRuleBo) error {
+	m := convert.ToInhibitRuleDo(ctx, req)
+	return query.InhibitRule.WithContext(ctx).Create(m)
+}
+
+func (r *inhibitRuleRepository) UpdateInhibitRule(ctx context.Context, req *bo.UpdateInhibitRuleBo) error {
+	ir := query.InhibitRule
+	m := &do.InhibitRule{
+		Name:           req.Name,
+		Remark:         req.Remark,
+		SourceLevelUID: req.SourceLevelUID,
+		SourceMatchers: convert.ToLabelMatchersDo(req.SourceMatchers),
+		TargetLevelUID: req.TargetLevelUID,
+		TargetMatchers: convert.ToLabelMatchersDo(req.TargetMatchers),
+		Equal:          req.Equal,
+	}
+	_, err := ir.WithContext(ctx).Where(
+		ir.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		ir.UID.Eq(req.UID.Int64()),
+	).Select(ir.Name, ir.Remark, ir.SourceLevelUID, ir.SourceMatchers, ir.TargetLevelUID, ir.TargetMatchers, ir.Equal).Updates(m)
+	return err
+}
+
+func (r *inhibitRuleRepository) UpdateInhibitRuleStatus(ctx context.Context, req *bo.UpdateInhibitRuleStatusBo) error {
+	ir := query.InhibitRule
+	info, err := ir.WithContext(ctx).Where(
+		ir.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		ir.UID.Eq(req.UID.Int64()),
+	).Update(ir.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("inhibit rule not found")
+	}
+	return nil
+}
+
+func (r *inhibitRuleRepository) DeleteInhibitRule(ctx context.Context, uid snowflake.ID) error {
+	ir := query.InhibitRule
+	info, err := ir.WithContext(ctx).Where(
+		ir.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		ir.UID.Eq(uid.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("inhibit rule not found")
+	}
+	return nil
+}
+
+func (r *inhibitRuleRepository) GetInhibitRule(ctx context.Context, uid snowflake.ID) (*bo.InhibitRuleItemBo, error) {
+	ir := query.InhibitRule
+	m, err := ir.WithContext(ctx).Where(
+		ir.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		ir.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("inhibit rule not found")
+		}
+		return nil, err
+	}
+	return convert.ToInhibitRuleItemBo(m), nil
+}
+
+func (r *inhibitRuleRepository) ListInhibitRule(ctx context.Context, req *bo.ListInhibitRuleBo) (*bo.PageResponseBo[*bo.InhibitRuleItemBo], error) {
+	ir := query.InhibitRule
+	wrappers := ir.WithContext(ctx)
+	wrappers = wrappers.Where(ir.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		k := "%" + strings.TrimSpace(req.Keyword) + "%"
+		wrappers = wrappers.Where(ir.Name.Like(k))
+	}
+	if req.LevelUID > 0 {
+		wrappers = wrappers.Where(field.Or(ir.SourceLevelUID.Eq(req.LevelUID.Int64()), ir.TargetLevelUID.Eq(req.LevelUID.Int64())))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(ir.Status.Eq(int32(req.Status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Order(ir.UID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.InhibitRuleItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToInhibitRuleItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func (r *inhibitRuleRepository) ListEnabledInhibitRules(ctx context.Context, namespaceUID snowflake.ID) ([]*bo.InhibitRuleItemBo, error) {
+	ir := query.InhibitRule
+	list, err := ir.WithContext(ctx).Where(
+		ir.NamespaceUID.Eq(namespaceUID.Int64()),
+		ir.Status.Eq(int32(enum.GlobalStatus_ENABLED)),
+	).Order(ir.UID).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.InhibitRuleItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToInhibitRuleItemBo(m))
+	}
+	return items, nil
+}
@@ -0,0 +1,82 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+// jobNodeTTL is how long a job node counts as live after its last heartbeat, the nodes
+// send one every 10s.
+const jobNodeTTL = 30 * time.Second
+
+// NewJobNodeRepository lists the job nodes from the registry when it supports discovery,
+// otherwise from the heartbeats in the database.
+func NewJobNodeRepository(serviceName string, d *data.Data) (repository.JobNode, error) {
+	query.SetDefault(d.DB())
+	r := &jobNodeRepository{db: d.DB()}
+	if discovery, ok := d.Registry().(registry.Discovery); ok {
+		r.discovery = discovery
+		// the job server is registered as <serviceName>.job, see run.NewApp
+		r.serviceName = strings.Join([]string{serviceName, "job"}, ".")
+	}
+	return r, nil
+}
+
+type jobNodeRepository struct {
+	db          *gorm.DB
+	discovery   registry.Discovery
+	serviceName string
+}
+
+func (r *jobNodeRepository) Heartbeat(ctx context.Context, nodeID string) error {
+	if r.discovery != nil {
+		return nil
+	}
+	j := query.JobNode
+	now := time.Now()
+	info, err := j.WithContext(ctx).Where(j.NodeID.Eq(nodeID)).UpdateColumnSimple(j.HeartbeatAt.Value(now), j.UpdatedAt.Value(now))
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected > 0 {
+		return nil
+	}
+	return j.WithContext(ctx).Create(&do.JobNode{NodeID: nodeID, HeartbeatAt: now})
+}
+
+func (r *jobNodeRepository) Leave(ctx context.Context, nodeID string) error {
+	if r.discovery != nil {
+		// the node is deregistered when its app stops
+		return nil
+	}
+	j := query.JobNode
+	_, err := j.WithContext(ctx).Where(j.NodeID.Eq(nodeID)).Delete()
+	return err
+}
+
+func (r *jobNodeRepository) ListJobNodes(ctx context.Context) ([]string, error) {
+	if r.discovery != nil {
+		instances, err := r.discovery.GetService(ctx, r.serviceName)
+		if err != nil {
+			return nil, err
+		}
+		nodes := make([]string, 0, len(instances))
+		for _, instance := range instances {
+			nodes = append(nodes, instance.ID)
+		}
+		return nodes, nil
+	}
+	j := query.JobNode
+	var nodes []string
+	err := j.WithContext(ctx).Where(j.HeartbeatAt.Gte(time.Now().Add(-jobNodeTTL))).Pluck(j.NodeID, &nodes)
+	return nodes, err
+}
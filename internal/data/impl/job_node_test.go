@@ -0,0 +1,80 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/shard"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+// openJobNodeDB opens a SQLite file, the in-process nodes share it like replicas share a database.
+func newJobNode(db *gorm.DB, nodeID string) *shard.Sharder {
+	return shard.NewSharder(nodeID, &jobNodeRepository{db: db}, klog.NewHelper(klog.DefaultLogger))
+}
+
+func refreshJobNodes(t *testing.T, sharders ...*shard.Sharder) {
+	t.Helper()
+	// the first round writes every heartbeat, the second one sees all of them
+	for round := 0; round < 2; round++ {
+		for _, s := range sharders {
+			if _, err := s.Refresh(context.Background()); err != nil {
+				t.Fatalf("refresh %s: %v", s.NodeID(), err)
+			}
+		}
+	}
+}
+
+func assertOneOwnerPerStrategy(t *testing.T, sharders ...*shard.Sharder) {
+	t.Helper()
+	for i := 0; i < 300; i++ {
+		strategyUID := strconv.FormatInt(1800000000000000000+int64(i)*104729, 10)
+		var owners []string
+		for _, s := range sharders {
+			if s.Owns(strategyUID) {
+				owners = append(owners, s.NodeID())
+			}
+		}
+		if len(owners) != 1 {
+			t.Fatalf("strategy %s is owned by %v", strategyUID, owners)
+		}
+	}
+}
+
+func TestJobNodesShareStrategies(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, &do.JobNode{})
+	a, b, c := newJobNode(db, "node-a"), newJobNode(db, "node-b"), newJobNode(db, "node-c")
+	refreshJobNodes(t, a, b, c)
+	assertOneOwnerPerStrategy(t, a, b, c)
+
+	// a joining node takes its share over once every node saw it
+	d := newJobNode(db, "node-d")
+	refreshJobNodes(t, a, b, c, d)
+	assertOneOwnerPerStrategy(t, a, b, c, d)
+
+	// a graceful shutdown hands the strategies over on the next refresh
+	if err := b.Leave(ctx); err != nil {
+		t.Fatalf("leave: %v", err)
+	}
+	refreshJobNodes(t, a, c, d)
+	assertOneOwnerPerStrategy(t, a, c, d)
+
+	// a crashed node stops sending heartbeats and drops out after the ttl
+	j := query.JobNode
+	if _, err := j.WithContext(ctx).Where(j.NodeID.Eq("node-c")).UpdateColumnSimple(j.HeartbeatAt.Value(time.Now().Add(-2 * jobNodeTTL))); err != nil {
+		t.Fatalf("expire heartbeat: %v", err)
+	}
+	for _, s := range []*shard.Sharder{a, d} {
+		if changed, err := s.Refresh(ctx); err != nil || !changed {
+			t.Fatalf("refresh %s after crash = %v, %v", s.NodeID(), changed, err)
+		}
+	}
+	assertOneOwnerPerStrategy(t, a, d)
+}
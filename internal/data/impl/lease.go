@@ -0,0 +1,120 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/aide-family/marksman/internal/biz/leader"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewLeaseRepository(d *data.Data) (repository.Lease, error) {
+	query.SetDefault(d.DB())
+	return &leaseRepository{db: d.DB()}, nil
+}
+
+type leaseRepository struct {
+	db *gorm.DB
+}
+
+func (r *leaseRepository) AcquireLease(ctx context.Context, name, holder string, token uint64, ttl time.Duration) (uint64, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	l := query.Lease
+	if token != 0 {
+		info, err := l.WithContext(ctx).
+			Where(l.Name.Eq(name), l.Holder.Eq(holder), l.Token.Eq(token), l.ExpiresAt.Gt(now)).
+			UpdateColumnSimple(l.ExpiresAt.Value(expiresAt), l.UpdatedAt.Value(now))
+		if err != nil {
+			return 0, err
+		}
+		if info.RowsAffected > 0 {
+			return token, nil
+		}
+	}
+
+	// the conditional update is atomic, so of the nodes taking over at the same time only one wins,
+	// the token is read in the same transaction before another node can take the lease again
+	var acquired uint64
+	err := query.Q.Transaction(func(tx *query.Query) error {
+		l := tx.Lease
+		info, err := l.WithContext(ctx).
+			Where(l.Name.Eq(name), field.Or(l.Holder.Eq(holder), l.ExpiresAt.Lte(now))).
+			UpdateColumnSimple(l.Holder.Value(holder), l.Token.Add(1), l.ExpiresAt.Value(expiresAt), l.UpdatedAt.Value(now))
+		if err != nil || info.RowsAffected == 0 {
+			return err
+		}
+		lease, err := l.WithContext(ctx).Where(l.Name.Eq(name)).First()
+		if err != nil {
+			return err
+		}
+		acquired = lease.Token
+		return nil
+	})
+	if err != nil || acquired != 0 {
+		return acquired, err
+	}
+
+	if _, err := l.WithContext(ctx).Where(l.Name.Eq(name)).First(); err == nil {
+		return 0, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+	lease := &do.Lease{Name: name, Holder: holder, Token: 1, ExpiresAt: expiresAt}
+	if err := l.WithContext(ctx).Create(lease); err != nil {
+		// another node created the lease first, the unique name rejects this one
+		if _, findErr := l.WithContext(ctx).Where(l.Name.Eq(name)).First(); findErr == nil {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return lease.Token, nil
+}
+
+func (r *leaseRepository) ReleaseLease(ctx context.Context, name, holder string, token uint64) error {
+	l := query.Lease
+	now := time.Now()
+	_, err := l.WithContext(ctx).
+		Where(l.Name.Eq(name), l.Holder.Eq(holder), l.Token.Eq(token)).
+		UpdateColumnSimple(l.ExpiresAt.Value(now), l.UpdatedAt.Value(now))
+	return err
+}
+
+func (r *leaseRepository) GetLeaseToken(ctx context.Context, name string) (uint64, error) {
+	l := query.Lease
+	lease, err := l.WithContext(ctx).Where(l.Name.Eq(name), l.ExpiresAt.Gt(time.Now())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return lease.Token, nil
+}
+
+// fence makes the writes of tx conditional on the fencing token of the guarded job of ctx. The
+// lease row stays locked until tx commits, so the lease is not taken over before the writes are
+// committed, and they are rolled back with leader.ErrNotLeader once it was. Writes outside of a
+// guarded job are not fenced.
+func fence(ctx context.Context, tx *query.Query) error {
+	token := leader.TokenFromContext(ctx)
+	if token == 0 {
+		return nil
+	}
+	l := tx.Lease
+	_, err := l.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(l.Name.Eq(leader.LeaseFromContext(ctx)), l.Token.Eq(token), l.ExpiresAt.Gt(time.Now())).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return leader.ErrNotLeader
+	}
+	return err
+}
@@ -0,0 +1,151 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/escalator"
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/leader"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+func newLeaseElector(db *gorm.DB, holder string, ttl time.Duration) *leader.Elector {
+	return leader.NewElector("marksman.job", holder, &leaseRepository{db: db}, klog.NewHelper(klog.DefaultLogger), leader.WithTTL(ttl))
+}
+
+func TestLeaseElectsOneLeader(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, &do.Lease{})
+	ttl := 200 * time.Millisecond
+	electors := []*leader.Elector{
+		newLeaseElector(db, "node-a", ttl),
+		newLeaseElector(db, "node-b", ttl),
+		newLeaseElector(db, "node-c", ttl),
+	}
+
+	// the nodes campaign at the same time, the first one creates the lease
+	var wg sync.WaitGroup
+	for _, e := range electors {
+		wg.Go(func() {
+			if _, err := e.Campaign(ctx); err != nil {
+				t.Errorf("campaign: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+	var current *leader.Elector
+	for _, e := range electors {
+		if e.IsLeader() {
+			if current != nil {
+				t.Fatalf("%s and another node both lead", e.Name())
+			}
+			current = e
+		}
+	}
+	if current == nil {
+		t.Fatal("no node leads")
+	}
+	token := current.Token()
+	if err := current.Fence(ctx, token); err != nil {
+		t.Fatalf("fence of the leader: %v", err)
+	}
+
+	// the leader stops renewing, a follower takes over after the ttl with a greater token
+	time.Sleep(ttl)
+	var next *leader.Elector
+	for _, e := range electors {
+		if e == current {
+			continue
+		}
+		if _, err := e.Campaign(ctx); err != nil {
+			t.Fatalf("campaign: %v", err)
+		}
+		if e.IsLeader() {
+			next = e
+			break
+		}
+	}
+	if next == nil || next.Token() <= token {
+		t.Fatalf("no follower took the expired lease over, token %d", token)
+	}
+	if current.IsLeader() {
+		t.Fatal("the old leader kept leading after its lease expired")
+	}
+	if err := current.Fence(ctx, token); err == nil {
+		t.Fatal("the stale token passed the fence")
+	}
+	if changed, err := current.Campaign(ctx); err != nil || changed {
+		t.Fatalf("the old leader took the lease back: %v, %v", changed, err)
+	}
+
+	// resigning frees the lease right away
+	if err := next.Resign(ctx); err != nil {
+		t.Fatalf("resign: %v", err)
+	}
+	if changed, err := current.Campaign(ctx); err != nil || !changed || current.Token() <= next.Token() {
+		t.Fatalf("the lease was not free after resigning: %v, %v", changed, err)
+	}
+}
+
+func TestLeaseFencesGuardedWrites(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, &do.Lease{})
+	if err := db.AutoMigrate(&do.EscalationTimer{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	ttl := 100 * time.Millisecond
+	a, b := newLeaseElector(db, "node-a", ttl), newLeaseElector(db, "node-b", ttl)
+	if _, err := a.Campaign(ctx); err != nil || !a.IsLeader() {
+		t.Fatalf("campaign: %v", err)
+	}
+
+	repo := &escalationTimerRepository{db: db}
+	timer := &escalator.Timer{
+		EventUID:     1,
+		NamespaceUID: 1,
+		PolicyUID:    9,
+		Steps:        []*escalator.Step{{ReceiverUIDs: []snowflake.ID{2}}, {Delay: time.Minute, ReceiverUIDs: []snowflake.ID{3}}},
+		FireAt:       time.Now(),
+		Message:      &notifier.Message{Version: notifier.MessageVersion, Status: notifier.StatusFiring, EventUID: 1, NamespaceUID: 1},
+	}
+	if err := repo.SaveTimer(ctx, timer); err != nil {
+		t.Fatalf("save timer: %v", err)
+	}
+	next := &escalator.Timer{EventUID: 1, PolicyUID: 9, Step: 1, FireAt: time.Now().Add(time.Minute)}
+
+	// the job of the former leader outlives its lease, node b takes it over before the job writes
+	guarded := a.Guard(&job.Job{Name: "fire-escalations", Run: func(ctx context.Context) error {
+		time.Sleep(2 * ttl)
+		if _, err := b.Campaign(context.Background()); err != nil || !b.IsLeader() {
+			t.Fatalf("take over: %v", err)
+		}
+		_, err := repo.CompleteStep(ctx, timer, next)
+		return err
+	}})
+	if err := guarded.Run(ctx); !errors.Is(err, leader.ErrNotLeader) {
+		t.Fatalf("got %v, want the write of the former leader fenced off", err)
+	}
+	timers, err := repo.ListDueTimers(ctx, time.Now(), 10)
+	if err != nil || len(timers) != 1 || timers[0].Step != 0 {
+		t.Fatalf("got %v and timers %+v, want the timer unchanged", err, timers)
+	}
+
+	// the new leader completes the step
+	var completed bool
+	guarded = b.Guard(&job.Job{Name: "fire-escalations", Run: func(ctx context.Context) error {
+		completed, err = repo.CompleteStep(ctx, timer, next)
+		return err
+	}})
+	if err := guarded.Run(ctx); err != nil || !completed {
+		t.Fatalf("got %v, want the step completed by the new leader", err)
+	}
+}
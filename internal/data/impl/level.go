@@ -88,6 +88,21 @@ func (r *levelRepository) GetLevel(ctx context.Context, uid snowflake.ID) (*bo.L
 	return convert.ToLevelItemBo(m), nil
 }
 
+func (r *levelRepository) GetLevelByName(ctx context.Context, name string) (*bo.LevelItemBo, error) {
+	l := query.Level
+	m, err := l.WithContext(ctx).Where(
+		l.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		l.Name.Eq(name),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("level not found")
+		}
+		return nil, err
+	}
+	return convert.ToLevelItemBo(m), nil
+}
+
 func (r *levelRepository) ListLevel(ctx context.Context, req *bo.ListLevelBo) (*bo.PageResponseBo[*bo.LevelItemBo], error) {
 	l := query.Level
 	wrappers := l.WithContext(ctx)
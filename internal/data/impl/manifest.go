@@ -0,0 +1,317 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aide-family/magicbox/safety"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	"github.com/aide-family/marksman/internal/data/secret"
+)
+
+func NewManifestRepository(d *data.Data) (repository.Manifest, error) {
+	query.SetDefault(d.DB())
+	return &manifestRepository{db: d.DB(), keyring: d.Keyring()}, nil
+}
+
+type manifestRepository struct {
+	db      *gorm.DB
+	keyring *secret.Keyring
+}
+
+func (r *manifestRepository) GetManifestState(ctx context.Context, namespaceUID snowflake.ID) (*bo.ManifestStateBo, error) {
+	namespace := namespaceUID.Int64()
+	l := query.Level
+	levels, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(namespace)).Order(l.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	d := query.Datasource
+	datasources, err := d.WithContext(ctx).Where(d.NamespaceUID.Eq(namespace)).Order(d.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	sg := query.StrategyGroup
+	groups, err := sg.WithContext(ctx).Where(sg.NamespaceUID.Eq(namespace)).Order(sg.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	s := query.Strategy
+	strategies, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespace)).Order(s.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	sm := query.StrategyMetric
+	metrics, err := sm.WithContext(ctx).Where(sm.NamespaceUID.Eq(namespace)).Find()
+	if err != nil {
+		return nil, err
+	}
+	sml := query.StrategyMetricLevel
+	metricLevels, err := sml.WithContext(ctx).Where(sml.NamespaceUID.Eq(namespace)).Order(sml.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &bo.ManifestStateBo{
+		Levels:         make([]*bo.LevelItemBo, 0, len(levels)),
+		Datasources:    make([]*bo.DatasourceItemBo, 0, len(datasources)),
+		StrategyGroups: make([]*bo.StrategyGroupItemBo, 0, len(groups)),
+		Strategies:     make([]*bo.StrategyItemBo, 0, len(strategies)),
+		Metrics:        make(map[snowflake.ID]*bo.StrategyMetricItemBo, len(metrics)),
+	}
+	levelMap := make(map[snowflake.ID]*do.Level, len(levels))
+	for _, m := range levels {
+		levelMap[m.UID] = m
+		state.Levels = append(state.Levels, convert.ToLevelItemBo(m))
+	}
+	for _, m := range datasources {
+		if err := decryptDatasourceConfig(r.keyring, m.UID, m.Config); err != nil {
+			return nil, err
+		}
+		state.Datasources = append(state.Datasources, convert.ToDatasourceItemBo(m))
+	}
+	for _, m := range groups {
+		state.StrategyGroups = append(state.StrategyGroups, convert.ToStrategyGroupItemBo(m))
+	}
+	for _, m := range strategies {
+		state.Strategies = append(state.Strategies, convert.ToStrategyItemBo(m))
+	}
+	metricLevelMap := make(map[snowflake.ID][]*bo.StrategyMetricLevelItemBo, len(metrics))
+	for _, m := range metricLevels {
+		metricLevelMap[m.StrategyUID] = append(metricLevelMap[m.StrategyUID], convert.ToStrategyMetricLevelItemBo(m, levelMap[m.LevelUID]))
+	}
+	for _, m := range metrics {
+		state.Metrics[m.StrategyUID] = convert.ToStrategyMetricItemBo(m, metricLevelMap[m.StrategyUID])
+	}
+	return state, nil
+}
+
+// ApplyManifestPlan deletes before it saves so that a name freed by a delete can be
+// taken, strategies are saved last since they reference the other kinds by name.
+func (r *manifestRepository) ApplyManifestPlan(ctx context.Context, plan *bo.ManifestPlanBo) error {
+	namespace := plan.NamespaceUID.Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		for _, uid := range plan.DeleteStrategyUIDs {
+			if err := deleteStrategy(ctx, tx, namespace, uid); err != nil {
+				return err
+			}
+		}
+		for _, uid := range plan.DeleteStrategyGroupUIDs {
+			if err := deleteStrategyGroup(ctx, tx, namespace, uid); err != nil {
+				return err
+			}
+		}
+		if len(plan.DeleteDatasourceUIDs) > 0 {
+			d := tx.Datasource
+			if _, err := d.WithContext(ctx).Where(d.NamespaceUID.Eq(namespace), d.UID.In(toInt64s(plan.DeleteDatasourceUIDs)...)).Delete(); err != nil {
+				return err
+			}
+		}
+		if len(plan.DeleteLevelUIDs) > 0 {
+			l := tx.Level
+			if _, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(namespace), l.UID.In(toInt64s(plan.DeleteLevelUIDs)...)).Delete(); err != nil {
+				return err
+			}
+		}
+
+		for _, level := range plan.Levels {
+			if err := saveManifestLevel(ctx, tx, plan, level); err != nil {
+				return err
+			}
+		}
+		for _, datasource := range plan.Datasources {
+			if err := r.saveManifestDatasource(ctx, tx, plan, datasource); err != nil {
+				return err
+			}
+		}
+		for _, group := range plan.StrategyGroups {
+			if err := saveManifestStrategyGroup(ctx, tx, plan, group); err != nil {
+				return err
+			}
+		}
+		if len(plan.Strategies) == 0 {
+			return nil
+		}
+		uids, err := loadManifestUIDs(ctx, tx, namespace)
+		if err != nil {
+			return err
+		}
+		for _, strategy := range plan.Strategies {
+			if err := saveManifestStrategy(ctx, tx, plan, uids, strategy); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func saveManifestLevel(ctx context.Context, tx *query.Query, plan *bo.ManifestPlanBo, req *bo.ManifestLevelBo) error {
+	l := tx.Level
+	if req.UID == 0 {
+		return l.WithContext(ctx).Create(convert.ToManifestLevelDo(plan.NamespaceUID, plan.Creator, req))
+	}
+	columns := []field.AssignExpr{
+		l.Remark.Value(req.Remark),
+		l.Metadata.Value(safety.NewMap(req.Metadata)),
+		l.Status.Value(int32(req.Status)),
+		l.Managed.Value(true),
+	}
+	_, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(plan.NamespaceUID.Int64()), l.UID.Eq(req.UID.Int64())).UpdateColumnSimple(columns...)
+	return err
+}
+
+func (r *manifestRepository) saveManifestDatasource(ctx context.Context, tx *query.Query, plan *bo.ManifestPlanBo, req *bo.ManifestDatasourceBo) error {
+	m := convert.ToManifestDatasourceDo(plan.NamespaceUID, plan.Creator, req)
+	m.UID = req.UID
+	if m.UID == 0 {
+		uid, err := do.NewUID()
+		if err != nil {
+			return err
+		}
+		m.UID = uid
+	}
+	if err := encryptDatasourceConfig(r.keyring, m.UID, m.Config); err != nil {
+		return err
+	}
+	d := tx.Datasource
+	if req.UID == 0 {
+		return d.WithContext(ctx).Create(m)
+	}
+	columns := []field.AssignExpr{
+		d.Type.Value(int32(req.Type)),
+		d.Driver.Value(int32(req.Driver)),
+		d.Metadata.Value(safety.NewMap(req.Metadata)),
+		d.Config.Value(m.Config),
+		d.Managed.Value(true),
+	}
+	_, err := d.WithContext(ctx).Where(d.NamespaceUID.Eq(plan.NamespaceUID.Int64()), d.UID.Eq(req.UID.Int64())).UpdateColumnSimple(columns...)
+	return err
+}
+
+func saveManifestStrategyGroup(ctx context.Context, tx *query.Query, plan *bo.ManifestPlanBo, req *bo.ManifestStrategyGroupBo) error {
+	sg := tx.StrategyGroup
+	if req.UID == 0 {
+		return sg.WithContext(ctx).Create(convert.ToManifestStrategyGroupDo(plan.NamespaceUID, plan.Creator, req))
+	}
+	columns := []field.AssignExpr{
+		sg.Remark.Value(req.Remark),
+		sg.Metadata.Value(safety.NewMap(req.Metadata)),
+		sg.Status.Value(int32(req.Status)),
+		sg.Managed.Value(true),
+	}
+	_, err := sg.WithContext(ctx).Where(sg.NamespaceUID.Eq(plan.NamespaceUID.Int64()), sg.UID.Eq(req.UID.Int64())).UpdateColumnSimple(columns...)
+	return err
+}
+
+func saveManifestStrategy(ctx context.Context, tx *query.Query, plan *bo.ManifestPlanBo, uids *manifestUIDs, req *bo.ManifestStrategyBo) error {
+	namespace := plan.NamespaceUID.Int64()
+	groupUID, ok := uids.strategyGroups[req.StrategyGroup]
+	if !ok {
+		return fmt.Errorf("strategy group %q of strategy %q not found", req.StrategyGroup, req.Name)
+	}
+	s := tx.Strategy
+	strategyUID := req.UID
+	if strategyUID == 0 {
+		m := convert.ToManifestStrategyDo(plan.NamespaceUID, plan.Creator, groupUID, req)
+		if err := s.WithContext(ctx).Create(m); err != nil {
+			return err
+		}
+		strategyUID = m.UID
+	} else {
+		columns := []field.AssignExpr{
+			s.StrategyGroupUID.Value(groupUID.Int64()),
+			s.Remark.Value(req.Remark),
+			s.Type.Value(int32(req.Type)),
+			s.Driver.Value(int32(req.Driver)),
+			s.Metadata.Value(safety.NewMap(req.Metadata)),
+			s.Status.Value(int32(req.Status)),
+			s.Managed.Value(true),
+		}
+		_, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespace), s.UID.Eq(strategyUID.Int64())).UpdateColumnSimple(columns...)
+		if err != nil {
+			return err
+		}
+	}
+	if req.Metric == nil {
+		if req.UID == 0 {
+			return nil
+		}
+		return deleteStrategyMetric(ctx, tx, namespace, strategyUID)
+	}
+
+	datasourceUIDs := make([]snowflake.ID, 0, len(req.Metric.Datasources))
+	for _, name := range req.Metric.Datasources {
+		uid, ok := uids.datasources[name]
+		if !ok {
+			return fmt.Errorf("datasource %q of strategy %q not found", name, req.Name)
+		}
+		datasourceUIDs = append(datasourceUIDs, uid)
+	}
+	levels := make([]*do.StrategyMetricLevel, 0, len(req.Metric.Levels))
+	for _, level := range req.Metric.Levels {
+		uid, ok := uids.levels[level.Level]
+		if !ok {
+			return fmt.Errorf("level %q of strategy %q not found", level.Level, req.Name)
+		}
+		levels = append(levels, convert.ToManifestStrategyMetricLevelDo(plan.NamespaceUID, plan.Creator, strategyUID, uid, level))
+	}
+	metric := convert.ToManifestStrategyMetricDo(plan.NamespaceUID, plan.Creator, strategyUID, datasourceUIDs, req.Metric)
+	return replaceStrategyMetric(ctx, tx, namespace, metric, levels, req.UID != 0)
+}
+
+// manifestUIDs holds the UIDs of the kinds strategies reference, by name.
+type manifestUIDs struct {
+	levels         map[string]snowflake.ID
+	datasources    map[string]snowflake.ID
+	strategyGroups map[string]snowflake.ID
+}
+
+func loadManifestUIDs(ctx context.Context, tx *query.Query, namespace int64) (*manifestUIDs, error) {
+	l := tx.Level
+	levels, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(namespace)).Select(l.UID, l.Name).Find()
+	if err != nil {
+		return nil, err
+	}
+	d := tx.Datasource
+	datasources, err := d.WithContext(ctx).Where(d.NamespaceUID.Eq(namespace)).Select(d.UID, d.Name).Find()
+	if err != nil {
+		return nil, err
+	}
+	sg := tx.StrategyGroup
+	groups, err := sg.WithContext(ctx).Where(sg.NamespaceUID.Eq(namespace)).Select(sg.UID, sg.Name).Find()
+	if err != nil {
+		return nil, err
+	}
+	uids := &manifestUIDs{
+		levels:         make(map[string]snowflake.ID, len(levels)),
+		datasources:    make(map[string]snowflake.ID, len(datasources)),
+		strategyGroups: make(map[string]snowflake.ID, len(groups)),
+	}
+	for _, m := range levels {
+		uids.levels[m.Name] = m.UID
+	}
+	for _, m := range datasources {
+		uids.datasources[m.Name] = m.UID
+	}
+	for _, m := range groups {
+		uids.strategyGroups[m.Name] = m.UID
+	}
+	return uids, nil
+}
+
+func toInt64s(uids []snowflake.ID) []int64 {
+	list := make([]int64, 0, len(uids))
+	for _, uid := range uids {
+		list = append(list, uid.Int64())
+	}
+	return list
+}
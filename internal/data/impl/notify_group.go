@@ -0,0 +1,132 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewNotifyGroupRepository(d *data.Data) (repository.NotifyGroup, error) {
+	query.SetDefault(d.DB())
+	return &notifyGroupRepository{db: d.DB()}, nil
+}
+
+type notifyGroupRepository struct {
+	db *gorm.DB
+}
+
+func (r *notifyGroupRepository) AddAlerts(ctx context.Context, group *aggregator.Group, msgs []*notifier.Message) error {
+	alerts := make([]*do.NotifyGroupAlert, 0, len(msgs))
+	for _, msg := range msgs {
+		m, err := convert.ToNotifyGroupAlertDo(group.Key, msg)
+		if err != nil {
+			return err
+		}
+		alerts = append(alerts, m)
+	}
+	return query.Q.Transaction(func(tx *query.Query) error {
+		a := tx.NotifyGroupAlert
+		err := a.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: a.GroupKey.ColumnName().String()}, {Name: a.EventUID.ColumnName().String()}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "message", "notified", "updated_at"}),
+		}).Create(alerts...)
+		if err != nil {
+			return err
+		}
+		// the group is written after its alerts, so that a flush emptying the group at the same time
+		// either sees the alerts or is followed by the group being created again
+		g := tx.NotifyGroup
+		return g.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: g.GroupKey.ColumnName().String()}},
+			DoUpdates: clause.AssignmentColumns([]string{"group_interval", "repeat_interval", "updated_at"}),
+		}).Create(convert.ToNotifyGroupDo(group))
+	})
+}
+
+func (r *notifyGroupRepository) ListDueGroups(ctx context.Context, now time.Time, limit int) ([]*aggregator.Group, error) {
+	g := query.NotifyGroup
+	groups, err := g.WithContext(ctx).Where(g.NextFlushAt.Lte(now)).Order(g.NextFlushAt).Limit(limit).Find()
+	if err != nil || len(groups) == 0 {
+		return nil, err
+	}
+	keys := make([]string, 0, len(groups))
+	for _, m := range groups {
+		keys = append(keys, m.GroupKey)
+	}
+	a := query.NotifyGroupAlert
+	rows, err := a.WithContext(ctx).Where(a.GroupKey.In(keys...)).Order(a.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	alerts := make(map[string][]*aggregator.Alert, len(groups))
+	for _, row := range rows {
+		alert, err := convert.ToAggregatorAlert(row)
+		if err != nil {
+			return nil, err
+		}
+		alerts[row.GroupKey] = append(alerts[row.GroupKey], alert)
+	}
+	list := make([]*aggregator.Group, 0, len(groups))
+	for _, m := range groups {
+		list = append(list, convert.ToAggregatorGroup(m, alerts[m.GroupKey]))
+	}
+	return list, nil
+}
+
+func (r *notifyGroupRepository) CompleteFlush(ctx context.Context, group *aggregator.Group, sent []*notifier.Message) error {
+	return query.Q.Transaction(func(tx *query.Query) error {
+		if err := fence(ctx, tx); err != nil {
+			return err
+		}
+		a := tx.NotifyGroupAlert
+		for _, msg := range sent {
+			// the status guards against a change of the event added after the group was listed
+			_, err := a.WithContext(ctx).
+				Where(a.GroupKey.Eq(group.Key), a.EventUID.Eq(msg.EventUID.Int64()), a.Status.Eq(msg.Status)).
+				UpdateColumnSimple(a.Notified.Value(true))
+			if err != nil {
+				return err
+			}
+		}
+		_, err := a.WithContext(ctx).
+			Where(a.GroupKey.Eq(group.Key), a.Notified.Is(true), a.Status.Eq(notifier.StatusResolved)).
+			Delete()
+		if err != nil {
+			return err
+		}
+		// the group is only deleted by the statement that sees it has no alert left
+		db := a.WithContext(ctx).UnderlyingDB()
+		remaining := db.Session(&gorm.Session{NewDB: true}).Model(&do.NotifyGroupAlert{}).Select("1").Where("group_key = ?", group.Key)
+		deleted := db.Session(&gorm.Session{NewDB: true}).
+			Where("group_key = ? AND NOT EXISTS (?)", group.Key, remaining).
+			Delete(&do.NotifyGroup{})
+		if deleted.Error != nil || deleted.RowsAffected > 0 {
+			return deleted.Error
+		}
+		g := tx.NotifyGroup
+		columns := []field.AssignExpr{g.NextFlushAt.Value(group.NextFlushAt), g.UpdatedAt.Value(time.Now())}
+		if !group.LastSentAt.IsZero() {
+			columns = append(columns, g.LastSentAt.Value(group.LastSentAt))
+		}
+		_, err = g.WithContext(ctx).Where(g.GroupKey.Eq(group.Key)).UpdateColumnSimple(columns...)
+		return err
+	})
+}
+
+func (r *notifyGroupRepository) DeleteEventAlerts(ctx context.Context, eventUID snowflake.ID) error {
+	a := query.NotifyGroupAlert
+	_, err := a.WithContext(ctx).Where(a.EventUID.Eq(eventUID.Int64())).Delete()
+	return err
+}
@@ -0,0 +1,117 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/marksman/internal/biz/aggregator"
+	"github.com/aide-family/marksman/internal/biz/notifier"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+)
+
+type batchWebhook struct {
+	mu      sync.Mutex
+	batches []*aggregator.Batch
+}
+
+func (b *batchWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var batch aggregator.Batch
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b.mu.Lock()
+	b.batches = append(b.batches, &batch)
+	b.mu.Unlock()
+}
+
+func (b *batchWebhook) take() []*aggregator.Batch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	batches := b.batches
+	b.batches = nil
+	return batches
+}
+
+func TestNotifyGroupSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t, &do.NotifyGroup{}, &do.NotifyGroupAlert{})
+	hook := &batchWebhook{}
+	srv := httptest.NewServer(hook)
+	defer srv.Close()
+
+	now := time.Unix(1700000000, 0)
+	newAggregator := func() *aggregator.Aggregator {
+		return aggregator.NewAggregator(&notifyGroupRepository{db: db}, aggregator.NewWebhookSink(srv.URL, nil),
+			klog.NewHelper(klog.DefaultLogger),
+			aggregator.WithClock(func() time.Time { return now }),
+			aggregator.WithBackoff(notifier.Backoff{MaxAttempts: 1}),
+		)
+	}
+	route := &aggregator.Route{
+		NamespaceUID: 1,
+		ReceiverUID:  2,
+		Scope:        "3",
+		Config: aggregator.Config{
+			GroupBy:        []string{"cluster"},
+			GroupWait:      30 * time.Second,
+			GroupInterval:  5 * time.Minute,
+			RepeatInterval: time.Hour,
+		},
+	}
+	message := func(eventUID int64, status string) *notifier.Message {
+		return &notifier.Message{
+			Version:  notifier.MessageVersion,
+			Status:   status,
+			EventUID: snowflake.ParseInt64(eventUID),
+			Labels:   map[string]string{"cluster": "a"},
+			StartsAt: now,
+		}
+	}
+	flush := func(a *aggregator.Aggregator) []*aggregator.Batch {
+		t.Helper()
+		if _, err := a.Flush(ctx); err != nil {
+			t.Fatalf("flush: %v", err)
+		}
+		return hook.take()
+	}
+
+	if err := newAggregator().Add(ctx, route, message(1, notifier.StatusFiring), message(2, notifier.StatusFiring)); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	now = now.Add(30 * time.Second)
+	batches := flush(newAggregator())
+	if len(batches) != 1 || len(batches[0].Alerts) != 2 || batches[0].GroupLabels["cluster"] != "a" {
+		t.Fatalf("got batches %+v, want one batch with 2 alerts", batches)
+	}
+
+	// a restarted node neither repeats the batch nor forgets the group
+	now = now.Add(5 * time.Minute)
+	if batches := flush(newAggregator()); len(batches) != 0 {
+		t.Fatalf("got %d duplicate batches after restart", len(batches))
+	}
+	if err := newAggregator().Add(ctx, route, message(1, notifier.StatusResolved), message(2, notifier.StatusResolved)); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	now = now.Add(5 * time.Minute)
+	batches = flush(newAggregator())
+	if len(batches) != 1 || batches[0].Status != notifier.StatusResolved || len(batches[0].Alerts) != 2 {
+		t.Fatalf("got batches %+v, want the resolved batch", batches)
+	}
+
+	// the group is gone with its last alert
+	var groups, alerts int64
+	db.Model(&do.NotifyGroup{}).Count(&groups)
+	db.Model(&do.NotifyGroupAlert{}).Count(&alerts)
+	if groups != 0 || alerts != 0 {
+		t.Fatalf("%d groups and %d alerts left", groups, alerts)
+	}
+}
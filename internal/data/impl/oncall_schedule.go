@@ -0,0 +1,241 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewOnCallScheduleRepository(d *data.Data) (repository.OnCallSchedule, error) {
+	query.SetDefault(d.DB())
+	return &onCallScheduleRepository{db: d.DB()}, nil
+}
+
+type onCallScheduleRepository struct {
+	db *gorm.DB
+}
+
+func (r *onCallScheduleRepository) CreateOnCallSchedule(ctx context.Context, req *bo.CreateOnCallScheduleBo) error {
+	return query.OnCallSchedule.WithContext(ctx).Create(convert.ToOnCallScheduleDo(ctx, req))
+}
+
+func (r *onCallScheduleRepository) UpdateOnCallSchedule(ctx context.Context, req *bo.UpdateOnCallScheduleBo) error {
+	s := query.OnCallSchedule
+	wrappers := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(req.UID.Int64()),
+	)
+	total, err := wrappers.Count()
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return merr.ErrorNotFound("oncall schedule not found")
+	}
+	m := &do.OnCallSchedule{
+		Name:           req.Name,
+		Remark:         req.Remark,
+		TimeZone:       req.TimeZone,
+		RotationType:   req.RotationType,
+		HandoffTime:    req.HandoffTime,
+		HandoffWeekday: req.HandoffWeekday,
+		StartsAt:       req.StartsAt,
+		Members:        req.Members,
+	}
+	_, err = wrappers.Select(
+		s.Name, s.Remark, s.TimeZone, s.RotationType, s.HandoffTime, s.HandoffWeekday, s.StartsAt, s.Members,
+	).Updates(m)
+	return err
+}
+
+func (r *onCallScheduleRepository) UpdateOnCallScheduleStatus(ctx context.Context, req *bo.UpdateOnCallScheduleStatusBo) error {
+	s := query.OnCallSchedule
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(req.UID.Int64()),
+	).Update(s.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("oncall schedule not found")
+	}
+	return nil
+}
+
+func (r *onCallScheduleRepository) DeleteOnCallSchedule(ctx context.Context, uid snowflake.ID) error {
+	namespaceUID := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		s := tx.OnCallSchedule
+		info, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespaceUID), s.UID.Eq(uid.Int64())).Delete()
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return merr.ErrorNotFound("oncall schedule not found")
+		}
+		o := tx.OnCallOverride
+		_, err = o.WithContext(ctx).Where(o.NamespaceUID.Eq(namespaceUID), o.ScheduleUID.Eq(uid.Int64())).Delete()
+		return err
+	})
+}
+
+func (r *onCallScheduleRepository) GetOnCallSchedule(ctx context.Context, uid snowflake.ID, now time.Time) (*bo.OnCallScheduleItemBo, error) {
+	s := query.OnCallSchedule
+	m, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("oncall schedule not found")
+		}
+		return nil, err
+	}
+	overrides, err := r.listOverrides(ctx, m.NamespaceUID, now, m.UID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToOnCallScheduleItemBo(m, overrides[m.UID]), nil
+}
+
+func (r *onCallScheduleRepository) ListOnCallSchedule(ctx context.Context, req *bo.ListOnCallScheduleBo) (*bo.PageResponseBo[*bo.OnCallScheduleItemBo], error) {
+	s := query.OnCallSchedule
+	wrappers := s.WithContext(ctx)
+	wrappers = wrappers.Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		k := "%" + strings.TrimSpace(req.Keyword) + "%"
+		wrappers = wrappers.Where(s.Name.Like(k))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(s.Status.Eq(int32(req.Status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Order(s.UID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.OnCallScheduleItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToOnCallScheduleItemBo(m, nil))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func (r *onCallScheduleRepository) CreateOnCallOverride(ctx context.Context, req *bo.CreateOnCallOverrideBo) error {
+	return query.OnCallOverride.WithContext(ctx).Create(convert.ToOnCallOverrideDo(ctx, req))
+}
+
+func (r *onCallScheduleRepository) DeleteOnCallOverride(ctx context.Context, req *bo.DeleteOnCallOverrideBo) error {
+	o := query.OnCallOverride
+	info, err := o.WithContext(ctx).Where(
+		o.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		o.ScheduleUID.Eq(req.ScheduleUID.Int64()),
+		o.UID.Eq(req.UID.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("oncall override not found")
+	}
+	return nil
+}
+
+func (r *onCallScheduleRepository) ListOnCallOverride(ctx context.Context, req *bo.ListOnCallOverrideBo) ([]*bo.OnCallOverrideItemBo, error) {
+	o := query.OnCallOverride
+	wrappers := o.WithContext(ctx).Where(
+		o.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		o.ScheduleUID.Eq(req.ScheduleUID.Int64()),
+	)
+	if !req.EndsAfter.IsZero() {
+		wrappers = wrappers.Where(o.EndsAt.Gt(req.EndsAfter))
+	}
+	list, err := wrappers.Order(o.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.OnCallOverrideItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToOnCallOverrideItemBo(m))
+	}
+	return items, nil
+}
+
+func (r *onCallScheduleRepository) ListEnabledOnCallSchedules(ctx context.Context, namespaceUID snowflake.ID, uids []snowflake.ID, now time.Time) ([]*bo.OnCallScheduleItemBo, error) {
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	scheduleUIDs := make([]int64, 0, len(uids))
+	for _, uid := range uids {
+		scheduleUIDs = append(scheduleUIDs, uid.Int64())
+	}
+	s := query.OnCallSchedule
+	list, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(namespaceUID.Int64()),
+		s.UID.In(scheduleUIDs...),
+		s.Status.Eq(int32(enum.GlobalStatus_ENABLED)),
+	).Find()
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	found := make([]snowflake.ID, 0, len(list))
+	for _, m := range list {
+		found = append(found, m.UID)
+	}
+	overrides, err := r.listOverrides(ctx, namespaceUID, now, found...)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.OnCallScheduleItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToOnCallScheduleItemBo(m, overrides[m.UID]))
+	}
+	return items, nil
+}
+
+// listOverrides returns the overrides of the schedules not ended at now by schedule uid,
+// in the order they were created.
+func (r *onCallScheduleRepository) listOverrides(ctx context.Context, namespaceUID snowflake.ID, now time.Time, scheduleUIDs ...snowflake.ID) (map[snowflake.ID][]*do.OnCallOverride, error) {
+	overrides := make(map[snowflake.ID][]*do.OnCallOverride, len(scheduleUIDs))
+	if len(scheduleUIDs) == 0 {
+		return overrides, nil
+	}
+	uids := make([]int64, 0, len(scheduleUIDs))
+	for _, uid := range scheduleUIDs {
+		uids = append(uids, uid.Int64())
+	}
+	o := query.OnCallOverride
+	list, err := o.WithContext(ctx).Where(
+		o.NamespaceUID.Eq(namespaceUID.Int64()),
+		o.ScheduleUID.In(uids...),
+		o.EndsAt.Gt(now),
+	).Order(o.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range list {
+		overrides[m.ScheduleUID] = append(overrides[m.ScheduleUID], m)
+	}
+	return overrides, nil
+}
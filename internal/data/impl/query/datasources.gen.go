@@ -38,7 +38,9 @@ func newDatasource(db *gorm.DB, opts ...gen.DOOption) datasource {
 	_datasource.Type = field.NewInt32(tableName, "type")
 	_datasource.Driver = field.NewInt32(tableName, "driver")
 	_datasource.Metadata = field.NewField(tableName, "metadata")
+	_datasource.Config = field.NewField(tableName, "config")
 	_datasource.Status = field.NewInt32(tableName, "status")
+	_datasource.Managed = field.NewBool(tableName, "managed")
 
 	_datasource.fillFieldMap()
 
@@ -60,7 +62,9 @@ type datasource struct {
 	Type         field.Int32
 	Driver       field.Int32
 	Metadata     field.Field
+	Config       field.Field
 	Status       field.Int32
+	Managed      field.Bool
 
 	fieldMap map[string]field.Expr
 }
@@ -88,7 +92,9 @@ func (d *datasource) updateTableName(table string) *datasource {
 	d.Type = field.NewInt32(table, "type")
 	d.Driver = field.NewInt32(table, "driver")
 	d.Metadata = field.NewField(table, "metadata")
+	d.Config = field.NewField(table, "config")
 	d.Status = field.NewInt32(table, "status")
+	d.Managed = field.NewBool(table, "managed")
 
 	d.fillFieldMap()
 
@@ -105,7 +111,7 @@ func (d *datasource) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (d *datasource) fillFieldMap() {
-	d.fieldMap = make(map[string]field.Expr, 12)
+	d.fieldMap = make(map[string]field.Expr, 14)
 	d.fieldMap["id"] = d.ID
 	d.fieldMap["uid"] = d.UID
 	d.fieldMap["created_at"] = d.CreatedAt
@@ -117,7 +123,9 @@ func (d *datasource) fillFieldMap() {
 	d.fieldMap["type"] = d.Type
 	d.fieldMap["driver"] = d.Driver
 	d.fieldMap["metadata"] = d.Metadata
+	d.fieldMap["config"] = d.Config
 	d.fieldMap["status"] = d.Status
+	d.fieldMap["managed"] = d.Managed
 }
 
 func (d datasource) clone(db *gorm.DB) datasource {
@@ -16,39 +16,154 @@ import (
 )
 
 var (
-	Q          = new(Query)
-	Datasource *datasource
-	Level      *level
+	Q                        = new(Query)
+	AlertState               *alertState
+	Datasource               *datasource
+	EscalationPolicy         *escalationPolicy
+	EscalationPolicyBinding  *escalationPolicyBinding
+	EscalationTimer          *escalationTimer
+	Event                    *event
+	EventActivity            *eventActivity
+	EventTimeline            *eventTimeline
+	InhibitRule              *inhibitRule
+	JobNode                  *jobNode
+	Lease                    *lease
+	Level                    *level
+	NotifyGroup              *notifyGroup
+	NotifyGroupAlert         *notifyGroupAlert
+	OnCallOverride           *onCallOverride
+	OnCallSchedule           *onCallSchedule
+	Receiver                 *receiver
+	ReceiverDelivery         *receiverDelivery
+	Silence                  *silence
+	Strategy                 *strategy
+	StrategyGroup            *strategyGroup
+	StrategyGroupAggregation *strategyGroupAggregation
+	StrategyMetric           *strategyMetric
+	StrategyMetricLevel      *strategyMetricLevel
+	StrategyReceiver         *strategyReceiver
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	AlertState = &Q.AlertState
 	Datasource = &Q.Datasource
+	EscalationPolicy = &Q.EscalationPolicy
+	EscalationPolicyBinding = &Q.EscalationPolicyBinding
+	EscalationTimer = &Q.EscalationTimer
+	Event = &Q.Event
+	EventActivity = &Q.EventActivity
+	EventTimeline = &Q.EventTimeline
+	InhibitRule = &Q.InhibitRule
+	JobNode = &Q.JobNode
+	Lease = &Q.Lease
 	Level = &Q.Level
+	NotifyGroup = &Q.NotifyGroup
+	NotifyGroupAlert = &Q.NotifyGroupAlert
+	OnCallOverride = &Q.OnCallOverride
+	OnCallSchedule = &Q.OnCallSchedule
+	Receiver = &Q.Receiver
+	ReceiverDelivery = &Q.ReceiverDelivery
+	Silence = &Q.Silence
+	Strategy = &Q.Strategy
+	StrategyGroup = &Q.StrategyGroup
+	StrategyGroupAggregation = &Q.StrategyGroupAggregation
+	StrategyMetric = &Q.StrategyMetric
+	StrategyMetricLevel = &Q.StrategyMetricLevel
+	StrategyReceiver = &Q.StrategyReceiver
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:         db,
-		Datasource: newDatasource(db, opts...),
-		Level:      newLevel(db, opts...),
+		db:                       db,
+		AlertState:               newAlertState(db, opts...),
+		Datasource:               newDatasource(db, opts...),
+		EscalationPolicy:         newEscalationPolicy(db, opts...),
+		EscalationPolicyBinding:  newEscalationPolicyBinding(db, opts...),
+		EscalationTimer:          newEscalationTimer(db, opts...),
+		Event:                    newEvent(db, opts...),
+		EventActivity:            newEventActivity(db, opts...),
+		EventTimeline:            newEventTimeline(db, opts...),
+		InhibitRule:              newInhibitRule(db, opts...),
+		JobNode:                  newJobNode(db, opts...),
+		Lease:                    newLease(db, opts...),
+		Level:                    newLevel(db, opts...),
+		NotifyGroup:              newNotifyGroup(db, opts...),
+		NotifyGroupAlert:         newNotifyGroupAlert(db, opts...),
+		OnCallOverride:           newOnCallOverride(db, opts...),
+		OnCallSchedule:           newOnCallSchedule(db, opts...),
+		Receiver:                 newReceiver(db, opts...),
+		ReceiverDelivery:         newReceiverDelivery(db, opts...),
+		Silence:                  newSilence(db, opts...),
+		Strategy:                 newStrategy(db, opts...),
+		StrategyGroup:            newStrategyGroup(db, opts...),
+		StrategyGroupAggregation: newStrategyGroupAggregation(db, opts...),
+		StrategyMetric:           newStrategyMetric(db, opts...),
+		StrategyMetricLevel:      newStrategyMetricLevel(db, opts...),
+		StrategyReceiver:         newStrategyReceiver(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Datasource datasource
-	Level      level
+	AlertState               alertState
+	Datasource               datasource
+	EscalationPolicy         escalationPolicy
+	EscalationPolicyBinding  escalationPolicyBinding
+	EscalationTimer          escalationTimer
+	Event                    event
+	EventActivity            eventActivity
+	EventTimeline            eventTimeline
+	InhibitRule              inhibitRule
+	JobNode                  jobNode
+	Lease                    lease
+	Level                    level
+	NotifyGroup              notifyGroup
+	NotifyGroupAlert         notifyGroupAlert
+	OnCallOverride           onCallOverride
+	OnCallSchedule           onCallSchedule
+	Receiver                 receiver
+	ReceiverDelivery         receiverDelivery
+	Silence                  silence
+	Strategy                 strategy
+	StrategyGroup            strategyGroup
+	StrategyGroupAggregation strategyGroupAggregation
+	StrategyMetric           strategyMetric
+	StrategyMetricLevel      strategyMetricLevel
+	StrategyReceiver         strategyReceiver
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:         db,
-		Datasource: q.Datasource.clone(db),
-		Level:      q.Level.clone(db),
+		db:                       db,
+		AlertState:               q.AlertState.clone(db),
+		Datasource:               q.Datasource.clone(db),
+		EscalationPolicy:         q.EscalationPolicy.clone(db),
+		EscalationPolicyBinding:  q.EscalationPolicyBinding.clone(db),
+		EscalationTimer:          q.EscalationTimer.clone(db),
+		Event:                    q.Event.clone(db),
+		EventActivity:            q.EventActivity.clone(db),
+		EventTimeline:            q.EventTimeline.clone(db),
+		InhibitRule:              q.InhibitRule.clone(db),
+		JobNode:                  q.JobNode.clone(db),
+		Lease:                    q.Lease.clone(db),
+		Level:                    q.Level.clone(db),
+		NotifyGroup:              q.NotifyGroup.clone(db),
+		NotifyGroupAlert:         q.NotifyGroupAlert.clone(db),
+		OnCallOverride:           q.OnCallOverride.clone(db),
+		OnCallSchedule:           q.OnCallSchedule.clone(db),
+		Receiver:                 q.Receiver.clone(db),
+		ReceiverDelivery:         q.ReceiverDelivery.clone(db),
+		Silence:                  q.Silence.clone(db),
+		Strategy:                 q.Strategy.clone(db),
+		StrategyGroup:            q.StrategyGroup.clone(db),
+		StrategyGroupAggregation: q.StrategyGroupAggregation.clone(db),
+		StrategyMetric:           q.StrategyMetric.clone(db),
+		StrategyMetricLevel:      q.StrategyMetricLevel.clone(db),
+		StrategyReceiver:         q.StrategyReceiver.clone(db),
 	}
 }
 
@@ -62,21 +177,90 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:         db,
-		Datasource: q.Datasource.replaceDB(db),
-		Level:      q.Level.replaceDB(db),
+		db:                       db,
+		AlertState:               q.AlertState.replaceDB(db),
+		Datasource:               q.Datasource.replaceDB(db),
+		EscalationPolicy:         q.EscalationPolicy.replaceDB(db),
+		EscalationPolicyBinding:  q.EscalationPolicyBinding.replaceDB(db),
+		EscalationTimer:          q.EscalationTimer.replaceDB(db),
+		Event:                    q.Event.replaceDB(db),
+		EventActivity:            q.EventActivity.replaceDB(db),
+		EventTimeline:            q.EventTimeline.replaceDB(db),
+		InhibitRule:              q.InhibitRule.replaceDB(db),
+		JobNode:                  q.JobNode.replaceDB(db),
+		Lease:                    q.Lease.replaceDB(db),
+		Level:                    q.Level.replaceDB(db),
+		NotifyGroup:              q.NotifyGroup.replaceDB(db),
+		NotifyGroupAlert:         q.NotifyGroupAlert.replaceDB(db),
+		OnCallOverride:           q.OnCallOverride.replaceDB(db),
+		OnCallSchedule:           q.OnCallSchedule.replaceDB(db),
+		Receiver:                 q.Receiver.replaceDB(db),
+		ReceiverDelivery:         q.ReceiverDelivery.replaceDB(db),
+		Silence:                  q.Silence.replaceDB(db),
+		Strategy:                 q.Strategy.replaceDB(db),
+		StrategyGroup:            q.StrategyGroup.replaceDB(db),
+		StrategyGroupAggregation: q.StrategyGroupAggregation.replaceDB(db),
+		StrategyMetric:           q.StrategyMetric.replaceDB(db),
+		StrategyMetricLevel:      q.StrategyMetricLevel.replaceDB(db),
+		StrategyReceiver:         q.StrategyReceiver.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Datasource IDatasourceDo
-	Level      ILevelDo
+	AlertState               IAlertStateDo
+	Datasource               IDatasourceDo
+	EscalationPolicy         IEscalationPolicyDo
+	EscalationPolicyBinding  IEscalationPolicyBindingDo
+	EscalationTimer          IEscalationTimerDo
+	Event                    IEventDo
+	EventActivity            IEventActivityDo
+	EventTimeline            IEventTimelineDo
+	InhibitRule              IInhibitRuleDo
+	JobNode                  IJobNodeDo
+	Lease                    ILeaseDo
+	Level                    ILevelDo
+	NotifyGroup              INotifyGroupDo
+	NotifyGroupAlert         INotifyGroupAlertDo
+	OnCallOverride           IOnCallOverrideDo
+	OnCallSchedule           IOnCallScheduleDo
+	Receiver                 IReceiverDo
+	ReceiverDelivery         IReceiverDeliveryDo
+	Silence                  ISilenceDo
+	Strategy                 IStrategyDo
+	StrategyGroup            IStrategyGroupDo
+	StrategyGroupAggregation IStrategyGroupAggregationDo
+	StrategyMetric           IStrategyMetricDo
+	StrategyMetricLevel      IStrategyMetricLevelDo
+	StrategyReceiver         IStrategyReceiverDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Datasource: q.Datasource.WithContext(ctx),
-		Level:      q.Level.WithContext(ctx),
+		AlertState:               q.AlertState.WithContext(ctx),
+		Datasource:               q.Datasource.WithContext(ctx),
+		EscalationPolicy:         q.EscalationPolicy.WithContext(ctx),
+		EscalationPolicyBinding:  q.EscalationPolicyBinding.WithContext(ctx),
+		EscalationTimer:          q.EscalationTimer.WithContext(ctx),
+		Event:                    q.Event.WithContext(ctx),
+		EventActivity:            q.EventActivity.WithContext(ctx),
+		EventTimeline:            q.EventTimeline.WithContext(ctx),
+		InhibitRule:              q.InhibitRule.WithContext(ctx),
+		JobNode:                  q.JobNode.WithContext(ctx),
+		Lease:                    q.Lease.WithContext(ctx),
+		Level:                    q.Level.WithContext(ctx),
+		NotifyGroup:              q.NotifyGroup.WithContext(ctx),
+		NotifyGroupAlert:         q.NotifyGroupAlert.WithContext(ctx),
+		OnCallOverride:           q.OnCallOverride.WithContext(ctx),
+		OnCallSchedule:           q.OnCallSchedule.WithContext(ctx),
+		Receiver:                 q.Receiver.WithContext(ctx),
+		ReceiverDelivery:         q.ReceiverDelivery.WithContext(ctx),
+		Silence:                  q.Silence.WithContext(ctx),
+		Strategy:                 q.Strategy.WithContext(ctx),
+		StrategyGroup:            q.StrategyGroup.WithContext(ctx),
+		StrategyGroupAggregation: q.StrategyGroupAggregation.WithContext(ctx),
+		StrategyMetric:           q.StrategyMetric.WithContext(ctx),
+		StrategyMetricLevel:      q.StrategyMetricLevel.WithContext(ctx),
+		StrategyReceiver:         q.StrategyReceiver.WithContext(ctx),
 	}
 }
 
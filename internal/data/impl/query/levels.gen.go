@@ -38,6 +38,7 @@ func newLevel(db *gorm.DB, opts ...gen.DOOption) level {
 	_level.Remark = field.NewString(tableName, "remark")
 	_level.Metadata = field.NewField(tableName, "metadata")
 	_level.Status = field.NewInt32(tableName, "status")
+	_level.Managed = field.NewBool(tableName, "managed")
 
 	_level.fillFieldMap()
 
@@ -59,6 +60,7 @@ type level struct {
 	Remark       field.String
 	Metadata     field.Field
 	Status       field.Int32
+	Managed      field.Bool
 
 	fieldMap map[string]field.Expr
 }
@@ -86,6 +88,7 @@ func (l *level) updateTableName(table string) *level {
 	l.Remark = field.NewString(table, "remark")
 	l.Metadata = field.NewField(table, "metadata")
 	l.Status = field.NewInt32(table, "status")
+	l.Managed = field.NewBool(table, "managed")
 
 	l.fillFieldMap()
 
@@ -102,7 +105,7 @@ func (l *level) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (l *level) fillFieldMap() {
-	l.fieldMap = make(map[string]field.Expr, 11)
+	l.fieldMap = make(map[string]field.Expr, 12)
 	l.fieldMap["id"] = l.ID
 	l.fieldMap["uid"] = l.UID
 	l.fieldMap["created_at"] = l.CreatedAt
@@ -114,6 +117,7 @@ func (l *level) fillFieldMap() {
 	l.fieldMap["remark"] = l.Remark
 	l.fieldMap["metadata"] = l.Metadata
 	l.fieldMap["status"] = l.Status
+	l.fieldMap["managed"] = l.Managed
 }
 
 func (l level) clone(db *gorm.DB) level {
@@ -0,0 +1,243 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewReceiverRepository(d *data.Data) (repository.Receiver, error) {
+	query.SetDefault(d.DB())
+	return &receiverRepository{db: d.DB()}, nil
+}
+
+type receiverRepository struct {
+	db *gorm.DB
+}
+
+func (r *receiverRepository) CreateReceiver(ctx context.Context, req *bo.CreateReceiverBo) error {
+	m := convert.ToReceiverDo(ctx, req)
+	return query.Receiver.WithContext(ctx).Create(m)
+}
+
+func (r *receiverRepository) UpdateReceiver(ctx context.Context, req *bo.UpdateReceiverBo) error {
+	rc := query.Receiver
+	wrappers := rc.WithContext(ctx).Where(
+		rc.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		rc.UID.Eq(req.UID.Int64()),
+	)
+	total, err := wrappers.Count()
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return merr.ErrorNotFound("receiver not found")
+	}
+	m := &do.Receiver{
+		Name:    req.Name,
+		Remark:  req.Remark,
+		Type:    req.Type,
+		Webhook: convert.ToWebhookConfigDo(req.Webhook),
+	}
+	_, err = wrappers.Select(rc.Name, rc.Remark, rc.Type, rc.Webhook).Updates(m)
+	return err
+}
+
+func (r *receiverRepository) UpdateReceiverStatus(ctx context.Context, req *bo.UpdateReceiverStatusBo) error {
+	rc := query.Receiver
+	info, err := rc.WithContext(ctx).Where(
+		rc.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		rc.UID.Eq(req.UID.Int64()),
+	).Update(rc.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("receiver not found")
+	}
+	return nil
+}
+
+func (r *receiverRepository) DeleteReceiver(ctx context.Context, uid snowflake.ID) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		rc := tx.Receiver
+		info, err := rc.WithContext(ctx).Where(rc.NamespaceUID.Eq(namespace), rc.UID.Eq(uid.Int64())).Delete()
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return merr.ErrorNotFound("receiver not found")
+		}
+		sr := tx.StrategyReceiver
+		_, err = sr.WithContext(ctx).Where(sr.NamespaceUID.Eq(namespace), sr.ReceiverUID.Eq(uid.Int64())).Delete()
+		return err
+	})
+}
+
+func (r *receiverRepository) GetReceiver(ctx context.Context, uid snowflake.ID) (*bo.ReceiverItemBo, error) {
+	rc := query.Receiver
+	m, err := rc.WithContext(ctx).Where(
+		rc.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		rc.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("receiver not found")
+		}
+		return nil, err
+	}
+	return convert.ToReceiverItemBo(m), nil
+}
+
+func (r *receiverRepository) GetEnabledReceiver(ctx context.Context, namespaceUID, uid snowflake.ID) (*bo.ReceiverItemBo, error) {
+	rc := query.Receiver
+	m, err := rc.WithContext(ctx).Where(
+		rc.NamespaceUID.Eq(namespaceUID.Int64()),
+		rc.UID.Eq(uid.Int64()),
+		rc.Status.Eq(int32(enum.GlobalStatus_ENABLED)),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("receiver not found")
+		}
+		return nil, err
+	}
+	return convert.ToReceiverItemBo(m), nil
+}
+
+func (r *receiverRepository) ListReceiver(ctx context.Context, req *bo.ListReceiverBo) (*bo.PageResponseBo[*bo.ReceiverItemBo], error) {
+	rc := query.Receiver
+	wrappers := rc.WithContext(ctx)
+	wrappers = wrappers.Where(rc.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		k := "%" + strings.TrimSpace(req.Keyword) + "%"
+		wrappers = wrappers.Where(rc.Name.Like(k))
+	}
+	if req.Type != apiv1.ReceiverType_ReceiverType_UNKNOWN {
+		wrappers = wrappers.Where(rc.Type.Eq(int32(req.Type)))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(rc.Status.Eq(int32(req.Status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Order(rc.UID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.ReceiverItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToReceiverItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func (r *receiverRepository) ResolveReceiverUIDs(ctx context.Context, namespaceUID, strategyUID, levelUID snowflake.ID) ([]snowflake.ID, error) {
+	s := query.Strategy
+	strategy, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespaceUID.Int64()), s.UID.Eq(strategyUID.Int64())).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	sr := query.StrategyReceiver
+	scopes := [][]gen.Condition{
+		{sr.StrategyUID.Eq(strategyUID.Int64()), sr.LevelUID.Eq(levelUID.Int64())},
+		{sr.StrategyUID.Eq(strategyUID.Int64()), sr.LevelUID.Eq(0)},
+		{sr.StrategyGroupUID.Eq(strategy.StrategyGroupUID.Int64()), sr.StrategyUID.Eq(0)},
+	}
+	var receiverUIDs []int64
+	for _, scope := range scopes {
+		err := sr.WithContext(ctx).Where(sr.NamespaceUID.Eq(namespaceUID.Int64())).Where(scope...).Pluck(sr.ReceiverUID, &receiverUIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(receiverUIDs) > 0 {
+			break
+		}
+	}
+	uids := make([]snowflake.ID, 0, len(receiverUIDs))
+	for _, uid := range receiverUIDs {
+		uids = append(uids, snowflake.ParseInt64(uid))
+	}
+	return uids, nil
+}
+
+func (r *receiverRepository) ListEnabledReceivers(ctx context.Context, namespaceUID snowflake.ID, uids []snowflake.ID) ([]*bo.ReceiverItemBo, error) {
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	receiverUIDs := make([]int64, 0, len(uids))
+	for _, uid := range uids {
+		receiverUIDs = append(receiverUIDs, uid.Int64())
+	}
+	rc := query.Receiver
+	list, err := rc.WithContext(ctx).Where(
+		rc.NamespaceUID.Eq(namespaceUID.Int64()),
+		rc.UID.In(receiverUIDs...),
+		rc.Status.Eq(int32(enum.GlobalStatus_ENABLED)),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.ReceiverItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToReceiverItemBo(m))
+	}
+	return items, nil
+}
+
+func (r *receiverRepository) CreateReceiverDelivery(ctx context.Context, req *bo.CreateReceiverDeliveryBo) error {
+	return query.ReceiverDelivery.WithContext(ctx).Create(convert.ToReceiverDeliveryDo(req))
+}
+
+func (r *receiverRepository) ListReceiverDelivery(ctx context.Context, req *bo.ListReceiverDeliveryBo) (*bo.PageResponseBo[*bo.ReceiverDeliveryItemBo], error) {
+	rd := query.ReceiverDelivery
+	wrappers := rd.WithContext(ctx)
+	wrappers = wrappers.Where(rd.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.ReceiverUID > 0 {
+		wrappers = wrappers.Where(rd.ReceiverUID.Eq(req.ReceiverUID.Int64()))
+	}
+	if req.EventUID > 0 {
+		wrappers = wrappers.Where(rd.EventUID.Eq(req.EventUID.Int64()))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Order(rd.ID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.ReceiverDeliveryItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToReceiverDeliveryItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
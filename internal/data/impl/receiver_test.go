@@ -0,0 +1,33 @@
+package impl
+
+import (
+	"testing"
+
+	"github.com/aide-family/magicbox/merr"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func TestUpdateReceiverNotFound(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	repo := &receiverRepository{db: db}
+	ctx, other := namespaceContext(1), namespaceContext(2)
+	uid := createReceiver(t, ctx, db, "ops")
+
+	req := &bo.UpdateReceiverBo{UID: uid, Name: "oncall", Type: apiv1.ReceiverType_WEBHOOK, Webhook: &bo.WebhookConfigBo{URL: "http://127.0.0.1/hook"}}
+	if err := repo.UpdateReceiver(other, req); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found when updating from another namespace", err)
+	}
+	if err := repo.UpdateReceiver(ctx, &bo.UpdateReceiverBo{UID: 404, Name: "oncall", Type: apiv1.ReceiverType_WEBHOOK}); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found for an unknown receiver", err)
+	}
+	if err := repo.UpdateReceiver(ctx, req); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	got, err := repo.GetReceiver(ctx, uid)
+	if err != nil || got.Name != "oncall" {
+		t.Fatalf("got %+v, %v, want the receiver renamed", got, err)
+	}
+}
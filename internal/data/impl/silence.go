@@ -0,0 +1,135 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewSilenceRepository(d *data.Data) (repository.Silence, error) {
+	query.SetDefault(d.DB())
+	return &silenceRepository{db: d.DB()}, nil
+}
+
+type silenceRepository struct {
+	db *gorm.DB
+}
+
+func (r *silenceRepository) CreateSilence(ctx context.Context, req *bo.CreateSilenceBo) error {
+	m := convert.ToSilenceDo(ctx, req)
+	return query.Silence.WithContext(ctx).Create(m)
+}
+
+func (r *silenceRepository) UpdateSilence(ctx context.Context, req *bo.UpdateSilenceBo) error {
+	s := query.Silence
+	m := &do.Silence{
+		Matchers: convert.ToLabelMatchersDo(req.Matchers),
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Comment:  req.Comment,
+	}
+	// updated_at is always written, so that an unchanged silence still counts as found
+	m.UpdatedAt = time.Now()
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(req.UID.Int64()),
+	).Select(s.Matchers, s.StartsAt, s.EndsAt, s.Comment, s.UpdatedAt).Updates(m)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("silence not found")
+	}
+	return nil
+}
+
+func (r *silenceRepository) DeleteSilence(ctx context.Context, uid snowflake.ID) error {
+	s := query.Silence
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(uid.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("silence not found")
+	}
+	return nil
+}
+
+func (r *silenceRepository) GetSilence(ctx context.Context, uid snowflake.ID) (*bo.SilenceItemBo, error) {
+	s := query.Silence
+	m, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("silence not found")
+		}
+		return nil, err
+	}
+	return convert.ToSilenceItemBo(m), nil
+}
+
+func (r *silenceRepository) ListSilence(ctx context.Context, req *bo.ListSilenceBo) (*bo.PageResponseBo[*bo.SilenceItemBo], error) {
+	s := query.Silence
+	wrappers := s.WithContext(ctx)
+	wrappers = wrappers.Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		k := "%" + strings.TrimSpace(req.Keyword) + "%"
+		wrappers = wrappers.Where(s.Comment.Like(k))
+	}
+	switch req.State {
+	case apiv1.SilenceState_SILENCE_PENDING:
+		wrappers = wrappers.Where(s.StartsAt.Gt(req.Now))
+	case apiv1.SilenceState_SILENCE_ACTIVE:
+		wrappers = wrappers.Where(s.StartsAt.Lte(req.Now), s.EndsAt.Gt(req.Now))
+	case apiv1.SilenceState_SILENCE_EXPIRED:
+		wrappers = wrappers.Where(s.EndsAt.Lte(req.Now))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Order(s.UID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.SilenceItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToSilenceItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func (r *silenceRepository) ListUnexpiredSilences(ctx context.Context, namespaceUID snowflake.ID, t time.Time) ([]*bo.SilenceItemBo, error) {
+	s := query.Silence
+	list, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespaceUID.Int64()), s.EndsAt.Gt(t)).Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.SilenceItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToSilenceItemBo(m))
+	}
+	return items, nil
+}
@@ -0,0 +1,286 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/aide-family/magicbox/safety"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewStrategyRepository(d *data.Data) (repository.Strategy, error) {
+	query.SetDefault(d.DB())
+	return &strategyRepository{db: d.DB()}, nil
+}
+
+type strategyRepository struct {
+	db *gorm.DB
+}
+
+func (r *strategyRepository) CreateStrategy(ctx context.Context, req *bo.CreateStrategyBo) error {
+	m := convert.ToStrategyDo(ctx, req)
+	return query.Strategy.WithContext(ctx).Create(m)
+}
+
+func (r *strategyRepository) UpdateStrategy(ctx context.Context, req *bo.UpdateStrategyBo) error {
+	s := query.Strategy
+	columns := []field.AssignExpr{
+		s.StrategyGroupUID.Value(req.StrategyGroupUID.Int64()),
+		s.Name.Value(req.Name),
+		s.Remark.Value(req.Remark),
+		s.Type.Value(int32(req.Type)),
+		s.Driver.Value(int32(req.Driver)),
+		s.Metadata.Value(safety.NewMap(req.Metadata)),
+	}
+	_, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()), s.UID.Eq(req.UID.Int64())).UpdateColumnSimple(columns...)
+	return err
+}
+
+func (r *strategyRepository) UpdateStrategyStatus(ctx context.Context, req *bo.UpdateStrategyStatusBo) error {
+	s := query.Strategy
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(req.UID.Int64()),
+	).Update(s.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("strategy not found")
+	}
+	return nil
+}
+
+func (r *strategyRepository) DeleteStrategy(ctx context.Context, uid snowflake.ID) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		return deleteStrategy(ctx, tx, namespace, uid)
+	})
+}
+
+// deleteStrategy deletes a strategy with its receivers, metric and levels.
+func deleteStrategy(ctx context.Context, tx *query.Query, namespace int64, uid snowflake.ID) error {
+	s := tx.Strategy
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(namespace),
+		s.UID.Eq(uid.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("strategy not found")
+	}
+	sr := tx.StrategyReceiver
+	_, err = sr.WithContext(ctx).Where(
+		sr.NamespaceUID.Eq(namespace),
+		sr.StrategyUID.Eq(uid.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	return deleteStrategyMetric(ctx, tx, namespace, uid)
+}
+
+func deleteStrategyMetric(ctx context.Context, tx *query.Query, namespace int64, strategyUID snowflake.ID) error {
+	sm := tx.StrategyMetric
+	_, err := sm.WithContext(ctx).Where(
+		sm.NamespaceUID.Eq(namespace),
+		sm.StrategyUID.Eq(strategyUID.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	sml := tx.StrategyMetricLevel
+	_, err = sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(namespace),
+		sml.StrategyUID.Eq(strategyUID.Int64()),
+	).Delete()
+	return err
+}
+
+func (r *strategyRepository) GetStrategy(ctx context.Context, uid snowflake.ID) (*bo.StrategyItemBo, error) {
+	s := query.Strategy
+	m, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("strategy not found")
+		}
+		return nil, err
+	}
+	return convert.ToStrategyItemBo(m), nil
+}
+
+func (r *strategyRepository) GetStrategyByName(ctx context.Context, name string) (*bo.StrategyItemBo, error) {
+	s := query.Strategy
+	m, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.Name.Eq(name),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("strategy not found")
+		}
+		return nil, err
+	}
+	return convert.ToStrategyItemBo(m), nil
+}
+
+func (r *strategyRepository) ListStrategy(ctx context.Context, req *bo.ListStrategyBo) (*bo.PageResponseBo[*bo.StrategyItemBo], error) {
+	s := query.Strategy
+	wrappers := s.WithContext(ctx)
+	wrappers = wrappers.Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		wrappers = wrappers.Where(s.Name.Like("%" + req.Keyword + "%"))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(s.Status.Eq(int32(req.Status)))
+	}
+	if req.StrategyGroupUID > 0 {
+		wrappers = wrappers.Where(s.StrategyGroupUID.Eq(req.StrategyGroupUID.Int64()))
+	}
+	if req.Type != enum.DatasourceType_DatasourceType_UNKNOWN {
+		wrappers = wrappers.Where(s.Type.Eq(int32(req.Type)))
+	}
+	if req.Driver != enum.DatasourceDriver_DatasourceDriver_UNKNOWN {
+		wrappers = wrappers.Where(s.Driver.Eq(int32(req.Driver)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.StrategyItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToStrategyItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func (r *strategyRepository) CountStrategyByGroup(ctx context.Context, strategyGroupUID snowflake.ID) (int64, error) {
+	s := query.Strategy
+	return s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.StrategyGroupUID.Eq(strategyGroupUID.Int64()),
+	).Count()
+}
+
+// ImportStrategies creates the missing groups and strategies of the plan and
+// overwrites the metric and levels of the existing ones, all or nothing.
+func (r *strategyRepository) ImportStrategies(ctx context.Context, groups []*bo.ImportStrategyGroupBo) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		for _, group := range groups {
+			groupUID := group.UID
+			if groupUID == 0 {
+				m := convert.ToStrategyGroupDo(ctx, &bo.CreateStrategyGroupBo{Name: group.Name})
+				if err := tx.StrategyGroup.WithContext(ctx).Create(m); err != nil {
+					return err
+				}
+				groupUID = m.UID
+			}
+			for _, strategy := range group.Strategies {
+				if err := importStrategy(ctx, tx, namespace, groupUID, strategy); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func importStrategy(ctx context.Context, tx *query.Query, namespace int64, groupUID snowflake.ID, req *bo.ImportStrategyBo) error {
+	s := tx.Strategy
+	strategyUID := req.UID
+	if strategyUID == 0 {
+		m := convert.ToStrategyDo(ctx, &bo.CreateStrategyBo{
+			StrategyGroupUID: groupUID,
+			Name:             req.Name,
+			Type:             req.Type,
+			Driver:           req.Driver,
+			Metadata:         req.Metadata,
+		})
+		if err := s.WithContext(ctx).Create(m); err != nil {
+			return err
+		}
+		strategyUID = m.UID
+	} else {
+		columns := []field.AssignExpr{
+			s.StrategyGroupUID.Value(groupUID.Int64()),
+			s.Type.Value(int32(req.Type)),
+			s.Driver.Value(int32(req.Driver)),
+			s.Metadata.Value(safety.NewMap(req.Metadata)),
+		}
+		_, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespace), s.UID.Eq(strategyUID.Int64())).UpdateColumnSimple(columns...)
+		if err != nil {
+			return err
+		}
+	}
+
+	metric := *req.Metric
+	metric.StrategyUID = strategyUID
+	levels := make([]*do.StrategyMetricLevel, 0, len(req.Levels))
+	for _, level := range req.Levels {
+		l := *level
+		l.StrategyUID = strategyUID
+		levels = append(levels, convert.ToStrategyMetricLevelDo(ctx, &l))
+	}
+	return replaceStrategyMetric(ctx, tx, namespace, convert.ToStrategyMetricDo(ctx, &metric), levels, req.UID != 0)
+}
+
+// replaceStrategyMetric saves the metric and levels of a strategy, when the strategy
+// existed the levels missing from levels are deleted together with their receivers.
+func replaceStrategyMetric(ctx context.Context, tx *query.Query, namespace int64, metric *do.StrategyMetric, levels []*do.StrategyMetricLevel, existed bool) error {
+	if err := saveStrategyMetric(ctx, tx, namespace, metric); err != nil {
+		return err
+	}
+	levelUIDs := make([]int64, 0, len(levels))
+	for _, level := range levels {
+		if err := saveStrategyMetricLevel(ctx, tx, namespace, level); err != nil {
+			return err
+		}
+		levelUIDs = append(levelUIDs, level.LevelUID.Int64())
+	}
+	if !existed {
+		return nil
+	}
+	sml := tx.StrategyMetricLevel
+	_, err := sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(namespace),
+		sml.StrategyUID.Eq(metric.StrategyUID.Int64()),
+		sml.LevelUID.NotIn(levelUIDs...),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	// receivers bound to the whole strategy have no level and are kept
+	sr := tx.StrategyReceiver
+	_, err = sr.WithContext(ctx).Where(
+		sr.NamespaceUID.Eq(namespace),
+		sr.StrategyUID.Eq(metric.StrategyUID.Int64()),
+		sr.LevelUID.Neq(0),
+		sr.LevelUID.NotIn(levelUIDs...),
+	).Delete()
+	return err
+}
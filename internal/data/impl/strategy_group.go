@@ -0,0 +1,210 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/aide-family/magicbox/safety"
+	"github.com/bwmarrin/snowflake"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+)
+
+func NewStrategyGroupRepository(d *data.Data) (repository.StrategyGroup, error) {
+	query.SetDefault(d.DB())
+	return &strategyGroupRepository{db: d.DB()}, nil
+}
+
+type strategyGroupRepository struct {
+	db *gorm.DB
+}
+
+func (r *strategyGroupRepository) CreateStrategyGroup(ctx context.Context, req *bo.CreateStrategyGroupBo) error {
+	m := convert.ToStrategyGroupDo(ctx, req)
+	return query.StrategyGroup.WithContext(ctx).Create(m)
+}
+
+func (r *strategyGroupRepository) UpdateStrategyGroup(ctx context.Context, req *bo.UpdateStrategyGroupBo) error {
+	s := query.StrategyGroup
+	columns := []field.AssignExpr{
+		s.Name.Value(req.Name),
+		s.Remark.Value(req.Remark),
+		s.Metadata.Value(safety.NewMap(req.Metadata)),
+	}
+	wrappers := s.WithContext(ctx).Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()), s.UID.Eq(req.UID.Int64()))
+	total, err := wrappers.Count()
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return merr.ErrorNotFound("strategy group not found")
+	}
+	_, err = wrappers.UpdateColumnSimple(columns...)
+	return err
+}
+
+func (r *strategyGroupRepository) UpdateStrategyGroupStatus(ctx context.Context, req *bo.UpdateStrategyGroupStatusBo) error {
+	s := query.StrategyGroup
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(req.UID.Int64()),
+	).Update(s.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("strategy group not found")
+	}
+	return nil
+}
+
+func (r *strategyGroupRepository) DeleteStrategyGroup(ctx context.Context, uid snowflake.ID) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		return deleteStrategyGroup(ctx, tx, namespace, uid)
+	})
+}
+
+// deleteStrategyGroup deletes a group and the receivers bound to the whole group.
+func deleteStrategyGroup(ctx context.Context, tx *query.Query, namespace int64, uid snowflake.ID) error {
+	s := tx.StrategyGroup
+	info, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(namespace),
+		s.UID.Eq(uid.Int64()),
+	).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("strategy group not found")
+	}
+	sr := tx.StrategyReceiver
+	_, err = sr.WithContext(ctx).Where(
+		sr.NamespaceUID.Eq(namespace),
+		sr.StrategyGroupUID.Eq(uid.Int64()),
+		sr.StrategyUID.Eq(0),
+	).Delete()
+	return err
+}
+
+func (r *strategyGroupRepository) GetStrategyGroup(ctx context.Context, uid snowflake.ID) (*bo.StrategyGroupItemBo, error) {
+	s := query.StrategyGroup
+	m, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("strategy group not found")
+		}
+		return nil, err
+	}
+	return convert.ToStrategyGroupItemBo(m), nil
+}
+
+func (r *strategyGroupRepository) GetStrategyGroupByName(ctx context.Context, name string) (*bo.StrategyGroupItemBo, error) {
+	s := query.StrategyGroup
+	m, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		s.Name.Eq(name),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("strategy group not found")
+		}
+		return nil, err
+	}
+	return convert.ToStrategyGroupItemBo(m), nil
+}
+
+func (r *strategyGroupRepository) ListStrategyGroup(ctx context.Context, req *bo.ListStrategyGroupBo) (*bo.PageResponseBo[*bo.StrategyGroupItemBo], error) {
+	s := query.StrategyGroup
+	wrappers := s.WithContext(ctx)
+	wrappers = wrappers.Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		wrappers = wrappers.Where(s.Name.Like("%" + req.Keyword + "%"))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(s.Status.Eq(int32(req.Status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	req.WithTotal(total)
+	if req.Page > 0 && req.PageSize > 0 {
+		wrappers = wrappers.Offset(req.Offset()).Limit(req.Limit())
+	}
+	list, err := wrappers.Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.StrategyGroupItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToStrategyGroupItemBo(m))
+	}
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func (r *strategyGroupRepository) SelectStrategyGroup(ctx context.Context, req *bo.SelectStrategyGroupBo) (*bo.SelectStrategyGroupBoResult, error) {
+	s := query.StrategyGroup
+	wrappers := s.WithContext(ctx)
+	wrappers = wrappers.Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()))
+	if req.Keyword != "" {
+		wrappers = wrappers.Where(s.Name.Like("%" + req.Keyword + "%"))
+	}
+	if req.Status != enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(s.Status.Eq(int32(req.Status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, err
+	}
+	if req.LastID > 0 {
+		wrappers = wrappers.Where(s.ID.Gt(req.LastID))
+	}
+	wrappers = wrappers.Order(s.ID).Limit(int(req.Limit))
+	list, err := wrappers.Find()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.StrategyGroupItemSelectBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToStrategyGroupItemSelectBo(m))
+	}
+	var nextID uint32
+	if len(list) > 0 {
+		nextID = list[len(list)-1].ID
+	}
+	return &bo.SelectStrategyGroupBoResult{
+		Items:   items,
+		Total:   total,
+		NextID:  nextID,
+		HasMore: len(list) >= int(req.Limit),
+	}, nil
+}
+
+func (r *strategyGroupRepository) BindStrategyGroupReceivers(ctx context.Context, req *bo.BindStrategyGroupReceiversBo) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	receivers := convert.ToStrategyGroupReceiverDos(ctx, req)
+	return query.Q.Transaction(func(tx *query.Query) error {
+		sr := tx.StrategyReceiver
+		_, err := sr.WithContext(ctx).Where(
+			sr.NamespaceUID.Eq(namespace),
+			sr.StrategyGroupUID.Eq(req.UID.Int64()),
+			sr.StrategyUID.Eq(0),
+		).Delete()
+		if err != nil {
+			return err
+		}
+		return sr.WithContext(ctx).Create(receivers...)
+	})
+}
@@ -0,0 +1,214 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/errors"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+// namespaceContext is the context of a request of user 1 in namespace.
+func namespaceContext(namespace snowflake.ID) context.Context {
+	ctx := contextx.WithNamespace(context.Background(), namespace)
+	return contextx.WithUserUID(ctx, 1)
+}
+
+func newStrategyBiz(db *gorm.DB) *biz.StrategyBiz {
+	return biz.NewStrategy(
+		&strategyGroupRepository{db: db},
+		&strategyRepository{db: db},
+		&strategyMetricRepository{db: db},
+		&levelRepository{db: db},
+		&datasourceRepository{db: db},
+		&receiverRepository{db: db},
+		&onCallScheduleRepository{db: db},
+		klog.NewHelper(klog.DefaultLogger),
+	)
+}
+
+func createStrategyGroup(t *testing.T, ctx context.Context, s *biz.StrategyBiz, name string) *bo.StrategyGroupItemBo {
+	t.Helper()
+	if err := s.CreateStrategyGroup(ctx, &bo.CreateStrategyGroupBo{Name: name, Metadata: map[string]string{"team": "ops"}}); err != nil {
+		t.Fatalf("create strategy group %s: %v", name, err)
+	}
+	list, err := s.ListStrategyGroup(ctx, &bo.ListStrategyGroupBo{PageRequestBo: bo.NewPageRequestBo(1, 10), Keyword: name})
+	if err != nil || len(list.GetItems()) != 1 {
+		t.Fatalf("list strategy group %s: %v", name, err)
+	}
+	return list.GetItems()[0]
+}
+
+func createReceiver(t *testing.T, ctx context.Context, db *gorm.DB, name string) snowflake.ID {
+	t.Helper()
+	repo := &receiverRepository{db: db}
+	req := &bo.CreateReceiverBo{Name: name, Type: apiv1.ReceiverType_WEBHOOK, Webhook: &bo.WebhookConfigBo{URL: "http://127.0.0.1/hook"}}
+	if err := repo.CreateReceiver(ctx, req); err != nil {
+		t.Fatalf("create receiver %s: %v", name, err)
+	}
+	rc := query.Receiver
+	m, err := rc.WithContext(ctx).Where(rc.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()), rc.Name.Eq(name)).First()
+	if err != nil {
+		t.Fatalf("get receiver %s: %v", name, err)
+	}
+	return m.UID
+}
+
+func createOnCallSchedule(t *testing.T, ctx context.Context, db *gorm.DB, name string, members ...snowflake.ID) snowflake.ID {
+	t.Helper()
+	req := &bo.CreateOnCallScheduleBo{OnCallScheduleSpecBo: &bo.OnCallScheduleSpecBo{Name: name, TimeZone: "UTC", HandoffTime: "09:00", Members: members}}
+	if err := (&onCallScheduleRepository{db: db}).CreateOnCallSchedule(ctx, req); err != nil {
+		t.Fatalf("create oncall schedule %s: %v", name, err)
+	}
+	s := query.OnCallSchedule
+	m, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()), s.Name.Eq(name)).First()
+	if err != nil {
+		t.Fatalf("get oncall schedule %s: %v", name, err)
+	}
+	return m.UID
+}
+
+func boundReceivers(t *testing.T, ctx context.Context, groupUID snowflake.ID) []snowflake.ID {
+	t.Helper()
+	sr := query.StrategyReceiver
+	list, err := sr.WithContext(ctx).Where(sr.StrategyGroupUID.Eq(groupUID.Int64()), sr.StrategyUID.Eq(0)).Order(sr.ID).Find()
+	if err != nil {
+		t.Fatalf("list bound receivers: %v", err)
+	}
+	uids := make([]snowflake.ID, 0, len(list))
+	for _, m := range list {
+		uids = append(uids, m.ReceiverUID)
+	}
+	return uids
+}
+
+func TestStrategyGroupCRUD(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	s := newStrategyBiz(db)
+	ctx, other := namespaceContext(1), namespaceContext(2)
+
+	group := createStrategyGroup(t, ctx, s, "node")
+	if group.Status != enum.GlobalStatus_ENABLED || group.Metadata["team"] != "ops" {
+		t.Fatalf("got group %+v, want an enabled group with its metadata", group)
+	}
+	// the group is not visible from another namespace
+	createStrategyGroup(t, other, s, "node")
+	if _, err := s.GetStrategyGroup(other, group.UID); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found from another namespace", err)
+	}
+
+	if err := s.UpdateStrategyGroup(ctx, &bo.UpdateStrategyGroupBo{UID: group.UID, Name: "host", Remark: "hosts"}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := s.UpdateStrategyGroup(other, &bo.UpdateStrategyGroupBo{UID: group.UID, Name: "other"}); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found when updating from another namespace", err)
+	}
+	status := &bo.UpdateStrategyGroupStatusBo{UID: group.UID, Status: enum.GlobalStatus_DISABLED}
+	if err := s.UpdateStrategyGroupStatus(ctx, status); err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+	if err := s.UpdateStrategyGroupStatus(other, status); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found when updating the status from another namespace", err)
+	}
+	got, err := s.GetStrategyGroup(ctx, group.UID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Name != "host" || got.Remark != "hosts" || got.Status != enum.GlobalStatus_DISABLED {
+		t.Fatalf("got group %+v after the updates", got)
+	}
+	list, err := s.ListStrategyGroup(ctx, &bo.ListStrategyGroupBo{PageRequestBo: bo.NewPageRequestBo(1, 10), Status: enum.GlobalStatus_ENABLED})
+	if err != nil || len(list.GetItems()) != 0 {
+		t.Fatalf("got %v and %d groups, want no enabled group", err, len(list.GetItems()))
+	}
+
+	// a group with strategies can not be deleted
+	strategy := &bo.CreateStrategyBo{StrategyGroupUID: group.UID, Name: "cpu", Type: enum.DatasourceType_METRICS, Driver: enum.DatasourceDriver_METRICS_PROMETHEUS}
+	if err := s.CreateStrategy(ctx, strategy); err != nil {
+		t.Fatalf("create strategy: %v", err)
+	}
+	if err := s.CreateStrategy(other, strategy); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found for a group of another namespace", err)
+	}
+	if err := s.DeleteStrategyGroup(ctx, group.UID); errors.Code(err) != 403 {
+		t.Fatalf("got %v, want forbidden while the group has strategies", err)
+	}
+	strategies, err := s.ListStrategy(ctx, &bo.ListStrategyBo{PageRequestBo: bo.NewPageRequestBo(1, 10), StrategyGroupUID: group.UID})
+	if err != nil || len(strategies.GetItems()) != 1 {
+		t.Fatalf("got %v and %d strategies, want the strategy of the group", err, len(strategies.GetItems()))
+	}
+	if err := s.DeleteStrategy(ctx, strategies.GetItems()[0].UID); err != nil {
+		t.Fatalf("delete strategy: %v", err)
+	}
+	if err := s.DeleteStrategyGroup(ctx, group.UID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := s.DeleteStrategyGroup(ctx, group.UID); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found for a deleted group", err)
+	}
+}
+
+func TestBindStrategyGroupReceivers(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	s := newStrategyBiz(db)
+	ctx, other := namespaceContext(1), namespaceContext(2)
+
+	group := createStrategyGroup(t, ctx, s, "node")
+	a, b := createReceiver(t, ctx, db, "a"), createReceiver(t, ctx, db, "b")
+	foreign := createReceiver(t, other, db, "c")
+	schedule := createOnCallSchedule(t, ctx, db, "ops", a, b)
+	foreignSchedule := createOnCallSchedule(t, other, db, "ops", foreign)
+
+	// an on-call schedule is bound the same as a receiver
+	if err := s.BindStrategyGroupReceivers(ctx, &bo.BindStrategyGroupReceiversBo{UID: group.UID, ReceiverUIDs: []snowflake.ID{schedule}}); err != nil {
+		t.Fatalf("bind oncall schedule: %v", err)
+	}
+	if got := boundReceivers(t, ctx, group.UID); len(got) != 1 || got[0] != schedule {
+		t.Fatalf("got bound receivers %v, want the oncall schedule", got)
+	}
+	if err := s.BindStrategyGroupReceivers(ctx, &bo.BindStrategyGroupReceiversBo{UID: group.UID, ReceiverUIDs: []snowflake.ID{a, b}}); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	if got := boundReceivers(t, ctx, group.UID); len(got) != 2 || got[0] != a || got[1] != b {
+		t.Fatalf("got bound receivers %v, want %v", got, []snowflake.ID{a, b})
+	}
+
+	// an unknown receiver, or one of another namespace, leaves the bindings as they were
+	for _, uid := range []snowflake.ID{foreign, foreignSchedule, 404} {
+		err := s.BindStrategyGroupReceivers(ctx, &bo.BindStrategyGroupReceiversBo{UID: group.UID, ReceiverUIDs: []snowflake.ID{a, uid}})
+		if errors.Code(err) != 400 {
+			t.Fatalf("bind receiver %d: got %v, want a params error", uid.Int64(), err)
+		}
+	}
+	if got := boundReceivers(t, ctx, group.UID); len(got) != 2 {
+		t.Fatalf("got bound receivers %v after the rejected binds, want both still bound", got)
+	}
+	if err := s.BindStrategyGroupReceivers(other, &bo.BindStrategyGroupReceiversBo{UID: group.UID, ReceiverUIDs: []snowflake.ID{foreign}}); !merr.IsNotFound(err) {
+		t.Fatalf("got %v, want not found for a group of another namespace", err)
+	}
+
+	// binding replaces the receivers, binding none unbinds them all
+	if err := s.BindStrategyGroupReceivers(ctx, &bo.BindStrategyGroupReceiversBo{UID: group.UID, ReceiverUIDs: []snowflake.ID{b}}); err != nil {
+		t.Fatalf("rebind: %v", err)
+	}
+	if got := boundReceivers(t, ctx, group.UID); len(got) != 1 || got[0] != b {
+		t.Fatalf("got bound receivers %v, want only %d", got, b.Int64())
+	}
+	if err := s.BindStrategyGroupReceivers(ctx, &bo.BindStrategyGroupReceiversBo{UID: group.UID}); err != nil {
+		t.Fatalf("unbind: %v", err)
+	}
+	if got := boundReceivers(t, ctx, group.UID); len(got) != 0 {
+		t.Fatalf("got bound receivers %v, want none", got)
+	}
+}
@@ -0,0 +1,429 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/aide-family/magicbox/merr"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/repository"
+	"github.com/aide-family/marksman/internal/data"
+	"github.com/aide-family/marksman/internal/data/impl/convert"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	"github.com/aide-family/marksman/internal/data/secret"
+)
+
+func NewStrategyMetricRepository(d *data.Data) (repository.StrategyMetric, error) {
+	query.SetDefault(d.DB())
+	return &strategyMetricRepository{db: d.DB(), keyring: d.Keyring()}, nil
+}
+
+type strategyMetricRepository struct {
+	db      *gorm.DB
+	keyring *secret.Keyring
+}
+
+// SaveStrategyMetric creates the metric of a strategy or overwrites the existing one.
+// Updates go through the model so that the json serializer of labels and
+// datasource uids is applied.
+func (r *strategyMetricRepository) SaveStrategyMetric(ctx context.Context, req *bo.SaveStrategyMetricBo) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	m := convert.ToStrategyMetricDo(ctx, req)
+	return query.Q.Transaction(func(tx *query.Query) error {
+		return saveStrategyMetric(ctx, tx, namespace, m)
+	})
+}
+
+func saveStrategyMetric(ctx context.Context, tx *query.Query, namespace int64, m *do.StrategyMetric) error {
+	sm := tx.StrategyMetric
+	wrappers := sm.WithContext(ctx).Where(sm.NamespaceUID.Eq(namespace), sm.StrategyUID.Eq(m.StrategyUID.Int64()))
+	total, err := wrappers.Count()
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return sm.WithContext(ctx).Create(m)
+	}
+	_, err = wrappers.Select(sm.Expr, sm.Labels, sm.Summary, sm.Description, sm.DatasourceUIDs, sm.Status).Updates(m)
+	return err
+}
+
+func (r *strategyMetricRepository) GetStrategyMetric(ctx context.Context, strategyUID snowflake.ID) (*bo.StrategyMetricItemBo, error) {
+	sm := query.StrategyMetric
+	m, err := sm.WithContext(ctx).Where(
+		sm.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		sm.StrategyUID.Eq(strategyUID.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("strategy metric not found")
+		}
+		return nil, err
+	}
+	levels, err := r.listStrategyMetricLevel(ctx, strategyUID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToStrategyMetricItemBo(m, levels), nil
+}
+
+func (r *strategyMetricRepository) listStrategyMetricLevel(ctx context.Context, strategyUID snowflake.ID) ([]*bo.StrategyMetricLevelItemBo, error) {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	sml := query.StrategyMetricLevel
+	list, err := sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(namespace),
+		sml.StrategyUID.Eq(strategyUID.Int64()),
+	).Order(sml.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	levelUIDs := make([]int64, 0, len(list))
+	for _, m := range list {
+		levelUIDs = append(levelUIDs, m.LevelUID.Int64())
+	}
+	levelMap := make(map[snowflake.ID]*do.Level, len(levelUIDs))
+	if len(levelUIDs) > 0 {
+		l := query.Level
+		levels, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(namespace), l.UID.In(levelUIDs...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, level := range levels {
+			levelMap[level.UID] = level
+		}
+	}
+	items := make([]*bo.StrategyMetricLevelItemBo, 0, len(list))
+	for _, m := range list {
+		items = append(items, convert.ToStrategyMetricLevelItemBo(m, levelMap[m.LevelUID]))
+	}
+	return items, nil
+}
+
+// SaveStrategyMetricLevel creates the threshold of a level or overwrites the
+// existing one, a strategy holds at most one row per level.
+func (r *strategyMetricRepository) SaveStrategyMetricLevel(ctx context.Context, req *bo.SaveStrategyMetricLevelBo) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	m := convert.ToStrategyMetricLevelDo(ctx, req)
+	return query.Q.Transaction(func(tx *query.Query) error {
+		return saveStrategyMetricLevel(ctx, tx, namespace, m)
+	})
+}
+
+func saveStrategyMetricLevel(ctx context.Context, tx *query.Query, namespace int64, m *do.StrategyMetricLevel) error {
+	sml := tx.StrategyMetricLevel
+	wrappers := sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(namespace),
+		sml.StrategyUID.Eq(m.StrategyUID.Int64()),
+		sml.LevelUID.Eq(m.LevelUID.Int64()),
+	)
+	total, err := wrappers.Count()
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return sml.WithContext(ctx).Create(m)
+	}
+	_, err = wrappers.Select(sml.Mode, sml.Condition, sml.Values, sml.Duration, sml.FlapWindow, sml.FlapThreshold, sml.Status).Updates(m)
+	return err
+}
+
+func (r *strategyMetricRepository) UpdateStrategyMetricLevelStatus(ctx context.Context, req *bo.UpdateStrategyMetricLevelStatusBo) error {
+	sml := query.StrategyMetricLevel
+	info, err := sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()),
+		sml.StrategyUID.Eq(req.StrategyUID.Int64()),
+		sml.UID.Eq(req.UID.Int64()),
+	).Update(sml.Status, req.Status)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return merr.ErrorNotFound("strategy metric level not found")
+	}
+	return nil
+}
+
+func (r *strategyMetricRepository) DeleteStrategyMetricLevel(ctx context.Context, strategyUID, uid snowflake.ID) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		sml := tx.StrategyMetricLevel
+		m, err := sml.WithContext(ctx).Where(
+			sml.NamespaceUID.Eq(namespace),
+			sml.StrategyUID.Eq(strategyUID.Int64()),
+			sml.UID.Eq(uid.Int64()),
+		).First()
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return merr.ErrorNotFound("strategy metric level not found")
+			}
+			return err
+		}
+		if _, err := sml.WithContext(ctx).Where(sml.ID.Eq(m.ID)).Delete(); err != nil {
+			return err
+		}
+		sr := tx.StrategyReceiver
+		_, err = sr.WithContext(ctx).Where(
+			sr.NamespaceUID.Eq(namespace),
+			sr.StrategyUID.Eq(strategyUID.Int64()),
+			sr.LevelUID.Eq(m.LevelUID.Int64()),
+		).Delete()
+		return err
+	})
+}
+
+func (r *strategyMetricRepository) GetStrategyMetricLevel(ctx context.Context, strategyUID, uid snowflake.ID) (*bo.StrategyMetricLevelItemBo, error) {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	sml := query.StrategyMetricLevel
+	m, err := sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(namespace),
+		sml.StrategyUID.Eq(strategyUID.Int64()),
+		sml.UID.Eq(uid.Int64()),
+	).First()
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, merr.ErrorNotFound("strategy metric level not found")
+		}
+		return nil, err
+	}
+	l := query.Level
+	level, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(namespace), l.UID.Eq(m.LevelUID.Int64())).First()
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	return convert.ToStrategyMetricLevelItemBo(m, level), nil
+}
+
+// BindStrategyMetricReceivers replaces the receivers of a strategy, or of a
+// single level of it when LevelUID is set.
+func (r *strategyMetricRepository) BindStrategyMetricReceivers(ctx context.Context, req *bo.BindStrategyMetricReceiversBo) error {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	return query.Q.Transaction(func(tx *query.Query) error {
+		s := tx.Strategy
+		strategy, err := s.WithContext(ctx).Where(s.NamespaceUID.Eq(namespace), s.UID.Eq(req.StrategyUID.Int64())).First()
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return merr.ErrorNotFound("strategy not found")
+			}
+			return err
+		}
+		sr := tx.StrategyReceiver
+		_, err = sr.WithContext(ctx).Where(
+			sr.NamespaceUID.Eq(namespace),
+			sr.StrategyUID.Eq(req.StrategyUID.Int64()),
+			sr.LevelUID.Eq(req.LevelUID.Int64()),
+		).Delete()
+		if err != nil {
+			return err
+		}
+		return sr.WithContext(ctx).Create(convert.ToStrategyMetricReceiverDos(ctx, strategy.StrategyGroupUID, req)...)
+	})
+}
+
+// ListStrategyMetricRules is not scoped to the namespace of ctx, it is used by
+// the evaluator which serves every namespace.
+func (r *strategyMetricRepository) ListStrategyMetricRules(ctx context.Context) ([]*bo.StrategyMetricRuleBo, error) {
+	enabled := int32(enum.GlobalStatus_ENABLED)
+	sm := query.StrategyMetric
+	metrics, err := sm.WithContext(ctx).Where(sm.Status.Eq(enabled)).Find()
+	if err != nil || len(metrics) == 0 {
+		return nil, err
+	}
+	strategyUIDs := make([]int64, 0, len(metrics))
+	datasourceUIDs := make([]int64, 0, len(metrics))
+	for _, m := range metrics {
+		strategyUIDs = append(strategyUIDs, m.StrategyUID.Int64())
+		for _, uid := range m.DatasourceUIDs {
+			datasourceUIDs = append(datasourceUIDs, uid.Int64())
+		}
+	}
+
+	s := query.Strategy
+	strategies, err := s.WithContext(ctx).Where(s.UID.In(strategyUIDs...), s.Status.Eq(enabled)).Find()
+	if err != nil {
+		return nil, err
+	}
+	groupUIDs := make([]int64, 0, len(strategies))
+	for _, strategy := range strategies {
+		groupUIDs = append(groupUIDs, strategy.StrategyGroupUID.Int64())
+	}
+	sg := query.StrategyGroup
+	groups, err := sg.WithContext(ctx).Where(sg.UID.In(groupUIDs...), sg.Status.Eq(enabled)).Find()
+	if err != nil {
+		return nil, err
+	}
+	groupMap := make(map[snowflake.ID]struct{}, len(groups))
+	for _, group := range groups {
+		groupMap[group.UID] = struct{}{}
+	}
+	strategyMap := make(map[snowflake.ID]*do.Strategy, len(strategies))
+	for _, strategy := range strategies {
+		if _, ok := groupMap[strategy.StrategyGroupUID]; ok {
+			strategyMap[strategy.UID] = strategy
+		}
+	}
+
+	d := query.Datasource
+	datasources, err := d.WithContext(ctx).Where(d.UID.In(datasourceUIDs...), d.Status.Eq(enabled)).Find()
+	if err != nil {
+		return nil, err
+	}
+	datasourceMap := make(map[snowflake.ID]*do.Datasource, len(datasources))
+	for _, datasource := range datasources {
+		// a datasource that can not be decrypted must not stop the evaluation of every other rule,
+		// it is listed without its config so that the evaluator keeps its alerts as they are
+		if err := decryptDatasourceConfig(r.keyring, datasource.UID, datasource.Config); err != nil {
+			klog.Context(ctx).Warnw("msg", "decrypt datasource config failed, leave it out", "error", err, "datasourceUID", datasource.UID)
+			datasource.Config = &do.DatasourceConfig{}
+		}
+		datasourceMap[datasource.UID] = datasource
+	}
+
+	sml := query.StrategyMetricLevel
+	metricLevels, err := sml.WithContext(ctx).Where(sml.StrategyUID.In(strategyUIDs...), sml.Status.Eq(enabled)).Order(sml.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	levelUIDs := make([]int64, 0, len(metricLevels))
+	for _, m := range metricLevels {
+		levelUIDs = append(levelUIDs, m.LevelUID.Int64())
+	}
+	l := query.Level
+	levels, err := l.WithContext(ctx).Where(l.UID.In(levelUIDs...), l.Status.Eq(enabled)).Find()
+	if err != nil {
+		return nil, err
+	}
+	levelMap := make(map[snowflake.ID]*do.Level, len(levels))
+	for _, level := range levels {
+		levelMap[level.UID] = level
+	}
+	metricLevelMap := make(map[snowflake.ID][]*bo.StrategyMetricLevelItemBo, len(metrics))
+	for _, m := range metricLevels {
+		level, ok := levelMap[m.LevelUID]
+		if !ok || level.NamespaceUID != m.NamespaceUID {
+			continue
+		}
+		metricLevelMap[m.StrategyUID] = append(metricLevelMap[m.StrategyUID], convert.ToStrategyMetricLevelItemBo(m, level))
+	}
+
+	rules := make([]*bo.StrategyMetricRuleBo, 0, len(metrics))
+	for _, m := range metrics {
+		strategy, ok := strategyMap[m.StrategyUID]
+		if !ok || strategy.NamespaceUID != m.NamespaceUID {
+			continue
+		}
+		ruleLevels := metricLevelMap[m.StrategyUID]
+		if len(ruleLevels) == 0 {
+			continue
+		}
+		items := make([]*bo.DatasourceItemBo, 0, len(m.DatasourceUIDs))
+		for _, uid := range m.DatasourceUIDs {
+			datasource, ok := datasourceMap[uid]
+			if !ok || datasource.NamespaceUID != m.NamespaceUID {
+				continue
+			}
+			items = append(items, convert.ToDatasourceItemBo(datasource))
+		}
+		if len(items) == 0 {
+			continue
+		}
+		rules = append(rules, convert.ToStrategyMetricRuleBo(strategy, m, items, ruleLevels))
+	}
+	return rules, nil
+}
+
+func (r *strategyMetricRepository) ListStrategyMetricExports(ctx context.Context, strategyGroupUID snowflake.ID) ([]*bo.StrategyMetricExportBo, error) {
+	namespace := contextx.GetNamespace(ctx).Int64()
+	enabled := int32(enum.GlobalStatus_ENABLED)
+	sg := query.StrategyGroup
+	groupWrappers := sg.WithContext(ctx).Where(sg.NamespaceUID.Eq(namespace), sg.Status.Eq(enabled))
+	if strategyGroupUID > 0 {
+		groupWrappers = groupWrappers.Where(sg.UID.Eq(strategyGroupUID.Int64()))
+	}
+	groups, err := groupWrappers.Order(sg.ID).Find()
+	if err != nil || len(groups) == 0 {
+		return nil, err
+	}
+	groupUIDs := make([]int64, 0, len(groups))
+	for _, group := range groups {
+		groupUIDs = append(groupUIDs, group.UID.Int64())
+	}
+
+	s := query.Strategy
+	strategies, err := s.WithContext(ctx).Where(
+		s.NamespaceUID.Eq(namespace),
+		s.StrategyGroupUID.In(groupUIDs...),
+		s.Type.Eq(int32(enum.DatasourceType_METRICS)),
+		s.Status.Eq(enabled),
+	).Order(s.ID).Find()
+	if err != nil || len(strategies) == 0 {
+		return nil, err
+	}
+	strategyUIDs := make([]int64, 0, len(strategies))
+	for _, strategy := range strategies {
+		strategyUIDs = append(strategyUIDs, strategy.UID.Int64())
+	}
+
+	sm := query.StrategyMetric
+	metrics, err := sm.WithContext(ctx).Where(
+		sm.NamespaceUID.Eq(namespace),
+		sm.StrategyUID.In(strategyUIDs...),
+		sm.Status.Eq(enabled),
+	).Find()
+	if err != nil {
+		return nil, err
+	}
+	metricMap := make(map[snowflake.ID]*do.StrategyMetric, len(metrics))
+	for _, m := range metrics {
+		metricMap[m.StrategyUID] = m
+	}
+
+	sml := query.StrategyMetricLevel
+	metricLevels, err := sml.WithContext(ctx).Where(
+		sml.NamespaceUID.Eq(namespace),
+		sml.StrategyUID.In(strategyUIDs...),
+		sml.Status.Eq(enabled),
+	).Order(sml.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+	levelUIDs := make([]int64, 0, len(metricLevels))
+	for _, m := range metricLevels {
+		levelUIDs = append(levelUIDs, m.LevelUID.Int64())
+	}
+	l := query.Level
+	levels, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(namespace), l.UID.In(levelUIDs...), l.Status.Eq(enabled)).Find()
+	if err != nil {
+		return nil, err
+	}
+	levelMap := make(map[snowflake.ID]*do.Level, len(levels))
+	for _, level := range levels {
+		levelMap[level.UID] = level
+	}
+	metricLevelMap := make(map[snowflake.ID][]*bo.StrategyMetricLevelItemBo, len(metrics))
+	for _, m := range metricLevels {
+		if level, ok := levelMap[m.LevelUID]; ok {
+			metricLevelMap[m.StrategyUID] = append(metricLevelMap[m.StrategyUID], convert.ToStrategyMetricLevelItemBo(m, level))
+		}
+	}
+
+	items := make([]*bo.StrategyMetricExportBo, 0, len(metrics))
+	for _, group := range groups {
+		for _, strategy := range strategies {
+			if strategy.StrategyGroupUID != group.UID {
+				continue
+			}
+			m, ok := metricMap[strategy.UID]
+			if !ok || len(metricLevelMap[strategy.UID]) == 0 {
+				continue
+			}
+			items = append(items, convert.ToStrategyMetricExportBo(group, strategy, m, metricLevelMap[strategy.UID]))
+		}
+	}
+	return items, nil
+}
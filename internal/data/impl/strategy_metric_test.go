@@ -0,0 +1,155 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aide-family/magicbox/contextx"
+	"github.com/aide-family/magicbox/enum"
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/errors"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/data/impl/do"
+	"github.com/aide-family/marksman/internal/data/impl/query"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func newStrategyMetricBiz(db *gorm.DB) *biz.StrategyMetricBiz {
+	return biz.NewStrategyMetric(
+		&strategyRepository{db: db},
+		&strategyMetricRepository{db: db},
+		&levelRepository{db: db},
+		&datasourceRepository{db: db},
+		&receiverRepository{db: db},
+		&onCallScheduleRepository{db: db},
+		klog.NewHelper(klog.DefaultLogger),
+	)
+}
+
+func createStrategy(t *testing.T, ctx context.Context, s *biz.StrategyBiz, groupUID snowflake.ID, name string) snowflake.ID {
+	t.Helper()
+	req := &bo.CreateStrategyBo{StrategyGroupUID: groupUID, Name: name, Type: enum.DatasourceType_METRICS, Driver: enum.DatasourceDriver_METRICS_PROMETHEUS}
+	if err := s.CreateStrategy(ctx, req); err != nil {
+		t.Fatalf("create strategy %s: %v", name, err)
+	}
+	list, err := s.ListStrategy(ctx, &bo.ListStrategyBo{PageRequestBo: bo.NewPageRequestBo(1, 10), Keyword: name})
+	if err != nil || len(list.GetItems()) != 1 {
+		t.Fatalf("list strategy %s: %v", name, err)
+	}
+	return list.GetItems()[0].UID
+}
+
+func createLevel(t *testing.T, ctx context.Context, db *gorm.DB, name string) snowflake.ID {
+	t.Helper()
+	if err := (&levelRepository{db: db}).CreateLevel(ctx, &bo.CreateLevelBo{Name: name}); err != nil {
+		t.Fatalf("create level %s: %v", name, err)
+	}
+	l := query.Level
+	m, err := l.WithContext(ctx).Where(l.NamespaceUID.Eq(contextx.GetNamespace(ctx).Int64()), l.Name.Eq(name)).First()
+	if err != nil {
+		t.Fatalf("get level %s: %v", name, err)
+	}
+	return m.UID
+}
+
+func TestSaveStrategyMetricLevelChecksLevel(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	strategies, metrics := newStrategyBiz(db), newStrategyMetricBiz(db)
+	ctx, other := namespaceContext(1), namespaceContext(2)
+
+	group := createStrategyGroup(t, ctx, strategies, "node")
+	strategyUID := createStrategy(t, ctx, strategies, group.UID, "cpu")
+	critical, warning := createLevel(t, ctx, db, "critical"), createLevel(t, ctx, db, "warning")
+	foreign := createLevel(t, other, db, "critical")
+	disable := &bo.UpdateLevelStatusBo{UID: warning, Status: enum.GlobalStatus_DISABLED}
+	if err := (&levelRepository{db: db}).UpdateLevelStatus(ctx, disable); err != nil {
+		t.Fatalf("disable level: %v", err)
+	}
+
+	save := func(levelUID snowflake.ID) error {
+		return metrics.SaveStrategyMetricLevel(ctx, &bo.SaveStrategyMetricLevelBo{
+			StrategyUID: strategyUID,
+			LevelUID:    levelUID,
+			Condition:   enum.ConditionMetric_CONDITION_METRIC_GT,
+			Values:      []int64{80},
+			Status:      enum.GlobalStatus_ENABLED,
+		})
+	}
+	if err := save(critical); err != nil {
+		t.Fatalf("save enabled level: %v", err)
+	}
+	for name, levelUID := range map[string]snowflake.ID{
+		"disabled level":             warning,
+		"level of another namespace": foreign,
+		"unknown level":              404,
+	} {
+		if err := save(levelUID); errors.Code(err) != 400 {
+			t.Errorf("%s: got %v, want a params error", name, err)
+		}
+	}
+	sml := query.StrategyMetricLevel
+	if total, err := sml.WithContext(ctx).Where(sml.StrategyUID.Eq(strategyUID.Int64())).Count(); err != nil || total != 1 {
+		t.Fatalf("got %v and %d levels, want only the enabled level saved", err, total)
+	}
+
+	// binding receivers to a level checks the level and the receivers the same way
+	receiver := createReceiver(t, ctx, db, "a")
+	for name, req := range map[string]*bo.BindStrategyMetricReceiversBo{
+		"disabled level":   {StrategyUID: strategyUID, LevelUID: warning, ReceiverUIDs: []snowflake.ID{receiver}},
+		"unknown receiver": {StrategyUID: strategyUID, LevelUID: critical, ReceiverUIDs: []snowflake.ID{receiver, 404}},
+	} {
+		if err := metrics.BindStrategyMetricReceivers(ctx, req); errors.Code(err) != 400 {
+			t.Errorf("bind with %s: got %v, want a params error", name, err)
+		}
+	}
+	// an on-call schedule is accepted where a receiver is
+	schedule := createOnCallSchedule(t, ctx, db, "ops", receiver)
+	bind := &bo.BindStrategyMetricReceiversBo{StrategyUID: strategyUID, LevelUID: critical, ReceiverUIDs: []snowflake.ID{receiver, schedule}}
+	if err := metrics.BindStrategyMetricReceivers(ctx, bind); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+}
+
+func TestListStrategyMetricRulesKeepsUndecryptableDatasource(t *testing.T) {
+	db := openTestDB(t, do.Models()...)
+	strategies, metrics := newStrategyBiz(db), newStrategyMetricBiz(db)
+	ctx := namespaceContext(1)
+
+	group := createStrategyGroup(t, ctx, strategies, "node")
+	level := createLevel(t, ctx, db, "critical")
+	good, bad := createDatasource(t, ctx, db, "good"), createDatasource(t, ctx, db, "bad")
+	strategyUID := createStrategy(t, ctx, strategies, group.UID, "cpu")
+	if err := metrics.SaveStrategyMetric(ctx, &bo.SaveStrategyMetricBo{StrategyUID: strategyUID, Expr: "up", DatasourceUIDs: []snowflake.ID{good, bad}, Status: enum.GlobalStatus_ENABLED}); err != nil {
+		t.Fatalf("save strategy metric: %v", err)
+	}
+	if err := metrics.SaveStrategyMetricLevel(ctx, &bo.SaveStrategyMetricLevelBo{StrategyUID: strategyUID, LevelUID: level, Condition: enum.ConditionMetric_CONDITION_METRIC_EQ, Values: []int64{0}, Status: enum.GlobalStatus_ENABLED}); err != nil {
+		t.Fatalf("save strategy metric level: %v", err)
+	}
+	// the password of bad is encrypted with a key the keyring does not have
+	d := query.Datasource
+	config := &do.DatasourceConfig{URL: "http://127.0.0.1:9090", AuthMode: apiv1.DatasourceAuthMode_AUTH_BASIC, Username: "admin", Password: "enc:v1:00000000:AA:AA"}
+	if _, err := d.WithContext(ctx).Where(d.UID.Eq(bad.Int64())).UpdateColumnSimple(d.Config.Value(config)); err != nil {
+		t.Fatalf("corrupt datasource: %v", err)
+	}
+
+	rules, err := (&strategyMetricRepository{db: db}).ListStrategyMetricRules(ctx)
+	if err != nil {
+		t.Fatalf("list rules: %v", err)
+	}
+	if len(rules) != 1 || len(rules[0].Datasources) != 2 {
+		t.Fatalf("got rules %+v, want the rule with both datasources", rules)
+	}
+	for _, datasource := range rules[0].Datasources {
+		if datasource.UID == bad && (datasource.Config.Password != "" || datasource.Config.URL != "") {
+			t.Fatalf("got config %+v of the datasource that does not decrypt, want it left out", datasource.Config)
+		}
+	}
+	list, err := (&datasourceRepository{db: db}).ListDatasource(ctx, &bo.ListDatasourceBo{PageRequestBo: bo.NewPageRequestBo(1, 10)})
+	if err != nil || len(list.GetItems()) != 1 || list.GetItems()[0].UID != good {
+		t.Fatalf("got %v, want the datasources that decrypt listed", err)
+	}
+}
@@ -0,0 +1,251 @@
+// Package secret implements envelope encryption of secrets stored in the database.
+//
+// Every value is encrypted with its own random data key, the data key is wrapped with a key
+// encryption key from the config. Rotating the key encryption key only re-wraps data keys.
+// The ciphertext of a value is bound to additional data, the row and the field it is stored
+// in, so that a value moved to another row or field no longer decrypts.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// prefix is written by Encrypt, prefixV1 values were written before the ciphertext was
+	// bound to additional data
+	prefix   = "enc:v2:"
+	prefixV1 = "enc:v1:"
+	keySize  = 32
+)
+
+var (
+	ErrNoKey      = errors.New("secret: no encryption key configured")
+	ErrUnknownKey = errors.New("secret: value is encrypted with an unknown key")
+)
+
+type key struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// Keyring encrypts with its primary key and decrypts with the primary or any previous key.
+// A keyring without keys stores values as they are.
+type Keyring struct {
+	primary *key
+	keys    map[string]*key
+}
+
+// NewKeyring parses base64 encoded 32 byte keys, primary may be empty to disable encryption.
+func NewKeyring(primary string, previous ...string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]*key, len(previous)+1)}
+	if primary == "" {
+		if len(previous) > 0 {
+			return nil, errors.New("secret: previous keys require a primary key")
+		}
+		return k, nil
+	}
+	for i, encoded := range append([]string{primary}, previous...) {
+		parsed, err := parseKey(encoded)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			k.primary = parsed
+		}
+		k.keys[parsed.id] = parsed
+	}
+	return k, nil
+}
+
+// GenerateKey returns a new random key in the format NewKeyring expects.
+func GenerateKey() (string, error) {
+	raw := make([]byte, keySize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+func parseKey(encoded string) (*key, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("secret: key is not valid base64: %w", err)
+	}
+	if len(raw) != keySize {
+		return nil, fmt.Errorf("secret: key must be %d bytes, got %d", keySize, len(raw))
+	}
+	aead, err := newAEAD(raw)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(raw)
+	return &key{id: hex.EncodeToString(sum[:4]), aead: aead}, nil
+}
+
+func newAEAD(raw []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Enabled reports whether values are encrypted.
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.primary != nil
+}
+
+// IsEncrypted reports whether value was produced by Encrypt.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix) || strings.HasPrefix(value, prefixV1)
+}
+
+// Encrypt returns value encrypted with a new data key and bound to aad, it only decrypts with
+// the same aad. Empty values stay empty. A value is encrypted even when it looks encrypted,
+// whether it is already encrypted is up to the caller.
+func (k *Keyring) Encrypt(value, aad string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+	if !k.Enabled() {
+		if IsEncrypted(value) {
+			// stored as it is, it would be read back as an encrypted value
+			return "", fmt.Errorf("%w for a value with the prefix of encrypted values", ErrNoKey)
+		}
+		return value, nil
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(k.primary.aead, dataKey, nil)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(aead, []byte(value), []byte(aad))
+	if err != nil {
+		return "", err
+	}
+	return format(k.primary.id, wrapped, ciphertext), nil
+}
+
+// Decrypt reverses Encrypt with the same aad, values that are not encrypted are returned as they are.
+func (k *Keyring) Decrypt(value, aad string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	_, dataKey, ciphertext, err := k.open(value)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	var additionalData []byte
+	if !strings.HasPrefix(value, prefixV1) {
+		additionalData = []byte(aad)
+	}
+	plaintext, err := unseal(aead, ciphertext, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Rotate re-wraps the data key of value with the primary key, and encrypts plain values and
+// values not yet bound to aad. It reports whether value changed.
+func (k *Keyring) Rotate(value, aad string) (string, bool, error) {
+	if !k.Enabled() {
+		return "", false, ErrNoKey
+	}
+	if value == "" {
+		return value, false, nil
+	}
+	if !strings.HasPrefix(value, prefix) {
+		plain, err := k.Decrypt(value, aad)
+		if err != nil {
+			return "", false, err
+		}
+		encrypted, err := k.Encrypt(plain, aad)
+		return encrypted, err == nil, err
+	}
+	id, dataKey, ciphertext, err := k.open(value)
+	if err != nil {
+		return "", false, err
+	}
+	if id == k.primary.id {
+		return value, false, nil
+	}
+	wrapped, err := seal(k.primary.aead, dataKey, nil)
+	if err != nil {
+		return "", false, err
+	}
+	return format(k.primary.id, wrapped, ciphertext), true, nil
+}
+
+// open unwraps the data key of an encrypted value.
+func (k *Keyring) open(value string) (id string, dataKey, ciphertext []byte, err error) {
+	rest, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		rest = strings.TrimPrefix(value, prefixV1)
+	}
+	parts := strings.Split(rest, ":")
+	if len(parts) != 3 {
+		return "", nil, nil, errors.New("secret: malformed encrypted value")
+	}
+	id = parts[0]
+	if k == nil || k.keys[id] == nil {
+		if !k.Enabled() {
+			return "", nil, nil, ErrNoKey
+		}
+		return "", nil, nil, fmt.Errorf("%w %s", ErrUnknownKey, id)
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("secret: malformed data key: %w", err)
+	}
+	if ciphertext, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+		return "", nil, nil, fmt.Errorf("secret: malformed ciphertext: %w", err)
+	}
+	if dataKey, err = unseal(k.keys[id].aead, wrapped, nil); err != nil {
+		return "", nil, nil, err
+	}
+	return id, dataKey, ciphertext, nil
+}
+
+func format(id string, wrapped, ciphertext []byte) string {
+	return prefix + id + ":" + base64.RawStdEncoding.EncodeToString(wrapped) + ":" + base64.RawStdEncoding.EncodeToString(ciphertext)
+}
+
+// seal prepends a random nonce to the sealed plaintext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func unseal(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("secret: ciphertext too short")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("secret: decrypt failed: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,139 @@
+package secret_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aide-family/marksman/internal/data/secret"
+)
+
+func newKey(t *testing.T) string {
+	t.Helper()
+	key, err := secret.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	keyring, err := secret.NewKeyring(newKey(t))
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	encrypted, err := keyring.Encrypt("s3cret", "row/1")
+	if err != nil {
+		t.Fatalf("encrypt: %v", err)
+	}
+	if !secret.IsEncrypted(encrypted) || strings.Contains(encrypted, "s3cret") {
+		t.Fatalf("value is not encrypted: %q", encrypted)
+	}
+	again, _ := keyring.Encrypt("s3cret", "row/1")
+	if again == encrypted {
+		t.Fatal("encrypting twice must use a new data key")
+	}
+	decrypted, err := keyring.Decrypt(encrypted, "row/1")
+	if err != nil || decrypted != "s3cret" {
+		t.Fatalf("decrypt = %q, %v", decrypted, err)
+	}
+	if plain, err := keyring.Decrypt("legacy", "row/1"); err != nil || plain != "legacy" {
+		t.Fatalf("plain values must pass through, got %q, %v", plain, err)
+	}
+	if empty, _ := keyring.Encrypt("", "row/1"); empty != "" {
+		t.Fatalf("empty values must stay empty, got %q", empty)
+	}
+
+	// change a character of the ciphertext, not the last one whose low bits are padding
+	i := len(encrypted) - 5
+	c := "A"
+	if encrypted[i:i+1] == c {
+		c = "B"
+	}
+	tampered := encrypted[:i] + c + encrypted[i+1:]
+	if _, err := keyring.Decrypt(tampered, "row/1"); err == nil {
+		t.Fatal("expected tampered value to fail")
+	}
+	if _, err := keyring.Decrypt(encrypted, "row/2"); err == nil {
+		t.Fatal("expected the value of another row to fail")
+	}
+
+	// a value that looks encrypted is still encrypted
+	looks, err := keyring.Encrypt("enc:v1:a:b:c", "row/1")
+	if err != nil || looks == "enc:v1:a:b:c" {
+		t.Fatalf("got %q, %v, want the value encrypted", looks, err)
+	}
+	if plain, err := keyring.Decrypt(looks, "row/1"); err != nil || plain != "enc:v1:a:b:c" {
+		t.Fatalf("decrypt = %q, %v", plain, err)
+	}
+}
+
+func TestRotateBindsValuesOfTheFirstVersion(t *testing.T) {
+	keyring, _ := secret.NewKeyring(newKey(t))
+	// a value of the first version is a value bound to no additional data
+	unbound, _ := keyring.Encrypt("token", "")
+	v1 := strings.Replace(unbound, "enc:v2:", "enc:v1:", 1)
+	if plain, err := keyring.Decrypt(v1, "row/1"); err != nil || plain != "token" {
+		t.Fatalf("decrypt = %q, %v", plain, err)
+	}
+	rotated, changed, err := keyring.Rotate(v1, "row/1")
+	if err != nil || !changed {
+		t.Fatalf("rotate = %v, %v", changed, err)
+	}
+	if plain, err := keyring.Decrypt(rotated, "row/1"); err != nil || plain != "token" {
+		t.Fatalf("rotated value decrypts to %q, %v", plain, err)
+	}
+	if _, err := keyring.Decrypt(rotated, "row/2"); err == nil {
+		t.Fatal("expected the rotated value to be bound to its row")
+	}
+}
+
+func TestRotate(t *testing.T) {
+	oldKey, newKeyValue := newKey(t), newKey(t)
+	oldKeyring, _ := secret.NewKeyring(oldKey)
+	encrypted, _ := oldKeyring.Encrypt("token", "row/1")
+
+	onlyNew, _ := secret.NewKeyring(newKeyValue)
+	if _, err := onlyNew.Decrypt(encrypted, "row/1"); !errors.Is(err, secret.ErrUnknownKey) {
+		t.Fatalf("expected unknown key, got %v", err)
+	}
+
+	rotating, err := secret.NewKeyring(newKeyValue, oldKey)
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	rotated, changed, err := rotating.Rotate(encrypted, "row/1")
+	if err != nil || !changed {
+		t.Fatalf("rotate = %v, %v", changed, err)
+	}
+	if plain, err := onlyNew.Decrypt(rotated, "row/1"); err != nil || plain != "token" {
+		t.Fatalf("rotated value decrypts to %q, %v", plain, err)
+	}
+	if _, changed, _ := rotating.Rotate(rotated, "row/1"); changed {
+		t.Fatal("rotating a value under the primary key must be a no-op")
+	}
+	if value, changed, err := rotating.Rotate("plain", "row/1"); err != nil || !changed || !secret.IsEncrypted(value) {
+		t.Fatalf("rotate must encrypt plain values, got %q, %v, %v", value, changed, err)
+	}
+}
+
+func TestKeyringWithoutKey(t *testing.T) {
+	keyring, err := secret.NewKeyring("")
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	if value, _ := keyring.Encrypt("plain", "row/1"); value != "plain" {
+		t.Fatalf("keyring without key must not encrypt, got %q", value)
+	}
+	if _, err := keyring.Encrypt("enc:v2:a:b:c", "row/1"); !errors.Is(err, secret.ErrNoKey) {
+		t.Fatalf("got %v, want no key for a value that would be read back as encrypted", err)
+	}
+	other, _ := secret.NewKeyring(newKey(t))
+	encrypted, _ := other.Encrypt("plain", "row/1")
+	if _, err := keyring.Decrypt(encrypted, "row/1"); !errors.Is(err, secret.ErrNoKey) {
+		t.Fatalf("expected no key, got %v", err)
+	}
+	if _, err := secret.NewKeyring("c2hvcnQ="); err == nil {
+		t.Fatal("expected short key to fail")
+	}
+}
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	magicboxapiv1 "github.com/aide-family/magicbox/api/v1"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	"github.com/aide-family/marksman/internal/biz/job"
+	"github.com/aide-family/marksman/internal/biz/leader"
+	"github.com/aide-family/marksman/internal/conf"
+	"github.com/aide-family/marksman/internal/service"
+)
+
+const (
+	// evaluatorSyncInterval is how often strategies are reloaded from the database.
+	evaluatorSyncInterval = 30 * time.Second
+	// jobNodeRefreshInterval is how often the job nodes are reloaded to rebalance the strategies.
+	jobNodeRefreshInterval = 10 * time.Second
+	// jobNodeLeaveTimeout bounds handing the strategies and the lease over on shutdown.
+	jobNodeLeaveTimeout = 5 * time.Second
+	// notifyFlushInterval is how often the leader looks for notify groups and escalation steps that are due.
+	notifyFlushInterval = time.Second
+	// manifestSyncInterval is how often the gitops directory is applied when no interval is set.
+	manifestSyncInterval = time.Minute
+)
+
+// NewJobEngine returns the worker pool of the job server, sized by jobCore.
+func NewJobEngine(bc *conf.Bootstrap, helper *klog.Helper) *job.Engine {
+	jobCore := bc.GetJobCore()
+	opts := []job.EngineOption{job.WithTimeout(jobCore.GetTimeout().AsDuration())}
+	if workers := jobCore.GetWorkerTotal(); workers > 0 {
+		opts = append(opts, job.WithWorkers(int(workers)))
+	}
+	if bufferSize := jobCore.GetBufferSize(); bufferSize > 0 {
+		opts = append(opts, job.WithBufferSize(int(bufferSize)))
+	}
+	return job.NewEngine(klog.NewHelper(klog.With(helper.Logger(), "server", "job")), opts...)
+}
+
+// NewJobServer new a job server, it runs strategy evaluation and notification on the job engine,
+// campaigns for the leadership of singleton jobs, flushes the notify groups and fires the
+// escalations while it leads, applies the gitops manifests while it leads when a directory
+// is configured, and serves health checks and metrics on the job address.
+func NewJobServer(
+	bc *conf.Bootstrap,
+	jobEngine *job.Engine,
+	elector *leader.Elector,
+	evaluateBiz *biz.EvaluateBiz,
+	notifyBiz *biz.NotifyBiz,
+	manifestBiz *biz.ManifestBiz,
+	healthService *service.HealthService,
+	helper *klog.Helper,
+) *JobServer {
+	srv := newJobHTTPServer(bc.GetServer().GetJob(), helper)
+	magicboxapiv1.RegisterHealthHTTPServer(srv, healthService)
+	BindMetrics(srv, bc)
+	s := &JobServer{
+		Server:      srv,
+		jobEngine:   jobEngine,
+		elector:     elector,
+		evaluateBiz: evaluateBiz,
+		notifyBiz:   notifyBiz,
+		manifestBiz: manifestBiz,
+		helper:      klog.NewHelper(klog.With(helper.Logger(), "server", "job")),
+		stop:        make(chan struct{}),
+	}
+	if gitops := bc.GetGitops(); gitops.GetDir() != "" {
+		s.manifestInterval = manifestSyncInterval
+		if interval := gitops.GetInterval().AsDuration(); interval > 0 {
+			s.manifestInterval = interval
+		}
+		s.syncManifestsBo = &bo.SyncManifestsBo{
+			Dir:          gitops.GetDir(),
+			NamespaceUID: snowflake.ParseInt64(gitops.GetNamespace()),
+			Creator:      snowflake.ParseInt64(gitops.GetCreator()),
+			Prune:        gitops.GetPrune(),
+			ExpandEnv:    gitops.GetExpandEnv(),
+		}
+	}
+	return s
+}
+
+func newJobHTTPServer(jobConf conf.ServerConfig, helper *klog.Helper) *http.Server {
+	opts := []http.ServerOption{
+		http.Middleware(recovery.Recovery(), logging.Server(helper.Logger())),
+	}
+	if network := jobConf.GetNetwork(); network != "" {
+		opts = append(opts, http.Network(network))
+	}
+	if address := jobConf.GetAddress(); address != "" {
+		opts = append(opts, http.Address(address))
+	}
+	if timeout := jobConf.GetTimeout(); timeout != nil {
+		opts = append(opts, http.Timeout(timeout.AsDuration()))
+	}
+	return http.NewServer(opts...)
+}
+
+// JobServer drives the background work as a transport.Server, so that it starts and
+// stops together with the kratos app. The embedded http server makes the job nodes
+// visible in the registry.
+type JobServer struct {
+	*http.Server
+
+	jobEngine   *job.Engine
+	elector     *leader.Elector
+	evaluateBiz *biz.EvaluateBiz
+	notifyBiz   *biz.NotifyBiz
+	manifestBiz *biz.ManifestBiz
+	helper      *klog.Helper
+	stop        chan struct{}
+	stopOnce    sync.Once
+	// syncManifestsBo is nil unless a gitops directory is configured
+	syncManifestsBo  *bo.SyncManifestsBo
+	manifestInterval time.Duration
+}
+
+// Start implements transport.Server, it blocks until the server is stopped.
+func (s *JobServer) Start(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.Server.Start(ctx)
+	}()
+	defer s.jobEngine.Stop()
+	defer s.evaluateBiz.Stop()
+	defer s.leave()
+	defer s.resign()
+	syncTicker := time.NewTicker(evaluatorSyncInterval)
+	defer syncTicker.Stop()
+	refreshTicker := time.NewTicker(jobNodeRefreshInterval)
+	defer refreshTicker.Stop()
+	campaignTicker := time.NewTicker(s.elector.RenewInterval())
+	defer campaignTicker.Stop()
+	flushTicker := time.NewTicker(notifyFlushInterval)
+	defer flushTicker.Stop()
+	var manifestTick <-chan time.Time
+	if s.syncManifestsBo != nil {
+		manifestTicker := time.NewTicker(s.manifestInterval)
+		defer manifestTicker.Stop()
+		manifestTick = manifestTicker.C
+	}
+	s.campaign(ctx)
+	s.refreshJobNodes(ctx)
+	s.syncRules(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case err := <-errCh:
+			return err
+		case <-refreshTicker.C:
+			if s.refreshJobNodes(ctx) {
+				s.syncRules(ctx)
+			}
+		case <-syncTicker.C:
+			s.syncRules(ctx)
+		case <-campaignTicker.C:
+			s.campaign(ctx)
+		case <-flushTicker.C:
+			s.flushNotifyGroups()
+			s.fireEscalations()
+		case <-manifestTick:
+			s.syncManifests()
+		}
+	}
+}
+
+func (s *JobServer) syncRules(ctx context.Context) {
+	if err := s.evaluateBiz.SyncRules(ctx); err != nil {
+		s.helper.Warnw("msg", "sync evaluator rules failed", "error", err)
+	}
+}
+
+// refreshJobNodes reports whether the strategies owned by this node may have changed.
+func (s *JobServer) refreshJobNodes(ctx context.Context) bool {
+	changed, err := s.evaluateBiz.RefreshJobNodes(ctx)
+	if err != nil {
+		s.helper.Warnw("msg", "refresh job nodes failed", "error", err)
+	}
+	return changed
+}
+
+func (s *JobServer) campaign(ctx context.Context) {
+	if _, err := s.elector.Campaign(ctx); err != nil {
+		s.helper.Warnw("msg", "campaign for leadership failed", "lease", s.elector.Name(), "error", err)
+	}
+}
+
+// flushNotifyGroups queues the flush of the notify groups, it only runs on the leader
+// so that a group is never sent by two nodes.
+func (s *JobServer) flushNotifyGroups() {
+	flush := s.elector.Guard(&job.Job{Name: "flush-notify-groups", Run: s.notifyBiz.FlushNotifyGroups})
+	if err := s.jobEngine.Submit(flush); err != nil {
+		s.helper.Warnw("msg", "submit flush notify groups failed", "error", err)
+	}
+}
+
+// fireEscalations queues paging the escalation steps that are due, it only runs on the leader
+// so that a step is never paged by two nodes.
+func (s *JobServer) fireEscalations() {
+	fire := s.elector.Guard(&job.Job{Name: "fire-escalations", Run: s.notifyBiz.FireEscalations})
+	if err := s.jobEngine.Submit(fire); err != nil {
+		s.helper.Warnw("msg", "submit fire escalations failed", "error", err)
+	}
+}
+
+// syncManifests queues applying the gitops directory, it only runs on the leader so
+// that the nodes do not apply the same manifests concurrently. Its writes go through many
+// repositories, so the token is checked once before applying, the manifests are declarative
+// and applying them twice is harmless.
+func (s *JobServer) syncManifests() {
+	apply := s.elector.Guard(&job.Job{Name: "sync-manifests", Run: func(ctx context.Context) error {
+		if err := s.elector.Fence(ctx, leader.TokenFromContext(ctx)); err != nil {
+			return err
+		}
+		return s.manifestBiz.SyncManifests(ctx, s.syncManifestsBo)
+	}})
+	if err := s.jobEngine.Submit(apply); err != nil {
+		s.helper.Warnw("msg", "submit sync manifests failed", "error", err)
+	}
+}
+
+// resign hands the lease over, so that another node leads without waiting for the lease to expire.
+func (s *JobServer) resign() {
+	ctx, cancel := context.WithTimeout(context.Background(), jobNodeLeaveTimeout)
+	defer cancel()
+	if err := s.elector.Resign(ctx); err != nil {
+		s.helper.Warnw("msg", "resign leadership failed", "lease", s.elector.Name(), "error", err)
+	}
+}
+
+// leave hands the strategies over before the loops stop, the alerts are checkpointed
+// when the loops stop, so the new owners continue them.
+func (s *JobServer) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), jobNodeLeaveTimeout)
+	defer cancel()
+	if err := s.evaluateBiz.LeaveJobNodes(ctx); err != nil {
+		s.helper.Warnw("msg", "leave job nodes failed", "error", err)
+	}
+}
+
+// Stop implements transport.Server.
+func (s *JobServer) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return s.Server.Stop(ctx)
+}
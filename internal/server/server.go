@@ -73,9 +73,10 @@ func (c *protoYAMLCodec) Name() string {
 }
 
 var (
-	ProviderSetServerAll  = wire.NewSet(NewHTTPServer, NewGRPCServer, RegisterService)
+	ProviderSetServerAll  = wire.NewSet(NewHTTPServer, NewGRPCServer, NewJobEngine, NewJobServer, RegisterService)
 	ProviderSetServerHTTP = wire.NewSet(NewHTTPServer, RegisterHTTPService)
 	ProviderSetServerGRPC = wire.NewSet(NewGRPCServer, RegisterGRPCService)
+	ProviderSetServerJob  = wire.NewSet(NewJobEngine, NewJobServer, RegisterJobService)
 )
 
 // init initializes the json.MarshalOptions.
@@ -143,11 +144,23 @@ func RegisterService(
 	c *conf.Bootstrap,
 	httpSrv *http.Server,
 	grpcSrv *grpc.Server,
+	jobSrv *JobServer,
 	authService *service.AuthService,
 	healthService *service.HealthService,
 	namespaceService *service.NamespaceService,
 	levelService *service.LevelService,
 	datasourceService *service.DatasourceService,
+	strategyService *service.StrategyService,
+	strategyMetricService *service.StrategyMetricService,
+	eventService *service.EventService,
+	receiverService *service.ReceiverService,
+	silenceService *service.SilenceService,
+	inhibitRuleService *service.InhibitRuleService,
+	aggregationService *service.AggregationService,
+	escalationPolicyService *service.EscalationPolicyService,
+	onCallScheduleService *service.OnCallScheduleService,
+	manifestService *service.ManifestService,
+	templateService *service.TemplateService,
 ) Servers {
 	var srvs Servers
 
@@ -157,8 +170,20 @@ func RegisterService(
 		namespaceService,
 		levelService,
 		datasourceService,
+		strategyService,
+		strategyMetricService,
+		eventService,
+		receiverService,
+		silenceService,
+		inhibitRuleService,
+		aggregationService,
+		escalationPolicyService,
+		onCallScheduleService,
+		manifestService,
+		templateService,
 	)...)
-	srvs = append(srvs, RegisterGRPCService(c, grpcSrv, healthService, namespaceService, levelService, datasourceService)...)
+	srvs = append(srvs, RegisterGRPCService(c, grpcSrv, healthService, namespaceService, levelService, datasourceService, strategyService, strategyMetricService, eventService, receiverService, silenceService, inhibitRuleService, aggregationService, escalationPolicyService, onCallScheduleService, manifestService, templateService)...)
+	srvs = append(srvs, RegisterJobService(jobSrv)...)
 	return srvs
 }
 
@@ -171,11 +196,33 @@ func RegisterHTTPService(
 	namespaceService *service.NamespaceService,
 	levelService *service.LevelService,
 	datasourceService *service.DatasourceService,
+	strategyService *service.StrategyService,
+	strategyMetricService *service.StrategyMetricService,
+	eventService *service.EventService,
+	receiverService *service.ReceiverService,
+	silenceService *service.SilenceService,
+	inhibitRuleService *service.InhibitRuleService,
+	aggregationService *service.AggregationService,
+	escalationPolicyService *service.EscalationPolicyService,
+	onCallScheduleService *service.OnCallScheduleService,
+	manifestService *service.ManifestService,
+	templateService *service.TemplateService,
 ) Servers {
 	magicboxapiv1.RegisterHealthHTTPServer(httpSrv, healthService)
 	magicboxapiv1.RegisterNamespaceHTTPServer(httpSrv, namespaceService)
 	apiv1.RegisterLevelHTTPServer(httpSrv, levelService)
 	apiv1.RegisterDatasourceHTTPServer(httpSrv, datasourceService)
+	apiv1.RegisterStrategyHTTPServer(httpSrv, strategyService)
+	apiv1.RegisterStrategyMetricHTTPServer(httpSrv, strategyMetricService)
+	apiv1.RegisterEventHTTPServer(httpSrv, eventService)
+	apiv1.RegisterReceiverHTTPServer(httpSrv, receiverService)
+	apiv1.RegisterSilenceHTTPServer(httpSrv, silenceService)
+	apiv1.RegisterInhibitRuleHTTPServer(httpSrv, inhibitRuleService)
+	apiv1.RegisterAggregationHTTPServer(httpSrv, aggregationService)
+	apiv1.RegisterEscalationPolicyHTTPServer(httpSrv, escalationPolicyService)
+	apiv1.RegisterOnCallScheduleHTTPServer(httpSrv, onCallScheduleService)
+	apiv1.RegisterManifestHTTPServer(httpSrv, manifestService)
+	apiv1.RegisterTemplateHTTPServer(httpSrv, templateService)
 
 	oauth2Handler := oauth.NewOAuth2Handler(c.GetOauth2(), authService.Login)
 	if err := oauth2Handler.Handler(httpSrv); err != nil {
@@ -192,14 +239,41 @@ func RegisterGRPCService(
 	namespaceService *service.NamespaceService,
 	levelService *service.LevelService,
 	datasourceService *service.DatasourceService,
+	strategyService *service.StrategyService,
+	strategyMetricService *service.StrategyMetricService,
+	eventService *service.EventService,
+	receiverService *service.ReceiverService,
+	silenceService *service.SilenceService,
+	inhibitRuleService *service.InhibitRuleService,
+	aggregationService *service.AggregationService,
+	escalationPolicyService *service.EscalationPolicyService,
+	onCallScheduleService *service.OnCallScheduleService,
+	manifestService *service.ManifestService,
+	templateService *service.TemplateService,
 ) Servers {
 	magicboxapiv1.RegisterHealthServer(grpcSrv, healthService)
 	magicboxapiv1.RegisterNamespaceServer(grpcSrv, namespaceService)
 	apiv1.RegisterLevelServer(grpcSrv, levelService)
 	apiv1.RegisterDatasourceServer(grpcSrv, datasourceService)
+	apiv1.RegisterStrategyServer(grpcSrv, strategyService)
+	apiv1.RegisterStrategyMetricServer(grpcSrv, strategyMetricService)
+	apiv1.RegisterEventServer(grpcSrv, eventService)
+	apiv1.RegisterReceiverServer(grpcSrv, receiverService)
+	apiv1.RegisterSilenceServer(grpcSrv, silenceService)
+	apiv1.RegisterInhibitRuleServer(grpcSrv, inhibitRuleService)
+	apiv1.RegisterAggregationServer(grpcSrv, aggregationService)
+	apiv1.RegisterEscalationPolicyServer(grpcSrv, escalationPolicyService)
+	apiv1.RegisterOnCallScheduleServer(grpcSrv, onCallScheduleService)
+	apiv1.RegisterManifestServer(grpcSrv, manifestService)
+	apiv1.RegisterTemplateServer(grpcSrv, templateService)
 	return Servers{newServer("grpc", grpcSrv)}
 }
 
+// RegisterJobService registers only the job server.
+func RegisterJobService(jobSrv *JobServer) Servers {
+	return Servers{newServer("job", jobSrv)}
+}
+
 var namespaceAllowList = []string{
 	magicboxapiv1.OperationNamespaceCreateNamespace,
 	magicboxapiv1.OperationNamespaceUpdateNamespace,
@@ -219,6 +293,82 @@ var namespaceAllowList = []string{
 	apiv1.OperationDatasourceDeleteDatasource,
 	apiv1.OperationDatasourceGetDatasource,
 	apiv1.OperationDatasourceListDatasource,
+	apiv1.OperationDatasourceTestDatasource,
+	apiv1.OperationStrategyCreateStrategyGroup,
+	apiv1.OperationStrategyUpdateStrategyGroup,
+	apiv1.OperationStrategyUpdateStrategyGroupStatus,
+	apiv1.OperationStrategyDeleteStrategyGroup,
+	apiv1.OperationStrategyGetStrategyGroup,
+	apiv1.OperationStrategyListStrategyGroup,
+	apiv1.OperationStrategySelectStrategyGroup,
+	apiv1.OperationStrategyStrategyGroupBindReceivers,
+	apiv1.OperationStrategyCreateStrategy,
+	apiv1.OperationStrategyUpdateStrategy,
+	apiv1.OperationStrategyUpdateStrategyStatus,
+	apiv1.OperationStrategyDeleteStrategy,
+	apiv1.OperationStrategyGetStrategy,
+	apiv1.OperationStrategyListStrategy,
+	apiv1.OperationStrategyImportPrometheusRules,
+	apiv1.OperationStrategyExportPrometheusRules,
+	apiv1.OperationStrategyMetricSaveStrategyMetric,
+	apiv1.OperationStrategyMetricGetStrategyMetric,
+	apiv1.OperationStrategyMetricSaveStrategyMetricLevel,
+	apiv1.OperationStrategyMetricUpdateStrategyMetricLevelStatus,
+	apiv1.OperationStrategyMetricDeleteStrategyMetricLevel,
+	apiv1.OperationStrategyMetricGetStrategyMetricLevel,
+	apiv1.OperationStrategyMetricStrategyMetricBindReceivers,
+	apiv1.OperationStrategyMetricParseStrategyMetricExpr,
+	apiv1.OperationStrategyMetricBacktestStrategyMetric,
+	apiv1.OperationEventGetEvent,
+	apiv1.OperationEventListEvent,
+	apiv1.OperationEventGetEventTimeline,
+	apiv1.OperationEventAckEvent,
+	apiv1.OperationEventAssignEvent,
+	apiv1.OperationEventAddEventNote,
+	apiv1.OperationEventResolveEvent,
+	apiv1.OperationEventListEventActivity,
+	apiv1.OperationReceiverCreateReceiver,
+	apiv1.OperationReceiverUpdateReceiver,
+	apiv1.OperationReceiverUpdateReceiverStatus,
+	apiv1.OperationReceiverDeleteReceiver,
+	apiv1.OperationReceiverGetReceiver,
+	apiv1.OperationReceiverListReceiver,
+	apiv1.OperationReceiverListReceiverDelivery,
+	apiv1.OperationSilenceCreateSilence,
+	apiv1.OperationSilenceUpdateSilence,
+	apiv1.OperationSilenceDeleteSilence,
+	apiv1.OperationSilenceGetSilence,
+	apiv1.OperationSilenceListSilence,
+	apiv1.OperationSilenceMatchSilences,
+	apiv1.OperationInhibitRuleCreateInhibitRule,
+	apiv1.OperationInhibitRuleUpdateInhibitRule,
+	apiv1.OperationInhibitRuleUpdateInhibitRuleStatus,
+	apiv1.OperationInhibitRuleDeleteInhibitRule,
+	apiv1.OperationInhibitRuleGetInhibitRule,
+	apiv1.OperationInhibitRuleListInhibitRule,
+	apiv1.OperationInhibitRuleEvaluateInhibition,
+	apiv1.OperationAggregationSaveAggregation,
+	apiv1.OperationAggregationGetAggregation,
+	apiv1.OperationAggregationDeleteAggregation,
+	apiv1.OperationEscalationPolicyCreateEscalationPolicy,
+	apiv1.OperationEscalationPolicyUpdateEscalationPolicy,
+	apiv1.OperationEscalationPolicyUpdateEscalationPolicyStatus,
+	apiv1.OperationEscalationPolicyDeleteEscalationPolicy,
+	apiv1.OperationEscalationPolicyGetEscalationPolicy,
+	apiv1.OperationEscalationPolicyListEscalationPolicy,
+	apiv1.OperationOnCallScheduleCreateOnCallSchedule,
+	apiv1.OperationOnCallScheduleUpdateOnCallSchedule,
+	apiv1.OperationOnCallScheduleUpdateOnCallScheduleStatus,
+	apiv1.OperationOnCallScheduleDeleteOnCallSchedule,
+	apiv1.OperationOnCallScheduleGetOnCallSchedule,
+	apiv1.OperationOnCallScheduleListOnCallSchedule,
+	apiv1.OperationOnCallScheduleCreateOnCallOverride,
+	apiv1.OperationOnCallScheduleDeleteOnCallOverride,
+	apiv1.OperationOnCallScheduleListOnCallOverride,
+	apiv1.OperationOnCallScheduleGetOnCall,
+	apiv1.OperationOnCallSchedulePreviewOnCallShifts,
+	apiv1.OperationManifestApplyManifests,
+	apiv1.OperationTemplateRenderPreview,
 }
 
 var authAllowList = []string{
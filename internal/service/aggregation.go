@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewAggregationService(aggregationBiz *biz.AggregationBiz) *AggregationService {
+	return &AggregationService{
+		aggregationBiz: aggregationBiz,
+	}
+}
+
+type AggregationService struct {
+	apiv1.UnimplementedAggregationServer
+
+	aggregationBiz *biz.AggregationBiz
+}
+
+func (s *AggregationService) SaveAggregation(ctx context.Context, req *apiv1.SaveAggregationRequest) (*apiv1.SaveAggregationReply, error) {
+	saveBo, err := bo.NewSaveAggregationBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.aggregationBiz.SaveAggregation(ctx, saveBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.SaveAggregationReply{}, nil
+}
+
+func (s *AggregationService) GetAggregation(ctx context.Context, req *apiv1.GetAggregationRequest) (*apiv1.AggregationItem, error) {
+	item, err := s.aggregationBiz.GetAggregation(ctx, snowflake.ParseInt64(req.GetStrategyGroupUID()))
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1AggregationItem(), nil
+}
+
+func (s *AggregationService) DeleteAggregation(ctx context.Context, req *apiv1.DeleteAggregationRequest) (*apiv1.DeleteAggregationReply, error) {
+	if err := s.aggregationBiz.DeleteAggregation(ctx, snowflake.ParseInt64(req.GetStrategyGroupUID())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteAggregationReply{}, nil
+}
@@ -22,7 +22,10 @@ type DatasourceService struct {
 }
 
 func (s *DatasourceService) CreateDatasource(ctx context.Context, req *apiv1.CreateDatasourceRequest) (*apiv1.CreateDatasourceReply, error) {
-	createBo := bo.NewCreateDatasourceBo(req)
+	createBo, err := bo.NewCreateDatasourceBo(req)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.datasourceBiz.CreateDatasource(ctx, createBo); err != nil {
 		return nil, err
 	}
@@ -30,7 +33,10 @@ func (s *DatasourceService) CreateDatasource(ctx context.Context, req *apiv1.Cre
 }
 
 func (s *DatasourceService) UpdateDatasource(ctx context.Context, req *apiv1.UpdateDatasourceRequest) (*apiv1.UpdateDatasourceReply, error) {
-	updateBo := bo.NewUpdateDatasourceBo(req)
+	updateBo, err := bo.NewUpdateDatasourceBo(req)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.datasourceBiz.UpdateDatasource(ctx, updateBo); err != nil {
 		return nil, err
 	}
@@ -59,3 +65,19 @@ func (s *DatasourceService) ListDatasource(ctx context.Context, req *apiv1.ListD
 	}
 	return bo.ToAPIV1ListDatasourceReply(result), nil
 }
+
+func (s *DatasourceService) TestDatasource(ctx context.Context, req *apiv1.TestDatasourceRequest) (*apiv1.TestDatasourceReply, error) {
+	testBo, err := bo.NewTestDatasourceBo(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.datasourceBiz.TestDatasource(ctx, testBo)
+	if err != nil {
+		return nil, err
+	}
+	return result.ToAPIV1TestDatasourceReply(), nil
+}
+
+func (s *DatasourceService) ListDatasourceConfigSchema(ctx context.Context, req *apiv1.ListDatasourceConfigSchemaRequest) (*apiv1.ListDatasourceConfigSchemaReply, error) {
+	return bo.ToAPIV1ListDatasourceConfigSchemaReply(bo.ListDatasourceConfigSchema(req.GetDriver())), nil
+}
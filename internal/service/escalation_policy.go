@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewEscalationPolicyService(escalationPolicyBiz *biz.EscalationPolicyBiz) *EscalationPolicyService {
+	return &EscalationPolicyService{
+		escalationPolicyBiz: escalationPolicyBiz,
+	}
+}
+
+type EscalationPolicyService struct {
+	apiv1.UnimplementedEscalationPolicyServer
+
+	escalationPolicyBiz *biz.EscalationPolicyBiz
+}
+
+func (s *EscalationPolicyService) CreateEscalationPolicy(ctx context.Context, req *apiv1.CreateEscalationPolicyRequest) (*apiv1.CreateEscalationPolicyReply, error) {
+	createBo, err := bo.NewCreateEscalationPolicyBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.escalationPolicyBiz.CreateEscalationPolicy(ctx, createBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateEscalationPolicyReply{}, nil
+}
+
+func (s *EscalationPolicyService) UpdateEscalationPolicy(ctx context.Context, req *apiv1.UpdateEscalationPolicyRequest) (*apiv1.UpdateEscalationPolicyReply, error) {
+	updateBo, err := bo.NewUpdateEscalationPolicyBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.escalationPolicyBiz.UpdateEscalationPolicy(ctx, updateBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateEscalationPolicyReply{}, nil
+}
+
+func (s *EscalationPolicyService) UpdateEscalationPolicyStatus(ctx context.Context, req *apiv1.UpdateEscalationPolicyStatusRequest) (*apiv1.UpdateEscalationPolicyStatusReply, error) {
+	if err := s.escalationPolicyBiz.UpdateEscalationPolicyStatus(ctx, bo.NewUpdateEscalationPolicyStatusBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateEscalationPolicyStatusReply{}, nil
+}
+
+func (s *EscalationPolicyService) DeleteEscalationPolicy(ctx context.Context, req *apiv1.DeleteEscalationPolicyRequest) (*apiv1.DeleteEscalationPolicyReply, error) {
+	if err := s.escalationPolicyBiz.DeleteEscalationPolicy(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteEscalationPolicyReply{}, nil
+}
+
+func (s *EscalationPolicyService) GetEscalationPolicy(ctx context.Context, req *apiv1.GetEscalationPolicyRequest) (*apiv1.EscalationPolicyItem, error) {
+	item, err := s.escalationPolicyBiz.GetEscalationPolicy(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1EscalationPolicyItem(), nil
+}
+
+func (s *EscalationPolicyService) ListEscalationPolicy(ctx context.Context, req *apiv1.ListEscalationPolicyRequest) (*apiv1.ListEscalationPolicyReply, error) {
+	result, err := s.escalationPolicyBiz.ListEscalationPolicy(ctx, bo.NewListEscalationPolicyBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListEscalationPolicyReply(result), nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewEventService(eventBiz *biz.EventBiz) *EventService {
+	return &EventService{
+		eventBiz: eventBiz,
+	}
+}
+
+type EventService struct {
+	apiv1.UnimplementedEventServer
+
+	eventBiz *biz.EventBiz
+}
+
+func (s *EventService) GetEvent(ctx context.Context, req *apiv1.GetEventRequest) (*apiv1.EventItem, error) {
+	item, err := s.eventBiz.GetEvent(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1EventItem(), nil
+}
+
+func (s *EventService) ListEvent(ctx context.Context, req *apiv1.ListEventRequest) (*apiv1.ListEventReply, error) {
+	listBo, err := bo.NewListEventBo(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.eventBiz.ListEvent(ctx, listBo)
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListEventReply(result), nil
+}
+
+func (s *EventService) GetEventTimeline(ctx context.Context, req *apiv1.GetEventTimelineRequest) (*apiv1.GetEventTimelineReply, error) {
+	items, err := s.eventBiz.GetEventTimeline(ctx, bo.NewGetEventTimelineBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1GetEventTimelineReply(items), nil
+}
+
+func (s *EventService) AckEvent(ctx context.Context, req *apiv1.AckEventRequest) (*apiv1.AckEventReply, error) {
+	if err := s.eventBiz.SaveEventActivity(ctx, bo.NewAckEventActivityBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.AckEventReply{}, nil
+}
+
+func (s *EventService) AssignEvent(ctx context.Context, req *apiv1.AssignEventRequest) (*apiv1.AssignEventReply, error) {
+	if err := s.eventBiz.SaveEventActivity(ctx, bo.NewAssignEventActivityBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.AssignEventReply{}, nil
+}
+
+func (s *EventService) AddEventNote(ctx context.Context, req *apiv1.AddEventNoteRequest) (*apiv1.AddEventNoteReply, error) {
+	if err := s.eventBiz.SaveEventActivity(ctx, bo.NewNoteEventActivityBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.AddEventNoteReply{}, nil
+}
+
+func (s *EventService) ResolveEvent(ctx context.Context, req *apiv1.ResolveEventRequest) (*apiv1.ResolveEventReply, error) {
+	if err := s.eventBiz.SaveEventActivity(ctx, bo.NewResolveEventActivityBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.ResolveEventReply{}, nil
+}
+
+func (s *EventService) ListEventActivity(ctx context.Context, req *apiv1.ListEventActivityRequest) (*apiv1.ListEventActivityReply, error) {
+	items, err := s.eventBiz.ListEventActivity(ctx, bo.NewListEventActivityBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListEventActivityReply(items), nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewInhibitRuleService(inhibitRuleBiz *biz.InhibitRuleBiz) *InhibitRuleService {
+	return &InhibitRuleService{
+		inhibitRuleBiz: inhibitRuleBiz,
+	}
+}
+
+type InhibitRuleService struct {
+	apiv1.UnimplementedInhibitRuleServer
+
+	inhibitRuleBiz *biz.InhibitRuleBiz
+}
+
+func (s *InhibitRuleService) CreateInhibitRule(ctx context.Context, req *apiv1.CreateInhibitRuleRequest) (*apiv1.CreateInhibitRuleReply, error) {
+	createBo, err := bo.NewCreateInhibitRuleBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.inhibitRuleBiz.CreateInhibitRule(ctx, createBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateInhibitRuleReply{}, nil
+}
+
+func (s *InhibitRuleService) UpdateInhibitRule(ctx context.Context, req *apiv1.UpdateInhibitRuleRequest) (*apiv1.UpdateInhibitRuleReply, error) {
+	updateBo, err := bo.NewUpdateInhibitRuleBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.inhibitRuleBiz.UpdateInhibitRule(ctx, updateBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateInhibitRuleReply{}, nil
+}
+
+func (s *InhibitRuleService) UpdateInhibitRuleStatus(ctx context.Context, req *apiv1.UpdateInhibitRuleStatusRequest) (*apiv1.UpdateInhibitRuleStatusReply, error) {
+	if err := s.inhibitRuleBiz.UpdateInhibitRuleStatus(ctx, bo.NewUpdateInhibitRuleStatusBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateInhibitRuleStatusReply{}, nil
+}
+
+func (s *InhibitRuleService) DeleteInhibitRule(ctx context.Context, req *apiv1.DeleteInhibitRuleRequest) (*apiv1.DeleteInhibitRuleReply, error) {
+	if err := s.inhibitRuleBiz.DeleteInhibitRule(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteInhibitRuleReply{}, nil
+}
+
+func (s *InhibitRuleService) GetInhibitRule(ctx context.Context, req *apiv1.GetInhibitRuleRequest) (*apiv1.InhibitRuleItem, error) {
+	item, err := s.inhibitRuleBiz.GetInhibitRule(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1InhibitRuleItem(), nil
+}
+
+func (s *InhibitRuleService) ListInhibitRule(ctx context.Context, req *apiv1.ListInhibitRuleRequest) (*apiv1.ListInhibitRuleReply, error) {
+	result, err := s.inhibitRuleBiz.ListInhibitRule(ctx, bo.NewListInhibitRuleBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListInhibitRuleReply(result), nil
+}
+
+func (s *InhibitRuleService) EvaluateInhibition(ctx context.Context, req *apiv1.EvaluateInhibitionRequest) (*apiv1.EvaluateInhibitionReply, error) {
+	results, err := s.inhibitRuleBiz.EvaluateInhibition(ctx, bo.NewEvaluateInhibitionAlerts(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1EvaluateInhibitionReply(results), nil
+}
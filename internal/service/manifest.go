@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewManifestService(manifestBiz *biz.ManifestBiz) *ManifestService {
+	return &ManifestService{
+		manifestBiz: manifestBiz,
+	}
+}
+
+type ManifestService struct {
+	apiv1.UnimplementedManifestServer
+
+	manifestBiz *biz.ManifestBiz
+}
+
+func (s *ManifestService) ApplyManifests(ctx context.Context, req *apiv1.ApplyManifestsRequest) (*apiv1.ApplyManifestsReply, error) {
+	applyBo, err := bo.NewApplyManifestsBo(req)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.manifestBiz.ApplyManifests(ctx, applyBo)
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ApplyManifestsReply(applyBo.DryRun, changes), nil
+}
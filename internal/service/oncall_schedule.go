@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewOnCallScheduleService(onCallScheduleBiz *biz.OnCallScheduleBiz) *OnCallScheduleService {
+	return &OnCallScheduleService{
+		onCallScheduleBiz: onCallScheduleBiz,
+	}
+}
+
+type OnCallScheduleService struct {
+	apiv1.UnimplementedOnCallScheduleServer
+
+	onCallScheduleBiz *biz.OnCallScheduleBiz
+}
+
+func (s *OnCallScheduleService) CreateOnCallSchedule(ctx context.Context, req *apiv1.CreateOnCallScheduleRequest) (*apiv1.CreateOnCallScheduleReply, error) {
+	createBo, err := bo.NewCreateOnCallScheduleBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.onCallScheduleBiz.CreateOnCallSchedule(ctx, createBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateOnCallScheduleReply{}, nil
+}
+
+func (s *OnCallScheduleService) UpdateOnCallSchedule(ctx context.Context, req *apiv1.UpdateOnCallScheduleRequest) (*apiv1.UpdateOnCallScheduleReply, error) {
+	updateBo, err := bo.NewUpdateOnCallScheduleBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.onCallScheduleBiz.UpdateOnCallSchedule(ctx, updateBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateOnCallScheduleReply{}, nil
+}
+
+func (s *OnCallScheduleService) UpdateOnCallScheduleStatus(ctx context.Context, req *apiv1.UpdateOnCallScheduleStatusRequest) (*apiv1.UpdateOnCallScheduleStatusReply, error) {
+	if err := s.onCallScheduleBiz.UpdateOnCallScheduleStatus(ctx, bo.NewUpdateOnCallScheduleStatusBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateOnCallScheduleStatusReply{}, nil
+}
+
+func (s *OnCallScheduleService) DeleteOnCallSchedule(ctx context.Context, req *apiv1.DeleteOnCallScheduleRequest) (*apiv1.DeleteOnCallScheduleReply, error) {
+	if err := s.onCallScheduleBiz.DeleteOnCallSchedule(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteOnCallScheduleReply{}, nil
+}
+
+func (s *OnCallScheduleService) GetOnCallSchedule(ctx context.Context, req *apiv1.GetOnCallScheduleRequest) (*apiv1.OnCallScheduleItem, error) {
+	item, err := s.onCallScheduleBiz.GetOnCallSchedule(ctx, snowflake.ParseInt64(req.GetUid()), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1OnCallScheduleItem(), nil
+}
+
+func (s *OnCallScheduleService) ListOnCallSchedule(ctx context.Context, req *apiv1.ListOnCallScheduleRequest) (*apiv1.ListOnCallScheduleReply, error) {
+	result, err := s.onCallScheduleBiz.ListOnCallSchedule(ctx, bo.NewListOnCallScheduleBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListOnCallScheduleReply(result), nil
+}
+
+func (s *OnCallScheduleService) CreateOnCallOverride(ctx context.Context, req *apiv1.CreateOnCallOverrideRequest) (*apiv1.CreateOnCallOverrideReply, error) {
+	createBo, err := bo.NewCreateOnCallOverrideBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.onCallScheduleBiz.CreateOnCallOverride(ctx, createBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateOnCallOverrideReply{}, nil
+}
+
+func (s *OnCallScheduleService) DeleteOnCallOverride(ctx context.Context, req *apiv1.DeleteOnCallOverrideRequest) (*apiv1.DeleteOnCallOverrideReply, error) {
+	if err := s.onCallScheduleBiz.DeleteOnCallOverride(ctx, bo.NewDeleteOnCallOverrideBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteOnCallOverrideReply{}, nil
+}
+
+func (s *OnCallScheduleService) ListOnCallOverride(ctx context.Context, req *apiv1.ListOnCallOverrideRequest) (*apiv1.ListOnCallOverrideReply, error) {
+	schedule, items, err := s.onCallScheduleBiz.ListOnCallOverride(ctx, bo.NewListOnCallOverrideBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListOnCallOverrideReply(schedule, items), nil
+}
+
+func (s *OnCallScheduleService) GetOnCall(ctx context.Context, req *apiv1.GetOnCallRequest) (*apiv1.GetOnCallReply, error) {
+	schedule, shift, err := s.onCallScheduleBiz.GetOnCall(ctx, bo.NewGetOnCallBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.GetOnCallReply{Shift: bo.ToAPIV1OnCallShift(shift, schedule.Location())}, nil
+}
+
+func (s *OnCallScheduleService) PreviewOnCallShifts(ctx context.Context, req *apiv1.PreviewOnCallShiftsRequest) (*apiv1.PreviewOnCallShiftsReply, error) {
+	schedule, shifts, err := s.onCallScheduleBiz.PreviewOnCallShifts(ctx, bo.NewPreviewOnCallShiftsBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1PreviewOnCallShiftsReply(shifts, schedule.Location()), nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewReceiverService(receiverBiz *biz.ReceiverBiz) *ReceiverService {
+	return &ReceiverService{
+		receiverBiz: receiverBiz,
+	}
+}
+
+type ReceiverService struct {
+	apiv1.UnimplementedReceiverServer
+
+	receiverBiz *biz.ReceiverBiz
+}
+
+func (s *ReceiverService) CreateReceiver(ctx context.Context, req *apiv1.CreateReceiverRequest) (*apiv1.CreateReceiverReply, error) {
+	createBo, err := bo.NewCreateReceiverBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.receiverBiz.CreateReceiver(ctx, createBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateReceiverReply{}, nil
+}
+
+func (s *ReceiverService) UpdateReceiver(ctx context.Context, req *apiv1.UpdateReceiverRequest) (*apiv1.UpdateReceiverReply, error) {
+	updateBo, err := bo.NewUpdateReceiverBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.receiverBiz.UpdateReceiver(ctx, updateBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateReceiverReply{}, nil
+}
+
+func (s *ReceiverService) UpdateReceiverStatus(ctx context.Context, req *apiv1.UpdateReceiverStatusRequest) (*apiv1.UpdateReceiverStatusReply, error) {
+	if err := s.receiverBiz.UpdateReceiverStatus(ctx, bo.NewUpdateReceiverStatusBo(req)); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateReceiverStatusReply{}, nil
+}
+
+func (s *ReceiverService) DeleteReceiver(ctx context.Context, req *apiv1.DeleteReceiverRequest) (*apiv1.DeleteReceiverReply, error) {
+	if err := s.receiverBiz.DeleteReceiver(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteReceiverReply{}, nil
+}
+
+func (s *ReceiverService) GetReceiver(ctx context.Context, req *apiv1.GetReceiverRequest) (*apiv1.ReceiverItem, error) {
+	item, err := s.receiverBiz.GetReceiver(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1ReceiverItem(), nil
+}
+
+func (s *ReceiverService) ListReceiver(ctx context.Context, req *apiv1.ListReceiverRequest) (*apiv1.ListReceiverReply, error) {
+	result, err := s.receiverBiz.ListReceiver(ctx, bo.NewListReceiverBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListReceiverReply(result), nil
+}
+
+func (s *ReceiverService) ListReceiverDelivery(ctx context.Context, req *apiv1.ListReceiverDeliveryRequest) (*apiv1.ListReceiverDeliveryReply, error) {
+	result, err := s.receiverBiz.ListReceiverDelivery(ctx, bo.NewListReceiverDeliveryBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListReceiverDeliveryReply(result), nil
+}
@@ -9,5 +9,16 @@ var ProviderSetService = wire.NewSet(
 	NewNamespaceService,
 	NewLevelService,
 	NewDatasourceService,
+	NewStrategyService,
+	NewStrategyMetricService,
+	NewEventService,
+	NewReceiverService,
+	NewSilenceService,
+	NewInhibitRuleService,
+	NewAggregationService,
+	NewEscalationPolicyService,
+	NewOnCallScheduleService,
+	NewManifestService,
+	NewTemplateService,
 	NewAuthService,
 )
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+	"github.com/bwmarrin/snowflake"
+)
+
+func NewSilenceService(silenceBiz *biz.SilenceBiz) *SilenceService {
+	return &SilenceService{
+		silenceBiz: silenceBiz,
+	}
+}
+
+type SilenceService struct {
+	apiv1.UnimplementedSilenceServer
+
+	silenceBiz *biz.SilenceBiz
+}
+
+func (s *SilenceService) CreateSilence(ctx context.Context, req *apiv1.CreateSilenceRequest) (*apiv1.CreateSilenceReply, error) {
+	createBo, err := bo.NewCreateSilenceBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.silenceBiz.CreateSilence(ctx, createBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.CreateSilenceReply{}, nil
+}
+
+func (s *SilenceService) UpdateSilence(ctx context.Context, req *apiv1.UpdateSilenceRequest) (*apiv1.UpdateSilenceReply, error) {
+	updateBo, err := bo.NewUpdateSilenceBo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.silenceBiz.UpdateSilence(ctx, updateBo); err != nil {
+		return nil, err
+	}
+	return &apiv1.UpdateSilenceReply{}, nil
+}
+
+func (s *SilenceService) DeleteSilence(ctx context.Context, req *apiv1.DeleteSilenceRequest) (*apiv1.DeleteSilenceReply, error) {
+	if err := s.silenceBiz.DeleteSilence(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteSilenceReply{}, nil
+}
+
+func (s *SilenceService) GetSilence(ctx context.Context, req *apiv1.GetSilenceRequest) (*apiv1.SilenceItem, error) {
+	item, err := s.silenceBiz.GetSilence(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return item.ToAPIV1SilenceItem(), nil
+}
+
+func (s *SilenceService) ListSilence(ctx context.Context, req *apiv1.ListSilenceRequest) (*apiv1.ListSilenceReply, error) {
+	result, err := s.silenceBiz.ListSilence(ctx, bo.NewListSilenceBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListSilenceReply(result), nil
+}
+
+func (s *SilenceService) MatchSilences(ctx context.Context, req *apiv1.MatchSilencesRequest) (*apiv1.MatchSilencesReply, error) {
+	matchBo, err := bo.NewMatchSilencesBo(req)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.silenceBiz.MatchSilences(ctx, matchBo)
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1MatchSilencesReply(list), nil
+}
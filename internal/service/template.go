@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/marksman/internal/biz"
+	"github.com/aide-family/marksman/internal/biz/bo"
+	apiv1 "github.com/aide-family/marksman/pkg/api/v1"
+)
+
+func NewTemplateService(strategyMetricBiz *biz.StrategyMetricBiz) *TemplateService {
+	return &TemplateService{
+		strategyMetricBiz: strategyMetricBiz,
+	}
+}
+
+type TemplateService struct {
+	apiv1.UnimplementedTemplateServer
+
+	strategyMetricBiz *biz.StrategyMetricBiz
+}
+
+func (s *TemplateService) RenderPreview(ctx context.Context, req *apiv1.RenderPreviewRequest) (*apiv1.RenderPreviewReply, error) {
+	result, err := s.strategyMetricBiz.RenderPreview(ctx, bo.NewRenderPreviewBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return result.ToAPIV1RenderPreviewReply(), nil
+}
@@ -11,10 +11,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/aide-family/marksman/cmd"
+	"github.com/aide-family/marksman/cmd/apply"
 	"github.com/aide-family/marksman/cmd/run"
 	"github.com/aide-family/marksman/cmd/run/all"
 	"github.com/aide-family/marksman/cmd/run/grpc"
 	"github.com/aide-family/marksman/cmd/run/http"
+	"github.com/aide-family/marksman/cmd/run/job"
+	"github.com/aide-family/marksman/cmd/secret"
+	"github.com/aide-family/marksman/cmd/strategy"
 	"github.com/aide-family/marksman/cmd/version"
 )
 
@@ -60,11 +64,14 @@ func init() {
 
 func main() {
 	runCmd := run.NewCmd(defaultServerConfig)
-	runCmd.AddCommand(grpc.NewCmd(), http.NewCmd(), all.NewCmd())
+	runCmd.AddCommand(grpc.NewCmd(), http.NewCmd(), job.NewCmd(), all.NewCmd())
 
 	children := []*cobra.Command{
 		version.NewCmd(),
 		runCmd,
+		secret.NewCmd(defaultServerConfig),
+		strategy.NewCmd(),
+		apply.NewCmd(),
 	}
 	cmd.Execute(cmd.NewCmd(), children...)
 }
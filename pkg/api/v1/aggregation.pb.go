@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.3
+// source: marksman/api/v1/aggregation.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AggregationItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StrategyGroupUID int64                  `protobuf:"varint,1,opt,name=strategyGroupUID,proto3" json:"strategyGroupUID,omitempty"`
+	GroupBy          []string               `protobuf:"bytes,2,rep,name=groupBy,proto3" json:"groupBy,omitempty"`
+	GroupWait        *durationpb.Duration   `protobuf:"bytes,3,opt,name=groupWait,proto3" json:"groupWait,omitempty"`
+	GroupInterval    *durationpb.Duration   `protobuf:"bytes,4,opt,name=groupInterval,proto3" json:"groupInterval,omitempty"`
+	RepeatInterval   *durationpb.Duration   `protobuf:"bytes,5,opt,name=repeatInterval,proto3" json:"repeatInterval,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AggregationItem) Reset() {
+	*x = AggregationItem{}
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregationItem) ProtoMessage() {}
+
+func (x *AggregationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregationItem.ProtoReflect.Descriptor instead.
+func (*AggregationItem) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_aggregation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AggregationItem) GetStrategyGroupUID() int64 {
+	if x != nil {
+		return x.StrategyGroupUID
+	}
+	return 0
+}
+
+func (x *AggregationItem) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregationItem) GetGroupWait() *durationpb.Duration {
+	if x != nil {
+		return x.GroupWait
+	}
+	return nil
+}
+
+func (x *AggregationItem) GetGroupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.GroupInterval
+	}
+	return nil
+}
+
+func (x *AggregationItem) GetRepeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RepeatInterval
+	}
+	return nil
+}
+
+func (x *AggregationItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AggregationItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SaveAggregationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StrategyGroupUID int64                  `protobuf:"varint,1,opt,name=strategyGroupUID,proto3" json:"strategyGroupUID,omitempty"`
+	GroupBy          []string               `protobuf:"bytes,2,rep,name=groupBy,proto3" json:"groupBy,omitempty"`
+	GroupWait        *durationpb.Duration   `protobuf:"bytes,3,opt,name=groupWait,proto3" json:"groupWait,omitempty"`
+	GroupInterval    *durationpb.Duration   `protobuf:"bytes,4,opt,name=groupInterval,proto3" json:"groupInterval,omitempty"`
+	RepeatInterval   *durationpb.Duration   `protobuf:"bytes,5,opt,name=repeatInterval,proto3" json:"repeatInterval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SaveAggregationRequest) Reset() {
+	*x = SaveAggregationRequest{}
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAggregationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAggregationRequest) ProtoMessage() {}
+
+func (x *SaveAggregationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAggregationRequest.ProtoReflect.Descriptor instead.
+func (*SaveAggregationRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_aggregation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveAggregationRequest) GetStrategyGroupUID() int64 {
+	if x != nil {
+		return x.StrategyGroupUID
+	}
+	return 0
+}
+
+func (x *SaveAggregationRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *SaveAggregationRequest) GetGroupWait() *durationpb.Duration {
+	if x != nil {
+		return x.GroupWait
+	}
+	return nil
+}
+
+func (x *SaveAggregationRequest) GetGroupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.GroupInterval
+	}
+	return nil
+}
+
+func (x *SaveAggregationRequest) GetRepeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RepeatInterval
+	}
+	return nil
+}
+
+type SaveAggregationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAggregationReply) Reset() {
+	*x = SaveAggregationReply{}
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAggregationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAggregationReply) ProtoMessage() {}
+
+func (x *SaveAggregationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAggregationReply.ProtoReflect.Descriptor instead.
+func (*SaveAggregationReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_aggregation_proto_rawDescGZIP(), []int{2}
+}
+
+type GetAggregationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StrategyGroupUID int64                  `protobuf:"varint,1,opt,name=strategyGroupUID,proto3" json:"strategyGroupUID,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetAggregationRequest) Reset() {
+	*x = GetAggregationRequest{}
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAggregationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAggregationRequest) ProtoMessage() {}
+
+func (x *GetAggregationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAggregationRequest.ProtoReflect.Descriptor instead.
+func (*GetAggregationRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_aggregation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAggregationRequest) GetStrategyGroupUID() int64 {
+	if x != nil {
+		return x.StrategyGroupUID
+	}
+	return 0
+}
+
+type DeleteAggregationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StrategyGroupUID int64                  `protobuf:"varint,1,opt,name=strategyGroupUID,proto3" json:"strategyGroupUID,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteAggregationRequest) Reset() {
+	*x = DeleteAggregationRequest{}
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAggregationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAggregationRequest) ProtoMessage() {}
+
+func (x *DeleteAggregationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAggregationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAggregationRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_aggregation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteAggregationRequest) GetStrategyGroupUID() int64 {
+	if x != nil {
+		return x.StrategyGroupUID
+	}
+	return 0
+}
+
+type DeleteAggregationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAggregationReply) Reset() {
+	*x = DeleteAggregationReply{}
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAggregationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAggregationReply) ProtoMessage() {}
+
+func (x *DeleteAggregationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_aggregation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAggregationReply.ProtoReflect.Descriptor instead.
+func (*DeleteAggregationReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_aggregation_proto_rawDescGZIP(), []int{5}
+}
+
+var File_marksman_api_v1_aggregation_proto protoreflect.FileDescriptor
+
+var file_marksman_api_v1_aggregation_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xd0, 0x02, 0x0a, 0x0f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x57, 0x61, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x61,
+	0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xe5, 0x02, 0x0a, 0x16, 0x53, 0x61, 0x76, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6a, 0x0a,
+	0x10, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3e, 0xba, 0x48, 0x3b, 0xba, 0x01, 0x35, 0x0a,
+	0x00, 0x12, 0x27, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x55, 0x49, 0x44, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92,
+	0x01, 0x02, 0x10, 0x14, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x37, 0x0a,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x61, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x57, 0x61, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x61,
+	0x76, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x22,
+	0x4e, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x22,
+	0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xec, 0x03, 0x0a, 0x0b, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x53, 0x61,
+	0x76, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x61, 0x76, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x1a, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x7d, 0x2f,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x95, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x12, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x7d, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0xa2, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x2a, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x7d, 0x2f, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x42, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marksman_api_v1_aggregation_proto_rawDescOnce sync.Once
+	file_marksman_api_v1_aggregation_proto_rawDescData = file_marksman_api_v1_aggregation_proto_rawDesc
+)
+
+func file_marksman_api_v1_aggregation_proto_rawDescGZIP() []byte {
+	file_marksman_api_v1_aggregation_proto_rawDescOnce.Do(func() {
+		file_marksman_api_v1_aggregation_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_aggregation_proto_rawDescData)
+	})
+	return file_marksman_api_v1_aggregation_proto_rawDescData
+}
+
+var file_marksman_api_v1_aggregation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_marksman_api_v1_aggregation_proto_goTypes = []any{
+	(*AggregationItem)(nil),          // 0: marksman.api.v1.AggregationItem
+	(*SaveAggregationRequest)(nil),   // 1: marksman.api.v1.SaveAggregationRequest
+	(*SaveAggregationReply)(nil),     // 2: marksman.api.v1.SaveAggregationReply
+	(*GetAggregationRequest)(nil),    // 3: marksman.api.v1.GetAggregationRequest
+	(*DeleteAggregationRequest)(nil), // 4: marksman.api.v1.DeleteAggregationRequest
+	(*DeleteAggregationReply)(nil),   // 5: marksman.api.v1.DeleteAggregationReply
+	(*durationpb.Duration)(nil),      // 6: google.protobuf.Duration
+}
+var file_marksman_api_v1_aggregation_proto_depIdxs = []int32{
+	6, // 0: marksman.api.v1.AggregationItem.groupWait:type_name -> google.protobuf.Duration
+	6, // 1: marksman.api.v1.AggregationItem.groupInterval:type_name -> google.protobuf.Duration
+	6, // 2: marksman.api.v1.AggregationItem.repeatInterval:type_name -> google.protobuf.Duration
+	6, // 3: marksman.api.v1.SaveAggregationRequest.groupWait:type_name -> google.protobuf.Duration
+	6, // 4: marksman.api.v1.SaveAggregationRequest.groupInterval:type_name -> google.protobuf.Duration
+	6, // 5: marksman.api.v1.SaveAggregationRequest.repeatInterval:type_name -> google.protobuf.Duration
+	1, // 6: marksman.api.v1.Aggregation.SaveAggregation:input_type -> marksman.api.v1.SaveAggregationRequest
+	3, // 7: marksman.api.v1.Aggregation.GetAggregation:input_type -> marksman.api.v1.GetAggregationRequest
+	4, // 8: marksman.api.v1.Aggregation.DeleteAggregation:input_type -> marksman.api.v1.DeleteAggregationRequest
+	2, // 9: marksman.api.v1.Aggregation.SaveAggregation:output_type -> marksman.api.v1.SaveAggregationReply
+	0, // 10: marksman.api.v1.Aggregation.GetAggregation:output_type -> marksman.api.v1.AggregationItem
+	5, // 11: marksman.api.v1.Aggregation.DeleteAggregation:output_type -> marksman.api.v1.DeleteAggregationReply
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_marksman_api_v1_aggregation_proto_init() }
+func file_marksman_api_v1_aggregation_proto_init() {
+	if File_marksman_api_v1_aggregation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marksman_api_v1_aggregation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_marksman_api_v1_aggregation_proto_goTypes,
+		DependencyIndexes: file_marksman_api_v1_aggregation_proto_depIdxs,
+		MessageInfos:      file_marksman_api_v1_aggregation_proto_msgTypes,
+	}.Build()
+	File_marksman_api_v1_aggregation_proto = out.File
+	file_marksman_api_v1_aggregation_proto_rawDesc = nil
+	file_marksman_api_v1_aggregation_proto_goTypes = nil
+	file_marksman_api_v1_aggregation_proto_depIdxs = nil
+}
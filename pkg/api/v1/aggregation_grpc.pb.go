@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/aggregation.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Aggregation_SaveAggregation_FullMethodName   = "/marksman.api.v1.Aggregation/SaveAggregation"
+	Aggregation_GetAggregation_FullMethodName    = "/marksman.api.v1.Aggregation/GetAggregation"
+	Aggregation_DeleteAggregation_FullMethodName = "/marksman.api.v1.Aggregation/DeleteAggregation"
+)
+
+// AggregationClient is the client API for Aggregation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AggregationClient interface {
+	SaveAggregation(ctx context.Context, in *SaveAggregationRequest, opts ...grpc.CallOption) (*SaveAggregationReply, error)
+	GetAggregation(ctx context.Context, in *GetAggregationRequest, opts ...grpc.CallOption) (*AggregationItem, error)
+	DeleteAggregation(ctx context.Context, in *DeleteAggregationRequest, opts ...grpc.CallOption) (*DeleteAggregationReply, error)
+}
+
+type aggregationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAggregationClient(cc grpc.ClientConnInterface) AggregationClient {
+	return &aggregationClient{cc}
+}
+
+func (c *aggregationClient) SaveAggregation(ctx context.Context, in *SaveAggregationRequest, opts ...grpc.CallOption) (*SaveAggregationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveAggregationReply)
+	err := c.cc.Invoke(ctx, Aggregation_SaveAggregation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregationClient) GetAggregation(ctx context.Context, in *GetAggregationRequest, opts ...grpc.CallOption) (*AggregationItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregationItem)
+	err := c.cc.Invoke(ctx, Aggregation_GetAggregation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aggregationClient) DeleteAggregation(ctx context.Context, in *DeleteAggregationRequest, opts ...grpc.CallOption) (*DeleteAggregationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAggregationReply)
+	err := c.cc.Invoke(ctx, Aggregation_DeleteAggregation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AggregationServer is the server API for Aggregation service.
+// All implementations must embed UnimplementedAggregationServer
+// for forward compatibility.
+type AggregationServer interface {
+	SaveAggregation(context.Context, *SaveAggregationRequest) (*SaveAggregationReply, error)
+	GetAggregation(context.Context, *GetAggregationRequest) (*AggregationItem, error)
+	DeleteAggregation(context.Context, *DeleteAggregationRequest) (*DeleteAggregationReply, error)
+	mustEmbedUnimplementedAggregationServer()
+}
+
+// UnimplementedAggregationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAggregationServer struct{}
+
+func (UnimplementedAggregationServer) SaveAggregation(context.Context, *SaveAggregationRequest) (*SaveAggregationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAggregation not implemented")
+}
+func (UnimplementedAggregationServer) GetAggregation(context.Context, *GetAggregationRequest) (*AggregationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAggregation not implemented")
+}
+func (UnimplementedAggregationServer) DeleteAggregation(context.Context, *DeleteAggregationRequest) (*DeleteAggregationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAggregation not implemented")
+}
+func (UnimplementedAggregationServer) mustEmbedUnimplementedAggregationServer() {}
+func (UnimplementedAggregationServer) testEmbeddedByValue()                     {}
+
+// UnsafeAggregationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AggregationServer will
+// result in compilation errors.
+type UnsafeAggregationServer interface {
+	mustEmbedUnimplementedAggregationServer()
+}
+
+func RegisterAggregationServer(s grpc.ServiceRegistrar, srv AggregationServer) {
+	// If the following call pancis, it indicates UnimplementedAggregationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Aggregation_ServiceDesc, srv)
+}
+
+func _Aggregation_SaveAggregation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAggregationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregationServer).SaveAggregation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Aggregation_SaveAggregation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregationServer).SaveAggregation(ctx, req.(*SaveAggregationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Aggregation_GetAggregation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAggregationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregationServer).GetAggregation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Aggregation_GetAggregation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregationServer).GetAggregation(ctx, req.(*GetAggregationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Aggregation_DeleteAggregation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAggregationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregationServer).DeleteAggregation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Aggregation_DeleteAggregation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregationServer).DeleteAggregation(ctx, req.(*DeleteAggregationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Aggregation_ServiceDesc is the grpc.ServiceDesc for Aggregation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Aggregation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.Aggregation",
+	HandlerType: (*AggregationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveAggregation",
+			Handler:    _Aggregation_SaveAggregation_Handler,
+		},
+		{
+			MethodName: "GetAggregation",
+			Handler:    _Aggregation_GetAggregation_Handler,
+		},
+		{
+			MethodName: "DeleteAggregation",
+			Handler:    _Aggregation_DeleteAggregation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/aggregation.proto",
+}
@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/aggregation.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationAggregationDeleteAggregation = "/marksman.api.v1.Aggregation/DeleteAggregation"
+const OperationAggregationGetAggregation = "/marksman.api.v1.Aggregation/GetAggregation"
+const OperationAggregationSaveAggregation = "/marksman.api.v1.Aggregation/SaveAggregation"
+
+type AggregationHTTPServer interface {
+	DeleteAggregation(context.Context, *DeleteAggregationRequest) (*DeleteAggregationReply, error)
+	GetAggregation(context.Context, *GetAggregationRequest) (*AggregationItem, error)
+	SaveAggregation(context.Context, *SaveAggregationRequest) (*SaveAggregationReply, error)
+}
+
+func RegisterAggregationHTTPServer(s *http.Server, srv AggregationHTTPServer) {
+	r := s.Route("/")
+	r.PUT("/v1/strategy-group/{strategyGroupUID}/aggregation", _Aggregation_SaveAggregation0_HTTP_Handler(srv))
+	r.GET("/v1/strategy-group/{strategyGroupUID}/aggregation", _Aggregation_GetAggregation0_HTTP_Handler(srv))
+	r.DELETE("/v1/strategy-group/{strategyGroupUID}/aggregation", _Aggregation_DeleteAggregation0_HTTP_Handler(srv))
+}
+
+func _Aggregation_SaveAggregation0_HTTP_Handler(srv AggregationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SaveAggregationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAggregationSaveAggregation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveAggregation(ctx, req.(*SaveAggregationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveAggregationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Aggregation_GetAggregation0_HTTP_Handler(srv AggregationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetAggregationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAggregationGetAggregation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetAggregation(ctx, req.(*GetAggregationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AggregationItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Aggregation_DeleteAggregation0_HTTP_Handler(srv AggregationHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAggregationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAggregationDeleteAggregation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAggregation(ctx, req.(*DeleteAggregationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAggregationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type AggregationHTTPClient interface {
+	DeleteAggregation(ctx context.Context, req *DeleteAggregationRequest, opts ...http.CallOption) (rsp *DeleteAggregationReply, err error)
+	GetAggregation(ctx context.Context, req *GetAggregationRequest, opts ...http.CallOption) (rsp *AggregationItem, err error)
+	SaveAggregation(ctx context.Context, req *SaveAggregationRequest, opts ...http.CallOption) (rsp *SaveAggregationReply, err error)
+}
+
+type AggregationHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAggregationHTTPClient(client *http.Client) AggregationHTTPClient {
+	return &AggregationHTTPClientImpl{client}
+}
+
+func (c *AggregationHTTPClientImpl) DeleteAggregation(ctx context.Context, in *DeleteAggregationRequest, opts ...http.CallOption) (*DeleteAggregationReply, error) {
+	var out DeleteAggregationReply
+	pattern := "/v1/strategy-group/{strategyGroupUID}/aggregation"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAggregationDeleteAggregation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AggregationHTTPClientImpl) GetAggregation(ctx context.Context, in *GetAggregationRequest, opts ...http.CallOption) (*AggregationItem, error) {
+	var out AggregationItem
+	pattern := "/v1/strategy-group/{strategyGroupUID}/aggregation"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAggregationGetAggregation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AggregationHTTPClientImpl) SaveAggregation(ctx context.Context, in *SaveAggregationRequest, opts ...http.CallOption) (*SaveAggregationReply, error) {
+	var out SaveAggregationReply
+	pattern := "/v1/strategy-group/{strategyGroupUID}/aggregation"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAggregationSaveAggregation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
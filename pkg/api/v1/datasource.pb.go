@@ -12,6 +12,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +24,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DatasourceTestErrorCode int32
+
+const (
+	DatasourceTestErrorCode_DatasourceTestErrorCode_UNKNOWN DatasourceTestErrorCode = 0
+	DatasourceTestErrorCode_TEST_ERROR_INVALID_CONFIG       DatasourceTestErrorCode = 1
+	DatasourceTestErrorCode_TEST_ERROR_UNSUPPORTED_DRIVER   DatasourceTestErrorCode = 2
+	DatasourceTestErrorCode_TEST_ERROR_UNREACHABLE          DatasourceTestErrorCode = 3
+	DatasourceTestErrorCode_TEST_ERROR_TIMEOUT              DatasourceTestErrorCode = 4
+	DatasourceTestErrorCode_TEST_ERROR_UNAUTHORIZED         DatasourceTestErrorCode = 5
+	DatasourceTestErrorCode_TEST_ERROR_BAD_RESPONSE         DatasourceTestErrorCode = 6
+)
+
+// Enum value maps for DatasourceTestErrorCode.
+var (
+	DatasourceTestErrorCode_name = map[int32]string{
+		0: "DatasourceTestErrorCode_UNKNOWN",
+		1: "TEST_ERROR_INVALID_CONFIG",
+		2: "TEST_ERROR_UNSUPPORTED_DRIVER",
+		3: "TEST_ERROR_UNREACHABLE",
+		4: "TEST_ERROR_TIMEOUT",
+		5: "TEST_ERROR_UNAUTHORIZED",
+		6: "TEST_ERROR_BAD_RESPONSE",
+	}
+	DatasourceTestErrorCode_value = map[string]int32{
+		"DatasourceTestErrorCode_UNKNOWN": 0,
+		"TEST_ERROR_INVALID_CONFIG":       1,
+		"TEST_ERROR_UNSUPPORTED_DRIVER":   2,
+		"TEST_ERROR_UNREACHABLE":          3,
+		"TEST_ERROR_TIMEOUT":              4,
+		"TEST_ERROR_UNAUTHORIZED":         5,
+		"TEST_ERROR_BAD_RESPONSE":         6,
+	}
+)
+
+func (x DatasourceTestErrorCode) Enum() *DatasourceTestErrorCode {
+	p := new(DatasourceTestErrorCode)
+	*p = x
+	return p
+}
+
+func (x DatasourceTestErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatasourceTestErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_marksman_api_v1_datasource_proto_enumTypes[0].Descriptor()
+}
+
+func (DatasourceTestErrorCode) Type() protoreflect.EnumType {
+	return &file_marksman_api_v1_datasource_proto_enumTypes[0]
+}
+
+func (x DatasourceTestErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatasourceTestErrorCode.Descriptor instead.
+func (DatasourceTestErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{0}
+}
+
+type DatasourceAuthMode int32
+
+const (
+	DatasourceAuthMode_DatasourceAuthMode_UNKNOWN DatasourceAuthMode = 0
+	DatasourceAuthMode_AUTH_NONE                  DatasourceAuthMode = 1
+	DatasourceAuthMode_AUTH_BASIC                 DatasourceAuthMode = 2
+	DatasourceAuthMode_AUTH_BEARER                DatasourceAuthMode = 3
+)
+
+// Enum value maps for DatasourceAuthMode.
+var (
+	DatasourceAuthMode_name = map[int32]string{
+		0: "DatasourceAuthMode_UNKNOWN",
+		1: "AUTH_NONE",
+		2: "AUTH_BASIC",
+		3: "AUTH_BEARER",
+	}
+	DatasourceAuthMode_value = map[string]int32{
+		"DatasourceAuthMode_UNKNOWN": 0,
+		"AUTH_NONE":                  1,
+		"AUTH_BASIC":                 2,
+		"AUTH_BEARER":                3,
+	}
+)
+
+func (x DatasourceAuthMode) Enum() *DatasourceAuthMode {
+	p := new(DatasourceAuthMode)
+	*p = x
+	return p
+}
+
+func (x DatasourceAuthMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatasourceAuthMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_marksman_api_v1_datasource_proto_enumTypes[1].Descriptor()
+}
+
+func (DatasourceAuthMode) Type() protoreflect.EnumType {
+	return &file_marksman_api_v1_datasource_proto_enumTypes[1]
+}
+
+func (x DatasourceAuthMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatasourceAuthMode.Descriptor instead.
+func (DatasourceAuthMode) EnumDescriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{1}
+}
+
 type DatasourceItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
@@ -33,6 +147,8 @@ type DatasourceItem struct {
 	Status        enum.GlobalStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Config        *DatasourceConfig      `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
+	Managed       bool                   `protobuf:"varint,10,opt,name=managed,proto3" json:"managed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,12 +239,27 @@ func (x *DatasourceItem) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *DatasourceItem) GetConfig() *DatasourceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DatasourceItem) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
 type CreateDatasourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type          enum.DatasourceType    `protobuf:"varint,2,opt,name=type,proto3,enum=magicbox.enum.DatasourceType" json:"type,omitempty"`
 	Driver        enum.DatasourceDriver  `protobuf:"varint,3,opt,name=driver,proto3,enum=magicbox.enum.DatasourceDriver" json:"driver,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config        *DatasourceConfig      `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -191,6 +322,13 @@ func (x *CreateDatasourceRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateDatasourceRequest) GetConfig() *DatasourceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 type CreateDatasourceReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -234,6 +372,7 @@ type UpdateDatasourceRequest struct {
 	Type          enum.DatasourceType    `protobuf:"varint,3,opt,name=type,proto3,enum=magicbox.enum.DatasourceType" json:"type,omitempty"`
 	Driver        enum.DatasourceDriver  `protobuf:"varint,4,opt,name=driver,proto3,enum=magicbox.enum.DatasourceDriver" json:"driver,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config        *DatasourceConfig      `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -303,6 +442,13 @@ func (x *UpdateDatasourceRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *UpdateDatasourceRequest) GetConfig() *DatasourceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 type UpdateDatasourceReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -615,332 +761,1241 @@ func (x *ListDatasourceReply) GetPageSize() int32 {
 	return 0
 }
 
-var File_marksman_api_v1_datasource_proto protoreflect.FileDescriptor
+type TestDatasourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*TestDatasourceRequest_Uid
+	//	*TestDatasourceRequest_Datasource
+	Target        isTestDatasourceRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_marksman_api_v1_datasource_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
-	0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e,
-	0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x03, 0x0a, 0x0e, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e,
-	0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x49, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63,
-	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe, 0x06, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0xe4, 0x01, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65,
-	0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0xb0, 0x01, 0xba, 0x48, 0xac, 0x01, 0xba, 0x01, 0xa5, 0x01, 0x12, 0x2c, 0x74,
-	0x79, 0x70, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b,
-	0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4f, 0x47, 0x53,
-	0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45, 0x27, 0x5d, 0x1a, 0x75, 0x74, 0x68, 0x69,
-	0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65,
-	0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69,
-	0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x4f, 0x47, 0x53, 0x2c, 0x20, 0x6d,
-	0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x52, 0x41, 0x43,
-	0x45, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x88, 0x03, 0x0a, 0x06,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d,
-	0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0xce, 0x02,
-	0xba, 0x48, 0xca, 0x02, 0xba, 0x01, 0xc3, 0x02, 0x12, 0x6a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x4d, 0x45,
-	0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55, 0x53,
-	0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x43, 0x54,
-	0x4f, 0x52, 0x49, 0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x27, 0x2c, 0x20, 0x27,
-	0x4c, 0x4f, 0x47, 0x53, 0x5f, 0x45, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x53, 0x45, 0x41, 0x52,
-	0x43, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x4a, 0x41, 0x45, 0x47,
-	0x45, 0x52, 0x27, 0x5d, 0x1a, 0xd4, 0x01, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b,
-	0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d,
-	0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55,
-	0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52,
-	0x49, 0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69,
-	0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x4f, 0x47, 0x53, 0x5f,
-	0x45, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x2c, 0x20, 0x6d,
-	0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x52,
-	0x41, 0x43, 0x45, 0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x06,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x81, 0x07, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2f, 0xba, 0x48, 0x2c, 0xba, 0x01,
-	0x26, 0x12, 0x1a, 0x75, 0x69, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74,
-	0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-	0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba,
-	0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0xe4, 0x01, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42,
-	0xb0, 0x01, 0xba, 0x48, 0xac, 0x01, 0xba, 0x01, 0xa5, 0x01, 0x12, 0x2c, 0x74, 0x79, 0x70, 0x65,
-	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x4d, 0x45,
-	0x54, 0x52, 0x49, 0x43, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4f, 0x47, 0x53, 0x27, 0x2c, 0x20,
-	0x27, 0x54, 0x52, 0x41, 0x43, 0x45, 0x27, 0x5d, 0x1a, 0x75, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69,
-	0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
-	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f,
-	0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x4f, 0x47, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69,
-	0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5d, 0xc8,
-	0x01, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x88, 0x03, 0x0a, 0x06, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69,
-	0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0xce, 0x02, 0xba, 0x48, 0xca,
-	0x02, 0xba, 0x01, 0xc3, 0x02, 0x12, 0x6a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x20, 0x6d, 0x75,
-	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49,
-	0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x27, 0x2c, 0x20,
-	0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52, 0x49,
-	0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4f, 0x47,
-	0x53, 0x5f, 0x45, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x27,
-	0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x27,
-	0x5d, 0x1a, 0xd4, 0x01, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67,
-	0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x45, 0x54, 0x52,
-	0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x2c, 0x20,
-	0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d,
-	0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x5f,
-	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f,
-	0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x4f, 0x47, 0x53, 0x5f, 0x45, 0x4c, 0x41,
-	0x53, 0x54, 0x49, 0x43, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69,
-	0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x52, 0x41, 0x43, 0x45,
-	0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x0a,
-	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xf9, 0x03,
-	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xba, 0x48, 0x42, 0xba, 0x01, 0x3f,
-	0x12, 0x29, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62,
-	0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65,
-	0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x30, 0x1a, 0x12, 0x74, 0x68, 0x69,
-	0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x30, 0x30, 0x52,
-	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x51, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3d, 0xba, 0x48, 0x3a, 0xba, 0x01, 0x34, 0x12, 0x27,
-	0x70, 0x61, 0x67, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75,
-	0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x1a, 0x09, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d,
-	0x20, 0x31, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x6e,
-	0xba, 0x48, 0x6b, 0xba, 0x01, 0x65, 0x12, 0x49, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72,
-	0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74,
-	0x6f, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x61,
-	0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x32, 0x30,
-	0x30, 0x1a, 0x18, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0x20, 0x26, 0x26, 0x20,
-	0x74, 0x68, 0x69, 0x73, 0x20, 0x3c, 0x3d, 0x20, 0x32, 0x30, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f,
-	0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61,
-	0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e,
-	0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x32, 0x8a,
-	0x05, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x7f, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e,
-	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x85,
-	0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a,
-	0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x75, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76,
-	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x7b, 0x75, 0x69,
-	0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0x42, 0x0a, 0x0f, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x50, 0x01,
-	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64,
-	0x65, 0x2d, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
-	0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *TestDatasourceRequest) Reset() {
+	*x = TestDatasourceRequest{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var (
-	file_marksman_api_v1_datasource_proto_rawDescOnce sync.Once
-	file_marksman_api_v1_datasource_proto_rawDescData = file_marksman_api_v1_datasource_proto_rawDesc
-)
+func (x *TestDatasourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_marksman_api_v1_datasource_proto_rawDescGZIP() []byte {
-	file_marksman_api_v1_datasource_proto_rawDescOnce.Do(func() {
-		file_marksman_api_v1_datasource_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_datasource_proto_rawDescData)
-	})
-	return file_marksman_api_v1_datasource_proto_rawDescData
+func (*TestDatasourceRequest) ProtoMessage() {}
+
+func (x *TestDatasourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_marksman_api_v1_datasource_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_marksman_api_v1_datasource_proto_goTypes = []any{
-	(*DatasourceItem)(nil),          // 0: marksman.api.v1.DatasourceItem
-	(*CreateDatasourceRequest)(nil), // 1: marksman.api.v1.CreateDatasourceRequest
-	(*CreateDatasourceReply)(nil),   // 2: marksman.api.v1.CreateDatasourceReply
-	(*UpdateDatasourceRequest)(nil), // 3: marksman.api.v1.UpdateDatasourceRequest
-	(*UpdateDatasourceReply)(nil),   // 4: marksman.api.v1.UpdateDatasourceReply
-	(*DeleteDatasourceRequest)(nil), // 5: marksman.api.v1.DeleteDatasourceRequest
-	(*DeleteDatasourceReply)(nil),   // 6: marksman.api.v1.DeleteDatasourceReply
-	(*GetDatasourceRequest)(nil),    // 7: marksman.api.v1.GetDatasourceRequest
-	(*ListDatasourceRequest)(nil),   // 8: marksman.api.v1.ListDatasourceRequest
-	(*ListDatasourceReply)(nil),     // 9: marksman.api.v1.ListDatasourceReply
-	nil,                             // 10: marksman.api.v1.DatasourceItem.MetadataEntry
-	nil,                             // 11: marksman.api.v1.CreateDatasourceRequest.MetadataEntry
-	nil,                             // 12: marksman.api.v1.UpdateDatasourceRequest.MetadataEntry
-	(enum.DatasourceType)(0),        // 13: magicbox.enum.DatasourceType
-	(enum.DatasourceDriver)(0),      // 14: magicbox.enum.DatasourceDriver
-	(enum.GlobalStatus)(0),          // 15: magicbox.enum.GlobalStatus
+// Deprecated: Use TestDatasourceRequest.ProtoReflect.Descriptor instead.
+func (*TestDatasourceRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{10}
 }
-var file_marksman_api_v1_datasource_proto_depIdxs = []int32{
-	13, // 0: marksman.api.v1.DatasourceItem.type:type_name -> magicbox.enum.DatasourceType
-	14, // 1: marksman.api.v1.DatasourceItem.driver:type_name -> magicbox.enum.DatasourceDriver
-	10, // 2: marksman.api.v1.DatasourceItem.metadata:type_name -> marksman.api.v1.DatasourceItem.MetadataEntry
-	15, // 3: marksman.api.v1.DatasourceItem.status:type_name -> magicbox.enum.GlobalStatus
-	13, // 4: marksman.api.v1.CreateDatasourceRequest.type:type_name -> magicbox.enum.DatasourceType
-	14, // 5: marksman.api.v1.CreateDatasourceRequest.driver:type_name -> magicbox.enum.DatasourceDriver
-	11, // 6: marksman.api.v1.CreateDatasourceRequest.metadata:type_name -> marksman.api.v1.CreateDatasourceRequest.MetadataEntry
-	13, // 7: marksman.api.v1.UpdateDatasourceRequest.type:type_name -> magicbox.enum.DatasourceType
-	14, // 8: marksman.api.v1.UpdateDatasourceRequest.driver:type_name -> magicbox.enum.DatasourceDriver
-	12, // 9: marksman.api.v1.UpdateDatasourceRequest.metadata:type_name -> marksman.api.v1.UpdateDatasourceRequest.MetadataEntry
-	13, // 10: marksman.api.v1.ListDatasourceRequest.type:type_name -> magicbox.enum.DatasourceType
-	14, // 11: marksman.api.v1.ListDatasourceRequest.driver:type_name -> magicbox.enum.DatasourceDriver
-	15, // 12: marksman.api.v1.ListDatasourceRequest.status:type_name -> magicbox.enum.GlobalStatus
-	0,  // 13: marksman.api.v1.ListDatasourceReply.items:type_name -> marksman.api.v1.DatasourceItem
-	1,  // 14: marksman.api.v1.Datasource.CreateDatasource:input_type -> marksman.api.v1.CreateDatasourceRequest
-	3,  // 15: marksman.api.v1.Datasource.UpdateDatasource:input_type -> marksman.api.v1.UpdateDatasourceRequest
-	5,  // 16: marksman.api.v1.Datasource.DeleteDatasource:input_type -> marksman.api.v1.DeleteDatasourceRequest
-	7,  // 17: marksman.api.v1.Datasource.GetDatasource:input_type -> marksman.api.v1.GetDatasourceRequest
-	8,  // 18: marksman.api.v1.Datasource.ListDatasource:input_type -> marksman.api.v1.ListDatasourceRequest
-	2,  // 19: marksman.api.v1.Datasource.CreateDatasource:output_type -> marksman.api.v1.CreateDatasourceReply
-	4,  // 20: marksman.api.v1.Datasource.UpdateDatasource:output_type -> marksman.api.v1.UpdateDatasourceReply
-	6,  // 21: marksman.api.v1.Datasource.DeleteDatasource:output_type -> marksman.api.v1.DeleteDatasourceReply
-	0,  // 22: marksman.api.v1.Datasource.GetDatasource:output_type -> marksman.api.v1.DatasourceItem
-	9,  // 23: marksman.api.v1.Datasource.ListDatasource:output_type -> marksman.api.v1.ListDatasourceReply
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+func (x *TestDatasourceRequest) GetTarget() isTestDatasourceRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
 }
 
-func init() { file_marksman_api_v1_datasource_proto_init() }
-func file_marksman_api_v1_datasource_proto_init() {
-	if File_marksman_api_v1_datasource_proto != nil {
-		return
+func (x *TestDatasourceRequest) GetUid() int64 {
+	if x != nil {
+		if x, ok := x.Target.(*TestDatasourceRequest_Uid); ok {
+			return x.Uid
+		}
+	}
+	return 0
+}
+
+func (x *TestDatasourceRequest) GetDatasource() *CreateDatasourceRequest {
+	if x != nil {
+		if x, ok := x.Target.(*TestDatasourceRequest_Datasource); ok {
+			return x.Datasource
+		}
+	}
+	return nil
+}
+
+type isTestDatasourceRequest_Target interface {
+	isTestDatasourceRequest_Target()
+}
+
+type TestDatasourceRequest_Uid struct {
+	Uid int64 `protobuf:"varint,1,opt,name=uid,proto3,oneof"`
+}
+
+type TestDatasourceRequest_Datasource struct {
+	Datasource *CreateDatasourceRequest `protobuf:"bytes,2,opt,name=datasource,proto3,oneof"`
+}
+
+func (*TestDatasourceRequest_Uid) isTestDatasourceRequest_Target() {}
+
+func (*TestDatasourceRequest_Datasource) isTestDatasourceRequest_Target() {}
+
+type DatasourceBuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Revision      string                 `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Branch        string                 `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	BuildUser     string                 `protobuf:"bytes,4,opt,name=buildUser,proto3" json:"buildUser,omitempty"`
+	BuildDate     string                 `protobuf:"bytes,5,opt,name=buildDate,proto3" json:"buildDate,omitempty"`
+	GoVersion     string                 `protobuf:"bytes,6,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasourceBuildInfo) Reset() {
+	*x = DatasourceBuildInfo{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceBuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceBuildInfo) ProtoMessage() {}
+
+func (x *DatasourceBuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceBuildInfo.ProtoReflect.Descriptor instead.
+func (*DatasourceBuildInfo) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DatasourceBuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DatasourceBuildInfo) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *DatasourceBuildInfo) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *DatasourceBuildInfo) GetBuildUser() string {
+	if x != nil {
+		return x.BuildUser
+	}
+	return ""
+}
+
+func (x *DatasourceBuildInfo) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *DatasourceBuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+type DatasourceTestError struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Code          DatasourceTestErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=marksman.api.v1.DatasourceTestErrorCode" json:"code,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasourceTestError) Reset() {
+	*x = DatasourceTestError{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceTestError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceTestError) ProtoMessage() {}
+
+func (x *DatasourceTestError) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceTestError.ProtoReflect.Descriptor instead.
+func (*DatasourceTestError) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DatasourceTestError) GetCode() DatasourceTestErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return DatasourceTestErrorCode_DatasourceTestErrorCode_UNKNOWN
+}
+
+func (x *DatasourceTestError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TestDatasourceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,2,opt,name=latencyMs,proto3" json:"latencyMs,omitempty"`
+	BuildInfo     *DatasourceBuildInfo   `protobuf:"bytes,3,opt,name=buildInfo,proto3" json:"buildInfo,omitempty"`
+	Error         *DatasourceTestError   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestDatasourceReply) Reset() {
+	*x = TestDatasourceReply{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestDatasourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestDatasourceReply) ProtoMessage() {}
+
+func (x *TestDatasourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestDatasourceReply.ProtoReflect.Descriptor instead.
+func (*TestDatasourceReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestDatasourceReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TestDatasourceReply) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *TestDatasourceReply) GetBuildInfo() *DatasourceBuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *TestDatasourceReply) GetError() *DatasourceTestError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DatasourceTLSConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CaCert             string                 `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	InsecureSkipVerify bool                   `protobuf:"varint,2,opt,name=insecureSkipVerify,proto3" json:"insecureSkipVerify,omitempty"`
+	ServerName         string                 `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DatasourceTLSConfig) Reset() {
+	*x = DatasourceTLSConfig{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceTLSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceTLSConfig) ProtoMessage() {}
+
+func (x *DatasourceTLSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceTLSConfig.ProtoReflect.Descriptor instead.
+func (*DatasourceTLSConfig) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DatasourceTLSConfig) GetCaCert() string {
+	if x != nil {
+		return x.CaCert
+	}
+	return ""
+}
+
+func (x *DatasourceTLSConfig) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *DatasourceTLSConfig) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type DatasourceConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Url            string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	AuthMode       DatasourceAuthMode     `protobuf:"varint,2,opt,name=authMode,proto3,enum=marksman.api.v1.DatasourceAuthMode" json:"authMode,omitempty"`
+	Username       string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password       string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	BearerToken    string                 `protobuf:"bytes,5,opt,name=bearerToken,proto3" json:"bearerToken,omitempty"`
+	Headers        map[string]string      `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tls            *DatasourceTLSConfig   `protobuf:"bytes,7,opt,name=tls,proto3" json:"tls,omitempty"`
+	Timeout        *durationpb.Duration   `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	ScrapeInterval *durationpb.Duration   `protobuf:"bytes,9,opt,name=scrapeInterval,proto3" json:"scrapeInterval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatasourceConfig) Reset() {
+	*x = DatasourceConfig{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceConfig) ProtoMessage() {}
+
+func (x *DatasourceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceConfig.ProtoReflect.Descriptor instead.
+func (*DatasourceConfig) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DatasourceConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DatasourceConfig) GetAuthMode() DatasourceAuthMode {
+	if x != nil {
+		return x.AuthMode
+	}
+	return DatasourceAuthMode_DatasourceAuthMode_UNKNOWN
+}
+
+func (x *DatasourceConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DatasourceConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DatasourceConfig) GetBearerToken() string {
+	if x != nil {
+		return x.BearerToken
+	}
+	return ""
+}
+
+func (x *DatasourceConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DatasourceConfig) GetTls() *DatasourceTLSConfig {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *DatasourceConfig) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *DatasourceConfig) GetScrapeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ScrapeInterval
+	}
+	return nil
+}
+
+type DatasourceConfigField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Secret        bool                   `protobuf:"varint,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,7,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasourceConfigField) Reset() {
+	*x = DatasourceConfigField{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceConfigField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceConfigField) ProtoMessage() {}
+
+func (x *DatasourceConfigField) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceConfigField.ProtoReflect.Descriptor instead.
+func (*DatasourceConfigField) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DatasourceConfigField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatasourceConfigField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DatasourceConfigField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *DatasourceConfigField) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+func (x *DatasourceConfigField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DatasourceConfigField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *DatasourceConfigField) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+type DatasourceConfigSchema struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Driver        enum.DatasourceDriver    `protobuf:"varint,1,opt,name=driver,proto3,enum=magicbox.enum.DatasourceDriver" json:"driver,omitempty"`
+	Type          enum.DatasourceType      `protobuf:"varint,2,opt,name=type,proto3,enum=magicbox.enum.DatasourceType" json:"type,omitempty"`
+	Fields        []*DatasourceConfigField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasourceConfigSchema) Reset() {
+	*x = DatasourceConfigSchema{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceConfigSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceConfigSchema) ProtoMessage() {}
+
+func (x *DatasourceConfigSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceConfigSchema.ProtoReflect.Descriptor instead.
+func (*DatasourceConfigSchema) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DatasourceConfigSchema) GetDriver() enum.DatasourceDriver {
+	if x != nil {
+		return x.Driver
+	}
+	return enum.DatasourceDriver(0)
+}
+
+func (x *DatasourceConfigSchema) GetType() enum.DatasourceType {
+	if x != nil {
+		return x.Type
+	}
+	return enum.DatasourceType(0)
+}
+
+func (x *DatasourceConfigSchema) GetFields() []*DatasourceConfigField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListDatasourceConfigSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        enum.DatasourceDriver  `protobuf:"varint,1,opt,name=driver,proto3,enum=magicbox.enum.DatasourceDriver" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatasourceConfigSchemaRequest) Reset() {
+	*x = ListDatasourceConfigSchemaRequest{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatasourceConfigSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatasourceConfigSchemaRequest) ProtoMessage() {}
+
+func (x *ListDatasourceConfigSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatasourceConfigSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ListDatasourceConfigSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDatasourceConfigSchemaRequest) GetDriver() enum.DatasourceDriver {
+	if x != nil {
+		return x.Driver
+	}
+	return enum.DatasourceDriver(0)
+}
+
+type ListDatasourceConfigSchemaReply struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*DatasourceConfigSchema `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatasourceConfigSchemaReply) Reset() {
+	*x = ListDatasourceConfigSchemaReply{}
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatasourceConfigSchemaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatasourceConfigSchemaReply) ProtoMessage() {}
+
+func (x *ListDatasourceConfigSchemaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_datasource_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatasourceConfigSchemaReply.ProtoReflect.Descriptor instead.
+func (*ListDatasourceConfigSchemaReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_datasource_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDatasourceConfigSchemaReply) GetItems() []*DatasourceConfigSchema {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_marksman_api_v1_datasource_proto protoreflect.FileDescriptor
+
+var file_marksman_api_v1_datasource_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
+	0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x03, 0x0a, 0x0e, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e,
+	0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x07, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10,
+	0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xe4, 0x01, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0xb0, 0x01, 0xba, 0x48, 0xac, 0x01, 0xba, 0x01,
+	0xa5, 0x01, 0x12, 0x2c, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x4c, 0x4f, 0x47, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45, 0x27, 0x5d,
+	0x1a, 0x75, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c,
+	0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x4f,
+	0x47, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x88, 0x03, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x42, 0xce, 0x02, 0xba, 0x48, 0xca, 0x02, 0xba, 0x01, 0xc3, 0x02, 0x12, 0x6a, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e,
+	0x20, 0x5b, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x45,
+	0x54, 0x48, 0x45, 0x55, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53,
+	0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43,
+	0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4f, 0x47, 0x53, 0x5f, 0x45, 0x4c, 0x41, 0x53, 0x54, 0x49,
+	0x43, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45,
+	0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x27, 0x5d, 0x1a, 0xd4, 0x01, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d,
+	0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56,
+	0x49, 0x43, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c,
+	0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x4f, 0x47, 0x53, 0x5f, 0x45, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x53, 0x45, 0x41, 0x52,
+	0x43, 0x48, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x5d,
+	0xc8, 0x01, 0x01, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x41, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc4, 0x07, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x2f, 0xba, 0x48, 0x2c, 0xba, 0x01, 0x26, 0x12, 0x1a, 0x75, 0x69, 0x64, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74,
+	0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8,
+	0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10,
+	0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xe4, 0x01, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0xb0, 0x01, 0xba, 0x48, 0xac, 0x01, 0xba, 0x01,
+	0xa5, 0x01, 0x12, 0x2c, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x4c, 0x4f, 0x47, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45, 0x27, 0x5d,
+	0x1a, 0x75, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c,
+	0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x4f,
+	0x47, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x2e, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x88, 0x03, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x42, 0xce, 0x02, 0xba, 0x48, 0xca, 0x02, 0xba, 0x01, 0xc3, 0x02, 0x12, 0x6a, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e,
+	0x20, 0x5b, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x45,
+	0x54, 0x48, 0x45, 0x55, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53,
+	0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43,
+	0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4f, 0x47, 0x53, 0x5f, 0x45, 0x4c, 0x41, 0x53, 0x54, 0x49,
+	0x43, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x41, 0x43, 0x45,
+	0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x27, 0x5d, 0x1a, 0xd4, 0x01, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d,
+	0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x56,
+	0x49, 0x43, 0x54, 0x4f, 0x52, 0x49, 0x41, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x2c,
+	0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x4f, 0x47, 0x53, 0x5f, 0x45, 0x4c, 0x41, 0x53, 0x54, 0x49, 0x43, 0x53, 0x45, 0x41, 0x52,
+	0x43, 0x48, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x4a, 0x41, 0x45, 0x47, 0x45, 0x52, 0x5d,
+	0xc8, 0x01, 0x01, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x41, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x17, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0xf9, 0x03, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5f, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x45, 0xba, 0x48, 0x42, 0xba, 0x01, 0x3f, 0x12, 0x29, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73,
+	0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20,
+	0x74, 0x6f, 0x20, 0x31, 0x30, 0x30, 0x1a, 0x12, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a,
+	0x65, 0x28, 0x29, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x30, 0x30, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x51, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x3d, 0xba, 0x48, 0x3a, 0xba, 0x01, 0x34, 0x12, 0x27, 0x70, 0x61, 0x67, 0x65, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20,
+	0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f,
+	0x20, 0x31, 0x1a, 0x09, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0xc8, 0x01, 0x01,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x6e, 0xba, 0x48, 0x6b, 0xba, 0x01,
+	0x65, 0x12, 0x49, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e,
+	0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x32, 0x30, 0x30, 0x1a, 0x18, 0x74, 0x68,
+	0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x3c, 0x3d, 0x20, 0x32, 0x30, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f,
+	0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x54, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xbd, 0x01,
+	0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a,
+	0x13, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcd, 0x01, 0x0a,
+	0x13, 0x54, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x42, 0x0a, 0x09,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x0a, 0x13,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x04, 0x0a, 0x10,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x3f, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x48, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x4c,
+	0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xd3, 0x01, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63,
+	0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5c, 0x0a,
+	0x21, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x1f, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2a, 0xee, 0x01,
+	0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x01, 0x12, 0x21, 0x0a,
+	0x1d, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x55,
+	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55,
+	0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f,
+	0x55, 0x54, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10,
+	0x05, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x06, 0x2a, 0x64,
+	0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x42, 0x41, 0x53, 0x49,
+	0x43, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x42, 0x45, 0x41, 0x52,
+	0x45, 0x52, 0x10, 0x03, 0x32, 0xaf, 0x07, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64,
+	0x7d, 0x12, 0x75, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0xa2, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x12, 0x32, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2d, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x7e, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
+	0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x42, 0x42, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_marksman_api_v1_datasource_proto_rawDescOnce sync.Once
+	file_marksman_api_v1_datasource_proto_rawDescData = file_marksman_api_v1_datasource_proto_rawDesc
+)
+
+func file_marksman_api_v1_datasource_proto_rawDescGZIP() []byte {
+	file_marksman_api_v1_datasource_proto_rawDescOnce.Do(func() {
+		file_marksman_api_v1_datasource_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_datasource_proto_rawDescData)
+	})
+	return file_marksman_api_v1_datasource_proto_rawDescData
+}
+
+var file_marksman_api_v1_datasource_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_marksman_api_v1_datasource_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_marksman_api_v1_datasource_proto_goTypes = []any{
+	(DatasourceTestErrorCode)(0),              // 0: marksman.api.v1.DatasourceTestErrorCode
+	(DatasourceAuthMode)(0),                   // 1: marksman.api.v1.DatasourceAuthMode
+	(*DatasourceItem)(nil),                    // 2: marksman.api.v1.DatasourceItem
+	(*CreateDatasourceRequest)(nil),           // 3: marksman.api.v1.CreateDatasourceRequest
+	(*CreateDatasourceReply)(nil),             // 4: marksman.api.v1.CreateDatasourceReply
+	(*UpdateDatasourceRequest)(nil),           // 5: marksman.api.v1.UpdateDatasourceRequest
+	(*UpdateDatasourceReply)(nil),             // 6: marksman.api.v1.UpdateDatasourceReply
+	(*DeleteDatasourceRequest)(nil),           // 7: marksman.api.v1.DeleteDatasourceRequest
+	(*DeleteDatasourceReply)(nil),             // 8: marksman.api.v1.DeleteDatasourceReply
+	(*GetDatasourceRequest)(nil),              // 9: marksman.api.v1.GetDatasourceRequest
+	(*ListDatasourceRequest)(nil),             // 10: marksman.api.v1.ListDatasourceRequest
+	(*ListDatasourceReply)(nil),               // 11: marksman.api.v1.ListDatasourceReply
+	(*TestDatasourceRequest)(nil),             // 12: marksman.api.v1.TestDatasourceRequest
+	(*DatasourceBuildInfo)(nil),               // 13: marksman.api.v1.DatasourceBuildInfo
+	(*DatasourceTestError)(nil),               // 14: marksman.api.v1.DatasourceTestError
+	(*TestDatasourceReply)(nil),               // 15: marksman.api.v1.TestDatasourceReply
+	(*DatasourceTLSConfig)(nil),               // 16: marksman.api.v1.DatasourceTLSConfig
+	(*DatasourceConfig)(nil),                  // 17: marksman.api.v1.DatasourceConfig
+	(*DatasourceConfigField)(nil),             // 18: marksman.api.v1.DatasourceConfigField
+	(*DatasourceConfigSchema)(nil),            // 19: marksman.api.v1.DatasourceConfigSchema
+	(*ListDatasourceConfigSchemaRequest)(nil), // 20: marksman.api.v1.ListDatasourceConfigSchemaRequest
+	(*ListDatasourceConfigSchemaReply)(nil),   // 21: marksman.api.v1.ListDatasourceConfigSchemaReply
+	nil,                                       // 22: marksman.api.v1.DatasourceItem.MetadataEntry
+	nil,                                       // 23: marksman.api.v1.CreateDatasourceRequest.MetadataEntry
+	nil,                                       // 24: marksman.api.v1.UpdateDatasourceRequest.MetadataEntry
+	nil,                                       // 25: marksman.api.v1.DatasourceConfig.HeadersEntry
+	(enum.DatasourceType)(0),                  // 26: magicbox.enum.DatasourceType
+	(enum.DatasourceDriver)(0),                // 27: magicbox.enum.DatasourceDriver
+	(enum.GlobalStatus)(0),                    // 28: magicbox.enum.GlobalStatus
+	(*durationpb.Duration)(nil),               // 29: google.protobuf.Duration
+}
+var file_marksman_api_v1_datasource_proto_depIdxs = []int32{
+	26, // 0: marksman.api.v1.DatasourceItem.type:type_name -> magicbox.enum.DatasourceType
+	27, // 1: marksman.api.v1.DatasourceItem.driver:type_name -> magicbox.enum.DatasourceDriver
+	22, // 2: marksman.api.v1.DatasourceItem.metadata:type_name -> marksman.api.v1.DatasourceItem.MetadataEntry
+	28, // 3: marksman.api.v1.DatasourceItem.status:type_name -> magicbox.enum.GlobalStatus
+	17, // 4: marksman.api.v1.DatasourceItem.config:type_name -> marksman.api.v1.DatasourceConfig
+	26, // 5: marksman.api.v1.CreateDatasourceRequest.type:type_name -> magicbox.enum.DatasourceType
+	27, // 6: marksman.api.v1.CreateDatasourceRequest.driver:type_name -> magicbox.enum.DatasourceDriver
+	23, // 7: marksman.api.v1.CreateDatasourceRequest.metadata:type_name -> marksman.api.v1.CreateDatasourceRequest.MetadataEntry
+	17, // 8: marksman.api.v1.CreateDatasourceRequest.config:type_name -> marksman.api.v1.DatasourceConfig
+	26, // 9: marksman.api.v1.UpdateDatasourceRequest.type:type_name -> magicbox.enum.DatasourceType
+	27, // 10: marksman.api.v1.UpdateDatasourceRequest.driver:type_name -> magicbox.enum.DatasourceDriver
+	24, // 11: marksman.api.v1.UpdateDatasourceRequest.metadata:type_name -> marksman.api.v1.UpdateDatasourceRequest.MetadataEntry
+	17, // 12: marksman.api.v1.UpdateDatasourceRequest.config:type_name -> marksman.api.v1.DatasourceConfig
+	26, // 13: marksman.api.v1.ListDatasourceRequest.type:type_name -> magicbox.enum.DatasourceType
+	27, // 14: marksman.api.v1.ListDatasourceRequest.driver:type_name -> magicbox.enum.DatasourceDriver
+	28, // 15: marksman.api.v1.ListDatasourceRequest.status:type_name -> magicbox.enum.GlobalStatus
+	2,  // 16: marksman.api.v1.ListDatasourceReply.items:type_name -> marksman.api.v1.DatasourceItem
+	3,  // 17: marksman.api.v1.TestDatasourceRequest.datasource:type_name -> marksman.api.v1.CreateDatasourceRequest
+	0,  // 18: marksman.api.v1.DatasourceTestError.code:type_name -> marksman.api.v1.DatasourceTestErrorCode
+	13, // 19: marksman.api.v1.TestDatasourceReply.buildInfo:type_name -> marksman.api.v1.DatasourceBuildInfo
+	14, // 20: marksman.api.v1.TestDatasourceReply.error:type_name -> marksman.api.v1.DatasourceTestError
+	1,  // 21: marksman.api.v1.DatasourceConfig.authMode:type_name -> marksman.api.v1.DatasourceAuthMode
+	25, // 22: marksman.api.v1.DatasourceConfig.headers:type_name -> marksman.api.v1.DatasourceConfig.HeadersEntry
+	16, // 23: marksman.api.v1.DatasourceConfig.tls:type_name -> marksman.api.v1.DatasourceTLSConfig
+	29, // 24: marksman.api.v1.DatasourceConfig.timeout:type_name -> google.protobuf.Duration
+	29, // 25: marksman.api.v1.DatasourceConfig.scrapeInterval:type_name -> google.protobuf.Duration
+	27, // 26: marksman.api.v1.DatasourceConfigSchema.driver:type_name -> magicbox.enum.DatasourceDriver
+	26, // 27: marksman.api.v1.DatasourceConfigSchema.type:type_name -> magicbox.enum.DatasourceType
+	18, // 28: marksman.api.v1.DatasourceConfigSchema.fields:type_name -> marksman.api.v1.DatasourceConfigField
+	27, // 29: marksman.api.v1.ListDatasourceConfigSchemaRequest.driver:type_name -> magicbox.enum.DatasourceDriver
+	19, // 30: marksman.api.v1.ListDatasourceConfigSchemaReply.items:type_name -> marksman.api.v1.DatasourceConfigSchema
+	3,  // 31: marksman.api.v1.Datasource.CreateDatasource:input_type -> marksman.api.v1.CreateDatasourceRequest
+	5,  // 32: marksman.api.v1.Datasource.UpdateDatasource:input_type -> marksman.api.v1.UpdateDatasourceRequest
+	7,  // 33: marksman.api.v1.Datasource.DeleteDatasource:input_type -> marksman.api.v1.DeleteDatasourceRequest
+	9,  // 34: marksman.api.v1.Datasource.GetDatasource:input_type -> marksman.api.v1.GetDatasourceRequest
+	10, // 35: marksman.api.v1.Datasource.ListDatasource:input_type -> marksman.api.v1.ListDatasourceRequest
+	20, // 36: marksman.api.v1.Datasource.ListDatasourceConfigSchema:input_type -> marksman.api.v1.ListDatasourceConfigSchemaRequest
+	12, // 37: marksman.api.v1.Datasource.TestDatasource:input_type -> marksman.api.v1.TestDatasourceRequest
+	4,  // 38: marksman.api.v1.Datasource.CreateDatasource:output_type -> marksman.api.v1.CreateDatasourceReply
+	6,  // 39: marksman.api.v1.Datasource.UpdateDatasource:output_type -> marksman.api.v1.UpdateDatasourceReply
+	8,  // 40: marksman.api.v1.Datasource.DeleteDatasource:output_type -> marksman.api.v1.DeleteDatasourceReply
+	2,  // 41: marksman.api.v1.Datasource.GetDatasource:output_type -> marksman.api.v1.DatasourceItem
+	11, // 42: marksman.api.v1.Datasource.ListDatasource:output_type -> marksman.api.v1.ListDatasourceReply
+	21, // 43: marksman.api.v1.Datasource.ListDatasourceConfigSchema:output_type -> marksman.api.v1.ListDatasourceConfigSchemaReply
+	15, // 44: marksman.api.v1.Datasource.TestDatasource:output_type -> marksman.api.v1.TestDatasourceReply
+	38, // [38:45] is the sub-list for method output_type
+	31, // [31:38] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_marksman_api_v1_datasource_proto_init() }
+func file_marksman_api_v1_datasource_proto_init() {
+	if File_marksman_api_v1_datasource_proto != nil {
+		return
+	}
+	file_marksman_api_v1_datasource_proto_msgTypes[10].OneofWrappers = []any{
+		(*TestDatasourceRequest_Uid)(nil),
+		(*TestDatasourceRequest_Datasource)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_marksman_api_v1_datasource_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_marksman_api_v1_datasource_proto_goTypes,
 		DependencyIndexes: file_marksman_api_v1_datasource_proto_depIdxs,
+		EnumInfos:         file_marksman_api_v1_datasource_proto_enumTypes,
 		MessageInfos:      file_marksman_api_v1_datasource_proto_msgTypes,
 	}.Build()
 	File_marksman_api_v1_datasource_proto = out.File
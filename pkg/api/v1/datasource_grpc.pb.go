@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Datasource_CreateDatasource_FullMethodName = "/marksman.api.v1.Datasource/CreateDatasource"
-	Datasource_UpdateDatasource_FullMethodName = "/marksman.api.v1.Datasource/UpdateDatasource"
-	Datasource_DeleteDatasource_FullMethodName = "/marksman.api.v1.Datasource/DeleteDatasource"
-	Datasource_GetDatasource_FullMethodName    = "/marksman.api.v1.Datasource/GetDatasource"
-	Datasource_ListDatasource_FullMethodName   = "/marksman.api.v1.Datasource/ListDatasource"
+	Datasource_CreateDatasource_FullMethodName           = "/marksman.api.v1.Datasource/CreateDatasource"
+	Datasource_UpdateDatasource_FullMethodName           = "/marksman.api.v1.Datasource/UpdateDatasource"
+	Datasource_DeleteDatasource_FullMethodName           = "/marksman.api.v1.Datasource/DeleteDatasource"
+	Datasource_GetDatasource_FullMethodName              = "/marksman.api.v1.Datasource/GetDatasource"
+	Datasource_ListDatasource_FullMethodName             = "/marksman.api.v1.Datasource/ListDatasource"
+	Datasource_ListDatasourceConfigSchema_FullMethodName = "/marksman.api.v1.Datasource/ListDatasourceConfigSchema"
+	Datasource_TestDatasource_FullMethodName             = "/marksman.api.v1.Datasource/TestDatasource"
 )
 
 // DatasourceClient is the client API for Datasource service.
@@ -35,6 +37,8 @@ type DatasourceClient interface {
 	DeleteDatasource(ctx context.Context, in *DeleteDatasourceRequest, opts ...grpc.CallOption) (*DeleteDatasourceReply, error)
 	GetDatasource(ctx context.Context, in *GetDatasourceRequest, opts ...grpc.CallOption) (*DatasourceItem, error)
 	ListDatasource(ctx context.Context, in *ListDatasourceRequest, opts ...grpc.CallOption) (*ListDatasourceReply, error)
+	ListDatasourceConfigSchema(ctx context.Context, in *ListDatasourceConfigSchemaRequest, opts ...grpc.CallOption) (*ListDatasourceConfigSchemaReply, error)
+	TestDatasource(ctx context.Context, in *TestDatasourceRequest, opts ...grpc.CallOption) (*TestDatasourceReply, error)
 }
 
 type datasourceClient struct {
@@ -95,6 +99,26 @@ func (c *datasourceClient) ListDatasource(ctx context.Context, in *ListDatasourc
 	return out, nil
 }
 
+func (c *datasourceClient) ListDatasourceConfigSchema(ctx context.Context, in *ListDatasourceConfigSchemaRequest, opts ...grpc.CallOption) (*ListDatasourceConfigSchemaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDatasourceConfigSchemaReply)
+	err := c.cc.Invoke(ctx, Datasource_ListDatasourceConfigSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datasourceClient) TestDatasource(ctx context.Context, in *TestDatasourceRequest, opts ...grpc.CallOption) (*TestDatasourceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestDatasourceReply)
+	err := c.cc.Invoke(ctx, Datasource_TestDatasource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatasourceServer is the server API for Datasource service.
 // All implementations must embed UnimplementedDatasourceServer
 // for forward compatibility.
@@ -104,6 +128,8 @@ type DatasourceServer interface {
 	DeleteDatasource(context.Context, *DeleteDatasourceRequest) (*DeleteDatasourceReply, error)
 	GetDatasource(context.Context, *GetDatasourceRequest) (*DatasourceItem, error)
 	ListDatasource(context.Context, *ListDatasourceRequest) (*ListDatasourceReply, error)
+	ListDatasourceConfigSchema(context.Context, *ListDatasourceConfigSchemaRequest) (*ListDatasourceConfigSchemaReply, error)
+	TestDatasource(context.Context, *TestDatasourceRequest) (*TestDatasourceReply, error)
 	mustEmbedUnimplementedDatasourceServer()
 }
 
@@ -129,6 +155,12 @@ func (UnimplementedDatasourceServer) GetDatasource(context.Context, *GetDatasour
 func (UnimplementedDatasourceServer) ListDatasource(context.Context, *ListDatasourceRequest) (*ListDatasourceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDatasource not implemented")
 }
+func (UnimplementedDatasourceServer) ListDatasourceConfigSchema(context.Context, *ListDatasourceConfigSchemaRequest) (*ListDatasourceConfigSchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatasourceConfigSchema not implemented")
+}
+func (UnimplementedDatasourceServer) TestDatasource(context.Context, *TestDatasourceRequest) (*TestDatasourceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestDatasource not implemented")
+}
 func (UnimplementedDatasourceServer) mustEmbedUnimplementedDatasourceServer() {}
 func (UnimplementedDatasourceServer) testEmbeddedByValue()                    {}
 
@@ -240,6 +272,42 @@ func _Datasource_ListDatasource_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Datasource_ListDatasourceConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatasourceConfigSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatasourceServer).ListDatasourceConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Datasource_ListDatasourceConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatasourceServer).ListDatasourceConfigSchema(ctx, req.(*ListDatasourceConfigSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Datasource_TestDatasource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestDatasourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatasourceServer).TestDatasource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Datasource_TestDatasource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatasourceServer).TestDatasource(ctx, req.(*TestDatasourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Datasource_ServiceDesc is the grpc.ServiceDesc for Datasource service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +335,14 @@ var Datasource_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDatasource",
 			Handler:    _Datasource_ListDatasource_Handler,
 		},
+		{
+			MethodName: "ListDatasourceConfigSchema",
+			Handler:    _Datasource_ListDatasourceConfigSchema_Handler,
+		},
+		{
+			MethodName: "TestDatasource",
+			Handler:    _Datasource_TestDatasource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "marksman/api/v1/datasource.proto",
@@ -23,6 +23,8 @@ const OperationDatasourceCreateDatasource = "/marksman.api.v1.Datasource/CreateD
 const OperationDatasourceDeleteDatasource = "/marksman.api.v1.Datasource/DeleteDatasource"
 const OperationDatasourceGetDatasource = "/marksman.api.v1.Datasource/GetDatasource"
 const OperationDatasourceListDatasource = "/marksman.api.v1.Datasource/ListDatasource"
+const OperationDatasourceListDatasourceConfigSchema = "/marksman.api.v1.Datasource/ListDatasourceConfigSchema"
+const OperationDatasourceTestDatasource = "/marksman.api.v1.Datasource/TestDatasource"
 const OperationDatasourceUpdateDatasource = "/marksman.api.v1.Datasource/UpdateDatasource"
 
 type DatasourceHTTPServer interface {
@@ -30,6 +32,8 @@ type DatasourceHTTPServer interface {
 	DeleteDatasource(context.Context, *DeleteDatasourceRequest) (*DeleteDatasourceReply, error)
 	GetDatasource(context.Context, *GetDatasourceRequest) (*DatasourceItem, error)
 	ListDatasource(context.Context, *ListDatasourceRequest) (*ListDatasourceReply, error)
+	ListDatasourceConfigSchema(context.Context, *ListDatasourceConfigSchemaRequest) (*ListDatasourceConfigSchemaReply, error)
+	TestDatasource(context.Context, *TestDatasourceRequest) (*TestDatasourceReply, error)
 	UpdateDatasource(context.Context, *UpdateDatasourceRequest) (*UpdateDatasourceReply, error)
 }
 
@@ -40,6 +44,8 @@ func RegisterDatasourceHTTPServer(s *http.Server, srv DatasourceHTTPServer) {
 	r.DELETE("/v1/datasource/{uid}", _Datasource_DeleteDatasource0_HTTP_Handler(srv))
 	r.GET("/v1/datasource/{uid}", _Datasource_GetDatasource0_HTTP_Handler(srv))
 	r.GET("/v1/datasources", _Datasource_ListDatasource0_HTTP_Handler(srv))
+	r.GET("/v1/datasource-schemas", _Datasource_ListDatasourceConfigSchema0_HTTP_Handler(srv))
+	r.POST("/v1/datasource/test", _Datasource_TestDatasource0_HTTP_Handler(srv))
 }
 
 func _Datasource_CreateDatasource0_HTTP_Handler(srv DatasourceHTTPServer) func(ctx http.Context) error {
@@ -152,11 +158,54 @@ func _Datasource_ListDatasource0_HTTP_Handler(srv DatasourceHTTPServer) func(ctx
 	}
 }
 
+func _Datasource_ListDatasourceConfigSchema0_HTTP_Handler(srv DatasourceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDatasourceConfigSchemaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDatasourceListDatasourceConfigSchema)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDatasourceConfigSchema(ctx, req.(*ListDatasourceConfigSchemaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDatasourceConfigSchemaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Datasource_TestDatasource0_HTTP_Handler(srv DatasourceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TestDatasourceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDatasourceTestDatasource)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TestDatasource(ctx, req.(*TestDatasourceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TestDatasourceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DatasourceHTTPClient interface {
 	CreateDatasource(ctx context.Context, req *CreateDatasourceRequest, opts ...http.CallOption) (rsp *CreateDatasourceReply, err error)
 	DeleteDatasource(ctx context.Context, req *DeleteDatasourceRequest, opts ...http.CallOption) (rsp *DeleteDatasourceReply, err error)
 	GetDatasource(ctx context.Context, req *GetDatasourceRequest, opts ...http.CallOption) (rsp *DatasourceItem, err error)
 	ListDatasource(ctx context.Context, req *ListDatasourceRequest, opts ...http.CallOption) (rsp *ListDatasourceReply, err error)
+	ListDatasourceConfigSchema(ctx context.Context, req *ListDatasourceConfigSchemaRequest, opts ...http.CallOption) (rsp *ListDatasourceConfigSchemaReply, err error)
+	TestDatasource(ctx context.Context, req *TestDatasourceRequest, opts ...http.CallOption) (rsp *TestDatasourceReply, err error)
 	UpdateDatasource(ctx context.Context, req *UpdateDatasourceRequest, opts ...http.CallOption) (rsp *UpdateDatasourceReply, err error)
 }
 
@@ -220,6 +269,32 @@ func (c *DatasourceHTTPClientImpl) ListDatasource(ctx context.Context, in *ListD
 	return &out, nil
 }
 
+func (c *DatasourceHTTPClientImpl) ListDatasourceConfigSchema(ctx context.Context, in *ListDatasourceConfigSchemaRequest, opts ...http.CallOption) (*ListDatasourceConfigSchemaReply, error) {
+	var out ListDatasourceConfigSchemaReply
+	pattern := "/v1/datasource-schemas"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDatasourceListDatasourceConfigSchema))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DatasourceHTTPClientImpl) TestDatasource(ctx context.Context, in *TestDatasourceRequest, opts ...http.CallOption) (*TestDatasourceReply, error) {
+	var out TestDatasourceReply
+	pattern := "/v1/datasource/test"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDatasourceTestDatasource))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DatasourceHTTPClientImpl) UpdateDatasource(ctx context.Context, in *UpdateDatasourceRequest, opts ...http.CallOption) (*UpdateDatasourceReply, error) {
 	var out UpdateDatasourceReply
 	pattern := "/v1/datasource/{uid}"
@@ -0,0 +1,1062 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.3
+// source: marksman/api/v1/escalation_policy.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	enum "github.com/aide-family/magicbox/enum"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EscalationStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delay         *durationpb.Duration   `protobuf:"bytes,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	ReceiverUIDs  []int64                `protobuf:"varint,2,rep,packed,name=receiverUIDs,proto3" json:"receiverUIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationStep) Reset() {
+	*x = EscalationStep{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStep) ProtoMessage() {}
+
+func (x *EscalationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationStep.ProtoReflect.Descriptor instead.
+func (*EscalationStep) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EscalationStep) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *EscalationStep) GetReceiverUIDs() []int64 {
+	if x != nil {
+		return x.ReceiverUIDs
+	}
+	return nil
+}
+
+type EscalationPolicyItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Uid               int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Remark            string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	Steps             []*EscalationStep      `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	StrategyGroupUIDs []int64                `protobuf:"varint,5,rep,packed,name=strategyGroupUIDs,proto3" json:"strategyGroupUIDs,omitempty"`
+	LevelUIDs         []int64                `protobuf:"varint,6,rep,packed,name=levelUIDs,proto3" json:"levelUIDs,omitempty"`
+	Status            enum.GlobalStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EscalationPolicyItem) Reset() {
+	*x = EscalationPolicyItem{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationPolicyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationPolicyItem) ProtoMessage() {}
+
+func (x *EscalationPolicyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationPolicyItem.ProtoReflect.Descriptor instead.
+func (*EscalationPolicyItem) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EscalationPolicyItem) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *EscalationPolicyItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EscalationPolicyItem) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *EscalationPolicyItem) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *EscalationPolicyItem) GetStrategyGroupUIDs() []int64 {
+	if x != nil {
+		return x.StrategyGroupUIDs
+	}
+	return nil
+}
+
+func (x *EscalationPolicyItem) GetLevelUIDs() []int64 {
+	if x != nil {
+		return x.LevelUIDs
+	}
+	return nil
+}
+
+func (x *EscalationPolicyItem) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+func (x *EscalationPolicyItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EscalationPolicyItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateEscalationPolicyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Remark            string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	Steps             []*EscalationStep      `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	StrategyGroupUIDs []int64                `protobuf:"varint,4,rep,packed,name=strategyGroupUIDs,proto3" json:"strategyGroupUIDs,omitempty"`
+	LevelUIDs         []int64                `protobuf:"varint,5,rep,packed,name=levelUIDs,proto3" json:"levelUIDs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateEscalationPolicyRequest) Reset() {
+	*x = CreateEscalationPolicyRequest{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *CreateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreateEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateEscalationPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEscalationPolicyRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateEscalationPolicyRequest) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CreateEscalationPolicyRequest) GetStrategyGroupUIDs() []int64 {
+	if x != nil {
+		return x.StrategyGroupUIDs
+	}
+	return nil
+}
+
+func (x *CreateEscalationPolicyRequest) GetLevelUIDs() []int64 {
+	if x != nil {
+		return x.LevelUIDs
+	}
+	return nil
+}
+
+type CreateEscalationPolicyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEscalationPolicyReply) Reset() {
+	*x = CreateEscalationPolicyReply{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEscalationPolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEscalationPolicyReply) ProtoMessage() {}
+
+func (x *CreateEscalationPolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEscalationPolicyReply.ProtoReflect.Descriptor instead.
+func (*CreateEscalationPolicyReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{3}
+}
+
+type UpdateEscalationPolicyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Uid               int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Remark            string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	Steps             []*EscalationStep      `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	StrategyGroupUIDs []int64                `protobuf:"varint,5,rep,packed,name=strategyGroupUIDs,proto3" json:"strategyGroupUIDs,omitempty"`
+	LevelUIDs         []int64                `protobuf:"varint,6,rep,packed,name=levelUIDs,proto3" json:"levelUIDs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateEscalationPolicyRequest) Reset() {
+	*x = UpdateEscalationPolicyRequest{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateEscalationPolicyRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateEscalationPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEscalationPolicyRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UpdateEscalationPolicyRequest) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *UpdateEscalationPolicyRequest) GetStrategyGroupUIDs() []int64 {
+	if x != nil {
+		return x.StrategyGroupUIDs
+	}
+	return nil
+}
+
+func (x *UpdateEscalationPolicyRequest) GetLevelUIDs() []int64 {
+	if x != nil {
+		return x.LevelUIDs
+	}
+	return nil
+}
+
+type UpdateEscalationPolicyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEscalationPolicyReply) Reset() {
+	*x = UpdateEscalationPolicyReply{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEscalationPolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEscalationPolicyReply) ProtoMessage() {}
+
+func (x *UpdateEscalationPolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEscalationPolicyReply.ProtoReflect.Descriptor instead.
+func (*UpdateEscalationPolicyReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{5}
+}
+
+type UpdateEscalationPolicyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEscalationPolicyStatusRequest) Reset() {
+	*x = UpdateEscalationPolicyStatusRequest{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEscalationPolicyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEscalationPolicyStatusRequest) ProtoMessage() {}
+
+func (x *UpdateEscalationPolicyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEscalationPolicyStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEscalationPolicyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateEscalationPolicyStatusRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateEscalationPolicyStatusRequest) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type UpdateEscalationPolicyStatusReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEscalationPolicyStatusReply) Reset() {
+	*x = UpdateEscalationPolicyStatusReply{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEscalationPolicyStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEscalationPolicyStatusReply) ProtoMessage() {}
+
+func (x *UpdateEscalationPolicyStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEscalationPolicyStatusReply.ProtoReflect.Descriptor instead.
+func (*UpdateEscalationPolicyStatusReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{7}
+}
+
+type DeleteEscalationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEscalationPolicyRequest) Reset() {
+	*x = DeleteEscalationPolicyRequest{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteEscalationPolicyRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type DeleteEscalationPolicyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEscalationPolicyReply) Reset() {
+	*x = DeleteEscalationPolicyReply{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEscalationPolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEscalationPolicyReply) ProtoMessage() {}
+
+func (x *DeleteEscalationPolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEscalationPolicyReply.ProtoReflect.Descriptor instead.
+func (*DeleteEscalationPolicyReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{9}
+}
+
+type GetEscalationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEscalationPolicyRequest) Reset() {
+	*x = GetEscalationPolicyRequest{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *GetEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetEscalationPolicyRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type ListEscalationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEscalationPolicyRequest) Reset() {
+	*x = ListEscalationPolicyRequest{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *ListEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ListEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListEscalationPolicyRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEscalationPolicyRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEscalationPolicyRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListEscalationPolicyRequest) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type ListEscalationPolicyReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         int64                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                   `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                   `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Items         []*EscalationPolicyItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEscalationPolicyReply) Reset() {
+	*x = ListEscalationPolicyReply{}
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEscalationPolicyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEscalationPolicyReply) ProtoMessage() {}
+
+func (x *ListEscalationPolicyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_escalation_policy_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEscalationPolicyReply.ProtoReflect.Descriptor instead.
+func (*ListEscalationPolicyReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_escalation_policy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListEscalationPolicyReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEscalationPolicyReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEscalationPolicyReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEscalationPolicyReply) GetItems() []*EscalationPolicyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_marksman_api_v1_escalation_policy_proto protoreflect.FileDescriptor
+
+var file_marksman_api_v1_escalation_policy_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2f,
+	0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x84, 0x01, 0x0a, 0x0e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x65, 0x70, 0x12, 0x42, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0xba, 0x48,
+	0x0e, 0xc8, 0x01, 0x01, 0xaa, 0x01, 0x08, 0x22, 0x04, 0x08, 0x80, 0xa3, 0x05, 0x32, 0x00, 0x52,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x55, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x14, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x55, 0x49, 0x44, 0x73, 0x22, 0xc8, 0x02, 0x0a, 0x14, 0x45, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x35, 0x0a,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49,
+	0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x73,
+	0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d,
+	0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xfc, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x41, 0x0a,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x0a, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x12, 0x36, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x55, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x73,
+	0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xc1, 0x02, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x43, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x31,
+	0xba, 0x48, 0x2e, 0xba, 0x01, 0x28, 0x0a, 0x00, 0x12, 0x1a, 0x75, 0x69, 0x64, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68,
+	0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01,
+	0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03,
+	0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x41, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x0a, 0x52, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x49, 0x44, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55,
+	0x49, 0x44, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x80, 0x02, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0xbe, 0x01, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x88, 0x01, 0xba, 0x48, 0x84, 0x01, 0xba, 0x01, 0x7e, 0x12, 0x29, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20,
+	0x5b, 0x27, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x49, 0x53,
+	0x41, 0x42, 0x4c, 0x45, 0x44, 0x27, 0x5d, 0x1a, 0x51, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e,
+	0x20, 0x5b, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x45, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x2c, 0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65,
+	0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x0a, 0x1d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x93, 0x03, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3d, 0xba, 0x48, 0x3a, 0xba,
+	0x01, 0x34, 0x12, 0x27, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72,
+	0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x1a, 0x09, 0x74, 0x68, 0x69,
+	0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x8a, 0x01, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x6e, 0xba, 0x48, 0x6b, 0xba, 0x01, 0x65, 0x12, 0x49, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74,
+	0x6f, 0x20, 0x32, 0x30, 0x30, 0x1a, 0x18, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31,
+	0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3c, 0x3d, 0x20, 0x32, 0x30, 0x30, 0xc8,
+	0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5f, 0x0a, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xba,
+	0x48, 0x42, 0xba, 0x01, 0x3f, 0x12, 0x29, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x61, 0x6e,
+	0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x30,
+	0x1a, 0x12, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20, 0x3c, 0x3d,
+	0x20, 0x31, 0x30, 0x30, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x32, 0xcb, 0x07, 0x0a, 0x10, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x98, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2e, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x7b,
+	0x75, 0x69, 0x64, 0x7d, 0x12, 0xb7, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x9b,
+	0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a,
+	0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x8e, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x91, 0x01,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x42, 0x42, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marksman_api_v1_escalation_policy_proto_rawDescOnce sync.Once
+	file_marksman_api_v1_escalation_policy_proto_rawDescData = file_marksman_api_v1_escalation_policy_proto_rawDesc
+)
+
+func file_marksman_api_v1_escalation_policy_proto_rawDescGZIP() []byte {
+	file_marksman_api_v1_escalation_policy_proto_rawDescOnce.Do(func() {
+		file_marksman_api_v1_escalation_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_escalation_policy_proto_rawDescData)
+	})
+	return file_marksman_api_v1_escalation_policy_proto_rawDescData
+}
+
+var file_marksman_api_v1_escalation_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_marksman_api_v1_escalation_policy_proto_goTypes = []any{
+	(*EscalationStep)(nil),                      // 0: marksman.api.v1.EscalationStep
+	(*EscalationPolicyItem)(nil),                // 1: marksman.api.v1.EscalationPolicyItem
+	(*CreateEscalationPolicyRequest)(nil),       // 2: marksman.api.v1.CreateEscalationPolicyRequest
+	(*CreateEscalationPolicyReply)(nil),         // 3: marksman.api.v1.CreateEscalationPolicyReply
+	(*UpdateEscalationPolicyRequest)(nil),       // 4: marksman.api.v1.UpdateEscalationPolicyRequest
+	(*UpdateEscalationPolicyReply)(nil),         // 5: marksman.api.v1.UpdateEscalationPolicyReply
+	(*UpdateEscalationPolicyStatusRequest)(nil), // 6: marksman.api.v1.UpdateEscalationPolicyStatusRequest
+	(*UpdateEscalationPolicyStatusReply)(nil),   // 7: marksman.api.v1.UpdateEscalationPolicyStatusReply
+	(*DeleteEscalationPolicyRequest)(nil),       // 8: marksman.api.v1.DeleteEscalationPolicyRequest
+	(*DeleteEscalationPolicyReply)(nil),         // 9: marksman.api.v1.DeleteEscalationPolicyReply
+	(*GetEscalationPolicyRequest)(nil),          // 10: marksman.api.v1.GetEscalationPolicyRequest
+	(*ListEscalationPolicyRequest)(nil),         // 11: marksman.api.v1.ListEscalationPolicyRequest
+	(*ListEscalationPolicyReply)(nil),           // 12: marksman.api.v1.ListEscalationPolicyReply
+	(*durationpb.Duration)(nil),                 // 13: google.protobuf.Duration
+	(enum.GlobalStatus)(0),                      // 14: magicbox.enum.GlobalStatus
+}
+var file_marksman_api_v1_escalation_policy_proto_depIdxs = []int32{
+	13, // 0: marksman.api.v1.EscalationStep.delay:type_name -> google.protobuf.Duration
+	0,  // 1: marksman.api.v1.EscalationPolicyItem.steps:type_name -> marksman.api.v1.EscalationStep
+	14, // 2: marksman.api.v1.EscalationPolicyItem.status:type_name -> magicbox.enum.GlobalStatus
+	0,  // 3: marksman.api.v1.CreateEscalationPolicyRequest.steps:type_name -> marksman.api.v1.EscalationStep
+	0,  // 4: marksman.api.v1.UpdateEscalationPolicyRequest.steps:type_name -> marksman.api.v1.EscalationStep
+	14, // 5: marksman.api.v1.UpdateEscalationPolicyStatusRequest.status:type_name -> magicbox.enum.GlobalStatus
+	14, // 6: marksman.api.v1.ListEscalationPolicyRequest.status:type_name -> magicbox.enum.GlobalStatus
+	1,  // 7: marksman.api.v1.ListEscalationPolicyReply.items:type_name -> marksman.api.v1.EscalationPolicyItem
+	2,  // 8: marksman.api.v1.EscalationPolicy.CreateEscalationPolicy:input_type -> marksman.api.v1.CreateEscalationPolicyRequest
+	4,  // 9: marksman.api.v1.EscalationPolicy.UpdateEscalationPolicy:input_type -> marksman.api.v1.UpdateEscalationPolicyRequest
+	6,  // 10: marksman.api.v1.EscalationPolicy.UpdateEscalationPolicyStatus:input_type -> marksman.api.v1.UpdateEscalationPolicyStatusRequest
+	8,  // 11: marksman.api.v1.EscalationPolicy.DeleteEscalationPolicy:input_type -> marksman.api.v1.DeleteEscalationPolicyRequest
+	10, // 12: marksman.api.v1.EscalationPolicy.GetEscalationPolicy:input_type -> marksman.api.v1.GetEscalationPolicyRequest
+	11, // 13: marksman.api.v1.EscalationPolicy.ListEscalationPolicy:input_type -> marksman.api.v1.ListEscalationPolicyRequest
+	3,  // 14: marksman.api.v1.EscalationPolicy.CreateEscalationPolicy:output_type -> marksman.api.v1.CreateEscalationPolicyReply
+	5,  // 15: marksman.api.v1.EscalationPolicy.UpdateEscalationPolicy:output_type -> marksman.api.v1.UpdateEscalationPolicyReply
+	7,  // 16: marksman.api.v1.EscalationPolicy.UpdateEscalationPolicyStatus:output_type -> marksman.api.v1.UpdateEscalationPolicyStatusReply
+	9,  // 17: marksman.api.v1.EscalationPolicy.DeleteEscalationPolicy:output_type -> marksman.api.v1.DeleteEscalationPolicyReply
+	1,  // 18: marksman.api.v1.EscalationPolicy.GetEscalationPolicy:output_type -> marksman.api.v1.EscalationPolicyItem
+	12, // 19: marksman.api.v1.EscalationPolicy.ListEscalationPolicy:output_type -> marksman.api.v1.ListEscalationPolicyReply
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_marksman_api_v1_escalation_policy_proto_init() }
+func file_marksman_api_v1_escalation_policy_proto_init() {
+	if File_marksman_api_v1_escalation_policy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marksman_api_v1_escalation_policy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_marksman_api_v1_escalation_policy_proto_goTypes,
+		DependencyIndexes: file_marksman_api_v1_escalation_policy_proto_depIdxs,
+		MessageInfos:      file_marksman_api_v1_escalation_policy_proto_msgTypes,
+	}.Build()
+	File_marksman_api_v1_escalation_policy_proto = out.File
+	file_marksman_api_v1_escalation_policy_proto_rawDesc = nil
+	file_marksman_api_v1_escalation_policy_proto_goTypes = nil
+	file_marksman_api_v1_escalation_policy_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/escalation_policy.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EscalationPolicy_CreateEscalationPolicy_FullMethodName       = "/marksman.api.v1.EscalationPolicy/CreateEscalationPolicy"
+	EscalationPolicy_UpdateEscalationPolicy_FullMethodName       = "/marksman.api.v1.EscalationPolicy/UpdateEscalationPolicy"
+	EscalationPolicy_UpdateEscalationPolicyStatus_FullMethodName = "/marksman.api.v1.EscalationPolicy/UpdateEscalationPolicyStatus"
+	EscalationPolicy_DeleteEscalationPolicy_FullMethodName       = "/marksman.api.v1.EscalationPolicy/DeleteEscalationPolicy"
+	EscalationPolicy_GetEscalationPolicy_FullMethodName          = "/marksman.api.v1.EscalationPolicy/GetEscalationPolicy"
+	EscalationPolicy_ListEscalationPolicy_FullMethodName         = "/marksman.api.v1.EscalationPolicy/ListEscalationPolicy"
+)
+
+// EscalationPolicyClient is the client API for EscalationPolicy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EscalationPolicyClient interface {
+	CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...grpc.CallOption) (*CreateEscalationPolicyReply, error)
+	UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...grpc.CallOption) (*UpdateEscalationPolicyReply, error)
+	UpdateEscalationPolicyStatus(ctx context.Context, in *UpdateEscalationPolicyStatusRequest, opts ...grpc.CallOption) (*UpdateEscalationPolicyStatusReply, error)
+	DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...grpc.CallOption) (*DeleteEscalationPolicyReply, error)
+	GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicyItem, error)
+	ListEscalationPolicy(ctx context.Context, in *ListEscalationPolicyRequest, opts ...grpc.CallOption) (*ListEscalationPolicyReply, error)
+}
+
+type escalationPolicyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEscalationPolicyClient(cc grpc.ClientConnInterface) EscalationPolicyClient {
+	return &escalationPolicyClient{cc}
+}
+
+func (c *escalationPolicyClient) CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...grpc.CallOption) (*CreateEscalationPolicyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEscalationPolicyReply)
+	err := c.cc.Invoke(ctx, EscalationPolicy_CreateEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationPolicyClient) UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...grpc.CallOption) (*UpdateEscalationPolicyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEscalationPolicyReply)
+	err := c.cc.Invoke(ctx, EscalationPolicy_UpdateEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationPolicyClient) UpdateEscalationPolicyStatus(ctx context.Context, in *UpdateEscalationPolicyStatusRequest, opts ...grpc.CallOption) (*UpdateEscalationPolicyStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEscalationPolicyStatusReply)
+	err := c.cc.Invoke(ctx, EscalationPolicy_UpdateEscalationPolicyStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationPolicyClient) DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...grpc.CallOption) (*DeleteEscalationPolicyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEscalationPolicyReply)
+	err := c.cc.Invoke(ctx, EscalationPolicy_DeleteEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationPolicyClient) GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicyItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicyItem)
+	err := c.cc.Invoke(ctx, EscalationPolicy_GetEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationPolicyClient) ListEscalationPolicy(ctx context.Context, in *ListEscalationPolicyRequest, opts ...grpc.CallOption) (*ListEscalationPolicyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEscalationPolicyReply)
+	err := c.cc.Invoke(ctx, EscalationPolicy_ListEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EscalationPolicyServer is the server API for EscalationPolicy service.
+// All implementations must embed UnimplementedEscalationPolicyServer
+// for forward compatibility.
+type EscalationPolicyServer interface {
+	CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*CreateEscalationPolicyReply, error)
+	UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*UpdateEscalationPolicyReply, error)
+	UpdateEscalationPolicyStatus(context.Context, *UpdateEscalationPolicyStatusRequest) (*UpdateEscalationPolicyStatusReply, error)
+	DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*DeleteEscalationPolicyReply, error)
+	GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicyItem, error)
+	ListEscalationPolicy(context.Context, *ListEscalationPolicyRequest) (*ListEscalationPolicyReply, error)
+	mustEmbedUnimplementedEscalationPolicyServer()
+}
+
+// UnimplementedEscalationPolicyServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEscalationPolicyServer struct{}
+
+func (UnimplementedEscalationPolicyServer) CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*CreateEscalationPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscalationPolicy not implemented")
+}
+func (UnimplementedEscalationPolicyServer) UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*UpdateEscalationPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEscalationPolicy not implemented")
+}
+func (UnimplementedEscalationPolicyServer) UpdateEscalationPolicyStatus(context.Context, *UpdateEscalationPolicyStatusRequest) (*UpdateEscalationPolicyStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEscalationPolicyStatus not implemented")
+}
+func (UnimplementedEscalationPolicyServer) DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*DeleteEscalationPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEscalationPolicy not implemented")
+}
+func (UnimplementedEscalationPolicyServer) GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicyItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscalationPolicy not implemented")
+}
+func (UnimplementedEscalationPolicyServer) ListEscalationPolicy(context.Context, *ListEscalationPolicyRequest) (*ListEscalationPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscalationPolicy not implemented")
+}
+func (UnimplementedEscalationPolicyServer) mustEmbedUnimplementedEscalationPolicyServer() {}
+func (UnimplementedEscalationPolicyServer) testEmbeddedByValue()                          {}
+
+// UnsafeEscalationPolicyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EscalationPolicyServer will
+// result in compilation errors.
+type UnsafeEscalationPolicyServer interface {
+	mustEmbedUnimplementedEscalationPolicyServer()
+}
+
+func RegisterEscalationPolicyServer(s grpc.ServiceRegistrar, srv EscalationPolicyServer) {
+	// If the following call pancis, it indicates UnimplementedEscalationPolicyServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EscalationPolicy_ServiceDesc, srv)
+}
+
+func _EscalationPolicy_CreateEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationPolicyServer).CreateEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationPolicy_CreateEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationPolicyServer).CreateEscalationPolicy(ctx, req.(*CreateEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationPolicy_UpdateEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationPolicyServer).UpdateEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationPolicy_UpdateEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationPolicyServer).UpdateEscalationPolicy(ctx, req.(*UpdateEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationPolicy_UpdateEscalationPolicyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEscalationPolicyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationPolicyServer).UpdateEscalationPolicyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationPolicy_UpdateEscalationPolicyStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationPolicyServer).UpdateEscalationPolicyStatus(ctx, req.(*UpdateEscalationPolicyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationPolicy_DeleteEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationPolicyServer).DeleteEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationPolicy_DeleteEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationPolicyServer).DeleteEscalationPolicy(ctx, req.(*DeleteEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationPolicy_GetEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationPolicyServer).GetEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationPolicy_GetEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationPolicyServer).GetEscalationPolicy(ctx, req.(*GetEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationPolicy_ListEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationPolicyServer).ListEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationPolicy_ListEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationPolicyServer).ListEscalationPolicy(ctx, req.(*ListEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EscalationPolicy_ServiceDesc is the grpc.ServiceDesc for EscalationPolicy service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EscalationPolicy_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.EscalationPolicy",
+	HandlerType: (*EscalationPolicyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEscalationPolicy",
+			Handler:    _EscalationPolicy_CreateEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateEscalationPolicy",
+			Handler:    _EscalationPolicy_UpdateEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateEscalationPolicyStatus",
+			Handler:    _EscalationPolicy_UpdateEscalationPolicyStatus_Handler,
+		},
+		{
+			MethodName: "DeleteEscalationPolicy",
+			Handler:    _EscalationPolicy_DeleteEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "GetEscalationPolicy",
+			Handler:    _EscalationPolicy_GetEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "ListEscalationPolicy",
+			Handler:    _EscalationPolicy_ListEscalationPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/escalation_policy.proto",
+}
@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/escalation_policy.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationEscalationPolicyCreateEscalationPolicy = "/marksman.api.v1.EscalationPolicy/CreateEscalationPolicy"
+const OperationEscalationPolicyDeleteEscalationPolicy = "/marksman.api.v1.EscalationPolicy/DeleteEscalationPolicy"
+const OperationEscalationPolicyGetEscalationPolicy = "/marksman.api.v1.EscalationPolicy/GetEscalationPolicy"
+const OperationEscalationPolicyListEscalationPolicy = "/marksman.api.v1.EscalationPolicy/ListEscalationPolicy"
+const OperationEscalationPolicyUpdateEscalationPolicy = "/marksman.api.v1.EscalationPolicy/UpdateEscalationPolicy"
+const OperationEscalationPolicyUpdateEscalationPolicyStatus = "/marksman.api.v1.EscalationPolicy/UpdateEscalationPolicyStatus"
+
+type EscalationPolicyHTTPServer interface {
+	CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*CreateEscalationPolicyReply, error)
+	DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*DeleteEscalationPolicyReply, error)
+	GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicyItem, error)
+	ListEscalationPolicy(context.Context, *ListEscalationPolicyRequest) (*ListEscalationPolicyReply, error)
+	UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*UpdateEscalationPolicyReply, error)
+	UpdateEscalationPolicyStatus(context.Context, *UpdateEscalationPolicyStatusRequest) (*UpdateEscalationPolicyStatusReply, error)
+}
+
+func RegisterEscalationPolicyHTTPServer(s *http.Server, srv EscalationPolicyHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/escalation-policy", _EscalationPolicy_CreateEscalationPolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/escalation-policy/{uid}", _EscalationPolicy_UpdateEscalationPolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/escalation-policy/{uid}/status", _EscalationPolicy_UpdateEscalationPolicyStatus0_HTTP_Handler(srv))
+	r.DELETE("/v1/escalation-policy/{uid}", _EscalationPolicy_DeleteEscalationPolicy0_HTTP_Handler(srv))
+	r.GET("/v1/escalation-policy/{uid}", _EscalationPolicy_GetEscalationPolicy0_HTTP_Handler(srv))
+	r.GET("/v1/escalation-policies", _EscalationPolicy_ListEscalationPolicy0_HTTP_Handler(srv))
+}
+
+func _EscalationPolicy_CreateEscalationPolicy0_HTTP_Handler(srv EscalationPolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateEscalationPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEscalationPolicyCreateEscalationPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateEscalationPolicy(ctx, req.(*CreateEscalationPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateEscalationPolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EscalationPolicy_UpdateEscalationPolicy0_HTTP_Handler(srv EscalationPolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateEscalationPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEscalationPolicyUpdateEscalationPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateEscalationPolicy(ctx, req.(*UpdateEscalationPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateEscalationPolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EscalationPolicy_UpdateEscalationPolicyStatus0_HTTP_Handler(srv EscalationPolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateEscalationPolicyStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEscalationPolicyUpdateEscalationPolicyStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateEscalationPolicyStatus(ctx, req.(*UpdateEscalationPolicyStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateEscalationPolicyStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EscalationPolicy_DeleteEscalationPolicy0_HTTP_Handler(srv EscalationPolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteEscalationPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEscalationPolicyDeleteEscalationPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteEscalationPolicy(ctx, req.(*DeleteEscalationPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteEscalationPolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EscalationPolicy_GetEscalationPolicy0_HTTP_Handler(srv EscalationPolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEscalationPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEscalationPolicyGetEscalationPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEscalationPolicy(ctx, req.(*GetEscalationPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EscalationPolicyItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EscalationPolicy_ListEscalationPolicy0_HTTP_Handler(srv EscalationPolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEscalationPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEscalationPolicyListEscalationPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEscalationPolicy(ctx, req.(*ListEscalationPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEscalationPolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type EscalationPolicyHTTPClient interface {
+	CreateEscalationPolicy(ctx context.Context, req *CreateEscalationPolicyRequest, opts ...http.CallOption) (rsp *CreateEscalationPolicyReply, err error)
+	DeleteEscalationPolicy(ctx context.Context, req *DeleteEscalationPolicyRequest, opts ...http.CallOption) (rsp *DeleteEscalationPolicyReply, err error)
+	GetEscalationPolicy(ctx context.Context, req *GetEscalationPolicyRequest, opts ...http.CallOption) (rsp *EscalationPolicyItem, err error)
+	ListEscalationPolicy(ctx context.Context, req *ListEscalationPolicyRequest, opts ...http.CallOption) (rsp *ListEscalationPolicyReply, err error)
+	UpdateEscalationPolicy(ctx context.Context, req *UpdateEscalationPolicyRequest, opts ...http.CallOption) (rsp *UpdateEscalationPolicyReply, err error)
+	UpdateEscalationPolicyStatus(ctx context.Context, req *UpdateEscalationPolicyStatusRequest, opts ...http.CallOption) (rsp *UpdateEscalationPolicyStatusReply, err error)
+}
+
+type EscalationPolicyHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewEscalationPolicyHTTPClient(client *http.Client) EscalationPolicyHTTPClient {
+	return &EscalationPolicyHTTPClientImpl{client}
+}
+
+func (c *EscalationPolicyHTTPClientImpl) CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...http.CallOption) (*CreateEscalationPolicyReply, error) {
+	var out CreateEscalationPolicyReply
+	pattern := "/v1/escalation-policy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEscalationPolicyCreateEscalationPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EscalationPolicyHTTPClientImpl) DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...http.CallOption) (*DeleteEscalationPolicyReply, error) {
+	var out DeleteEscalationPolicyReply
+	pattern := "/v1/escalation-policy/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEscalationPolicyDeleteEscalationPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EscalationPolicyHTTPClientImpl) GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...http.CallOption) (*EscalationPolicyItem, error) {
+	var out EscalationPolicyItem
+	pattern := "/v1/escalation-policy/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEscalationPolicyGetEscalationPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EscalationPolicyHTTPClientImpl) ListEscalationPolicy(ctx context.Context, in *ListEscalationPolicyRequest, opts ...http.CallOption) (*ListEscalationPolicyReply, error) {
+	var out ListEscalationPolicyReply
+	pattern := "/v1/escalation-policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEscalationPolicyListEscalationPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EscalationPolicyHTTPClientImpl) UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...http.CallOption) (*UpdateEscalationPolicyReply, error) {
+	var out UpdateEscalationPolicyReply
+	pattern := "/v1/escalation-policy/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEscalationPolicyUpdateEscalationPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EscalationPolicyHTTPClientImpl) UpdateEscalationPolicyStatus(ctx context.Context, in *UpdateEscalationPolicyStatusRequest, opts ...http.CallOption) (*UpdateEscalationPolicyStatusReply, error) {
+	var out UpdateEscalationPolicyStatusReply
+	pattern := "/v1/escalation-policy/{uid}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEscalationPolicyUpdateEscalationPolicyStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/event.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Event_GetEvent_FullMethodName          = "/marksman.api.v1.Event/GetEvent"
+	Event_ListEvent_FullMethodName         = "/marksman.api.v1.Event/ListEvent"
+	Event_GetEventTimeline_FullMethodName  = "/marksman.api.v1.Event/GetEventTimeline"
+	Event_AckEvent_FullMethodName          = "/marksman.api.v1.Event/AckEvent"
+	Event_AssignEvent_FullMethodName       = "/marksman.api.v1.Event/AssignEvent"
+	Event_AddEventNote_FullMethodName      = "/marksman.api.v1.Event/AddEventNote"
+	Event_ResolveEvent_FullMethodName      = "/marksman.api.v1.Event/ResolveEvent"
+	Event_ListEventActivity_FullMethodName = "/marksman.api.v1.Event/ListEventActivity"
+)
+
+// EventClient is the client API for Event service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventClient interface {
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*EventItem, error)
+	ListEvent(ctx context.Context, in *ListEventRequest, opts ...grpc.CallOption) (*ListEventReply, error)
+	GetEventTimeline(ctx context.Context, in *GetEventTimelineRequest, opts ...grpc.CallOption) (*GetEventTimelineReply, error)
+	AckEvent(ctx context.Context, in *AckEventRequest, opts ...grpc.CallOption) (*AckEventReply, error)
+	AssignEvent(ctx context.Context, in *AssignEventRequest, opts ...grpc.CallOption) (*AssignEventReply, error)
+	AddEventNote(ctx context.Context, in *AddEventNoteRequest, opts ...grpc.CallOption) (*AddEventNoteReply, error)
+	ResolveEvent(ctx context.Context, in *ResolveEventRequest, opts ...grpc.CallOption) (*ResolveEventReply, error)
+	ListEventActivity(ctx context.Context, in *ListEventActivityRequest, opts ...grpc.CallOption) (*ListEventActivityReply, error)
+}
+
+type eventClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventClient(cc grpc.ClientConnInterface) EventClient {
+	return &eventClient{cc}
+}
+
+func (c *eventClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*EventItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventItem)
+	err := c.cc.Invoke(ctx, Event_GetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) ListEvent(ctx context.Context, in *ListEventRequest, opts ...grpc.CallOption) (*ListEventReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventReply)
+	err := c.cc.Invoke(ctx, Event_ListEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) GetEventTimeline(ctx context.Context, in *GetEventTimelineRequest, opts ...grpc.CallOption) (*GetEventTimelineReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventTimelineReply)
+	err := c.cc.Invoke(ctx, Event_GetEventTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) AckEvent(ctx context.Context, in *AckEventRequest, opts ...grpc.CallOption) (*AckEventReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckEventReply)
+	err := c.cc.Invoke(ctx, Event_AckEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) AssignEvent(ctx context.Context, in *AssignEventRequest, opts ...grpc.CallOption) (*AssignEventReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignEventReply)
+	err := c.cc.Invoke(ctx, Event_AssignEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) AddEventNote(ctx context.Context, in *AddEventNoteRequest, opts ...grpc.CallOption) (*AddEventNoteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddEventNoteReply)
+	err := c.cc.Invoke(ctx, Event_AddEventNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) ResolveEvent(ctx context.Context, in *ResolveEventRequest, opts ...grpc.CallOption) (*ResolveEventReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveEventReply)
+	err := c.cc.Invoke(ctx, Event_ResolveEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventClient) ListEventActivity(ctx context.Context, in *ListEventActivityRequest, opts ...grpc.CallOption) (*ListEventActivityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventActivityReply)
+	err := c.cc.Invoke(ctx, Event_ListEventActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServer is the server API for Event service.
+// All implementations must embed UnimplementedEventServer
+// for forward compatibility.
+type EventServer interface {
+	GetEvent(context.Context, *GetEventRequest) (*EventItem, error)
+	ListEvent(context.Context, *ListEventRequest) (*ListEventReply, error)
+	GetEventTimeline(context.Context, *GetEventTimelineRequest) (*GetEventTimelineReply, error)
+	AckEvent(context.Context, *AckEventRequest) (*AckEventReply, error)
+	AssignEvent(context.Context, *AssignEventRequest) (*AssignEventReply, error)
+	AddEventNote(context.Context, *AddEventNoteRequest) (*AddEventNoteReply, error)
+	ResolveEvent(context.Context, *ResolveEventRequest) (*ResolveEventReply, error)
+	ListEventActivity(context.Context, *ListEventActivityRequest) (*ListEventActivityReply, error)
+	mustEmbedUnimplementedEventServer()
+}
+
+// UnimplementedEventServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServer struct{}
+
+func (UnimplementedEventServer) GetEvent(context.Context, *GetEventRequest) (*EventItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServer) ListEvent(context.Context, *ListEventRequest) (*ListEventReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvent not implemented")
+}
+func (UnimplementedEventServer) GetEventTimeline(context.Context, *GetEventTimelineRequest) (*GetEventTimelineReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventTimeline not implemented")
+}
+func (UnimplementedEventServer) AckEvent(context.Context, *AckEventRequest) (*AckEventReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckEvent not implemented")
+}
+func (UnimplementedEventServer) AssignEvent(context.Context, *AssignEventRequest) (*AssignEventReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignEvent not implemented")
+}
+func (UnimplementedEventServer) AddEventNote(context.Context, *AddEventNoteRequest) (*AddEventNoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEventNote not implemented")
+}
+func (UnimplementedEventServer) ResolveEvent(context.Context, *ResolveEventRequest) (*ResolveEventReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEvent not implemented")
+}
+func (UnimplementedEventServer) ListEventActivity(context.Context, *ListEventActivityRequest) (*ListEventActivityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventActivity not implemented")
+}
+func (UnimplementedEventServer) mustEmbedUnimplementedEventServer() {}
+func (UnimplementedEventServer) testEmbeddedByValue()               {}
+
+// UnsafeEventServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServer will
+// result in compilation errors.
+type UnsafeEventServer interface {
+	mustEmbedUnimplementedEventServer()
+}
+
+func RegisterEventServer(s grpc.ServiceRegistrar, srv EventServer) {
+	// If the following call pancis, it indicates UnimplementedEventServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Event_ServiceDesc, srv)
+}
+
+func _Event_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_ListEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).ListEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_ListEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).ListEvent(ctx, req.(*ListEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_GetEventTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).GetEventTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_GetEventTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).GetEventTimeline(ctx, req.(*GetEventTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_AckEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).AckEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_AckEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).AckEvent(ctx, req.(*AckEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_AssignEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).AssignEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_AssignEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).AssignEvent(ctx, req.(*AssignEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_AddEventNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).AddEventNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_AddEventNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).AddEventNote(ctx, req.(*AddEventNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_ResolveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).ResolveEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_ResolveEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).ResolveEvent(ctx, req.(*ResolveEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_ListEventActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).ListEventActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Event_ListEventActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).ListEventActivity(ctx, req.(*ListEventActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Event_ServiceDesc is the grpc.ServiceDesc for Event service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Event_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.Event",
+	HandlerType: (*EventServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEvent",
+			Handler:    _Event_GetEvent_Handler,
+		},
+		{
+			MethodName: "ListEvent",
+			Handler:    _Event_ListEvent_Handler,
+		},
+		{
+			MethodName: "GetEventTimeline",
+			Handler:    _Event_GetEventTimeline_Handler,
+		},
+		{
+			MethodName: "AckEvent",
+			Handler:    _Event_AckEvent_Handler,
+		},
+		{
+			MethodName: "AssignEvent",
+			Handler:    _Event_AssignEvent_Handler,
+		},
+		{
+			MethodName: "AddEventNote",
+			Handler:    _Event_AddEventNote_Handler,
+		},
+		{
+			MethodName: "ResolveEvent",
+			Handler:    _Event_ResolveEvent_Handler,
+		},
+		{
+			MethodName: "ListEventActivity",
+			Handler:    _Event_ListEventActivity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/event.proto",
+}
@@ -0,0 +1,360 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/event.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationEventAckEvent = "/marksman.api.v1.Event/AckEvent"
+const OperationEventAddEventNote = "/marksman.api.v1.Event/AddEventNote"
+const OperationEventAssignEvent = "/marksman.api.v1.Event/AssignEvent"
+const OperationEventGetEvent = "/marksman.api.v1.Event/GetEvent"
+const OperationEventGetEventTimeline = "/marksman.api.v1.Event/GetEventTimeline"
+const OperationEventListEvent = "/marksman.api.v1.Event/ListEvent"
+const OperationEventListEventActivity = "/marksman.api.v1.Event/ListEventActivity"
+const OperationEventResolveEvent = "/marksman.api.v1.Event/ResolveEvent"
+
+type EventHTTPServer interface {
+	AckEvent(context.Context, *AckEventRequest) (*AckEventReply, error)
+	AddEventNote(context.Context, *AddEventNoteRequest) (*AddEventNoteReply, error)
+	AssignEvent(context.Context, *AssignEventRequest) (*AssignEventReply, error)
+	GetEvent(context.Context, *GetEventRequest) (*EventItem, error)
+	GetEventTimeline(context.Context, *GetEventTimelineRequest) (*GetEventTimelineReply, error)
+	ListEvent(context.Context, *ListEventRequest) (*ListEventReply, error)
+	ListEventActivity(context.Context, *ListEventActivityRequest) (*ListEventActivityReply, error)
+	ResolveEvent(context.Context, *ResolveEventRequest) (*ResolveEventReply, error)
+}
+
+func RegisterEventHTTPServer(s *http.Server, srv EventHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/event/{uid}", _Event_GetEvent0_HTTP_Handler(srv))
+	r.GET("/v1/events", _Event_ListEvent0_HTTP_Handler(srv))
+	r.GET("/v1/event/{uid}/timeline", _Event_GetEventTimeline0_HTTP_Handler(srv))
+	r.POST("/v1/event/{uid}/ack", _Event_AckEvent0_HTTP_Handler(srv))
+	r.POST("/v1/event/{uid}/assign", _Event_AssignEvent0_HTTP_Handler(srv))
+	r.POST("/v1/event/{uid}/note", _Event_AddEventNote0_HTTP_Handler(srv))
+	r.POST("/v1/event/{uid}/resolve", _Event_ResolveEvent0_HTTP_Handler(srv))
+	r.GET("/v1/event/{uid}/activities", _Event_ListEventActivity0_HTTP_Handler(srv))
+}
+
+func _Event_GetEvent0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEventRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventGetEvent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEvent(ctx, req.(*GetEventRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EventItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_ListEvent0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEventRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventListEvent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEvent(ctx, req.(*ListEventRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEventReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_GetEventTimeline0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetEventTimelineRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventGetEventTimeline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEventTimeline(ctx, req.(*GetEventTimelineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetEventTimelineReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_AckEvent0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AckEventRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventAckEvent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AckEvent(ctx, req.(*AckEventRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AckEventReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_AssignEvent0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignEventRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventAssignEvent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignEvent(ctx, req.(*AssignEventRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AssignEventReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_AddEventNote0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddEventNoteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventAddEventNote)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddEventNote(ctx, req.(*AddEventNoteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddEventNoteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_ResolveEvent0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveEventRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventResolveEvent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveEvent(ctx, req.(*ResolveEventRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveEventReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Event_ListEventActivity0_HTTP_Handler(srv EventHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEventActivityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventListEventActivity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEventActivity(ctx, req.(*ListEventActivityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEventActivityReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type EventHTTPClient interface {
+	AckEvent(ctx context.Context, req *AckEventRequest, opts ...http.CallOption) (rsp *AckEventReply, err error)
+	AddEventNote(ctx context.Context, req *AddEventNoteRequest, opts ...http.CallOption) (rsp *AddEventNoteReply, err error)
+	AssignEvent(ctx context.Context, req *AssignEventRequest, opts ...http.CallOption) (rsp *AssignEventReply, err error)
+	GetEvent(ctx context.Context, req *GetEventRequest, opts ...http.CallOption) (rsp *EventItem, err error)
+	GetEventTimeline(ctx context.Context, req *GetEventTimelineRequest, opts ...http.CallOption) (rsp *GetEventTimelineReply, err error)
+	ListEvent(ctx context.Context, req *ListEventRequest, opts ...http.CallOption) (rsp *ListEventReply, err error)
+	ListEventActivity(ctx context.Context, req *ListEventActivityRequest, opts ...http.CallOption) (rsp *ListEventActivityReply, err error)
+	ResolveEvent(ctx context.Context, req *ResolveEventRequest, opts ...http.CallOption) (rsp *ResolveEventReply, err error)
+}
+
+type EventHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewEventHTTPClient(client *http.Client) EventHTTPClient {
+	return &EventHTTPClientImpl{client}
+}
+
+func (c *EventHTTPClientImpl) AckEvent(ctx context.Context, in *AckEventRequest, opts ...http.CallOption) (*AckEventReply, error) {
+	var out AckEventReply
+	pattern := "/v1/event/{uid}/ack"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEventAckEvent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) AddEventNote(ctx context.Context, in *AddEventNoteRequest, opts ...http.CallOption) (*AddEventNoteReply, error) {
+	var out AddEventNoteReply
+	pattern := "/v1/event/{uid}/note"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEventAddEventNote))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) AssignEvent(ctx context.Context, in *AssignEventRequest, opts ...http.CallOption) (*AssignEventReply, error) {
+	var out AssignEventReply
+	pattern := "/v1/event/{uid}/assign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEventAssignEvent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) GetEvent(ctx context.Context, in *GetEventRequest, opts ...http.CallOption) (*EventItem, error) {
+	var out EventItem
+	pattern := "/v1/event/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEventGetEvent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) GetEventTimeline(ctx context.Context, in *GetEventTimelineRequest, opts ...http.CallOption) (*GetEventTimelineReply, error) {
+	var out GetEventTimelineReply
+	pattern := "/v1/event/{uid}/timeline"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEventGetEventTimeline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) ListEvent(ctx context.Context, in *ListEventRequest, opts ...http.CallOption) (*ListEventReply, error) {
+	var out ListEventReply
+	pattern := "/v1/events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEventListEvent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) ListEventActivity(ctx context.Context, in *ListEventActivityRequest, opts ...http.CallOption) (*ListEventActivityReply, error) {
+	var out ListEventActivityReply
+	pattern := "/v1/event/{uid}/activities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEventListEventActivity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EventHTTPClientImpl) ResolveEvent(ctx context.Context, in *ResolveEventRequest, opts ...http.CallOption) (*ResolveEventReply, error) {
+	var out ResolveEventReply
+	pattern := "/v1/event/{uid}/resolve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEventResolveEvent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,1337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.3
+// source: marksman/api/v1/inhibit_rule.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	enum "github.com/aide-family/magicbox/enum"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InhibitRuleItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Remark         string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	SourceLevelUID int64                  `protobuf:"varint,4,opt,name=sourceLevelUID,proto3" json:"sourceLevelUID,omitempty"`
+	SourceMatchers []string               `protobuf:"bytes,5,rep,name=sourceMatchers,proto3" json:"sourceMatchers,omitempty"`
+	TargetLevelUID int64                  `protobuf:"varint,6,opt,name=targetLevelUID,proto3" json:"targetLevelUID,omitempty"`
+	TargetMatchers []string               `protobuf:"bytes,7,rep,name=targetMatchers,proto3" json:"targetMatchers,omitempty"`
+	Equal          []string               `protobuf:"bytes,8,rep,name=equal,proto3" json:"equal,omitempty"`
+	Status         enum.GlobalStatus      `protobuf:"varint,9,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InhibitRuleItem) Reset() {
+	*x = InhibitRuleItem{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitRuleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitRuleItem) ProtoMessage() {}
+
+func (x *InhibitRuleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitRuleItem.ProtoReflect.Descriptor instead.
+func (*InhibitRuleItem) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InhibitRuleItem) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *InhibitRuleItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InhibitRuleItem) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *InhibitRuleItem) GetSourceLevelUID() int64 {
+	if x != nil {
+		return x.SourceLevelUID
+	}
+	return 0
+}
+
+func (x *InhibitRuleItem) GetSourceMatchers() []string {
+	if x != nil {
+		return x.SourceMatchers
+	}
+	return nil
+}
+
+func (x *InhibitRuleItem) GetTargetLevelUID() int64 {
+	if x != nil {
+		return x.TargetLevelUID
+	}
+	return 0
+}
+
+func (x *InhibitRuleItem) GetTargetMatchers() []string {
+	if x != nil {
+		return x.TargetMatchers
+	}
+	return nil
+}
+
+func (x *InhibitRuleItem) GetEqual() []string {
+	if x != nil {
+		return x.Equal
+	}
+	return nil
+}
+
+func (x *InhibitRuleItem) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+func (x *InhibitRuleItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *InhibitRuleItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateInhibitRuleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Remark         string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	SourceLevelUID int64                  `protobuf:"varint,3,opt,name=sourceLevelUID,proto3" json:"sourceLevelUID,omitempty"`
+	SourceMatchers []string               `protobuf:"bytes,4,rep,name=sourceMatchers,proto3" json:"sourceMatchers,omitempty"`
+	TargetLevelUID int64                  `protobuf:"varint,5,opt,name=targetLevelUID,proto3" json:"targetLevelUID,omitempty"`
+	TargetMatchers []string               `protobuf:"bytes,6,rep,name=targetMatchers,proto3" json:"targetMatchers,omitempty"`
+	Equal          []string               `protobuf:"bytes,7,rep,name=equal,proto3" json:"equal,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateInhibitRuleRequest) Reset() {
+	*x = CreateInhibitRuleRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInhibitRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInhibitRuleRequest) ProtoMessage() {}
+
+func (x *CreateInhibitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInhibitRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateInhibitRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInhibitRuleRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateInhibitRuleRequest) GetSourceLevelUID() int64 {
+	if x != nil {
+		return x.SourceLevelUID
+	}
+	return 0
+}
+
+func (x *CreateInhibitRuleRequest) GetSourceMatchers() []string {
+	if x != nil {
+		return x.SourceMatchers
+	}
+	return nil
+}
+
+func (x *CreateInhibitRuleRequest) GetTargetLevelUID() int64 {
+	if x != nil {
+		return x.TargetLevelUID
+	}
+	return 0
+}
+
+func (x *CreateInhibitRuleRequest) GetTargetMatchers() []string {
+	if x != nil {
+		return x.TargetMatchers
+	}
+	return nil
+}
+
+func (x *CreateInhibitRuleRequest) GetEqual() []string {
+	if x != nil {
+		return x.Equal
+	}
+	return nil
+}
+
+type CreateInhibitRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInhibitRuleReply) Reset() {
+	*x = CreateInhibitRuleReply{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInhibitRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInhibitRuleReply) ProtoMessage() {}
+
+func (x *CreateInhibitRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInhibitRuleReply.ProtoReflect.Descriptor instead.
+func (*CreateInhibitRuleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{2}
+}
+
+type UpdateInhibitRuleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Remark         string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	SourceLevelUID int64                  `protobuf:"varint,4,opt,name=sourceLevelUID,proto3" json:"sourceLevelUID,omitempty"`
+	SourceMatchers []string               `protobuf:"bytes,5,rep,name=sourceMatchers,proto3" json:"sourceMatchers,omitempty"`
+	TargetLevelUID int64                  `protobuf:"varint,6,opt,name=targetLevelUID,proto3" json:"targetLevelUID,omitempty"`
+	TargetMatchers []string               `protobuf:"bytes,7,rep,name=targetMatchers,proto3" json:"targetMatchers,omitempty"`
+	Equal          []string               `protobuf:"bytes,8,rep,name=equal,proto3" json:"equal,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateInhibitRuleRequest) Reset() {
+	*x = UpdateInhibitRuleRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInhibitRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInhibitRuleRequest) ProtoMessage() {}
+
+func (x *UpdateInhibitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInhibitRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateInhibitRuleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateInhibitRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateInhibitRuleRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UpdateInhibitRuleRequest) GetSourceLevelUID() int64 {
+	if x != nil {
+		return x.SourceLevelUID
+	}
+	return 0
+}
+
+func (x *UpdateInhibitRuleRequest) GetSourceMatchers() []string {
+	if x != nil {
+		return x.SourceMatchers
+	}
+	return nil
+}
+
+func (x *UpdateInhibitRuleRequest) GetTargetLevelUID() int64 {
+	if x != nil {
+		return x.TargetLevelUID
+	}
+	return 0
+}
+
+func (x *UpdateInhibitRuleRequest) GetTargetMatchers() []string {
+	if x != nil {
+		return x.TargetMatchers
+	}
+	return nil
+}
+
+func (x *UpdateInhibitRuleRequest) GetEqual() []string {
+	if x != nil {
+		return x.Equal
+	}
+	return nil
+}
+
+type UpdateInhibitRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInhibitRuleReply) Reset() {
+	*x = UpdateInhibitRuleReply{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInhibitRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInhibitRuleReply) ProtoMessage() {}
+
+func (x *UpdateInhibitRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInhibitRuleReply.ProtoReflect.Descriptor instead.
+func (*UpdateInhibitRuleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{4}
+}
+
+type UpdateInhibitRuleStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInhibitRuleStatusRequest) Reset() {
+	*x = UpdateInhibitRuleStatusRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInhibitRuleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInhibitRuleStatusRequest) ProtoMessage() {}
+
+func (x *UpdateInhibitRuleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInhibitRuleStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInhibitRuleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateInhibitRuleStatusRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateInhibitRuleStatusRequest) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type UpdateInhibitRuleStatusReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInhibitRuleStatusReply) Reset() {
+	*x = UpdateInhibitRuleStatusReply{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInhibitRuleStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInhibitRuleStatusReply) ProtoMessage() {}
+
+func (x *UpdateInhibitRuleStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInhibitRuleStatusReply.ProtoReflect.Descriptor instead.
+func (*UpdateInhibitRuleStatusReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{6}
+}
+
+type DeleteInhibitRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInhibitRuleRequest) Reset() {
+	*x = DeleteInhibitRuleRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInhibitRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInhibitRuleRequest) ProtoMessage() {}
+
+func (x *DeleteInhibitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInhibitRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteInhibitRuleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type DeleteInhibitRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInhibitRuleReply) Reset() {
+	*x = DeleteInhibitRuleReply{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInhibitRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInhibitRuleReply) ProtoMessage() {}
+
+func (x *DeleteInhibitRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInhibitRuleReply.ProtoReflect.Descriptor instead.
+func (*DeleteInhibitRuleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{8}
+}
+
+type GetInhibitRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInhibitRuleRequest) Reset() {
+	*x = GetInhibitRuleRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInhibitRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInhibitRuleRequest) ProtoMessage() {}
+
+func (x *GetInhibitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInhibitRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetInhibitRuleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type ListInhibitRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	LevelUID      int64                  `protobuf:"varint,4,opt,name=levelUID,proto3" json:"levelUID,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInhibitRuleRequest) Reset() {
+	*x = ListInhibitRuleRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInhibitRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInhibitRuleRequest) ProtoMessage() {}
+
+func (x *ListInhibitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInhibitRuleRequest.ProtoReflect.Descriptor instead.
+func (*ListInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListInhibitRuleRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInhibitRuleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInhibitRuleRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListInhibitRuleRequest) GetLevelUID() int64 {
+	if x != nil {
+		return x.LevelUID
+	}
+	return 0
+}
+
+func (x *ListInhibitRuleRequest) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type ListInhibitRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Items         []*InhibitRuleItem     `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInhibitRuleReply) Reset() {
+	*x = ListInhibitRuleReply{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInhibitRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInhibitRuleReply) ProtoMessage() {}
+
+func (x *ListInhibitRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInhibitRuleReply.ProtoReflect.Descriptor instead.
+func (*ListInhibitRuleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListInhibitRuleReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListInhibitRuleReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInhibitRuleReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInhibitRuleReply) GetItems() []*InhibitRuleItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type InhibitionAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	LevelUID      int64                  `protobuf:"varint,2,opt,name=levelUID,proto3" json:"levelUID,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InhibitionAlert) Reset() {
+	*x = InhibitionAlert{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitionAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitionAlert) ProtoMessage() {}
+
+func (x *InhibitionAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitionAlert.ProtoReflect.Descriptor instead.
+func (*InhibitionAlert) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InhibitionAlert) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *InhibitionAlert) GetLevelUID() int64 {
+	if x != nil {
+		return x.LevelUID
+	}
+	return 0
+}
+
+func (x *InhibitionAlert) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type EvaluateInhibitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*InhibitionAlert     `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateInhibitionRequest) Reset() {
+	*x = EvaluateInhibitionRequest{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateInhibitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateInhibitionRequest) ProtoMessage() {}
+
+func (x *EvaluateInhibitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateInhibitionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateInhibitionRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EvaluateInhibitionRequest) GetAlerts() []*InhibitionAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type InhibitionResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Fingerprint       string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Inhibited         bool                   `protobuf:"varint,2,opt,name=inhibited,proto3" json:"inhibited,omitempty"`
+	RuleUID           int64                  `protobuf:"varint,3,opt,name=ruleUID,proto3" json:"ruleUID,omitempty"`
+	SourceFingerprint string                 `protobuf:"bytes,4,opt,name=sourceFingerprint,proto3" json:"sourceFingerprint,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InhibitionResult) Reset() {
+	*x = InhibitionResult{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InhibitionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InhibitionResult) ProtoMessage() {}
+
+func (x *InhibitionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InhibitionResult.ProtoReflect.Descriptor instead.
+func (*InhibitionResult) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InhibitionResult) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *InhibitionResult) GetInhibited() bool {
+	if x != nil {
+		return x.Inhibited
+	}
+	return false
+}
+
+func (x *InhibitionResult) GetRuleUID() int64 {
+	if x != nil {
+		return x.RuleUID
+	}
+	return 0
+}
+
+func (x *InhibitionResult) GetSourceFingerprint() string {
+	if x != nil {
+		return x.SourceFingerprint
+	}
+	return ""
+}
+
+type EvaluateInhibitionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*InhibitionResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateInhibitionReply) Reset() {
+	*x = EvaluateInhibitionReply{}
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateInhibitionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateInhibitionReply) ProtoMessage() {}
+
+func (x *EvaluateInhibitionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_inhibit_rule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateInhibitionReply.ProtoReflect.Descriptor instead.
+func (*EvaluateInhibitionReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EvaluateInhibitionReply) GetResults() []*InhibitionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_marksman_api_v1_inhibit_rule_proto protoreflect.FileDescriptor
+
+var file_marksman_api_v1_inhibit_rule_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x18, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f,
+	0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x02, 0x0a, 0x0f, 0x49,
+	0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x62, 0x0a, 0x0e, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x12, 0x25, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20,
+	0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20,
+	0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x12, 0x30,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x14,
+	0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73,
+	0x12, 0x62, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55,
+	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31,
+	0x12, 0x25, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20,
+	0x30, 0xc8, 0x01, 0x01, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x92, 0x01, 0x02, 0x10, 0x14, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x14, 0x52,
+	0x05, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0xe5, 0x03, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62,
+	0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x31, 0xba, 0x48, 0x2e, 0xba,
+	0x01, 0x28, 0x0a, 0x00, 0x12, 0x1a, 0x75, 0x69, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30,
+	0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x62, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba, 0x01, 0x31, 0x12, 0x25, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x20, 0x6d, 0x75, 0x73,
+	0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61,
+	0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01,
+	0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44,
+	0x12, 0x30, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
+	0x10, 0x14, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x12, 0x62, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x55, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3a, 0xba, 0x48, 0x37, 0xba,
+	0x01, 0x31, 0x12, 0x25, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x55,
+	0x49, 0x44, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x14, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x65, 0x71, 0x75, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10,
+	0x14, 0x52, 0x05, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0xfb, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68,
+	0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0xbe, 0x01, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d,
+	0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x88, 0x01,
+	0xba, 0x48, 0x84, 0x01, 0xba, 0x01, 0x7e, 0x12, 0x29, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x45, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x27, 0x5d, 0x1a, 0x51, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67,
+	0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x2c,
+	0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x49, 0x53, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x34, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x22, 0xaa, 0x03, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x68, 0x69,
+	0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3d, 0xba, 0x48,
+	0x3a, 0xba, 0x01, 0x34, 0x12, 0x27, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20,
+	0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20,
+	0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x1a, 0x09, 0x74,
+	0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x8a, 0x01, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x6e, 0xba, 0x48, 0x6b, 0xba, 0x01, 0x65, 0x12, 0x49, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65,
+	0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x20, 0x74, 0x6f, 0x20, 0x32, 0x30, 0x30, 0x1a, 0x18, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d,
+	0x20, 0x31, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3c, 0x3d, 0x20, 0x32, 0x30,
+	0x30, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5f,
+	0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x45, 0xba, 0x48, 0x42, 0xba, 0x01, 0x3f, 0x12, 0x29, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68,
+	0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31,
+	0x30, 0x30, 0x1a, 0x12, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20,
+	0x3c, 0x3d, 0x20, 0x31, 0x30, 0x30, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61,
+	0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x94, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x68, 0x69,
+	0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x18, 0x40, 0x52, 0x0b, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x08, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x34, 0xba, 0x48, 0x31,
+	0xba, 0x01, 0x2b, 0x12, 0x1f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68,
+	0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01,
+	0x01, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x55, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x68, 0x69, 0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x62, 0x0a, 0x19,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x68, 0x69,
+	0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x22, 0x9a, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x68, 0x69, 0x62,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x68, 0x69,
+	0x62, 0x69, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12,
+	0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x22, 0x56, 0x0a,
+	0x17, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x68, 0x69,
+	0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xdf, 0x07, 0x0a, 0x0b, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x8a, 0x01, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x29, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62,
+	0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
+	0x2a, 0x1a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72,
+	0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x17, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a,
+	0x1a, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72, 0x75,
+	0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x87, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72,
+	0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x2f,
+	0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x7c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x68,
+	0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x42, 0x42, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marksman_api_v1_inhibit_rule_proto_rawDescOnce sync.Once
+	file_marksman_api_v1_inhibit_rule_proto_rawDescData = file_marksman_api_v1_inhibit_rule_proto_rawDesc
+)
+
+func file_marksman_api_v1_inhibit_rule_proto_rawDescGZIP() []byte {
+	file_marksman_api_v1_inhibit_rule_proto_rawDescOnce.Do(func() {
+		file_marksman_api_v1_inhibit_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_inhibit_rule_proto_rawDescData)
+	})
+	return file_marksman_api_v1_inhibit_rule_proto_rawDescData
+}
+
+var file_marksman_api_v1_inhibit_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_marksman_api_v1_inhibit_rule_proto_goTypes = []any{
+	(*InhibitRuleItem)(nil),                // 0: marksman.api.v1.InhibitRuleItem
+	(*CreateInhibitRuleRequest)(nil),       // 1: marksman.api.v1.CreateInhibitRuleRequest
+	(*CreateInhibitRuleReply)(nil),         // 2: marksman.api.v1.CreateInhibitRuleReply
+	(*UpdateInhibitRuleRequest)(nil),       // 3: marksman.api.v1.UpdateInhibitRuleRequest
+	(*UpdateInhibitRuleReply)(nil),         // 4: marksman.api.v1.UpdateInhibitRuleReply
+	(*UpdateInhibitRuleStatusRequest)(nil), // 5: marksman.api.v1.UpdateInhibitRuleStatusRequest
+	(*UpdateInhibitRuleStatusReply)(nil),   // 6: marksman.api.v1.UpdateInhibitRuleStatusReply
+	(*DeleteInhibitRuleRequest)(nil),       // 7: marksman.api.v1.DeleteInhibitRuleRequest
+	(*DeleteInhibitRuleReply)(nil),         // 8: marksman.api.v1.DeleteInhibitRuleReply
+	(*GetInhibitRuleRequest)(nil),          // 9: marksman.api.v1.GetInhibitRuleRequest
+	(*ListInhibitRuleRequest)(nil),         // 10: marksman.api.v1.ListInhibitRuleRequest
+	(*ListInhibitRuleReply)(nil),           // 11: marksman.api.v1.ListInhibitRuleReply
+	(*InhibitionAlert)(nil),                // 12: marksman.api.v1.InhibitionAlert
+	(*EvaluateInhibitionRequest)(nil),      // 13: marksman.api.v1.EvaluateInhibitionRequest
+	(*InhibitionResult)(nil),               // 14: marksman.api.v1.InhibitionResult
+	(*EvaluateInhibitionReply)(nil),        // 15: marksman.api.v1.EvaluateInhibitionReply
+	nil,                                    // 16: marksman.api.v1.InhibitionAlert.LabelsEntry
+	(enum.GlobalStatus)(0),                 // 17: magicbox.enum.GlobalStatus
+}
+var file_marksman_api_v1_inhibit_rule_proto_depIdxs = []int32{
+	17, // 0: marksman.api.v1.InhibitRuleItem.status:type_name -> magicbox.enum.GlobalStatus
+	17, // 1: marksman.api.v1.UpdateInhibitRuleStatusRequest.status:type_name -> magicbox.enum.GlobalStatus
+	17, // 2: marksman.api.v1.ListInhibitRuleRequest.status:type_name -> magicbox.enum.GlobalStatus
+	0,  // 3: marksman.api.v1.ListInhibitRuleReply.items:type_name -> marksman.api.v1.InhibitRuleItem
+	16, // 4: marksman.api.v1.InhibitionAlert.labels:type_name -> marksman.api.v1.InhibitionAlert.LabelsEntry
+	12, // 5: marksman.api.v1.EvaluateInhibitionRequest.alerts:type_name -> marksman.api.v1.InhibitionAlert
+	14, // 6: marksman.api.v1.EvaluateInhibitionReply.results:type_name -> marksman.api.v1.InhibitionResult
+	1,  // 7: marksman.api.v1.InhibitRule.CreateInhibitRule:input_type -> marksman.api.v1.CreateInhibitRuleRequest
+	3,  // 8: marksman.api.v1.InhibitRule.UpdateInhibitRule:input_type -> marksman.api.v1.UpdateInhibitRuleRequest
+	5,  // 9: marksman.api.v1.InhibitRule.UpdateInhibitRuleStatus:input_type -> marksman.api.v1.UpdateInhibitRuleStatusRequest
+	7,  // 10: marksman.api.v1.InhibitRule.DeleteInhibitRule:input_type -> marksman.api.v1.DeleteInhibitRuleRequest
+	9,  // 11: marksman.api.v1.InhibitRule.GetInhibitRule:input_type -> marksman.api.v1.GetInhibitRuleRequest
+	10, // 12: marksman.api.v1.InhibitRule.ListInhibitRule:input_type -> marksman.api.v1.ListInhibitRuleRequest
+	13, // 13: marksman.api.v1.InhibitRule.EvaluateInhibition:input_type -> marksman.api.v1.EvaluateInhibitionRequest
+	2,  // 14: marksman.api.v1.InhibitRule.CreateInhibitRule:output_type -> marksman.api.v1.CreateInhibitRuleReply
+	4,  // 15: marksman.api.v1.InhibitRule.UpdateInhibitRule:output_type -> marksman.api.v1.UpdateInhibitRuleReply
+	6,  // 16: marksman.api.v1.InhibitRule.UpdateInhibitRuleStatus:output_type -> marksman.api.v1.UpdateInhibitRuleStatusReply
+	8,  // 17: marksman.api.v1.InhibitRule.DeleteInhibitRule:output_type -> marksman.api.v1.DeleteInhibitRuleReply
+	0,  // 18: marksman.api.v1.InhibitRule.GetInhibitRule:output_type -> marksman.api.v1.InhibitRuleItem
+	11, // 19: marksman.api.v1.InhibitRule.ListInhibitRule:output_type -> marksman.api.v1.ListInhibitRuleReply
+	15, // 20: marksman.api.v1.InhibitRule.EvaluateInhibition:output_type -> marksman.api.v1.EvaluateInhibitionReply
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_marksman_api_v1_inhibit_rule_proto_init() }
+func file_marksman_api_v1_inhibit_rule_proto_init() {
+	if File_marksman_api_v1_inhibit_rule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marksman_api_v1_inhibit_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_marksman_api_v1_inhibit_rule_proto_goTypes,
+		DependencyIndexes: file_marksman_api_v1_inhibit_rule_proto_depIdxs,
+		MessageInfos:      file_marksman_api_v1_inhibit_rule_proto_msgTypes,
+	}.Build()
+	File_marksman_api_v1_inhibit_rule_proto = out.File
+	file_marksman_api_v1_inhibit_rule_proto_rawDesc = nil
+	file_marksman_api_v1_inhibit_rule_proto_goTypes = nil
+	file_marksman_api_v1_inhibit_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/inhibit_rule.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InhibitRule_CreateInhibitRule_FullMethodName       = "/marksman.api.v1.InhibitRule/CreateInhibitRule"
+	InhibitRule_UpdateInhibitRule_FullMethodName       = "/marksman.api.v1.InhibitRule/UpdateInhibitRule"
+	InhibitRule_UpdateInhibitRuleStatus_FullMethodName = "/marksman.api.v1.InhibitRule/UpdateInhibitRuleStatus"
+	InhibitRule_DeleteInhibitRule_FullMethodName       = "/marksman.api.v1.InhibitRule/DeleteInhibitRule"
+	InhibitRule_GetInhibitRule_FullMethodName          = "/marksman.api.v1.InhibitRule/GetInhibitRule"
+	InhibitRule_ListInhibitRule_FullMethodName         = "/marksman.api.v1.InhibitRule/ListInhibitRule"
+	InhibitRule_EvaluateInhibition_FullMethodName      = "/marksman.api.v1.InhibitRule/EvaluateInhibition"
+)
+
+// InhibitRuleClient is the client API for InhibitRule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InhibitRuleClient interface {
+	CreateInhibitRule(ctx context.Context, in *CreateInhibitRuleRequest, opts ...grpc.CallOption) (*CreateInhibitRuleReply, error)
+	UpdateInhibitRule(ctx context.Context, in *UpdateInhibitRuleRequest, opts ...grpc.CallOption) (*UpdateInhibitRuleReply, error)
+	UpdateInhibitRuleStatus(ctx context.Context, in *UpdateInhibitRuleStatusRequest, opts ...grpc.CallOption) (*UpdateInhibitRuleStatusReply, error)
+	DeleteInhibitRule(ctx context.Context, in *DeleteInhibitRuleRequest, opts ...grpc.CallOption) (*DeleteInhibitRuleReply, error)
+	GetInhibitRule(ctx context.Context, in *GetInhibitRuleRequest, opts ...grpc.CallOption) (*InhibitRuleItem, error)
+	ListInhibitRule(ctx context.Context, in *ListInhibitRuleRequest, opts ...grpc.CallOption) (*ListInhibitRuleReply, error)
+	EvaluateInhibition(ctx context.Context, in *EvaluateInhibitionRequest, opts ...grpc.CallOption) (*EvaluateInhibitionReply, error)
+}
+
+type inhibitRuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInhibitRuleClient(cc grpc.ClientConnInterface) InhibitRuleClient {
+	return &inhibitRuleClient{cc}
+}
+
+func (c *inhibitRuleClient) CreateInhibitRule(ctx context.Context, in *CreateInhibitRuleRequest, opts ...grpc.CallOption) (*CreateInhibitRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInhibitRuleReply)
+	err := c.cc.Invoke(ctx, InhibitRule_CreateInhibitRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inhibitRuleClient) UpdateInhibitRule(ctx context.Context, in *UpdateInhibitRuleRequest, opts ...grpc.CallOption) (*UpdateInhibitRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInhibitRuleReply)
+	err := c.cc.Invoke(ctx, InhibitRule_UpdateInhibitRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inhibitRuleClient) UpdateInhibitRuleStatus(ctx context.Context, in *UpdateInhibitRuleStatusRequest, opts ...grpc.CallOption) (*UpdateInhibitRuleStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInhibitRuleStatusReply)
+	err := c.cc.Invoke(ctx, InhibitRule_UpdateInhibitRuleStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inhibitRuleClient) DeleteInhibitRule(ctx context.Context, in *DeleteInhibitRuleRequest, opts ...grpc.CallOption) (*DeleteInhibitRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInhibitRuleReply)
+	err := c.cc.Invoke(ctx, InhibitRule_DeleteInhibitRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inhibitRuleClient) GetInhibitRule(ctx context.Context, in *GetInhibitRuleRequest, opts ...grpc.CallOption) (*InhibitRuleItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InhibitRuleItem)
+	err := c.cc.Invoke(ctx, InhibitRule_GetInhibitRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inhibitRuleClient) ListInhibitRule(ctx context.Context, in *ListInhibitRuleRequest, opts ...grpc.CallOption) (*ListInhibitRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInhibitRuleReply)
+	err := c.cc.Invoke(ctx, InhibitRule_ListInhibitRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inhibitRuleClient) EvaluateInhibition(ctx context.Context, in *EvaluateInhibitionRequest, opts ...grpc.CallOption) (*EvaluateInhibitionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateInhibitionReply)
+	err := c.cc.Invoke(ctx, InhibitRule_EvaluateInhibition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InhibitRuleServer is the server API for InhibitRule service.
+// All implementations must embed UnimplementedInhibitRuleServer
+// for forward compatibility.
+type InhibitRuleServer interface {
+	CreateInhibitRule(context.Context, *CreateInhibitRuleRequest) (*CreateInhibitRuleReply, error)
+	UpdateInhibitRule(context.Context, *UpdateInhibitRuleRequest) (*UpdateInhibitRuleReply, error)
+	UpdateInhibitRuleStatus(context.Context, *UpdateInhibitRuleStatusRequest) (*UpdateInhibitRuleStatusReply, error)
+	DeleteInhibitRule(context.Context, *DeleteInhibitRuleRequest) (*DeleteInhibitRuleReply, error)
+	GetInhibitRule(context.Context, *GetInhibitRuleRequest) (*InhibitRuleItem, error)
+	ListInhibitRule(context.Context, *ListInhibitRuleRequest) (*ListInhibitRuleReply, error)
+	EvaluateInhibition(context.Context, *EvaluateInhibitionRequest) (*EvaluateInhibitionReply, error)
+	mustEmbedUnimplementedInhibitRuleServer()
+}
+
+// UnimplementedInhibitRuleServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInhibitRuleServer struct{}
+
+func (UnimplementedInhibitRuleServer) CreateInhibitRule(context.Context, *CreateInhibitRuleRequest) (*CreateInhibitRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInhibitRule not implemented")
+}
+func (UnimplementedInhibitRuleServer) UpdateInhibitRule(context.Context, *UpdateInhibitRuleRequest) (*UpdateInhibitRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInhibitRule not implemented")
+}
+func (UnimplementedInhibitRuleServer) UpdateInhibitRuleStatus(context.Context, *UpdateInhibitRuleStatusRequest) (*UpdateInhibitRuleStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInhibitRuleStatus not implemented")
+}
+func (UnimplementedInhibitRuleServer) DeleteInhibitRule(context.Context, *DeleteInhibitRuleRequest) (*DeleteInhibitRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInhibitRule not implemented")
+}
+func (UnimplementedInhibitRuleServer) GetInhibitRule(context.Context, *GetInhibitRuleRequest) (*InhibitRuleItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInhibitRule not implemented")
+}
+func (UnimplementedInhibitRuleServer) ListInhibitRule(context.Context, *ListInhibitRuleRequest) (*ListInhibitRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInhibitRule not implemented")
+}
+func (UnimplementedInhibitRuleServer) EvaluateInhibition(context.Context, *EvaluateInhibitionRequest) (*EvaluateInhibitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateInhibition not implemented")
+}
+func (UnimplementedInhibitRuleServer) mustEmbedUnimplementedInhibitRuleServer() {}
+func (UnimplementedInhibitRuleServer) testEmbeddedByValue()                     {}
+
+// UnsafeInhibitRuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InhibitRuleServer will
+// result in compilation errors.
+type UnsafeInhibitRuleServer interface {
+	mustEmbedUnimplementedInhibitRuleServer()
+}
+
+func RegisterInhibitRuleServer(s grpc.ServiceRegistrar, srv InhibitRuleServer) {
+	// If the following call pancis, it indicates UnimplementedInhibitRuleServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InhibitRule_ServiceDesc, srv)
+}
+
+func _InhibitRule_CreateInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).CreateInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_CreateInhibitRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).CreateInhibitRule(ctx, req.(*CreateInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InhibitRule_UpdateInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).UpdateInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_UpdateInhibitRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).UpdateInhibitRule(ctx, req.(*UpdateInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InhibitRule_UpdateInhibitRuleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInhibitRuleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).UpdateInhibitRuleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_UpdateInhibitRuleStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).UpdateInhibitRuleStatus(ctx, req.(*UpdateInhibitRuleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InhibitRule_DeleteInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).DeleteInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_DeleteInhibitRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).DeleteInhibitRule(ctx, req.(*DeleteInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InhibitRule_GetInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).GetInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_GetInhibitRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).GetInhibitRule(ctx, req.(*GetInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InhibitRule_ListInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).ListInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_ListInhibitRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).ListInhibitRule(ctx, req.(*ListInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InhibitRule_EvaluateInhibition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateInhibitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InhibitRuleServer).EvaluateInhibition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InhibitRule_EvaluateInhibition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InhibitRuleServer).EvaluateInhibition(ctx, req.(*EvaluateInhibitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InhibitRule_ServiceDesc is the grpc.ServiceDesc for InhibitRule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InhibitRule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.InhibitRule",
+	HandlerType: (*InhibitRuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInhibitRule",
+			Handler:    _InhibitRule_CreateInhibitRule_Handler,
+		},
+		{
+			MethodName: "UpdateInhibitRule",
+			Handler:    _InhibitRule_UpdateInhibitRule_Handler,
+		},
+		{
+			MethodName: "UpdateInhibitRuleStatus",
+			Handler:    _InhibitRule_UpdateInhibitRuleStatus_Handler,
+		},
+		{
+			MethodName: "DeleteInhibitRule",
+			Handler:    _InhibitRule_DeleteInhibitRule_Handler,
+		},
+		{
+			MethodName: "GetInhibitRule",
+			Handler:    _InhibitRule_GetInhibitRule_Handler,
+		},
+		{
+			MethodName: "ListInhibitRule",
+			Handler:    _InhibitRule_ListInhibitRule_Handler,
+		},
+		{
+			MethodName: "EvaluateInhibition",
+			Handler:    _InhibitRule_EvaluateInhibition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/inhibit_rule.proto",
+}
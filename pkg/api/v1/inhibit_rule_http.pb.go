@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/inhibit_rule.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationInhibitRuleCreateInhibitRule = "/marksman.api.v1.InhibitRule/CreateInhibitRule"
+const OperationInhibitRuleDeleteInhibitRule = "/marksman.api.v1.InhibitRule/DeleteInhibitRule"
+const OperationInhibitRuleEvaluateInhibition = "/marksman.api.v1.InhibitRule/EvaluateInhibition"
+const OperationInhibitRuleGetInhibitRule = "/marksman.api.v1.InhibitRule/GetInhibitRule"
+const OperationInhibitRuleListInhibitRule = "/marksman.api.v1.InhibitRule/ListInhibitRule"
+const OperationInhibitRuleUpdateInhibitRule = "/marksman.api.v1.InhibitRule/UpdateInhibitRule"
+const OperationInhibitRuleUpdateInhibitRuleStatus = "/marksman.api.v1.InhibitRule/UpdateInhibitRuleStatus"
+
+type InhibitRuleHTTPServer interface {
+	CreateInhibitRule(context.Context, *CreateInhibitRuleRequest) (*CreateInhibitRuleReply, error)
+	DeleteInhibitRule(context.Context, *DeleteInhibitRuleRequest) (*DeleteInhibitRuleReply, error)
+	EvaluateInhibition(context.Context, *EvaluateInhibitionRequest) (*EvaluateInhibitionReply, error)
+	GetInhibitRule(context.Context, *GetInhibitRuleRequest) (*InhibitRuleItem, error)
+	ListInhibitRule(context.Context, *ListInhibitRuleRequest) (*ListInhibitRuleReply, error)
+	UpdateInhibitRule(context.Context, *UpdateInhibitRuleRequest) (*UpdateInhibitRuleReply, error)
+	UpdateInhibitRuleStatus(context.Context, *UpdateInhibitRuleStatusRequest) (*UpdateInhibitRuleStatusReply, error)
+}
+
+func RegisterInhibitRuleHTTPServer(s *http.Server, srv InhibitRuleHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/inhibit-rule", _InhibitRule_CreateInhibitRule0_HTTP_Handler(srv))
+	r.PUT("/v1/inhibit-rule/{uid}", _InhibitRule_UpdateInhibitRule0_HTTP_Handler(srv))
+	r.PUT("/v1/inhibit-rule/{uid}/status", _InhibitRule_UpdateInhibitRuleStatus0_HTTP_Handler(srv))
+	r.DELETE("/v1/inhibit-rule/{uid}", _InhibitRule_DeleteInhibitRule0_HTTP_Handler(srv))
+	r.GET("/v1/inhibit-rule/{uid}", _InhibitRule_GetInhibitRule0_HTTP_Handler(srv))
+	r.GET("/v1/inhibit-rules", _InhibitRule_ListInhibitRule0_HTTP_Handler(srv))
+	r.POST("/v1/inhibit-rules/evaluate", _InhibitRule_EvaluateInhibition0_HTTP_Handler(srv))
+}
+
+func _InhibitRule_CreateInhibitRule0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateInhibitRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleCreateInhibitRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateInhibitRule(ctx, req.(*CreateInhibitRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateInhibitRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InhibitRule_UpdateInhibitRule0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateInhibitRuleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleUpdateInhibitRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateInhibitRule(ctx, req.(*UpdateInhibitRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateInhibitRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InhibitRule_UpdateInhibitRuleStatus0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateInhibitRuleStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleUpdateInhibitRuleStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateInhibitRuleStatus(ctx, req.(*UpdateInhibitRuleStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateInhibitRuleStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InhibitRule_DeleteInhibitRule0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteInhibitRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleDeleteInhibitRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteInhibitRule(ctx, req.(*DeleteInhibitRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteInhibitRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InhibitRule_GetInhibitRule0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetInhibitRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleGetInhibitRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetInhibitRule(ctx, req.(*GetInhibitRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InhibitRuleItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InhibitRule_ListInhibitRule0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListInhibitRuleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleListInhibitRule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListInhibitRule(ctx, req.(*ListInhibitRuleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListInhibitRuleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InhibitRule_EvaluateInhibition0_HTTP_Handler(srv InhibitRuleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EvaluateInhibitionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInhibitRuleEvaluateInhibition)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EvaluateInhibition(ctx, req.(*EvaluateInhibitionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EvaluateInhibitionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type InhibitRuleHTTPClient interface {
+	CreateInhibitRule(ctx context.Context, req *CreateInhibitRuleRequest, opts ...http.CallOption) (rsp *CreateInhibitRuleReply, err error)
+	DeleteInhibitRule(ctx context.Context, req *DeleteInhibitRuleRequest, opts ...http.CallOption) (rsp *DeleteInhibitRuleReply, err error)
+	EvaluateInhibition(ctx context.Context, req *EvaluateInhibitionRequest, opts ...http.CallOption) (rsp *EvaluateInhibitionReply, err error)
+	GetInhibitRule(ctx context.Context, req *GetInhibitRuleRequest, opts ...http.CallOption) (rsp *InhibitRuleItem, err error)
+	ListInhibitRule(ctx context.Context, req *ListInhibitRuleRequest, opts ...http.CallOption) (rsp *ListInhibitRuleReply, err error)
+	UpdateInhibitRule(ctx context.Context, req *UpdateInhibitRuleRequest, opts ...http.CallOption) (rsp *UpdateInhibitRuleReply, err error)
+	UpdateInhibitRuleStatus(ctx context.Context, req *UpdateInhibitRuleStatusRequest, opts ...http.CallOption) (rsp *UpdateInhibitRuleStatusReply, err error)
+}
+
+type InhibitRuleHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewInhibitRuleHTTPClient(client *http.Client) InhibitRuleHTTPClient {
+	return &InhibitRuleHTTPClientImpl{client}
+}
+
+func (c *InhibitRuleHTTPClientImpl) CreateInhibitRule(ctx context.Context, in *CreateInhibitRuleRequest, opts ...http.CallOption) (*CreateInhibitRuleReply, error) {
+	var out CreateInhibitRuleReply
+	pattern := "/v1/inhibit-rule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInhibitRuleCreateInhibitRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *InhibitRuleHTTPClientImpl) DeleteInhibitRule(ctx context.Context, in *DeleteInhibitRuleRequest, opts ...http.CallOption) (*DeleteInhibitRuleReply, error) {
+	var out DeleteInhibitRuleReply
+	pattern := "/v1/inhibit-rule/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInhibitRuleDeleteInhibitRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *InhibitRuleHTTPClientImpl) EvaluateInhibition(ctx context.Context, in *EvaluateInhibitionRequest, opts ...http.CallOption) (*EvaluateInhibitionReply, error) {
+	var out EvaluateInhibitionReply
+	pattern := "/v1/inhibit-rules/evaluate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInhibitRuleEvaluateInhibition))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *InhibitRuleHTTPClientImpl) GetInhibitRule(ctx context.Context, in *GetInhibitRuleRequest, opts ...http.CallOption) (*InhibitRuleItem, error) {
+	var out InhibitRuleItem
+	pattern := "/v1/inhibit-rule/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInhibitRuleGetInhibitRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *InhibitRuleHTTPClientImpl) ListInhibitRule(ctx context.Context, in *ListInhibitRuleRequest, opts ...http.CallOption) (*ListInhibitRuleReply, error) {
+	var out ListInhibitRuleReply
+	pattern := "/v1/inhibit-rules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInhibitRuleListInhibitRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *InhibitRuleHTTPClientImpl) UpdateInhibitRule(ctx context.Context, in *UpdateInhibitRuleRequest, opts ...http.CallOption) (*UpdateInhibitRuleReply, error) {
+	var out UpdateInhibitRuleReply
+	pattern := "/v1/inhibit-rule/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInhibitRuleUpdateInhibitRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *InhibitRuleHTTPClientImpl) UpdateInhibitRuleStatus(ctx context.Context, in *UpdateInhibitRuleStatusRequest, opts ...http.CallOption) (*UpdateInhibitRuleStatusReply, error) {
+	var out UpdateInhibitRuleStatusReply
+	pattern := "/v1/inhibit-rule/{uid}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInhibitRuleUpdateInhibitRuleStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,939 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.3
+// source: marksman/api/v1/manifest.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	enum "github.com/aide-family/magicbox/enum"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ManifestFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestFile) Reset() {
+	*x = ManifestFile{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestFile) ProtoMessage() {}
+
+func (x *ManifestFile) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestFile.ProtoReflect.Descriptor instead.
+func (*ManifestFile) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ManifestFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ManifestFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ApplyManifestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*ManifestFile        `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Prune         bool                   `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyManifestsRequest) Reset() {
+	*x = ApplyManifestsRequest{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyManifestsRequest) ProtoMessage() {}
+
+func (x *ApplyManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplyManifestsRequest) GetFiles() []*ManifestFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ApplyManifestsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyManifestsRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type ApplyManifestsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Changes       []*ResourceChange      `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyManifestsReply) Reset() {
+	*x = ApplyManifestsReply{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyManifestsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyManifestsReply) ProtoMessage() {}
+
+func (x *ApplyManifestsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyManifestsReply.ProtoReflect.Descriptor instead.
+func (*ApplyManifestsReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplyManifestsReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyManifestsReply) GetChanges() []*ResourceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type LevelSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remark        string                 `protobuf:"bytes,1,opt,name=remark,proto3" json:"remark,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status        enum.GlobalStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LevelSpec) Reset() {
+	*x = LevelSpec{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelSpec) ProtoMessage() {}
+
+func (x *LevelSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelSpec.ProtoReflect.Descriptor instead.
+func (*LevelSpec) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LevelSpec) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *LevelSpec) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LevelSpec) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type DatasourceSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          enum.DatasourceType    `protobuf:"varint,1,opt,name=type,proto3,enum=magicbox.enum.DatasourceType" json:"type,omitempty"`
+	Driver        enum.DatasourceDriver  `protobuf:"varint,2,opt,name=driver,proto3,enum=magicbox.enum.DatasourceDriver" json:"driver,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config        *DatasourceConfig      `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasourceSpec) Reset() {
+	*x = DatasourceSpec{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceSpec) ProtoMessage() {}
+
+func (x *DatasourceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceSpec.ProtoReflect.Descriptor instead.
+func (*DatasourceSpec) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DatasourceSpec) GetType() enum.DatasourceType {
+	if x != nil {
+		return x.Type
+	}
+	return enum.DatasourceType(0)
+}
+
+func (x *DatasourceSpec) GetDriver() enum.DatasourceDriver {
+	if x != nil {
+		return x.Driver
+	}
+	return enum.DatasourceDriver(0)
+}
+
+func (x *DatasourceSpec) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DatasourceSpec) GetConfig() *DatasourceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type StrategyGroupSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remark        string                 `protobuf:"bytes,1,opt,name=remark,proto3" json:"remark,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status        enum.GlobalStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyGroupSpec) Reset() {
+	*x = StrategyGroupSpec{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyGroupSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyGroupSpec) ProtoMessage() {}
+
+func (x *StrategyGroupSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyGroupSpec.ProtoReflect.Descriptor instead.
+func (*StrategyGroupSpec) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StrategyGroupSpec) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *StrategyGroupSpec) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StrategyGroupSpec) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type StrategySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StrategyGroup string                 `protobuf:"bytes,1,opt,name=strategyGroup,proto3" json:"strategyGroup,omitempty"`
+	Remark        string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	Type          enum.DatasourceType    `protobuf:"varint,3,opt,name=type,proto3,enum=magicbox.enum.DatasourceType" json:"type,omitempty"`
+	Driver        enum.DatasourceDriver  `protobuf:"varint,4,opt,name=driver,proto3,enum=magicbox.enum.DatasourceDriver" json:"driver,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status        enum.GlobalStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	Metric        *StrategyMetricSpec    `protobuf:"bytes,7,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategySpec) Reset() {
+	*x = StrategySpec{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategySpec) ProtoMessage() {}
+
+func (x *StrategySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategySpec.ProtoReflect.Descriptor instead.
+func (*StrategySpec) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StrategySpec) GetStrategyGroup() string {
+	if x != nil {
+		return x.StrategyGroup
+	}
+	return ""
+}
+
+func (x *StrategySpec) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *StrategySpec) GetType() enum.DatasourceType {
+	if x != nil {
+		return x.Type
+	}
+	return enum.DatasourceType(0)
+}
+
+func (x *StrategySpec) GetDriver() enum.DatasourceDriver {
+	if x != nil {
+		return x.Driver
+	}
+	return enum.DatasourceDriver(0)
+}
+
+func (x *StrategySpec) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StrategySpec) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+func (x *StrategySpec) GetMetric() *StrategyMetricSpec {
+	if x != nil {
+		return x.Metric
+	}
+	return nil
+}
+
+type StrategyMetricSpec struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Expr          string                     `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	Labels        map[string]string          `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Summary       string                     `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Description   string                     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Datasources   []string                   `protobuf:"bytes,5,rep,name=datasources,proto3" json:"datasources,omitempty"`
+	Status        enum.GlobalStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	Levels        []*StrategyMetricLevelSpec `protobuf:"bytes,7,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyMetricSpec) Reset() {
+	*x = StrategyMetricSpec{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyMetricSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyMetricSpec) ProtoMessage() {}
+
+func (x *StrategyMetricSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyMetricSpec.ProtoReflect.Descriptor instead.
+func (*StrategyMetricSpec) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StrategyMetricSpec) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *StrategyMetricSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StrategyMetricSpec) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *StrategyMetricSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StrategyMetricSpec) GetDatasources() []string {
+	if x != nil {
+		return x.Datasources
+	}
+	return nil
+}
+
+func (x *StrategyMetricSpec) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+func (x *StrategyMetricSpec) GetLevels() []*StrategyMetricLevelSpec {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type StrategyMetricLevelSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Mode          enum.SampleMode        `protobuf:"varint,2,opt,name=mode,proto3,enum=magicbox.enum.SampleMode" json:"mode,omitempty"`
+	Condition     enum.ConditionMetric   `protobuf:"varint,3,opt,name=condition,proto3,enum=magicbox.enum.ConditionMetric" json:"condition,omitempty"`
+	Values        []int64                `protobuf:"varint,4,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	FlapWindow    *durationpb.Duration   `protobuf:"bytes,6,opt,name=flapWindow,proto3" json:"flapWindow,omitempty"`
+	FlapThreshold int32                  `protobuf:"varint,7,opt,name=flapThreshold,proto3" json:"flapThreshold,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyMetricLevelSpec) Reset() {
+	*x = StrategyMetricLevelSpec{}
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyMetricLevelSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyMetricLevelSpec) ProtoMessage() {}
+
+func (x *StrategyMetricLevelSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_manifest_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyMetricLevelSpec.ProtoReflect.Descriptor instead.
+func (*StrategyMetricLevelSpec) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_manifest_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StrategyMetricLevelSpec) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *StrategyMetricLevelSpec) GetMode() enum.SampleMode {
+	if x != nil {
+		return x.Mode
+	}
+	return enum.SampleMode(0)
+}
+
+func (x *StrategyMetricLevelSpec) GetCondition() enum.ConditionMetric {
+	if x != nil {
+		return x.Condition
+	}
+	return enum.ConditionMetric(0)
+}
+
+func (x *StrategyMetricLevelSpec) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *StrategyMetricLevelSpec) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *StrategyMetricLevelSpec) GetFlapWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FlapWindow
+	}
+	return nil
+}
+
+func (x *StrategyMetricLevelSpec) GetFlapThreshold() int32 {
+	if x != nil {
+		return x.FlapThreshold
+	}
+	return 0
+}
+
+func (x *StrategyMetricLevelSpec) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+var File_marksman_api_v1_manifest_proto protoreflect.FileDescriptor
+
+var file_marksman_api_v1_manifest_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d, 0x61,
+	0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78,
+	0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x0c, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0xc8, 0x01, 0x01, 0x72, 0x06, 0x10,
+	0x01, 0x18, 0x80, 0x80, 0x40, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x87,
+	0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01,
+	0x10, 0xf4, 0x03, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x22, 0x68, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x09, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f,
+	0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x49, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xeb, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x33,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xb0, 0x03, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e,
+	0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x70, 0x65, 0x63, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x81, 0x03, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x47,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53,
+	0x70, 0x65, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x03, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62,
+	0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x61,
+	0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x35,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x70, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x61, 0x70, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x6c, 0x61, 0x70, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f,
+	0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x8a, 0x01, 0x0a, 0x08,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x42, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marksman_api_v1_manifest_proto_rawDescOnce sync.Once
+	file_marksman_api_v1_manifest_proto_rawDescData = file_marksman_api_v1_manifest_proto_rawDesc
+)
+
+func file_marksman_api_v1_manifest_proto_rawDescGZIP() []byte {
+	file_marksman_api_v1_manifest_proto_rawDescOnce.Do(func() {
+		file_marksman_api_v1_manifest_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_manifest_proto_rawDescData)
+	})
+	return file_marksman_api_v1_manifest_proto_rawDescData
+}
+
+var file_marksman_api_v1_manifest_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_marksman_api_v1_manifest_proto_goTypes = []any{
+	(*ManifestFile)(nil),            // 0: marksman.api.v1.ManifestFile
+	(*ApplyManifestsRequest)(nil),   // 1: marksman.api.v1.ApplyManifestsRequest
+	(*ApplyManifestsReply)(nil),     // 2: marksman.api.v1.ApplyManifestsReply
+	(*LevelSpec)(nil),               // 3: marksman.api.v1.LevelSpec
+	(*DatasourceSpec)(nil),          // 4: marksman.api.v1.DatasourceSpec
+	(*StrategyGroupSpec)(nil),       // 5: marksman.api.v1.StrategyGroupSpec
+	(*StrategySpec)(nil),            // 6: marksman.api.v1.StrategySpec
+	(*StrategyMetricSpec)(nil),      // 7: marksman.api.v1.StrategyMetricSpec
+	(*StrategyMetricLevelSpec)(nil), // 8: marksman.api.v1.StrategyMetricLevelSpec
+	nil,                             // 9: marksman.api.v1.LevelSpec.MetadataEntry
+	nil,                             // 10: marksman.api.v1.DatasourceSpec.MetadataEntry
+	nil,                             // 11: marksman.api.v1.StrategyGroupSpec.MetadataEntry
+	nil,                             // 12: marksman.api.v1.StrategySpec.MetadataEntry
+	nil,                             // 13: marksman.api.v1.StrategyMetricSpec.LabelsEntry
+	(*ResourceChange)(nil),          // 14: marksman.api.v1.ResourceChange
+	(enum.GlobalStatus)(0),          // 15: magicbox.enum.GlobalStatus
+	(enum.DatasourceType)(0),        // 16: magicbox.enum.DatasourceType
+	(enum.DatasourceDriver)(0),      // 17: magicbox.enum.DatasourceDriver
+	(*DatasourceConfig)(nil),        // 18: marksman.api.v1.DatasourceConfig
+	(enum.SampleMode)(0),            // 19: magicbox.enum.SampleMode
+	(enum.ConditionMetric)(0),       // 20: magicbox.enum.ConditionMetric
+	(*durationpb.Duration)(nil),     // 21: google.protobuf.Duration
+}
+var file_marksman_api_v1_manifest_proto_depIdxs = []int32{
+	0,  // 0: marksman.api.v1.ApplyManifestsRequest.files:type_name -> marksman.api.v1.ManifestFile
+	14, // 1: marksman.api.v1.ApplyManifestsReply.changes:type_name -> marksman.api.v1.ResourceChange
+	9,  // 2: marksman.api.v1.LevelSpec.metadata:type_name -> marksman.api.v1.LevelSpec.MetadataEntry
+	15, // 3: marksman.api.v1.LevelSpec.status:type_name -> magicbox.enum.GlobalStatus
+	16, // 4: marksman.api.v1.DatasourceSpec.type:type_name -> magicbox.enum.DatasourceType
+	17, // 5: marksman.api.v1.DatasourceSpec.driver:type_name -> magicbox.enum.DatasourceDriver
+	10, // 6: marksman.api.v1.DatasourceSpec.metadata:type_name -> marksman.api.v1.DatasourceSpec.MetadataEntry
+	18, // 7: marksman.api.v1.DatasourceSpec.config:type_name -> marksman.api.v1.DatasourceConfig
+	11, // 8: marksman.api.v1.StrategyGroupSpec.metadata:type_name -> marksman.api.v1.StrategyGroupSpec.MetadataEntry
+	15, // 9: marksman.api.v1.StrategyGroupSpec.status:type_name -> magicbox.enum.GlobalStatus
+	16, // 10: marksman.api.v1.StrategySpec.type:type_name -> magicbox.enum.DatasourceType
+	17, // 11: marksman.api.v1.StrategySpec.driver:type_name -> magicbox.enum.DatasourceDriver
+	12, // 12: marksman.api.v1.StrategySpec.metadata:type_name -> marksman.api.v1.StrategySpec.MetadataEntry
+	15, // 13: marksman.api.v1.StrategySpec.status:type_name -> magicbox.enum.GlobalStatus
+	7,  // 14: marksman.api.v1.StrategySpec.metric:type_name -> marksman.api.v1.StrategyMetricSpec
+	13, // 15: marksman.api.v1.StrategyMetricSpec.labels:type_name -> marksman.api.v1.StrategyMetricSpec.LabelsEntry
+	15, // 16: marksman.api.v1.StrategyMetricSpec.status:type_name -> magicbox.enum.GlobalStatus
+	8,  // 17: marksman.api.v1.StrategyMetricSpec.levels:type_name -> marksman.api.v1.StrategyMetricLevelSpec
+	19, // 18: marksman.api.v1.StrategyMetricLevelSpec.mode:type_name -> magicbox.enum.SampleMode
+	20, // 19: marksman.api.v1.StrategyMetricLevelSpec.condition:type_name -> magicbox.enum.ConditionMetric
+	21, // 20: marksman.api.v1.StrategyMetricLevelSpec.duration:type_name -> google.protobuf.Duration
+	21, // 21: marksman.api.v1.StrategyMetricLevelSpec.flapWindow:type_name -> google.protobuf.Duration
+	15, // 22: marksman.api.v1.StrategyMetricLevelSpec.status:type_name -> magicbox.enum.GlobalStatus
+	1,  // 23: marksman.api.v1.Manifest.ApplyManifests:input_type -> marksman.api.v1.ApplyManifestsRequest
+	2,  // 24: marksman.api.v1.Manifest.ApplyManifests:output_type -> marksman.api.v1.ApplyManifestsReply
+	24, // [24:25] is the sub-list for method output_type
+	23, // [23:24] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_marksman_api_v1_manifest_proto_init() }
+func file_marksman_api_v1_manifest_proto_init() {
+	if File_marksman_api_v1_manifest_proto != nil {
+		return
+	}
+	file_marksman_api_v1_datasource_proto_init()
+	file_marksman_api_v1_strategy_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marksman_api_v1_manifest_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_marksman_api_v1_manifest_proto_goTypes,
+		DependencyIndexes: file_marksman_api_v1_manifest_proto_depIdxs,
+		MessageInfos:      file_marksman_api_v1_manifest_proto_msgTypes,
+	}.Build()
+	File_marksman_api_v1_manifest_proto = out.File
+	file_marksman_api_v1_manifest_proto_rawDesc = nil
+	file_marksman_api_v1_manifest_proto_goTypes = nil
+	file_marksman_api_v1_manifest_proto_depIdxs = nil
+}
@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/manifest.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Manifest_ApplyManifests_FullMethodName = "/marksman.api.v1.Manifest/ApplyManifests"
+)
+
+// ManifestClient is the client API for Manifest service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManifestClient interface {
+	ApplyManifests(ctx context.Context, in *ApplyManifestsRequest, opts ...grpc.CallOption) (*ApplyManifestsReply, error)
+}
+
+type manifestClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManifestClient(cc grpc.ClientConnInterface) ManifestClient {
+	return &manifestClient{cc}
+}
+
+func (c *manifestClient) ApplyManifests(ctx context.Context, in *ApplyManifestsRequest, opts ...grpc.CallOption) (*ApplyManifestsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyManifestsReply)
+	err := c.cc.Invoke(ctx, Manifest_ApplyManifests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManifestServer is the server API for Manifest service.
+// All implementations must embed UnimplementedManifestServer
+// for forward compatibility.
+type ManifestServer interface {
+	ApplyManifests(context.Context, *ApplyManifestsRequest) (*ApplyManifestsReply, error)
+	mustEmbedUnimplementedManifestServer()
+}
+
+// UnimplementedManifestServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedManifestServer struct{}
+
+func (UnimplementedManifestServer) ApplyManifests(context.Context, *ApplyManifestsRequest) (*ApplyManifestsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyManifests not implemented")
+}
+func (UnimplementedManifestServer) mustEmbedUnimplementedManifestServer() {}
+func (UnimplementedManifestServer) testEmbeddedByValue()                  {}
+
+// UnsafeManifestServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManifestServer will
+// result in compilation errors.
+type UnsafeManifestServer interface {
+	mustEmbedUnimplementedManifestServer()
+}
+
+func RegisterManifestServer(s grpc.ServiceRegistrar, srv ManifestServer) {
+	// If the following call pancis, it indicates UnimplementedManifestServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Manifest_ServiceDesc, srv)
+}
+
+func _Manifest_ApplyManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).ApplyManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manifest_ApplyManifests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).ApplyManifests(ctx, req.(*ApplyManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Manifest_ServiceDesc is the grpc.ServiceDesc for Manifest service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Manifest_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.Manifest",
+	HandlerType: (*ManifestServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyManifests",
+			Handler:    _Manifest_ApplyManifests_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/manifest.proto",
+}
@@ -0,0 +1,78 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/manifest.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationManifestApplyManifests = "/marksman.api.v1.Manifest/ApplyManifests"
+
+type ManifestHTTPServer interface {
+	ApplyManifests(context.Context, *ApplyManifestsRequest) (*ApplyManifestsReply, error)
+}
+
+func RegisterManifestHTTPServer(s *http.Server, srv ManifestHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/manifests/apply", _Manifest_ApplyManifests0_HTTP_Handler(srv))
+}
+
+func _Manifest_ApplyManifests0_HTTP_Handler(srv ManifestHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApplyManifestsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationManifestApplyManifests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApplyManifests(ctx, req.(*ApplyManifestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApplyManifestsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ManifestHTTPClient interface {
+	ApplyManifests(ctx context.Context, req *ApplyManifestsRequest, opts ...http.CallOption) (rsp *ApplyManifestsReply, err error)
+}
+
+type ManifestHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewManifestHTTPClient(client *http.Client) ManifestHTTPClient {
+	return &ManifestHTTPClientImpl{client}
+}
+
+func (c *ManifestHTTPClientImpl) ApplyManifests(ctx context.Context, in *ApplyManifestsRequest, opts ...http.CallOption) (*ApplyManifestsReply, error) {
+	var out ApplyManifestsReply
+	pattern := "/v1/manifests/apply"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationManifestApplyManifests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,1957 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.3
+// 	protoc        v5.29.3
+// source: marksman/api/v1/oncall_schedule.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	enum "github.com/aide-family/magicbox/enum"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OnCallRotationType int32
+
+const (
+	OnCallRotationType_OnCallRotationType_UNKNOWN OnCallRotationType = 0
+	OnCallRotationType_ROTATION_DAILY             OnCallRotationType = 1
+	OnCallRotationType_ROTATION_WEEKLY            OnCallRotationType = 2
+)
+
+// Enum value maps for OnCallRotationType.
+var (
+	OnCallRotationType_name = map[int32]string{
+		0: "OnCallRotationType_UNKNOWN",
+		1: "ROTATION_DAILY",
+		2: "ROTATION_WEEKLY",
+	}
+	OnCallRotationType_value = map[string]int32{
+		"OnCallRotationType_UNKNOWN": 0,
+		"ROTATION_DAILY":             1,
+		"ROTATION_WEEKLY":            2,
+	}
+)
+
+func (x OnCallRotationType) Enum() *OnCallRotationType {
+	p := new(OnCallRotationType)
+	*p = x
+	return p
+}
+
+func (x OnCallRotationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnCallRotationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_marksman_api_v1_oncall_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (OnCallRotationType) Type() protoreflect.EnumType {
+	return &file_marksman_api_v1_oncall_schedule_proto_enumTypes[0]
+}
+
+func (x OnCallRotationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnCallRotationType.Descriptor instead.
+func (OnCallRotationType) EnumDescriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+type OnCallScheduleItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Remark         string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	TimeZone       string                 `protobuf:"bytes,4,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	RotationType   OnCallRotationType     `protobuf:"varint,5,opt,name=rotationType,proto3,enum=marksman.api.v1.OnCallRotationType" json:"rotationType,omitempty"`
+	HandoffTime    string                 `protobuf:"bytes,6,opt,name=handoffTime,proto3" json:"handoffTime,omitempty"`
+	HandoffWeekday int32                  `protobuf:"varint,7,opt,name=handoffWeekday,proto3" json:"handoffWeekday,omitempty"`
+	StartsAt       string                 `protobuf:"bytes,8,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	Members        []int64                `protobuf:"varint,9,rep,packed,name=members,proto3" json:"members,omitempty"`
+	Status         enum.GlobalStatus      `protobuf:"varint,10,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OnCallScheduleItem) Reset() {
+	*x = OnCallScheduleItem{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnCallScheduleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCallScheduleItem) ProtoMessage() {}
+
+func (x *OnCallScheduleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCallScheduleItem.ProtoReflect.Descriptor instead.
+func (*OnCallScheduleItem) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnCallScheduleItem) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *OnCallScheduleItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OnCallScheduleItem) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *OnCallScheduleItem) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *OnCallScheduleItem) GetRotationType() OnCallRotationType {
+	if x != nil {
+		return x.RotationType
+	}
+	return OnCallRotationType_OnCallRotationType_UNKNOWN
+}
+
+func (x *OnCallScheduleItem) GetHandoffTime() string {
+	if x != nil {
+		return x.HandoffTime
+	}
+	return ""
+}
+
+func (x *OnCallScheduleItem) GetHandoffWeekday() int32 {
+	if x != nil {
+		return x.HandoffWeekday
+	}
+	return 0
+}
+
+func (x *OnCallScheduleItem) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *OnCallScheduleItem) GetMembers() []int64 {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *OnCallScheduleItem) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+func (x *OnCallScheduleItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OnCallScheduleItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateOnCallScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Remark         string                 `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark,omitempty"`
+	TimeZone       string                 `protobuf:"bytes,3,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	RotationType   OnCallRotationType     `protobuf:"varint,4,opt,name=rotationType,proto3,enum=marksman.api.v1.OnCallRotationType" json:"rotationType,omitempty"`
+	HandoffTime    string                 `protobuf:"bytes,5,opt,name=handoffTime,proto3" json:"handoffTime,omitempty"`
+	HandoffWeekday int32                  `protobuf:"varint,6,opt,name=handoffWeekday,proto3" json:"handoffWeekday,omitempty"`
+	StartsAt       int64                  `protobuf:"varint,7,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	Members        []int64                `protobuf:"varint,8,rep,packed,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateOnCallScheduleRequest) Reset() {
+	*x = CreateOnCallScheduleRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOnCallScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOnCallScheduleRequest) ProtoMessage() {}
+
+func (x *CreateOnCallScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOnCallScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateOnCallScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOnCallScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOnCallScheduleRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateOnCallScheduleRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateOnCallScheduleRequest) GetRotationType() OnCallRotationType {
+	if x != nil {
+		return x.RotationType
+	}
+	return OnCallRotationType_OnCallRotationType_UNKNOWN
+}
+
+func (x *CreateOnCallScheduleRequest) GetHandoffTime() string {
+	if x != nil {
+		return x.HandoffTime
+	}
+	return ""
+}
+
+func (x *CreateOnCallScheduleRequest) GetHandoffWeekday() int32 {
+	if x != nil {
+		return x.HandoffWeekday
+	}
+	return 0
+}
+
+func (x *CreateOnCallScheduleRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *CreateOnCallScheduleRequest) GetMembers() []int64 {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type CreateOnCallScheduleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOnCallScheduleReply) Reset() {
+	*x = CreateOnCallScheduleReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOnCallScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOnCallScheduleReply) ProtoMessage() {}
+
+func (x *CreateOnCallScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOnCallScheduleReply.ProtoReflect.Descriptor instead.
+func (*CreateOnCallScheduleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+type UpdateOnCallScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Remark         string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	TimeZone       string                 `protobuf:"bytes,4,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	RotationType   OnCallRotationType     `protobuf:"varint,5,opt,name=rotationType,proto3,enum=marksman.api.v1.OnCallRotationType" json:"rotationType,omitempty"`
+	HandoffTime    string                 `protobuf:"bytes,6,opt,name=handoffTime,proto3" json:"handoffTime,omitempty"`
+	HandoffWeekday int32                  `protobuf:"varint,7,opt,name=handoffWeekday,proto3" json:"handoffWeekday,omitempty"`
+	StartsAt       int64                  `protobuf:"varint,8,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	Members        []int64                `protobuf:"varint,9,rep,packed,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateOnCallScheduleRequest) Reset() {
+	*x = UpdateOnCallScheduleRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnCallScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnCallScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateOnCallScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnCallScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOnCallScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateOnCallScheduleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateOnCallScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOnCallScheduleRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UpdateOnCallScheduleRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateOnCallScheduleRequest) GetRotationType() OnCallRotationType {
+	if x != nil {
+		return x.RotationType
+	}
+	return OnCallRotationType_OnCallRotationType_UNKNOWN
+}
+
+func (x *UpdateOnCallScheduleRequest) GetHandoffTime() string {
+	if x != nil {
+		return x.HandoffTime
+	}
+	return ""
+}
+
+func (x *UpdateOnCallScheduleRequest) GetHandoffWeekday() int32 {
+	if x != nil {
+		return x.HandoffWeekday
+	}
+	return 0
+}
+
+func (x *UpdateOnCallScheduleRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *UpdateOnCallScheduleRequest) GetMembers() []int64 {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type UpdateOnCallScheduleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOnCallScheduleReply) Reset() {
+	*x = UpdateOnCallScheduleReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnCallScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnCallScheduleReply) ProtoMessage() {}
+
+func (x *UpdateOnCallScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnCallScheduleReply.ProtoReflect.Descriptor instead.
+func (*UpdateOnCallScheduleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+type UpdateOnCallScheduleStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOnCallScheduleStatusRequest) Reset() {
+	*x = UpdateOnCallScheduleStatusRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnCallScheduleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnCallScheduleStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOnCallScheduleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnCallScheduleStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOnCallScheduleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateOnCallScheduleStatusRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *UpdateOnCallScheduleStatusRequest) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type UpdateOnCallScheduleStatusReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOnCallScheduleStatusReply) Reset() {
+	*x = UpdateOnCallScheduleStatusReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOnCallScheduleStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOnCallScheduleStatusReply) ProtoMessage() {}
+
+func (x *UpdateOnCallScheduleStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOnCallScheduleStatusReply.ProtoReflect.Descriptor instead.
+func (*UpdateOnCallScheduleStatusReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+type DeleteOnCallScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOnCallScheduleRequest) Reset() {
+	*x = DeleteOnCallScheduleRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOnCallScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOnCallScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteOnCallScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOnCallScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOnCallScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteOnCallScheduleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type DeleteOnCallScheduleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOnCallScheduleReply) Reset() {
+	*x = DeleteOnCallScheduleReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOnCallScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOnCallScheduleReply) ProtoMessage() {}
+
+func (x *DeleteOnCallScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOnCallScheduleReply.ProtoReflect.Descriptor instead.
+func (*DeleteOnCallScheduleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+type GetOnCallScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallScheduleRequest) Reset() {
+	*x = GetOnCallScheduleRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallScheduleRequest) ProtoMessage() {}
+
+func (x *GetOnCallScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetOnCallScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOnCallScheduleRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type ListOnCallScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Status        enum.GlobalStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=magicbox.enum.GlobalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnCallScheduleRequest) Reset() {
+	*x = ListOnCallScheduleRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnCallScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnCallScheduleRequest) ProtoMessage() {}
+
+func (x *ListOnCallScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnCallScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ListOnCallScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListOnCallScheduleRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOnCallScheduleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOnCallScheduleRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListOnCallScheduleRequest) GetStatus() enum.GlobalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return enum.GlobalStatus(0)
+}
+
+type ListOnCallScheduleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Items         []*OnCallScheduleItem  `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnCallScheduleReply) Reset() {
+	*x = ListOnCallScheduleReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnCallScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnCallScheduleReply) ProtoMessage() {}
+
+func (x *ListOnCallScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnCallScheduleReply.ProtoReflect.Descriptor instead.
+func (*ListOnCallScheduleReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListOnCallScheduleReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListOnCallScheduleReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOnCallScheduleReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOnCallScheduleReply) GetItems() []*OnCallScheduleItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type OnCallOverrideItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ScheduleUID   int64                  `protobuf:"varint,2,opt,name=scheduleUID,proto3" json:"scheduleUID,omitempty"`
+	ReceiverUID   int64                  `protobuf:"varint,3,opt,name=receiverUID,proto3" json:"receiverUID,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,4,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,5,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	Creator       int64                  `protobuf:"varint,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnCallOverrideItem) Reset() {
+	*x = OnCallOverrideItem{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnCallOverrideItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCallOverrideItem) ProtoMessage() {}
+
+func (x *OnCallOverrideItem) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCallOverrideItem.ProtoReflect.Descriptor instead.
+func (*OnCallOverrideItem) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OnCallOverrideItem) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *OnCallOverrideItem) GetScheduleUID() int64 {
+	if x != nil {
+		return x.ScheduleUID
+	}
+	return 0
+}
+
+func (x *OnCallOverrideItem) GetReceiverUID() int64 {
+	if x != nil {
+		return x.ReceiverUID
+	}
+	return 0
+}
+
+func (x *OnCallOverrideItem) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *OnCallOverrideItem) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *OnCallOverrideItem) GetCreator() int64 {
+	if x != nil {
+		return x.Creator
+	}
+	return 0
+}
+
+func (x *OnCallOverrideItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateOnCallOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleUID   int64                  `protobuf:"varint,1,opt,name=scheduleUID,proto3" json:"scheduleUID,omitempty"`
+	ReceiverUID   int64                  `protobuf:"varint,2,opt,name=receiverUID,proto3" json:"receiverUID,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,3,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,4,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOnCallOverrideRequest) Reset() {
+	*x = CreateOnCallOverrideRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOnCallOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOnCallOverrideRequest) ProtoMessage() {}
+
+func (x *CreateOnCallOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOnCallOverrideRequest.ProtoReflect.Descriptor instead.
+func (*CreateOnCallOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateOnCallOverrideRequest) GetScheduleUID() int64 {
+	if x != nil {
+		return x.ScheduleUID
+	}
+	return 0
+}
+
+func (x *CreateOnCallOverrideRequest) GetReceiverUID() int64 {
+	if x != nil {
+		return x.ReceiverUID
+	}
+	return 0
+}
+
+func (x *CreateOnCallOverrideRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *CreateOnCallOverrideRequest) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type CreateOnCallOverrideReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOnCallOverrideReply) Reset() {
+	*x = CreateOnCallOverrideReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOnCallOverrideReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOnCallOverrideReply) ProtoMessage() {}
+
+func (x *CreateOnCallOverrideReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOnCallOverrideReply.ProtoReflect.Descriptor instead.
+func (*CreateOnCallOverrideReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{14}
+}
+
+type DeleteOnCallOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleUID   int64                  `protobuf:"varint,1,opt,name=scheduleUID,proto3" json:"scheduleUID,omitempty"`
+	Uid           int64                  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOnCallOverrideRequest) Reset() {
+	*x = DeleteOnCallOverrideRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOnCallOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOnCallOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteOnCallOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOnCallOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOnCallOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteOnCallOverrideRequest) GetScheduleUID() int64 {
+	if x != nil {
+		return x.ScheduleUID
+	}
+	return 0
+}
+
+func (x *DeleteOnCallOverrideRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+type DeleteOnCallOverrideReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOnCallOverrideReply) Reset() {
+	*x = DeleteOnCallOverrideReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOnCallOverrideReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOnCallOverrideReply) ProtoMessage() {}
+
+func (x *DeleteOnCallOverrideReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOnCallOverrideReply.ProtoReflect.Descriptor instead.
+func (*DeleteOnCallOverrideReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{16}
+}
+
+type ListOnCallOverrideRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleUID    int64                  `protobuf:"varint,1,opt,name=scheduleUID,proto3" json:"scheduleUID,omitempty"`
+	IncludeExpired bool                   `protobuf:"varint,2,opt,name=includeExpired,proto3" json:"includeExpired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOnCallOverrideRequest) Reset() {
+	*x = ListOnCallOverrideRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnCallOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnCallOverrideRequest) ProtoMessage() {}
+
+func (x *ListOnCallOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnCallOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ListOnCallOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListOnCallOverrideRequest) GetScheduleUID() int64 {
+	if x != nil {
+		return x.ScheduleUID
+	}
+	return 0
+}
+
+func (x *ListOnCallOverrideRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListOnCallOverrideReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*OnCallOverrideItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnCallOverrideReply) Reset() {
+	*x = ListOnCallOverrideReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnCallOverrideReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnCallOverrideReply) ProtoMessage() {}
+
+func (x *ListOnCallOverrideReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnCallOverrideReply.ProtoReflect.Descriptor instead.
+func (*ListOnCallOverrideReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListOnCallOverrideReply) GetItems() []*OnCallOverrideItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type OnCallShift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceiverUID   int64                  `protobuf:"varint,1,opt,name=receiverUID,proto3" json:"receiverUID,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,2,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,3,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	Override      bool                   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnCallShift) Reset() {
+	*x = OnCallShift{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnCallShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCallShift) ProtoMessage() {}
+
+func (x *OnCallShift) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCallShift.ProtoReflect.Descriptor instead.
+func (*OnCallShift) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OnCallShift) GetReceiverUID() int64 {
+	if x != nil {
+		return x.ReceiverUID
+	}
+	return 0
+}
+
+func (x *OnCallShift) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *OnCallShift) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *OnCallShift) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+type GetOnCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Time          int64                  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallRequest) Reset() {
+	*x = GetOnCallRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallRequest) ProtoMessage() {}
+
+func (x *GetOnCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallRequest.ProtoReflect.Descriptor instead.
+func (*GetOnCallRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetOnCallRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *GetOnCallRequest) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type GetOnCallReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *OnCallShift           `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnCallReply) Reset() {
+	*x = GetOnCallReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnCallReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnCallReply) ProtoMessage() {}
+
+func (x *GetOnCallReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnCallReply.ProtoReflect.Descriptor instead.
+func (*GetOnCallReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOnCallReply) GetShift() *OnCallShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type PreviewOnCallShiftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewOnCallShiftsRequest) Reset() {
+	*x = PreviewOnCallShiftsRequest{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewOnCallShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOnCallShiftsRequest) ProtoMessage() {}
+
+func (x *PreviewOnCallShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOnCallShiftsRequest.ProtoReflect.Descriptor instead.
+func (*PreviewOnCallShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PreviewOnCallShiftsRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *PreviewOnCallShiftsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *PreviewOnCallShiftsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PreviewOnCallShiftsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*OnCallShift         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewOnCallShiftsReply) Reset() {
+	*x = PreviewOnCallShiftsReply{}
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewOnCallShiftsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOnCallShiftsReply) ProtoMessage() {}
+
+func (x *PreviewOnCallShiftsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_marksman_api_v1_oncall_schedule_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOnCallShiftsReply.ProtoReflect.Descriptor instead.
+func (*PreviewOnCallShiftsReply) Descriptor() ([]byte, []int) {
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PreviewOnCallShiftsReply) GetItems() []*OnCallShift {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_marksman_api_v1_oncall_schedule_proto protoreflect.FileDescriptor
+
+var file_marksman_api_v1_oncall_schedule_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x03,
+	0x0a, 0x12, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x47,
+	0x0a, 0x0c, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6f,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61,
+	0x6e, 0x64, 0x6f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x66, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62,
+	0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd1, 0x03, 0x0a, 0x1b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04,
+	0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x01,
+	0x18, 0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x56, 0x0a, 0x0c,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x82,
+	0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xba, 0x48, 0x26, 0xc8, 0x01,
+	0x01, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x31, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d,
+	0x7c, 0x32, 0x5b, 0x30, 0x2d, 0x33, 0x5d, 0x29, 0x3a, 0x5b, 0x30, 0x2d, 0x35, 0x5d, 0x5b, 0x30,
+	0x2d, 0x39, 0x5d, 0x24, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x57, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04,
+	0x18, 0x06, 0x28, 0x00, 0x52, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x34, 0xba, 0x48, 0x31, 0xba, 0x01, 0x2b, 0x12, 0x1f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a,
+	0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08,
+	0x01, 0x10, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x1b, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x96, 0x04, 0x0a, 0x1b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x31, 0xba, 0x48, 0x2e, 0xba, 0x01, 0x28, 0x0a, 0x00,
+	0x12, 0x1a, 0x75, 0x69, 0x64, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68,
+	0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48,
+	0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8,
+	0x01, 0x01, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73,
+	0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba,
+	0x48, 0x0a, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0c, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x68, 0x61,
+	0x6e, 0x64, 0x6f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x29, 0xba, 0x48, 0x26, 0xc8, 0x01, 0x01, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x31,
+	0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7c, 0x32, 0x5b, 0x30, 0x2d, 0x33, 0x5d, 0x29, 0x3a, 0x5b,
+	0x30, 0x2d, 0x35, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x24, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64,
+	0x6f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x6f,
+	0x66, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x06, 0x28, 0x00, 0x52, 0x0e, 0x68, 0x61, 0x6e, 0x64,
+	0x6f, 0x66, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x34, 0xba, 0x48,
+	0x31, 0xba, 0x01, 0x2b, 0x12, 0x1f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74,
+	0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30, 0xc8,
+	0x01, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xfe, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0xbe, 0x01, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d,
+	0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x88, 0x01,
+	0xba, 0x48, 0x84, 0x01, 0xba, 0x01, 0x7e, 0x12, 0x29, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x45, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x27, 0x5d, 0x1a, 0x51, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x6d, 0x61, 0x67,
+	0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x2c,
+	0x20, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x49, 0x53, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x5d, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x37, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22,
+	0x91, 0x03, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3d, 0xba, 0x48, 0x3a,
+	0xba, 0x01, 0x34, 0x12, 0x27, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f,
+	0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x1a, 0x09, 0x74, 0x68,
+	0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x8a, 0x01, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x6e, 0xba, 0x48, 0x6b, 0xba, 0x01, 0x65, 0x12, 0x49, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71,
+	0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x65, 0x73,
+	0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20,
+	0x74, 0x6f, 0x20, 0x32, 0x30, 0x30, 0x1a, 0x18, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x3d, 0x20,
+	0x31, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3c, 0x3d, 0x20, 0x32, 0x30, 0x30,
+	0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5f, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45,
+	0xba, 0x48, 0x42, 0xba, 0x01, 0x3f, 0x12, 0x29, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x61,
+	0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30,
+	0x30, 0x1a, 0x12, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x29, 0x20, 0x3c,
+	0x3d, 0x20, 0x31, 0x30, 0x30, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x62, 0x6f, 0x78, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0xd6, 0x01, 0x0a, 0x12, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x1b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x55, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x49, 0x44, 0x12, 0x50, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x34, 0xba, 0x48, 0x31, 0xba, 0x01, 0x2b, 0x12, 0x1f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e,
+	0x20, 0x30, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12,
+	0x4a, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x32, 0xba, 0x48, 0x2f, 0xba, 0x01, 0x29, 0x12, 0x1d, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20,
+	0x74, 0x68, 0x61, 0x6e, 0x20, 0x30, 0x1a, 0x08, 0x74, 0x68, 0x69, 0x73, 0x20, 0x3e, 0x20, 0x30,
+	0xc8, 0x01, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x61, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6d, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7f, 0x0a,
+	0x0b, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x55, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x64, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22, 0x40,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52,
+	0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x22, 0x6b, 0x0a, 0x1a, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x2a, 0x5d, 0x0a, 0x12, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x4f, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59,
+	0x10, 0x02, 0x32, 0xa6, 0x0d, 0x0a, 0x0e, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x1a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61,
+	0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64,
+	0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x32, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01,
+	0x2a, 0x1a, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x29, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22,
+	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63,
+	0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69,
+	0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63,
+	0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xa7, 0x01,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x76, 0x31,
+	0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x2f, 0x7b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x7d, 0x2f, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x32, 0x2a, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x55, 0x49, 0x44, 0x7d, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x7b,
+	0x75, 0x69, 0x64, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d,
+	0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e,
+	0x43, 0x61, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x49, 0x44, 0x7d, 0x2f, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x79, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x6e, 0x63, 0x61, 0x6c,
+	0x6c, 0x12, 0x97, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e, 0x43,
+	0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x6e, 0x63, 0x61, 0x6c, 0x6c, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b,
+	0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x42, 0x42, 0x0a, 0x0f, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x50, 0x01,
+	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64,
+	0x65, 0x2d, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x6d, 0x61,
+	0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marksman_api_v1_oncall_schedule_proto_rawDescOnce sync.Once
+	file_marksman_api_v1_oncall_schedule_proto_rawDescData = file_marksman_api_v1_oncall_schedule_proto_rawDesc
+)
+
+func file_marksman_api_v1_oncall_schedule_proto_rawDescGZIP() []byte {
+	file_marksman_api_v1_oncall_schedule_proto_rawDescOnce.Do(func() {
+		file_marksman_api_v1_oncall_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(file_marksman_api_v1_oncall_schedule_proto_rawDescData)
+	})
+	return file_marksman_api_v1_oncall_schedule_proto_rawDescData
+}
+
+var file_marksman_api_v1_oncall_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_marksman_api_v1_oncall_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_marksman_api_v1_oncall_schedule_proto_goTypes = []any{
+	(OnCallRotationType)(0),                   // 0: marksman.api.v1.OnCallRotationType
+	(*OnCallScheduleItem)(nil),                // 1: marksman.api.v1.OnCallScheduleItem
+	(*CreateOnCallScheduleRequest)(nil),       // 2: marksman.api.v1.CreateOnCallScheduleRequest
+	(*CreateOnCallScheduleReply)(nil),         // 3: marksman.api.v1.CreateOnCallScheduleReply
+	(*UpdateOnCallScheduleRequest)(nil),       // 4: marksman.api.v1.UpdateOnCallScheduleRequest
+	(*UpdateOnCallScheduleReply)(nil),         // 5: marksman.api.v1.UpdateOnCallScheduleReply
+	(*UpdateOnCallScheduleStatusRequest)(nil), // 6: marksman.api.v1.UpdateOnCallScheduleStatusRequest
+	(*UpdateOnCallScheduleStatusReply)(nil),   // 7: marksman.api.v1.UpdateOnCallScheduleStatusReply
+	(*DeleteOnCallScheduleRequest)(nil),       // 8: marksman.api.v1.DeleteOnCallScheduleRequest
+	(*DeleteOnCallScheduleReply)(nil),         // 9: marksman.api.v1.DeleteOnCallScheduleReply
+	(*GetOnCallScheduleRequest)(nil),          // 10: marksman.api.v1.GetOnCallScheduleRequest
+	(*ListOnCallScheduleRequest)(nil),         // 11: marksman.api.v1.ListOnCallScheduleRequest
+	(*ListOnCallScheduleReply)(nil),           // 12: marksman.api.v1.ListOnCallScheduleReply
+	(*OnCallOverrideItem)(nil),                // 13: marksman.api.v1.OnCallOverrideItem
+	(*CreateOnCallOverrideRequest)(nil),       // 14: marksman.api.v1.CreateOnCallOverrideRequest
+	(*CreateOnCallOverrideReply)(nil),         // 15: marksman.api.v1.CreateOnCallOverrideReply
+	(*DeleteOnCallOverrideRequest)(nil),       // 16: marksman.api.v1.DeleteOnCallOverrideRequest
+	(*DeleteOnCallOverrideReply)(nil),         // 17: marksman.api.v1.DeleteOnCallOverrideReply
+	(*ListOnCallOverrideRequest)(nil),         // 18: marksman.api.v1.ListOnCallOverrideRequest
+	(*ListOnCallOverrideReply)(nil),           // 19: marksman.api.v1.ListOnCallOverrideReply
+	(*OnCallShift)(nil),                       // 20: marksman.api.v1.OnCallShift
+	(*GetOnCallRequest)(nil),                  // 21: marksman.api.v1.GetOnCallRequest
+	(*GetOnCallReply)(nil),                    // 22: marksman.api.v1.GetOnCallReply
+	(*PreviewOnCallShiftsRequest)(nil),        // 23: marksman.api.v1.PreviewOnCallShiftsRequest
+	(*PreviewOnCallShiftsReply)(nil),          // 24: marksman.api.v1.PreviewOnCallShiftsReply
+	(enum.GlobalStatus)(0),                    // 25: magicbox.enum.GlobalStatus
+}
+var file_marksman_api_v1_oncall_schedule_proto_depIdxs = []int32{
+	0,  // 0: marksman.api.v1.OnCallScheduleItem.rotationType:type_name -> marksman.api.v1.OnCallRotationType
+	25, // 1: marksman.api.v1.OnCallScheduleItem.status:type_name -> magicbox.enum.GlobalStatus
+	0,  // 2: marksman.api.v1.CreateOnCallScheduleRequest.rotationType:type_name -> marksman.api.v1.OnCallRotationType
+	0,  // 3: marksman.api.v1.UpdateOnCallScheduleRequest.rotationType:type_name -> marksman.api.v1.OnCallRotationType
+	25, // 4: marksman.api.v1.UpdateOnCallScheduleStatusRequest.status:type_name -> magicbox.enum.GlobalStatus
+	25, // 5: marksman.api.v1.ListOnCallScheduleRequest.status:type_name -> magicbox.enum.GlobalStatus
+	1,  // 6: marksman.api.v1.ListOnCallScheduleReply.items:type_name -> marksman.api.v1.OnCallScheduleItem
+	13, // 7: marksman.api.v1.ListOnCallOverrideReply.items:type_name -> marksman.api.v1.OnCallOverrideItem
+	20, // 8: marksman.api.v1.GetOnCallReply.shift:type_name -> marksman.api.v1.OnCallShift
+	20, // 9: marksman.api.v1.PreviewOnCallShiftsReply.items:type_name -> marksman.api.v1.OnCallShift
+	2,  // 10: marksman.api.v1.OnCallSchedule.CreateOnCallSchedule:input_type -> marksman.api.v1.CreateOnCallScheduleRequest
+	4,  // 11: marksman.api.v1.OnCallSchedule.UpdateOnCallSchedule:input_type -> marksman.api.v1.UpdateOnCallScheduleRequest
+	6,  // 12: marksman.api.v1.OnCallSchedule.UpdateOnCallScheduleStatus:input_type -> marksman.api.v1.UpdateOnCallScheduleStatusRequest
+	8,  // 13: marksman.api.v1.OnCallSchedule.DeleteOnCallSchedule:input_type -> marksman.api.v1.DeleteOnCallScheduleRequest
+	10, // 14: marksman.api.v1.OnCallSchedule.GetOnCallSchedule:input_type -> marksman.api.v1.GetOnCallScheduleRequest
+	11, // 15: marksman.api.v1.OnCallSchedule.ListOnCallSchedule:input_type -> marksman.api.v1.ListOnCallScheduleRequest
+	14, // 16: marksman.api.v1.OnCallSchedule.CreateOnCallOverride:input_type -> marksman.api.v1.CreateOnCallOverrideRequest
+	16, // 17: marksman.api.v1.OnCallSchedule.DeleteOnCallOverride:input_type -> marksman.api.v1.DeleteOnCallOverrideRequest
+	18, // 18: marksman.api.v1.OnCallSchedule.ListOnCallOverride:input_type -> marksman.api.v1.ListOnCallOverrideRequest
+	21, // 19: marksman.api.v1.OnCallSchedule.GetOnCall:input_type -> marksman.api.v1.GetOnCallRequest
+	23, // 20: marksman.api.v1.OnCallSchedule.PreviewOnCallShifts:input_type -> marksman.api.v1.PreviewOnCallShiftsRequest
+	3,  // 21: marksman.api.v1.OnCallSchedule.CreateOnCallSchedule:output_type -> marksman.api.v1.CreateOnCallScheduleReply
+	5,  // 22: marksman.api.v1.OnCallSchedule.UpdateOnCallSchedule:output_type -> marksman.api.v1.UpdateOnCallScheduleReply
+	7,  // 23: marksman.api.v1.OnCallSchedule.UpdateOnCallScheduleStatus:output_type -> marksman.api.v1.UpdateOnCallScheduleStatusReply
+	9,  // 24: marksman.api.v1.OnCallSchedule.DeleteOnCallSchedule:output_type -> marksman.api.v1.DeleteOnCallScheduleReply
+	1,  // 25: marksman.api.v1.OnCallSchedule.GetOnCallSchedule:output_type -> marksman.api.v1.OnCallScheduleItem
+	12, // 26: marksman.api.v1.OnCallSchedule.ListOnCallSchedule:output_type -> marksman.api.v1.ListOnCallScheduleReply
+	15, // 27: marksman.api.v1.OnCallSchedule.CreateOnCallOverride:output_type -> marksman.api.v1.CreateOnCallOverrideReply
+	17, // 28: marksman.api.v1.OnCallSchedule.DeleteOnCallOverride:output_type -> marksman.api.v1.DeleteOnCallOverrideReply
+	19, // 29: marksman.api.v1.OnCallSchedule.ListOnCallOverride:output_type -> marksman.api.v1.ListOnCallOverrideReply
+	22, // 30: marksman.api.v1.OnCallSchedule.GetOnCall:output_type -> marksman.api.v1.GetOnCallReply
+	24, // 31: marksman.api.v1.OnCallSchedule.PreviewOnCallShifts:output_type -> marksman.api.v1.PreviewOnCallShiftsReply
+	21, // [21:32] is the sub-list for method output_type
+	10, // [10:21] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_marksman_api_v1_oncall_schedule_proto_init() }
+func file_marksman_api_v1_oncall_schedule_proto_init() {
+	if File_marksman_api_v1_oncall_schedule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marksman_api_v1_oncall_schedule_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_marksman_api_v1_oncall_schedule_proto_goTypes,
+		DependencyIndexes: file_marksman_api_v1_oncall_schedule_proto_depIdxs,
+		EnumInfos:         file_marksman_api_v1_oncall_schedule_proto_enumTypes,
+		MessageInfos:      file_marksman_api_v1_oncall_schedule_proto_msgTypes,
+	}.Build()
+	File_marksman_api_v1_oncall_schedule_proto = out.File
+	file_marksman_api_v1_oncall_schedule_proto_rawDesc = nil
+	file_marksman_api_v1_oncall_schedule_proto_goTypes = nil
+	file_marksman_api_v1_oncall_schedule_proto_depIdxs = nil
+}
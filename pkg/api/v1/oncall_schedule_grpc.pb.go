@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/oncall_schedule.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OnCallSchedule_CreateOnCallSchedule_FullMethodName       = "/marksman.api.v1.OnCallSchedule/CreateOnCallSchedule"
+	OnCallSchedule_UpdateOnCallSchedule_FullMethodName       = "/marksman.api.v1.OnCallSchedule/UpdateOnCallSchedule"
+	OnCallSchedule_UpdateOnCallScheduleStatus_FullMethodName = "/marksman.api.v1.OnCallSchedule/UpdateOnCallScheduleStatus"
+	OnCallSchedule_DeleteOnCallSchedule_FullMethodName       = "/marksman.api.v1.OnCallSchedule/DeleteOnCallSchedule"
+	OnCallSchedule_GetOnCallSchedule_FullMethodName          = "/marksman.api.v1.OnCallSchedule/GetOnCallSchedule"
+	OnCallSchedule_ListOnCallSchedule_FullMethodName         = "/marksman.api.v1.OnCallSchedule/ListOnCallSchedule"
+	OnCallSchedule_CreateOnCallOverride_FullMethodName       = "/marksman.api.v1.OnCallSchedule/CreateOnCallOverride"
+	OnCallSchedule_DeleteOnCallOverride_FullMethodName       = "/marksman.api.v1.OnCallSchedule/DeleteOnCallOverride"
+	OnCallSchedule_ListOnCallOverride_FullMethodName         = "/marksman.api.v1.OnCallSchedule/ListOnCallOverride"
+	OnCallSchedule_GetOnCall_FullMethodName                  = "/marksman.api.v1.OnCallSchedule/GetOnCall"
+	OnCallSchedule_PreviewOnCallShifts_FullMethodName        = "/marksman.api.v1.OnCallSchedule/PreviewOnCallShifts"
+)
+
+// OnCallScheduleClient is the client API for OnCallSchedule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OnCallScheduleClient interface {
+	CreateOnCallSchedule(ctx context.Context, in *CreateOnCallScheduleRequest, opts ...grpc.CallOption) (*CreateOnCallScheduleReply, error)
+	UpdateOnCallSchedule(ctx context.Context, in *UpdateOnCallScheduleRequest, opts ...grpc.CallOption) (*UpdateOnCallScheduleReply, error)
+	UpdateOnCallScheduleStatus(ctx context.Context, in *UpdateOnCallScheduleStatusRequest, opts ...grpc.CallOption) (*UpdateOnCallScheduleStatusReply, error)
+	DeleteOnCallSchedule(ctx context.Context, in *DeleteOnCallScheduleRequest, opts ...grpc.CallOption) (*DeleteOnCallScheduleReply, error)
+	GetOnCallSchedule(ctx context.Context, in *GetOnCallScheduleRequest, opts ...grpc.CallOption) (*OnCallScheduleItem, error)
+	ListOnCallSchedule(ctx context.Context, in *ListOnCallScheduleRequest, opts ...grpc.CallOption) (*ListOnCallScheduleReply, error)
+	CreateOnCallOverride(ctx context.Context, in *CreateOnCallOverrideRequest, opts ...grpc.CallOption) (*CreateOnCallOverrideReply, error)
+	DeleteOnCallOverride(ctx context.Context, in *DeleteOnCallOverrideRequest, opts ...grpc.CallOption) (*DeleteOnCallOverrideReply, error)
+	ListOnCallOverride(ctx context.Context, in *ListOnCallOverrideRequest, opts ...grpc.CallOption) (*ListOnCallOverrideReply, error)
+	GetOnCall(ctx context.Context, in *GetOnCallRequest, opts ...grpc.CallOption) (*GetOnCallReply, error)
+	PreviewOnCallShifts(ctx context.Context, in *PreviewOnCallShiftsRequest, opts ...grpc.CallOption) (*PreviewOnCallShiftsReply, error)
+}
+
+type onCallScheduleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOnCallScheduleClient(cc grpc.ClientConnInterface) OnCallScheduleClient {
+	return &onCallScheduleClient{cc}
+}
+
+func (c *onCallScheduleClient) CreateOnCallSchedule(ctx context.Context, in *CreateOnCallScheduleRequest, opts ...grpc.CallOption) (*CreateOnCallScheduleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOnCallScheduleReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_CreateOnCallSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) UpdateOnCallSchedule(ctx context.Context, in *UpdateOnCallScheduleRequest, opts ...grpc.CallOption) (*UpdateOnCallScheduleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOnCallScheduleReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_UpdateOnCallSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) UpdateOnCallScheduleStatus(ctx context.Context, in *UpdateOnCallScheduleStatusRequest, opts ...grpc.CallOption) (*UpdateOnCallScheduleStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOnCallScheduleStatusReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_UpdateOnCallScheduleStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) DeleteOnCallSchedule(ctx context.Context, in *DeleteOnCallScheduleRequest, opts ...grpc.CallOption) (*DeleteOnCallScheduleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOnCallScheduleReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_DeleteOnCallSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) GetOnCallSchedule(ctx context.Context, in *GetOnCallScheduleRequest, opts ...grpc.CallOption) (*OnCallScheduleItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnCallScheduleItem)
+	err := c.cc.Invoke(ctx, OnCallSchedule_GetOnCallSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) ListOnCallSchedule(ctx context.Context, in *ListOnCallScheduleRequest, opts ...grpc.CallOption) (*ListOnCallScheduleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnCallScheduleReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_ListOnCallSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) CreateOnCallOverride(ctx context.Context, in *CreateOnCallOverrideRequest, opts ...grpc.CallOption) (*CreateOnCallOverrideReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOnCallOverrideReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_CreateOnCallOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) DeleteOnCallOverride(ctx context.Context, in *DeleteOnCallOverrideRequest, opts ...grpc.CallOption) (*DeleteOnCallOverrideReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOnCallOverrideReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_DeleteOnCallOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) ListOnCallOverride(ctx context.Context, in *ListOnCallOverrideRequest, opts ...grpc.CallOption) (*ListOnCallOverrideReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnCallOverrideReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_ListOnCallOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) GetOnCall(ctx context.Context, in *GetOnCallRequest, opts ...grpc.CallOption) (*GetOnCallReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnCallReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_GetOnCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onCallScheduleClient) PreviewOnCallShifts(ctx context.Context, in *PreviewOnCallShiftsRequest, opts ...grpc.CallOption) (*PreviewOnCallShiftsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewOnCallShiftsReply)
+	err := c.cc.Invoke(ctx, OnCallSchedule_PreviewOnCallShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OnCallScheduleServer is the server API for OnCallSchedule service.
+// All implementations must embed UnimplementedOnCallScheduleServer
+// for forward compatibility.
+type OnCallScheduleServer interface {
+	CreateOnCallSchedule(context.Context, *CreateOnCallScheduleRequest) (*CreateOnCallScheduleReply, error)
+	UpdateOnCallSchedule(context.Context, *UpdateOnCallScheduleRequest) (*UpdateOnCallScheduleReply, error)
+	UpdateOnCallScheduleStatus(context.Context, *UpdateOnCallScheduleStatusRequest) (*UpdateOnCallScheduleStatusReply, error)
+	DeleteOnCallSchedule(context.Context, *DeleteOnCallScheduleRequest) (*DeleteOnCallScheduleReply, error)
+	GetOnCallSchedule(context.Context, *GetOnCallScheduleRequest) (*OnCallScheduleItem, error)
+	ListOnCallSchedule(context.Context, *ListOnCallScheduleRequest) (*ListOnCallScheduleReply, error)
+	CreateOnCallOverride(context.Context, *CreateOnCallOverrideRequest) (*CreateOnCallOverrideReply, error)
+	DeleteOnCallOverride(context.Context, *DeleteOnCallOverrideRequest) (*DeleteOnCallOverrideReply, error)
+	ListOnCallOverride(context.Context, *ListOnCallOverrideRequest) (*ListOnCallOverrideReply, error)
+	GetOnCall(context.Context, *GetOnCallRequest) (*GetOnCallReply, error)
+	PreviewOnCallShifts(context.Context, *PreviewOnCallShiftsRequest) (*PreviewOnCallShiftsReply, error)
+	mustEmbedUnimplementedOnCallScheduleServer()
+}
+
+// UnimplementedOnCallScheduleServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOnCallScheduleServer struct{}
+
+func (UnimplementedOnCallScheduleServer) CreateOnCallSchedule(context.Context, *CreateOnCallScheduleRequest) (*CreateOnCallScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnCallSchedule not implemented")
+}
+func (UnimplementedOnCallScheduleServer) UpdateOnCallSchedule(context.Context, *UpdateOnCallScheduleRequest) (*UpdateOnCallScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOnCallSchedule not implemented")
+}
+func (UnimplementedOnCallScheduleServer) UpdateOnCallScheduleStatus(context.Context, *UpdateOnCallScheduleStatusRequest) (*UpdateOnCallScheduleStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOnCallScheduleStatus not implemented")
+}
+func (UnimplementedOnCallScheduleServer) DeleteOnCallSchedule(context.Context, *DeleteOnCallScheduleRequest) (*DeleteOnCallScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnCallSchedule not implemented")
+}
+func (UnimplementedOnCallScheduleServer) GetOnCallSchedule(context.Context, *GetOnCallScheduleRequest) (*OnCallScheduleItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnCallSchedule not implemented")
+}
+func (UnimplementedOnCallScheduleServer) ListOnCallSchedule(context.Context, *ListOnCallScheduleRequest) (*ListOnCallScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnCallSchedule not implemented")
+}
+func (UnimplementedOnCallScheduleServer) CreateOnCallOverride(context.Context, *CreateOnCallOverrideRequest) (*CreateOnCallOverrideReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnCallOverride not implemented")
+}
+func (UnimplementedOnCallScheduleServer) DeleteOnCallOverride(context.Context, *DeleteOnCallOverrideRequest) (*DeleteOnCallOverrideReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnCallOverride not implemented")
+}
+func (UnimplementedOnCallScheduleServer) ListOnCallOverride(context.Context, *ListOnCallOverrideRequest) (*ListOnCallOverrideReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnCallOverride not implemented")
+}
+func (UnimplementedOnCallScheduleServer) GetOnCall(context.Context, *GetOnCallRequest) (*GetOnCallReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnCall not implemented")
+}
+func (UnimplementedOnCallScheduleServer) PreviewOnCallShifts(context.Context, *PreviewOnCallShiftsRequest) (*PreviewOnCallShiftsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOnCallShifts not implemented")
+}
+func (UnimplementedOnCallScheduleServer) mustEmbedUnimplementedOnCallScheduleServer() {}
+func (UnimplementedOnCallScheduleServer) testEmbeddedByValue()                        {}
+
+// UnsafeOnCallScheduleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OnCallScheduleServer will
+// result in compilation errors.
+type UnsafeOnCallScheduleServer interface {
+	mustEmbedUnimplementedOnCallScheduleServer()
+}
+
+func RegisterOnCallScheduleServer(s grpc.ServiceRegistrar, srv OnCallScheduleServer) {
+	// If the following call pancis, it indicates UnimplementedOnCallScheduleServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OnCallSchedule_ServiceDesc, srv)
+}
+
+func _OnCallSchedule_CreateOnCallSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOnCallScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).CreateOnCallSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_CreateOnCallSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).CreateOnCallSchedule(ctx, req.(*CreateOnCallScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_UpdateOnCallSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOnCallScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).UpdateOnCallSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_UpdateOnCallSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).UpdateOnCallSchedule(ctx, req.(*UpdateOnCallScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_UpdateOnCallScheduleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOnCallScheduleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).UpdateOnCallScheduleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_UpdateOnCallScheduleStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).UpdateOnCallScheduleStatus(ctx, req.(*UpdateOnCallScheduleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_DeleteOnCallSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOnCallScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).DeleteOnCallSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_DeleteOnCallSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).DeleteOnCallSchedule(ctx, req.(*DeleteOnCallScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_GetOnCallSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnCallScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).GetOnCallSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_GetOnCallSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).GetOnCallSchedule(ctx, req.(*GetOnCallScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_ListOnCallSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnCallScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).ListOnCallSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_ListOnCallSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).ListOnCallSchedule(ctx, req.(*ListOnCallScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_CreateOnCallOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOnCallOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).CreateOnCallOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_CreateOnCallOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).CreateOnCallOverride(ctx, req.(*CreateOnCallOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_DeleteOnCallOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOnCallOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).DeleteOnCallOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_DeleteOnCallOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).DeleteOnCallOverride(ctx, req.(*DeleteOnCallOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_ListOnCallOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnCallOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).ListOnCallOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_ListOnCallOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).ListOnCallOverride(ctx, req.(*ListOnCallOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_GetOnCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).GetOnCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_GetOnCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).GetOnCall(ctx, req.(*GetOnCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnCallSchedule_PreviewOnCallShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewOnCallShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnCallScheduleServer).PreviewOnCallShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnCallSchedule_PreviewOnCallShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnCallScheduleServer).PreviewOnCallShifts(ctx, req.(*PreviewOnCallShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OnCallSchedule_ServiceDesc is the grpc.ServiceDesc for OnCallSchedule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OnCallSchedule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.OnCallSchedule",
+	HandlerType: (*OnCallScheduleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOnCallSchedule",
+			Handler:    _OnCallSchedule_CreateOnCallSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateOnCallSchedule",
+			Handler:    _OnCallSchedule_UpdateOnCallSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateOnCallScheduleStatus",
+			Handler:    _OnCallSchedule_UpdateOnCallScheduleStatus_Handler,
+		},
+		{
+			MethodName: "DeleteOnCallSchedule",
+			Handler:    _OnCallSchedule_DeleteOnCallSchedule_Handler,
+		},
+		{
+			MethodName: "GetOnCallSchedule",
+			Handler:    _OnCallSchedule_GetOnCallSchedule_Handler,
+		},
+		{
+			MethodName: "ListOnCallSchedule",
+			Handler:    _OnCallSchedule_ListOnCallSchedule_Handler,
+		},
+		{
+			MethodName: "CreateOnCallOverride",
+			Handler:    _OnCallSchedule_CreateOnCallOverride_Handler,
+		},
+		{
+			MethodName: "DeleteOnCallOverride",
+			Handler:    _OnCallSchedule_DeleteOnCallOverride_Handler,
+		},
+		{
+			MethodName: "ListOnCallOverride",
+			Handler:    _OnCallSchedule_ListOnCallOverride_Handler,
+		},
+		{
+			MethodName: "GetOnCall",
+			Handler:    _OnCallSchedule_GetOnCall_Handler,
+		},
+		{
+			MethodName: "PreviewOnCallShifts",
+			Handler:    _OnCallSchedule_PreviewOnCallShifts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/oncall_schedule.proto",
+}
@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/oncall_schedule.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationOnCallScheduleCreateOnCallOverride = "/marksman.api.v1.OnCallSchedule/CreateOnCallOverride"
+const OperationOnCallScheduleCreateOnCallSchedule = "/marksman.api.v1.OnCallSchedule/CreateOnCallSchedule"
+const OperationOnCallScheduleDeleteOnCallOverride = "/marksman.api.v1.OnCallSchedule/DeleteOnCallOverride"
+const OperationOnCallScheduleDeleteOnCallSchedule = "/marksman.api.v1.OnCallSchedule/DeleteOnCallSchedule"
+const OperationOnCallScheduleGetOnCall = "/marksman.api.v1.OnCallSchedule/GetOnCall"
+const OperationOnCallScheduleGetOnCallSchedule = "/marksman.api.v1.OnCallSchedule/GetOnCallSchedule"
+const OperationOnCallScheduleListOnCallOverride = "/marksman.api.v1.OnCallSchedule/ListOnCallOverride"
+const OperationOnCallScheduleListOnCallSchedule = "/marksman.api.v1.OnCallSchedule/ListOnCallSchedule"
+const OperationOnCallSchedulePreviewOnCallShifts = "/marksman.api.v1.OnCallSchedule/PreviewOnCallShifts"
+const OperationOnCallScheduleUpdateOnCallSchedule = "/marksman.api.v1.OnCallSchedule/UpdateOnCallSchedule"
+const OperationOnCallScheduleUpdateOnCallScheduleStatus = "/marksman.api.v1.OnCallSchedule/UpdateOnCallScheduleStatus"
+
+type OnCallScheduleHTTPServer interface {
+	CreateOnCallOverride(context.Context, *CreateOnCallOverrideRequest) (*CreateOnCallOverrideReply, error)
+	CreateOnCallSchedule(context.Context, *CreateOnCallScheduleRequest) (*CreateOnCallScheduleReply, error)
+	DeleteOnCallOverride(context.Context, *DeleteOnCallOverrideRequest) (*DeleteOnCallOverrideReply, error)
+	DeleteOnCallSchedule(context.Context, *DeleteOnCallScheduleRequest) (*DeleteOnCallScheduleReply, error)
+	GetOnCall(context.Context, *GetOnCallRequest) (*GetOnCallReply, error)
+	GetOnCallSchedule(context.Context, *GetOnCallScheduleRequest) (*OnCallScheduleItem, error)
+	ListOnCallOverride(context.Context, *ListOnCallOverrideRequest) (*ListOnCallOverrideReply, error)
+	ListOnCallSchedule(context.Context, *ListOnCallScheduleRequest) (*ListOnCallScheduleReply, error)
+	PreviewOnCallShifts(context.Context, *PreviewOnCallShiftsRequest) (*PreviewOnCallShiftsReply, error)
+	UpdateOnCallSchedule(context.Context, *UpdateOnCallScheduleRequest) (*UpdateOnCallScheduleReply, error)
+	UpdateOnCallScheduleStatus(context.Context, *UpdateOnCallScheduleStatusRequest) (*UpdateOnCallScheduleStatusReply, error)
+}
+
+func RegisterOnCallScheduleHTTPServer(s *http.Server, srv OnCallScheduleHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/oncall-schedule", _OnCallSchedule_CreateOnCallSchedule0_HTTP_Handler(srv))
+	r.PUT("/v1/oncall-schedule/{uid}", _OnCallSchedule_UpdateOnCallSchedule0_HTTP_Handler(srv))
+	r.PUT("/v1/oncall-schedule/{uid}/status", _OnCallSchedule_UpdateOnCallScheduleStatus0_HTTP_Handler(srv))
+	r.DELETE("/v1/oncall-schedule/{uid}", _OnCallSchedule_DeleteOnCallSchedule0_HTTP_Handler(srv))
+	r.GET("/v1/oncall-schedule/{uid}", _OnCallSchedule_GetOnCallSchedule0_HTTP_Handler(srv))
+	r.GET("/v1/oncall-schedules", _OnCallSchedule_ListOnCallSchedule0_HTTP_Handler(srv))
+	r.POST("/v1/oncall-schedule/{scheduleUID}/override", _OnCallSchedule_CreateOnCallOverride0_HTTP_Handler(srv))
+	r.DELETE("/v1/oncall-schedule/{scheduleUID}/override/{uid}", _OnCallSchedule_DeleteOnCallOverride0_HTTP_Handler(srv))
+	r.GET("/v1/oncall-schedule/{scheduleUID}/overrides", _OnCallSchedule_ListOnCallOverride0_HTTP_Handler(srv))
+	r.GET("/v1/oncall-schedule/{uid}/oncall", _OnCallSchedule_GetOnCall0_HTTP_Handler(srv))
+	r.GET("/v1/oncall-schedule/{uid}/shifts", _OnCallSchedule_PreviewOnCallShifts0_HTTP_Handler(srv))
+}
+
+func _OnCallSchedule_CreateOnCallSchedule0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOnCallScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleCreateOnCallSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOnCallSchedule(ctx, req.(*CreateOnCallScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateOnCallScheduleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_UpdateOnCallSchedule0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateOnCallScheduleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleUpdateOnCallSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateOnCallSchedule(ctx, req.(*UpdateOnCallScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateOnCallScheduleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_UpdateOnCallScheduleStatus0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateOnCallScheduleStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleUpdateOnCallScheduleStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateOnCallScheduleStatus(ctx, req.(*UpdateOnCallScheduleStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateOnCallScheduleStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_DeleteOnCallSchedule0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteOnCallScheduleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleDeleteOnCallSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteOnCallSchedule(ctx, req.(*DeleteOnCallScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteOnCallScheduleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_GetOnCallSchedule0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOnCallScheduleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleGetOnCallSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOnCallSchedule(ctx, req.(*GetOnCallScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OnCallScheduleItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_ListOnCallSchedule0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOnCallScheduleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleListOnCallSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOnCallSchedule(ctx, req.(*ListOnCallScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOnCallScheduleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_CreateOnCallOverride0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOnCallOverrideRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleCreateOnCallOverride)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOnCallOverride(ctx, req.(*CreateOnCallOverrideRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateOnCallOverrideReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_DeleteOnCallOverride0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteOnCallOverrideRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleDeleteOnCallOverride)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteOnCallOverride(ctx, req.(*DeleteOnCallOverrideRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteOnCallOverrideReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_ListOnCallOverride0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOnCallOverrideRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleListOnCallOverride)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOnCallOverride(ctx, req.(*ListOnCallOverrideRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOnCallOverrideReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_GetOnCall0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOnCallRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallScheduleGetOnCall)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOnCall(ctx, req.(*GetOnCallRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetOnCallReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OnCallSchedule_PreviewOnCallShifts0_HTTP_Handler(srv OnCallScheduleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PreviewOnCallShiftsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOnCallSchedulePreviewOnCallShifts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PreviewOnCallShifts(ctx, req.(*PreviewOnCallShiftsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreviewOnCallShiftsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type OnCallScheduleHTTPClient interface {
+	CreateOnCallOverride(ctx context.Context, req *CreateOnCallOverrideRequest, opts ...http.CallOption) (rsp *CreateOnCallOverrideReply, err error)
+	CreateOnCallSchedule(ctx context.Context, req *CreateOnCallScheduleRequest, opts ...http.CallOption) (rsp *CreateOnCallScheduleReply, err error)
+	DeleteOnCallOverride(ctx context.Context, req *DeleteOnCallOverrideRequest, opts ...http.CallOption) (rsp *DeleteOnCallOverrideReply, err error)
+	DeleteOnCallSchedule(ctx context.Context, req *DeleteOnCallScheduleRequest, opts ...http.CallOption) (rsp *DeleteOnCallScheduleReply, err error)
+	GetOnCall(ctx context.Context, req *GetOnCallRequest, opts ...http.CallOption) (rsp *GetOnCallReply, err error)
+	GetOnCallSchedule(ctx context.Context, req *GetOnCallScheduleRequest, opts ...http.CallOption) (rsp *OnCallScheduleItem, err error)
+	ListOnCallOverride(ctx context.Context, req *ListOnCallOverrideRequest, opts ...http.CallOption) (rsp *ListOnCallOverrideReply, err error)
+	ListOnCallSchedule(ctx context.Context, req *ListOnCallScheduleRequest, opts ...http.CallOption) (rsp *ListOnCallScheduleReply, err error)
+	PreviewOnCallShifts(ctx context.Context, req *PreviewOnCallShiftsRequest, opts ...http.CallOption) (rsp *PreviewOnCallShiftsReply, err error)
+	UpdateOnCallSchedule(ctx context.Context, req *UpdateOnCallScheduleRequest, opts ...http.CallOption) (rsp *UpdateOnCallScheduleReply, err error)
+	UpdateOnCallScheduleStatus(ctx context.Context, req *UpdateOnCallScheduleStatusRequest, opts ...http.CallOption) (rsp *UpdateOnCallScheduleStatusReply, err error)
+}
+
+type OnCallScheduleHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewOnCallScheduleHTTPClient(client *http.Client) OnCallScheduleHTTPClient {
+	return &OnCallScheduleHTTPClientImpl{client}
+}
+
+func (c *OnCallScheduleHTTPClientImpl) CreateOnCallOverride(ctx context.Context, in *CreateOnCallOverrideRequest, opts ...http.CallOption) (*CreateOnCallOverrideReply, error) {
+	var out CreateOnCallOverrideReply
+	pattern := "/v1/oncall-schedule/{scheduleUID}/override"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOnCallScheduleCreateOnCallOverride))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) CreateOnCallSchedule(ctx context.Context, in *CreateOnCallScheduleRequest, opts ...http.CallOption) (*CreateOnCallScheduleReply, error) {
+	var out CreateOnCallScheduleReply
+	pattern := "/v1/oncall-schedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOnCallScheduleCreateOnCallSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) DeleteOnCallOverride(ctx context.Context, in *DeleteOnCallOverrideRequest, opts ...http.CallOption) (*DeleteOnCallOverrideReply, error) {
+	var out DeleteOnCallOverrideReply
+	pattern := "/v1/oncall-schedule/{scheduleUID}/override/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallScheduleDeleteOnCallOverride))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) DeleteOnCallSchedule(ctx context.Context, in *DeleteOnCallScheduleRequest, opts ...http.CallOption) (*DeleteOnCallScheduleReply, error) {
+	var out DeleteOnCallScheduleReply
+	pattern := "/v1/oncall-schedule/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallScheduleDeleteOnCallSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) GetOnCall(ctx context.Context, in *GetOnCallRequest, opts ...http.CallOption) (*GetOnCallReply, error) {
+	var out GetOnCallReply
+	pattern := "/v1/oncall-schedule/{uid}/oncall"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallScheduleGetOnCall))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) GetOnCallSchedule(ctx context.Context, in *GetOnCallScheduleRequest, opts ...http.CallOption) (*OnCallScheduleItem, error) {
+	var out OnCallScheduleItem
+	pattern := "/v1/oncall-schedule/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallScheduleGetOnCallSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) ListOnCallOverride(ctx context.Context, in *ListOnCallOverrideRequest, opts ...http.CallOption) (*ListOnCallOverrideReply, error) {
+	var out ListOnCallOverrideReply
+	pattern := "/v1/oncall-schedule/{scheduleUID}/overrides"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallScheduleListOnCallOverride))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) ListOnCallSchedule(ctx context.Context, in *ListOnCallScheduleRequest, opts ...http.CallOption) (*ListOnCallScheduleReply, error) {
+	var out ListOnCallScheduleReply
+	pattern := "/v1/oncall-schedules"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallScheduleListOnCallSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) PreviewOnCallShifts(ctx context.Context, in *PreviewOnCallShiftsRequest, opts ...http.CallOption) (*PreviewOnCallShiftsReply, error) {
+	var out PreviewOnCallShiftsReply
+	pattern := "/v1/oncall-schedule/{uid}/shifts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOnCallSchedulePreviewOnCallShifts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) UpdateOnCallSchedule(ctx context.Context, in *UpdateOnCallScheduleRequest, opts ...http.CallOption) (*UpdateOnCallScheduleReply, error) {
+	var out UpdateOnCallScheduleReply
+	pattern := "/v1/oncall-schedule/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOnCallScheduleUpdateOnCallSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OnCallScheduleHTTPClientImpl) UpdateOnCallScheduleStatus(ctx context.Context, in *UpdateOnCallScheduleStatusRequest, opts ...http.CallOption) (*UpdateOnCallScheduleStatusReply, error) {
+	var out UpdateOnCallScheduleStatusReply
+	pattern := "/v1/oncall-schedule/{uid}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOnCallScheduleUpdateOnCallScheduleStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
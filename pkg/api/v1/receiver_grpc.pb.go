@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/receiver.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Receiver_CreateReceiver_FullMethodName       = "/marksman.api.v1.Receiver/CreateReceiver"
+	Receiver_UpdateReceiver_FullMethodName       = "/marksman.api.v1.Receiver/UpdateReceiver"
+	Receiver_UpdateReceiverStatus_FullMethodName = "/marksman.api.v1.Receiver/UpdateReceiverStatus"
+	Receiver_DeleteReceiver_FullMethodName       = "/marksman.api.v1.Receiver/DeleteReceiver"
+	Receiver_GetReceiver_FullMethodName          = "/marksman.api.v1.Receiver/GetReceiver"
+	Receiver_ListReceiver_FullMethodName         = "/marksman.api.v1.Receiver/ListReceiver"
+	Receiver_ListReceiverDelivery_FullMethodName = "/marksman.api.v1.Receiver/ListReceiverDelivery"
+)
+
+// ReceiverClient is the client API for Receiver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReceiverClient interface {
+	CreateReceiver(ctx context.Context, in *CreateReceiverRequest, opts ...grpc.CallOption) (*CreateReceiverReply, error)
+	UpdateReceiver(ctx context.Context, in *UpdateReceiverRequest, opts ...grpc.CallOption) (*UpdateReceiverReply, error)
+	UpdateReceiverStatus(ctx context.Context, in *UpdateReceiverStatusRequest, opts ...grpc.CallOption) (*UpdateReceiverStatusReply, error)
+	DeleteReceiver(ctx context.Context, in *DeleteReceiverRequest, opts ...grpc.CallOption) (*DeleteReceiverReply, error)
+	GetReceiver(ctx context.Context, in *GetReceiverRequest, opts ...grpc.CallOption) (*ReceiverItem, error)
+	ListReceiver(ctx context.Context, in *ListReceiverRequest, opts ...grpc.CallOption) (*ListReceiverReply, error)
+	ListReceiverDelivery(ctx context.Context, in *ListReceiverDeliveryRequest, opts ...grpc.CallOption) (*ListReceiverDeliveryReply, error)
+}
+
+type receiverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReceiverClient(cc grpc.ClientConnInterface) ReceiverClient {
+	return &receiverClient{cc}
+}
+
+func (c *receiverClient) CreateReceiver(ctx context.Context, in *CreateReceiverRequest, opts ...grpc.CallOption) (*CreateReceiverReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReceiverReply)
+	err := c.cc.Invoke(ctx, Receiver_CreateReceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) UpdateReceiver(ctx context.Context, in *UpdateReceiverRequest, opts ...grpc.CallOption) (*UpdateReceiverReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReceiverReply)
+	err := c.cc.Invoke(ctx, Receiver_UpdateReceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) UpdateReceiverStatus(ctx context.Context, in *UpdateReceiverStatusRequest, opts ...grpc.CallOption) (*UpdateReceiverStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReceiverStatusReply)
+	err := c.cc.Invoke(ctx, Receiver_UpdateReceiverStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) DeleteReceiver(ctx context.Context, in *DeleteReceiverRequest, opts ...grpc.CallOption) (*DeleteReceiverReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReceiverReply)
+	err := c.cc.Invoke(ctx, Receiver_DeleteReceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) GetReceiver(ctx context.Context, in *GetReceiverRequest, opts ...grpc.CallOption) (*ReceiverItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiverItem)
+	err := c.cc.Invoke(ctx, Receiver_GetReceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) ListReceiver(ctx context.Context, in *ListReceiverRequest, opts ...grpc.CallOption) (*ListReceiverReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceiverReply)
+	err := c.cc.Invoke(ctx, Receiver_ListReceiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiverClient) ListReceiverDelivery(ctx context.Context, in *ListReceiverDeliveryRequest, opts ...grpc.CallOption) (*ListReceiverDeliveryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceiverDeliveryReply)
+	err := c.cc.Invoke(ctx, Receiver_ListReceiverDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReceiverServer is the server API for Receiver service.
+// All implementations must embed UnimplementedReceiverServer
+// for forward compatibility.
+type ReceiverServer interface {
+	CreateReceiver(context.Context, *CreateReceiverRequest) (*CreateReceiverReply, error)
+	UpdateReceiver(context.Context, *UpdateReceiverRequest) (*UpdateReceiverReply, error)
+	UpdateReceiverStatus(context.Context, *UpdateReceiverStatusRequest) (*UpdateReceiverStatusReply, error)
+	DeleteReceiver(context.Context, *DeleteReceiverRequest) (*DeleteReceiverReply, error)
+	GetReceiver(context.Context, *GetReceiverRequest) (*ReceiverItem, error)
+	ListReceiver(context.Context, *ListReceiverRequest) (*ListReceiverReply, error)
+	ListReceiverDelivery(context.Context, *ListReceiverDeliveryRequest) (*ListReceiverDeliveryReply, error)
+	mustEmbedUnimplementedReceiverServer()
+}
+
+// UnimplementedReceiverServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReceiverServer struct{}
+
+func (UnimplementedReceiverServer) CreateReceiver(context.Context, *CreateReceiverRequest) (*CreateReceiverReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReceiver not implemented")
+}
+func (UnimplementedReceiverServer) UpdateReceiver(context.Context, *UpdateReceiverRequest) (*UpdateReceiverReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReceiver not implemented")
+}
+func (UnimplementedReceiverServer) UpdateReceiverStatus(context.Context, *UpdateReceiverStatusRequest) (*UpdateReceiverStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReceiverStatus not implemented")
+}
+func (UnimplementedReceiverServer) DeleteReceiver(context.Context, *DeleteReceiverRequest) (*DeleteReceiverReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReceiver not implemented")
+}
+func (UnimplementedReceiverServer) GetReceiver(context.Context, *GetReceiverRequest) (*ReceiverItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiver not implemented")
+}
+func (UnimplementedReceiverServer) ListReceiver(context.Context, *ListReceiverRequest) (*ListReceiverReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceiver not implemented")
+}
+func (UnimplementedReceiverServer) ListReceiverDelivery(context.Context, *ListReceiverDeliveryRequest) (*ListReceiverDeliveryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceiverDelivery not implemented")
+}
+func (UnimplementedReceiverServer) mustEmbedUnimplementedReceiverServer() {}
+func (UnimplementedReceiverServer) testEmbeddedByValue()                  {}
+
+// UnsafeReceiverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReceiverServer will
+// result in compilation errors.
+type UnsafeReceiverServer interface {
+	mustEmbedUnimplementedReceiverServer()
+}
+
+func RegisterReceiverServer(s grpc.ServiceRegistrar, srv ReceiverServer) {
+	// If the following call pancis, it indicates UnimplementedReceiverServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Receiver_ServiceDesc, srv)
+}
+
+func _Receiver_CreateReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).CreateReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_CreateReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).CreateReceiver(ctx, req.(*CreateReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_UpdateReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).UpdateReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_UpdateReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).UpdateReceiver(ctx, req.(*UpdateReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_UpdateReceiverStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReceiverStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).UpdateReceiverStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_UpdateReceiverStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).UpdateReceiverStatus(ctx, req.(*UpdateReceiverStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_DeleteReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).DeleteReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_DeleteReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).DeleteReceiver(ctx, req.(*DeleteReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_GetReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).GetReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_GetReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).GetReceiver(ctx, req.(*GetReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_ListReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).ListReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_ListReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).ListReceiver(ctx, req.(*ListReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Receiver_ListReceiverDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceiverDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiverServer).ListReceiverDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Receiver_ListReceiverDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiverServer).ListReceiverDelivery(ctx, req.(*ListReceiverDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Receiver_ServiceDesc is the grpc.ServiceDesc for Receiver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Receiver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.Receiver",
+	HandlerType: (*ReceiverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReceiver",
+			Handler:    _Receiver_CreateReceiver_Handler,
+		},
+		{
+			MethodName: "UpdateReceiver",
+			Handler:    _Receiver_UpdateReceiver_Handler,
+		},
+		{
+			MethodName: "UpdateReceiverStatus",
+			Handler:    _Receiver_UpdateReceiverStatus_Handler,
+		},
+		{
+			MethodName: "DeleteReceiver",
+			Handler:    _Receiver_DeleteReceiver_Handler,
+		},
+		{
+			MethodName: "GetReceiver",
+			Handler:    _Receiver_GetReceiver_Handler,
+		},
+		{
+			MethodName: "ListReceiver",
+			Handler:    _Receiver_ListReceiver_Handler,
+		},
+		{
+			MethodName: "ListReceiverDelivery",
+			Handler:    _Receiver_ListReceiverDelivery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/receiver.proto",
+}
@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/receiver.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationReceiverCreateReceiver = "/marksman.api.v1.Receiver/CreateReceiver"
+const OperationReceiverDeleteReceiver = "/marksman.api.v1.Receiver/DeleteReceiver"
+const OperationReceiverGetReceiver = "/marksman.api.v1.Receiver/GetReceiver"
+const OperationReceiverListReceiver = "/marksman.api.v1.Receiver/ListReceiver"
+const OperationReceiverListReceiverDelivery = "/marksman.api.v1.Receiver/ListReceiverDelivery"
+const OperationReceiverUpdateReceiver = "/marksman.api.v1.Receiver/UpdateReceiver"
+const OperationReceiverUpdateReceiverStatus = "/marksman.api.v1.Receiver/UpdateReceiverStatus"
+
+type ReceiverHTTPServer interface {
+	CreateReceiver(context.Context, *CreateReceiverRequest) (*CreateReceiverReply, error)
+	DeleteReceiver(context.Context, *DeleteReceiverRequest) (*DeleteReceiverReply, error)
+	GetReceiver(context.Context, *GetReceiverRequest) (*ReceiverItem, error)
+	ListReceiver(context.Context, *ListReceiverRequest) (*ListReceiverReply, error)
+	ListReceiverDelivery(context.Context, *ListReceiverDeliveryRequest) (*ListReceiverDeliveryReply, error)
+	UpdateReceiver(context.Context, *UpdateReceiverRequest) (*UpdateReceiverReply, error)
+	UpdateReceiverStatus(context.Context, *UpdateReceiverStatusRequest) (*UpdateReceiverStatusReply, error)
+}
+
+func RegisterReceiverHTTPServer(s *http.Server, srv ReceiverHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/receiver", _Receiver_CreateReceiver0_HTTP_Handler(srv))
+	r.PUT("/v1/receiver/{uid}", _Receiver_UpdateReceiver0_HTTP_Handler(srv))
+	r.PUT("/v1/receiver/{uid}/status", _Receiver_UpdateReceiverStatus0_HTTP_Handler(srv))
+	r.DELETE("/v1/receiver/{uid}", _Receiver_DeleteReceiver0_HTTP_Handler(srv))
+	r.GET("/v1/receiver/{uid}", _Receiver_GetReceiver0_HTTP_Handler(srv))
+	r.GET("/v1/receivers", _Receiver_ListReceiver0_HTTP_Handler(srv))
+	r.GET("/v1/receiver-deliveries", _Receiver_ListReceiverDelivery0_HTTP_Handler(srv))
+}
+
+func _Receiver_CreateReceiver0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReceiverRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverCreateReceiver)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReceiver(ctx, req.(*CreateReceiverRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReceiverReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Receiver_UpdateReceiver0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReceiverRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverUpdateReceiver)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReceiver(ctx, req.(*UpdateReceiverRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReceiverReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Receiver_UpdateReceiverStatus0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReceiverStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverUpdateReceiverStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReceiverStatus(ctx, req.(*UpdateReceiverStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReceiverStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Receiver_DeleteReceiver0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteReceiverRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverDeleteReceiver)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReceiver(ctx, req.(*DeleteReceiverRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteReceiverReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Receiver_GetReceiver0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReceiverRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverGetReceiver)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReceiver(ctx, req.(*GetReceiverRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReceiverItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Receiver_ListReceiver0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReceiverRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverListReceiver)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReceiver(ctx, req.(*ListReceiverRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReceiverReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Receiver_ListReceiverDelivery0_HTTP_Handler(srv ReceiverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReceiverDeliveryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReceiverListReceiverDelivery)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReceiverDelivery(ctx, req.(*ListReceiverDeliveryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReceiverDeliveryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ReceiverHTTPClient interface {
+	CreateReceiver(ctx context.Context, req *CreateReceiverRequest, opts ...http.CallOption) (rsp *CreateReceiverReply, err error)
+	DeleteReceiver(ctx context.Context, req *DeleteReceiverRequest, opts ...http.CallOption) (rsp *DeleteReceiverReply, err error)
+	GetReceiver(ctx context.Context, req *GetReceiverRequest, opts ...http.CallOption) (rsp *ReceiverItem, err error)
+	ListReceiver(ctx context.Context, req *ListReceiverRequest, opts ...http.CallOption) (rsp *ListReceiverReply, err error)
+	ListReceiverDelivery(ctx context.Context, req *ListReceiverDeliveryRequest, opts ...http.CallOption) (rsp *ListReceiverDeliveryReply, err error)
+	UpdateReceiver(ctx context.Context, req *UpdateReceiverRequest, opts ...http.CallOption) (rsp *UpdateReceiverReply, err error)
+	UpdateReceiverStatus(ctx context.Context, req *UpdateReceiverStatusRequest, opts ...http.CallOption) (rsp *UpdateReceiverStatusReply, err error)
+}
+
+type ReceiverHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewReceiverHTTPClient(client *http.Client) ReceiverHTTPClient {
+	return &ReceiverHTTPClientImpl{client}
+}
+
+func (c *ReceiverHTTPClientImpl) CreateReceiver(ctx context.Context, in *CreateReceiverRequest, opts ...http.CallOption) (*CreateReceiverReply, error) {
+	var out CreateReceiverReply
+	pattern := "/v1/receiver"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReceiverCreateReceiver))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReceiverHTTPClientImpl) DeleteReceiver(ctx context.Context, in *DeleteReceiverRequest, opts ...http.CallOption) (*DeleteReceiverReply, error) {
+	var out DeleteReceiverReply
+	pattern := "/v1/receiver/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReceiverDeleteReceiver))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReceiverHTTPClientImpl) GetReceiver(ctx context.Context, in *GetReceiverRequest, opts ...http.CallOption) (*ReceiverItem, error) {
+	var out ReceiverItem
+	pattern := "/v1/receiver/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReceiverGetReceiver))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReceiverHTTPClientImpl) ListReceiver(ctx context.Context, in *ListReceiverRequest, opts ...http.CallOption) (*ListReceiverReply, error) {
+	var out ListReceiverReply
+	pattern := "/v1/receivers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReceiverListReceiver))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReceiverHTTPClientImpl) ListReceiverDelivery(ctx context.Context, in *ListReceiverDeliveryRequest, opts ...http.CallOption) (*ListReceiverDeliveryReply, error) {
+	var out ListReceiverDeliveryReply
+	pattern := "/v1/receiver-deliveries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReceiverListReceiverDelivery))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReceiverHTTPClientImpl) UpdateReceiver(ctx context.Context, in *UpdateReceiverRequest, opts ...http.CallOption) (*UpdateReceiverReply, error) {
+	var out UpdateReceiverReply
+	pattern := "/v1/receiver/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReceiverUpdateReceiver))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReceiverHTTPClientImpl) UpdateReceiverStatus(ctx context.Context, in *UpdateReceiverStatusRequest, opts ...http.CallOption) (*UpdateReceiverStatusReply, error) {
+	var out UpdateReceiverStatusReply
+	pattern := "/v1/receiver/{uid}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReceiverUpdateReceiverStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: marksman/api/v1/silence.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Silence_CreateSilence_FullMethodName = "/marksman.api.v1.Silence/CreateSilence"
+	Silence_UpdateSilence_FullMethodName = "/marksman.api.v1.Silence/UpdateSilence"
+	Silence_DeleteSilence_FullMethodName = "/marksman.api.v1.Silence/DeleteSilence"
+	Silence_GetSilence_FullMethodName    = "/marksman.api.v1.Silence/GetSilence"
+	Silence_ListSilence_FullMethodName   = "/marksman.api.v1.Silence/ListSilence"
+	Silence_MatchSilences_FullMethodName = "/marksman.api.v1.Silence/MatchSilences"
+)
+
+// SilenceClient is the client API for Silence service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SilenceClient interface {
+	CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*CreateSilenceReply, error)
+	UpdateSilence(ctx context.Context, in *UpdateSilenceRequest, opts ...grpc.CallOption) (*UpdateSilenceReply, error)
+	DeleteSilence(ctx context.Context, in *DeleteSilenceRequest, opts ...grpc.CallOption) (*DeleteSilenceReply, error)
+	GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*SilenceItem, error)
+	ListSilence(ctx context.Context, in *ListSilenceRequest, opts ...grpc.CallOption) (*ListSilenceReply, error)
+	MatchSilences(ctx context.Context, in *MatchSilencesRequest, opts ...grpc.CallOption) (*MatchSilencesReply, error)
+}
+
+type silenceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSilenceClient(cc grpc.ClientConnInterface) SilenceClient {
+	return &silenceClient{cc}
+}
+
+func (c *silenceClient) CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*CreateSilenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSilenceReply)
+	err := c.cc.Invoke(ctx, Silence_CreateSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceClient) UpdateSilence(ctx context.Context, in *UpdateSilenceRequest, opts ...grpc.CallOption) (*UpdateSilenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSilenceReply)
+	err := c.cc.Invoke(ctx, Silence_UpdateSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceClient) DeleteSilence(ctx context.Context, in *DeleteSilenceRequest, opts ...grpc.CallOption) (*DeleteSilenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSilenceReply)
+	err := c.cc.Invoke(ctx, Silence_DeleteSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceClient) GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*SilenceItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SilenceItem)
+	err := c.cc.Invoke(ctx, Silence_GetSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceClient) ListSilence(ctx context.Context, in *ListSilenceRequest, opts ...grpc.CallOption) (*ListSilenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSilenceReply)
+	err := c.cc.Invoke(ctx, Silence_ListSilence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *silenceClient) MatchSilences(ctx context.Context, in *MatchSilencesRequest, opts ...grpc.CallOption) (*MatchSilencesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchSilencesReply)
+	err := c.cc.Invoke(ctx, Silence_MatchSilences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SilenceServer is the server API for Silence service.
+// All implementations must embed UnimplementedSilenceServer
+// for forward compatibility.
+type SilenceServer interface {
+	CreateSilence(context.Context, *CreateSilenceRequest) (*CreateSilenceReply, error)
+	UpdateSilence(context.Context, *UpdateSilenceRequest) (*UpdateSilenceReply, error)
+	DeleteSilence(context.Context, *DeleteSilenceRequest) (*DeleteSilenceReply, error)
+	GetSilence(context.Context, *GetSilenceRequest) (*SilenceItem, error)
+	ListSilence(context.Context, *ListSilenceRequest) (*ListSilenceReply, error)
+	MatchSilences(context.Context, *MatchSilencesRequest) (*MatchSilencesReply, error)
+	mustEmbedUnimplementedSilenceServer()
+}
+
+// UnimplementedSilenceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSilenceServer struct{}
+
+func (UnimplementedSilenceServer) CreateSilence(context.Context, *CreateSilenceRequest) (*CreateSilenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSilence not implemented")
+}
+func (UnimplementedSilenceServer) UpdateSilence(context.Context, *UpdateSilenceRequest) (*UpdateSilenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSilence not implemented")
+}
+func (UnimplementedSilenceServer) DeleteSilence(context.Context, *DeleteSilenceRequest) (*DeleteSilenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSilence not implemented")
+}
+func (UnimplementedSilenceServer) GetSilence(context.Context, *GetSilenceRequest) (*SilenceItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSilence not implemented")
+}
+func (UnimplementedSilenceServer) ListSilence(context.Context, *ListSilenceRequest) (*ListSilenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSilence not implemented")
+}
+func (UnimplementedSilenceServer) MatchSilences(context.Context, *MatchSilencesRequest) (*MatchSilencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchSilences not implemented")
+}
+func (UnimplementedSilenceServer) mustEmbedUnimplementedSilenceServer() {}
+func (UnimplementedSilenceServer) testEmbeddedByValue()                 {}
+
+// UnsafeSilenceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SilenceServer will
+// result in compilation errors.
+type UnsafeSilenceServer interface {
+	mustEmbedUnimplementedSilenceServer()
+}
+
+func RegisterSilenceServer(s grpc.ServiceRegistrar, srv SilenceServer) {
+	// If the following call pancis, it indicates UnimplementedSilenceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Silence_ServiceDesc, srv)
+}
+
+func _Silence_CreateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServer).CreateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Silence_CreateSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServer).CreateSilence(ctx, req.(*CreateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Silence_UpdateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServer).UpdateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Silence_UpdateSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServer).UpdateSilence(ctx, req.(*UpdateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Silence_DeleteSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServer).DeleteSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Silence_DeleteSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServer).DeleteSilence(ctx, req.(*DeleteSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Silence_GetSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServer).GetSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Silence_GetSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServer).GetSilence(ctx, req.(*GetSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Silence_ListSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServer).ListSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Silence_ListSilence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServer).ListSilence(ctx, req.(*ListSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Silence_MatchSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SilenceServer).MatchSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Silence_MatchSilences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SilenceServer).MatchSilences(ctx, req.(*MatchSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Silence_ServiceDesc is the grpc.ServiceDesc for Silence service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Silence_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "marksman.api.v1.Silence",
+	HandlerType: (*SilenceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSilence",
+			Handler:    _Silence_CreateSilence_Handler,
+		},
+		{
+			MethodName: "UpdateSilence",
+			Handler:    _Silence_UpdateSilence_Handler,
+		},
+		{
+			MethodName: "DeleteSilence",
+			Handler:    _Silence_DeleteSilence_Handler,
+		},
+		{
+			MethodName: "GetSilence",
+			Handler:    _Silence_GetSilence_Handler,
+		},
+		{
+			MethodName: "ListSilence",
+			Handler:    _Silence_ListSilence_Handler,
+		},
+		{
+			MethodName: "MatchSilences",
+			Handler:    _Silence_MatchSilences_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "marksman/api/v1/silence.proto",
+}
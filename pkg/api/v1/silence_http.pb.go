@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/silence.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationSilenceCreateSilence = "/marksman.api.v1.Silence/CreateSilence"
+const OperationSilenceDeleteSilence = "/marksman.api.v1.Silence/DeleteSilence"
+const OperationSilenceGetSilence = "/marksman.api.v1.Silence/GetSilence"
+const OperationSilenceListSilence = "/marksman.api.v1.Silence/ListSilence"
+const OperationSilenceMatchSilences = "/marksman.api.v1.Silence/MatchSilences"
+const OperationSilenceUpdateSilence = "/marksman.api.v1.Silence/UpdateSilence"
+
+type SilenceHTTPServer interface {
+	CreateSilence(context.Context, *CreateSilenceRequest) (*CreateSilenceReply, error)
+	DeleteSilence(context.Context, *DeleteSilenceRequest) (*DeleteSilenceReply, error)
+	GetSilence(context.Context, *GetSilenceRequest) (*SilenceItem, error)
+	ListSilence(context.Context, *ListSilenceRequest) (*ListSilenceReply, error)
+	MatchSilences(context.Context, *MatchSilencesRequest) (*MatchSilencesReply, error)
+	UpdateSilence(context.Context, *UpdateSilenceRequest) (*UpdateSilenceReply, error)
+}
+
+func RegisterSilenceHTTPServer(s *http.Server, srv SilenceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/silence", _Silence_CreateSilence0_HTTP_Handler(srv))
+	r.PUT("/v1/silence/{uid}", _Silence_UpdateSilence0_HTTP_Handler(srv))
+	r.DELETE("/v1/silence/{uid}", _Silence_DeleteSilence0_HTTP_Handler(srv))
+	r.GET("/v1/silence/{uid}", _Silence_GetSilence0_HTTP_Handler(srv))
+	r.GET("/v1/silences", _Silence_ListSilence0_HTTP_Handler(srv))
+	r.POST("/v1/silences/match", _Silence_MatchSilences0_HTTP_Handler(srv))
+}
+
+func _Silence_CreateSilence0_HTTP_Handler(srv SilenceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSilenceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSilenceCreateSilence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSilence(ctx, req.(*CreateSilenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateSilenceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Silence_UpdateSilence0_HTTP_Handler(srv SilenceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSilenceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSilenceUpdateSilence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSilence(ctx, req.(*UpdateSilenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateSilenceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Silence_DeleteSilence0_HTTP_Handler(srv SilenceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSilenceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSilenceDeleteSilence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSilence(ctx, req.(*DeleteSilenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSilenceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Silence_GetSilence0_HTTP_Handler(srv SilenceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSilenceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSilenceGetSilence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSilence(ctx, req.(*GetSilenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SilenceItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Silence_ListSilence0_HTTP_Handler(srv SilenceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSilenceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSilenceListSilence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSilence(ctx, req.(*ListSilenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSilenceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Silence_MatchSilences0_HTTP_Handler(srv SilenceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MatchSilencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSilenceMatchSilences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MatchSilences(ctx, req.(*MatchSilencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MatchSilencesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SilenceHTTPClient interface {
+	CreateSilence(ctx context.Context, req *CreateSilenceRequest, opts ...http.CallOption) (rsp *CreateSilenceReply, err error)
+	DeleteSilence(ctx context.Context, req *DeleteSilenceRequest, opts ...http.CallOption) (rsp *DeleteSilenceReply, err error)
+	GetSilence(ctx context.Context, req *GetSilenceRequest, opts ...http.CallOption) (rsp *SilenceItem, err error)
+	ListSilence(ctx context.Context, req *ListSilenceRequest, opts ...http.CallOption) (rsp *ListSilenceReply, err error)
+	MatchSilences(ctx context.Context, req *MatchSilencesRequest, opts ...http.CallOption) (rsp *MatchSilencesReply, err error)
+	UpdateSilence(ctx context.Context, req *UpdateSilenceRequest, opts ...http.CallOption) (rsp *UpdateSilenceReply, err error)
+}
+
+type SilenceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSilenceHTTPClient(client *http.Client) SilenceHTTPClient {
+	return &SilenceHTTPClientImpl{client}
+}
+
+func (c *SilenceHTTPClientImpl) CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...http.CallOption) (*CreateSilenceReply, error) {
+	var out CreateSilenceReply
+	pattern := "/v1/silence"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSilenceCreateSilence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SilenceHTTPClientImpl) DeleteSilence(ctx context.Context, in *DeleteSilenceRequest, opts ...http.CallOption) (*DeleteSilenceReply, error) {
+	var out DeleteSilenceReply
+	pattern := "/v1/silence/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSilenceDeleteSilence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SilenceHTTPClientImpl) GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...http.CallOption) (*SilenceItem, error) {
+	var out SilenceItem
+	pattern := "/v1/silence/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSilenceGetSilence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SilenceHTTPClientImpl) ListSilence(ctx context.Context, in *ListSilenceRequest, opts ...http.CallOption) (*ListSilenceReply, error) {
+	var out ListSilenceReply
+	pattern := "/v1/silences"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSilenceListSilence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SilenceHTTPClientImpl) MatchSilences(ctx context.Context, in *MatchSilencesRequest, opts ...http.CallOption) (*MatchSilencesReply, error) {
+	var out MatchSilencesReply
+	pattern := "/v1/silences/match"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSilenceMatchSilences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SilenceHTTPClientImpl) UpdateSilence(ctx context.Context, in *UpdateSilenceRequest, opts ...http.CallOption) (*UpdateSilenceReply, error) {
+	var out UpdateSilenceReply
+	pattern := "/v1/silence/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSilenceUpdateSilence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
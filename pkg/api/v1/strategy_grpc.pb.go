@@ -33,6 +33,8 @@ const (
 	Strategy_DeleteStrategy_FullMethodName             = "/marksman.api.v1.Strategy/DeleteStrategy"
 	Strategy_GetStrategy_FullMethodName                = "/marksman.api.v1.Strategy/GetStrategy"
 	Strategy_ListStrategy_FullMethodName               = "/marksman.api.v1.Strategy/ListStrategy"
+	Strategy_ImportPrometheusRules_FullMethodName      = "/marksman.api.v1.Strategy/ImportPrometheusRules"
+	Strategy_ExportPrometheusRules_FullMethodName      = "/marksman.api.v1.Strategy/ExportPrometheusRules"
 )
 
 // StrategyClient is the client API for Strategy service.
@@ -53,6 +55,8 @@ type StrategyClient interface {
 	DeleteStrategy(ctx context.Context, in *DeleteStrategyRequest, opts ...grpc.CallOption) (*DeleteStrategyReply, error)
 	GetStrategy(ctx context.Context, in *GetStrategyRequest, opts ...grpc.CallOption) (*StrategyItem, error)
 	ListStrategy(ctx context.Context, in *ListStrategyRequest, opts ...grpc.CallOption) (*ListStrategyReply, error)
+	ImportPrometheusRules(ctx context.Context, in *ImportPrometheusRulesRequest, opts ...grpc.CallOption) (*ImportPrometheusRulesReply, error)
+	ExportPrometheusRules(ctx context.Context, in *ExportPrometheusRulesRequest, opts ...grpc.CallOption) (*ExportPrometheusRulesReply, error)
 }
 
 type strategyClient struct {
@@ -203,6 +207,26 @@ func (c *strategyClient) ListStrategy(ctx context.Context, in *ListStrategyReque
 	return out, nil
 }
 
+func (c *strategyClient) ImportPrometheusRules(ctx context.Context, in *ImportPrometheusRulesRequest, opts ...grpc.CallOption) (*ImportPrometheusRulesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportPrometheusRulesReply)
+	err := c.cc.Invoke(ctx, Strategy_ImportPrometheusRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyClient) ExportPrometheusRules(ctx context.Context, in *ExportPrometheusRulesRequest, opts ...grpc.CallOption) (*ExportPrometheusRulesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPrometheusRulesReply)
+	err := c.cc.Invoke(ctx, Strategy_ExportPrometheusRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StrategyServer is the server API for Strategy service.
 // All implementations must embed UnimplementedStrategyServer
 // for forward compatibility.
@@ -221,6 +245,8 @@ type StrategyServer interface {
 	DeleteStrategy(context.Context, *DeleteStrategyRequest) (*DeleteStrategyReply, error)
 	GetStrategy(context.Context, *GetStrategyRequest) (*StrategyItem, error)
 	ListStrategy(context.Context, *ListStrategyRequest) (*ListStrategyReply, error)
+	ImportPrometheusRules(context.Context, *ImportPrometheusRulesRequest) (*ImportPrometheusRulesReply, error)
+	ExportPrometheusRules(context.Context, *ExportPrometheusRulesRequest) (*ExportPrometheusRulesReply, error)
 	mustEmbedUnimplementedStrategyServer()
 }
 
@@ -273,6 +299,12 @@ func (UnimplementedStrategyServer) GetStrategy(context.Context, *GetStrategyRequ
 func (UnimplementedStrategyServer) ListStrategy(context.Context, *ListStrategyRequest) (*ListStrategyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStrategy not implemented")
 }
+func (UnimplementedStrategyServer) ImportPrometheusRules(context.Context, *ImportPrometheusRulesRequest) (*ImportPrometheusRulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPrometheusRules not implemented")
+}
+func (UnimplementedStrategyServer) ExportPrometheusRules(context.Context, *ExportPrometheusRulesRequest) (*ExportPrometheusRulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPrometheusRules not implemented")
+}
 func (UnimplementedStrategyServer) mustEmbedUnimplementedStrategyServer() {}
 func (UnimplementedStrategyServer) testEmbeddedByValue()                  {}
 
@@ -546,6 +578,42 @@ func _Strategy_ListStrategy_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Strategy_ImportPrometheusRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrometheusRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServer).ImportPrometheusRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Strategy_ImportPrometheusRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServer).ImportPrometheusRules(ctx, req.(*ImportPrometheusRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Strategy_ExportPrometheusRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPrometheusRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServer).ExportPrometheusRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Strategy_ExportPrometheusRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServer).ExportPrometheusRules(ctx, req.(*ExportPrometheusRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Strategy_ServiceDesc is the grpc.ServiceDesc for Strategy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -609,6 +677,14 @@ var Strategy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStrategy",
 			Handler:    _Strategy_ListStrategy_Handler,
 		},
+		{
+			MethodName: "ImportPrometheusRules",
+			Handler:    _Strategy_ImportPrometheusRules_Handler,
+		},
+		{
+			MethodName: "ExportPrometheusRules",
+			Handler:    _Strategy_ExportPrometheusRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "marksman/api/v1/strategy.proto",
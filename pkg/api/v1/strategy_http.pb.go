@@ -23,8 +23,10 @@ const OperationStrategyCreateStrategy = "/marksman.api.v1.Strategy/CreateStrateg
 const OperationStrategyCreateStrategyGroup = "/marksman.api.v1.Strategy/CreateStrategyGroup"
 const OperationStrategyDeleteStrategy = "/marksman.api.v1.Strategy/DeleteStrategy"
 const OperationStrategyDeleteStrategyGroup = "/marksman.api.v1.Strategy/DeleteStrategyGroup"
+const OperationStrategyExportPrometheusRules = "/marksman.api.v1.Strategy/ExportPrometheusRules"
 const OperationStrategyGetStrategy = "/marksman.api.v1.Strategy/GetStrategy"
 const OperationStrategyGetStrategyGroup = "/marksman.api.v1.Strategy/GetStrategyGroup"
+const OperationStrategyImportPrometheusRules = "/marksman.api.v1.Strategy/ImportPrometheusRules"
 const OperationStrategyListStrategy = "/marksman.api.v1.Strategy/ListStrategy"
 const OperationStrategyListStrategyGroup = "/marksman.api.v1.Strategy/ListStrategyGroup"
 const OperationStrategySelectStrategyGroup = "/marksman.api.v1.Strategy/SelectStrategyGroup"
@@ -39,8 +41,10 @@ type StrategyHTTPServer interface {
 	CreateStrategyGroup(context.Context, *CreateStrategyGroupRequest) (*CreateStrategyGroupReply, error)
 	DeleteStrategy(context.Context, *DeleteStrategyRequest) (*DeleteStrategyReply, error)
 	DeleteStrategyGroup(context.Context, *DeleteStrategyGroupRequest) (*DeleteStrategyGroupReply, error)
+	ExportPrometheusRules(context.Context, *ExportPrometheusRulesRequest) (*ExportPrometheusRulesReply, error)
 	GetStrategy(context.Context, *GetStrategyRequest) (*StrategyItem, error)
 	GetStrategyGroup(context.Context, *GetStrategyGroupRequest) (*StrategyGroupItem, error)
+	ImportPrometheusRules(context.Context, *ImportPrometheusRulesRequest) (*ImportPrometheusRulesReply, error)
 	ListStrategy(context.Context, *ListStrategyRequest) (*ListStrategyReply, error)
 	ListStrategyGroup(context.Context, *ListStrategyGroupRequest) (*ListStrategyGroupReply, error)
 	SelectStrategyGroup(context.Context, *SelectStrategyGroupRequest) (*SelectStrategyGroupReply, error)
@@ -67,6 +71,8 @@ func RegisterStrategyHTTPServer(s *http.Server, srv StrategyHTTPServer) {
 	r.DELETE("/v1/strategy/{uid}", _Strategy_DeleteStrategy0_HTTP_Handler(srv))
 	r.GET("/v1/strategy/{uid}", _Strategy_GetStrategy0_HTTP_Handler(srv))
 	r.GET("/v1/strategies", _Strategy_ListStrategy0_HTTP_Handler(srv))
+	r.POST("/v1/strategies/import/prometheus", _Strategy_ImportPrometheusRules0_HTTP_Handler(srv))
+	r.GET("/v1/strategies/export/prometheus", _Strategy_ExportPrometheusRules0_HTTP_Handler(srv))
 }
 
 func _Strategy_CreateStrategyGroup0_HTTP_Handler(srv StrategyHTTPServer) func(ctx http.Context) error {
@@ -383,13 +389,56 @@ func _Strategy_ListStrategy0_HTTP_Handler(srv StrategyHTTPServer) func(ctx http.
 	}
 }
 
+func _Strategy_ImportPrometheusRules0_HTTP_Handler(srv StrategyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportPrometheusRulesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStrategyImportPrometheusRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportPrometheusRules(ctx, req.(*ImportPrometheusRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportPrometheusRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Strategy_ExportPrometheusRules0_HTTP_Handler(srv StrategyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportPrometheusRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStrategyExportPrometheusRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportPrometheusRules(ctx, req.(*ExportPrometheusRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportPrometheusRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type StrategyHTTPClient interface {
 	CreateStrategy(ctx context.Context, req *CreateStrategyRequest, opts ...http.CallOption) (rsp *CreateStrategyReply, err error)
 	CreateStrategyGroup(ctx context.Context, req *CreateStrategyGroupRequest, opts ...http.CallOption) (rsp *CreateStrategyGroupReply, err error)
 	DeleteStrategy(ctx context.Context, req *DeleteStrategyRequest, opts ...http.CallOption) (rsp *DeleteStrategyReply, err error)
 	DeleteStrategyGroup(ctx context.Context, req *DeleteStrategyGroupRequest, opts ...http.CallOption) (rsp *DeleteStrategyGroupReply, err error)
+	ExportPrometheusRules(ctx context.Context, req *ExportPrometheusRulesRequest, opts ...http.CallOption) (rsp *ExportPrometheusRulesReply, err error)
 	GetStrategy(ctx context.Context, req *GetStrategyRequest, opts ...http.CallOption) (rsp *StrategyItem, err error)
 	GetStrategyGroup(ctx context.Context, req *GetStrategyGroupRequest, opts ...http.CallOption) (rsp *StrategyGroupItem, err error)
+	ImportPrometheusRules(ctx context.Context, req *ImportPrometheusRulesRequest, opts ...http.CallOption) (rsp *ImportPrometheusRulesReply, err error)
 	ListStrategy(ctx context.Context, req *ListStrategyRequest, opts ...http.CallOption) (rsp *ListStrategyReply, err error)
 	ListStrategyGroup(ctx context.Context, req *ListStrategyGroupRequest, opts ...http.CallOption) (rsp *ListStrategyGroupReply, err error)
 	SelectStrategyGroup(ctx context.Context, req *SelectStrategyGroupRequest, opts ...http.CallOption) (rsp *SelectStrategyGroupReply, err error)
@@ -460,6 +509,19 @@ func (c *StrategyHTTPClientImpl) DeleteStrategyGroup(ctx context.Context, in *De
 	return &out, nil
 }
 
+func (c *StrategyHTTPClientImpl) ExportPrometheusRules(ctx context.Context, in *ExportPrometheusRulesRequest, opts ...http.CallOption) (*ExportPrometheusRulesReply, error) {
+	var out ExportPrometheusRulesReply
+	pattern := "/v1/strategies/export/prometheus"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStrategyExportPrometheusRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StrategyHTTPClientImpl) GetStrategy(ctx context.Context, in *GetStrategyRequest, opts ...http.CallOption) (*StrategyItem, error) {
 	var out StrategyItem
 	pattern := "/v1/strategy/{uid}"
@@ -486,6 +548,19 @@ func (c *StrategyHTTPClientImpl) GetStrategyGroup(ctx context.Context, in *GetSt
 	return &out, nil
 }
 
+func (c *StrategyHTTPClientImpl) ImportPrometheusRules(ctx context.Context, in *ImportPrometheusRulesRequest, opts ...http.CallOption) (*ImportPrometheusRulesReply, error) {
+	var out ImportPrometheusRulesReply
+	pattern := "/v1/strategies/import/prometheus"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStrategyImportPrometheusRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StrategyHTTPClientImpl) ListStrategy(ctx context.Context, in *ListStrategyRequest, opts ...http.CallOption) (*ListStrategyReply, error) {
 	var out ListStrategyReply
 	pattern := "/v1/strategies"
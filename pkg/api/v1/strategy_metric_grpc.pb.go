@@ -26,6 +26,8 @@ const (
 	StrategyMetric_DeleteStrategyMetricLevel_FullMethodName       = "/marksman.api.v1.StrategyMetric/DeleteStrategyMetricLevel"
 	StrategyMetric_GetStrategyMetricLevel_FullMethodName          = "/marksman.api.v1.StrategyMetric/GetStrategyMetricLevel"
 	StrategyMetric_StrategyMetricBindReceivers_FullMethodName     = "/marksman.api.v1.StrategyMetric/StrategyMetricBindReceivers"
+	StrategyMetric_ParseStrategyMetricExpr_FullMethodName         = "/marksman.api.v1.StrategyMetric/ParseStrategyMetricExpr"
+	StrategyMetric_BacktestStrategyMetric_FullMethodName          = "/marksman.api.v1.StrategyMetric/BacktestStrategyMetric"
 )
 
 // StrategyMetricClient is the client API for StrategyMetric service.
@@ -39,6 +41,8 @@ type StrategyMetricClient interface {
 	DeleteStrategyMetricLevel(ctx context.Context, in *DeleteStrategyMetricLevelRequest, opts ...grpc.CallOption) (*DeleteStrategyMetricLevelReply, error)
 	GetStrategyMetricLevel(ctx context.Context, in *GetStrategyMetricLevelRequest, opts ...grpc.CallOption) (*StrategyMetricLevelItem, error)
 	StrategyMetricBindReceivers(ctx context.Context, in *StrategyMetricBindReceiversRequest, opts ...grpc.CallOption) (*StrategyMetricBindReceiversReply, error)
+	ParseStrategyMetricExpr(ctx context.Context, in *ParseStrategyMetricExprRequest, opts ...grpc.CallOption) (*ParseStrategyMetricExprReply, error)
+	BacktestStrategyMetric(ctx context.Context, in *BacktestStrategyMetricRequest, opts ...grpc.CallOption) (*BacktestStrategyMetricReply, error)
 }
 
 type strategyMetricClient struct {
@@ -119,6 +123,26 @@ func (c *strategyMetricClient) StrategyMetricBindReceivers(ctx context.Context,
 	return out, nil
 }
 
+func (c *strategyMetricClient) ParseStrategyMetricExpr(ctx context.Context, in *ParseStrategyMetricExprRequest, opts ...grpc.CallOption) (*ParseStrategyMetricExprReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseStrategyMetricExprReply)
+	err := c.cc.Invoke(ctx, StrategyMetric_ParseStrategyMetricExpr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyMetricClient) BacktestStrategyMetric(ctx context.Context, in *BacktestStrategyMetricRequest, opts ...grpc.CallOption) (*BacktestStrategyMetricReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BacktestStrategyMetricReply)
+	err := c.cc.Invoke(ctx, StrategyMetric_BacktestStrategyMetric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StrategyMetricServer is the server API for StrategyMetric service.
 // All implementations must embed UnimplementedStrategyMetricServer
 // for forward compatibility.
@@ -130,6 +154,8 @@ type StrategyMetricServer interface {
 	DeleteStrategyMetricLevel(context.Context, *DeleteStrategyMetricLevelRequest) (*DeleteStrategyMetricLevelReply, error)
 	GetStrategyMetricLevel(context.Context, *GetStrategyMetricLevelRequest) (*StrategyMetricLevelItem, error)
 	StrategyMetricBindReceivers(context.Context, *StrategyMetricBindReceiversRequest) (*StrategyMetricBindReceiversReply, error)
+	ParseStrategyMetricExpr(context.Context, *ParseStrategyMetricExprRequest) (*ParseStrategyMetricExprReply, error)
+	BacktestStrategyMetric(context.Context, *BacktestStrategyMetricRequest) (*BacktestStrategyMetricReply, error)
 	mustEmbedUnimplementedStrategyMetricServer()
 }
 
@@ -161,6 +187,12 @@ func (UnimplementedStrategyMetricServer) GetStrategyMetricLevel(context.Context,
 func (UnimplementedStrategyMetricServer) StrategyMetricBindReceivers(context.Context, *StrategyMetricBindReceiversRequest) (*StrategyMetricBindReceiversReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StrategyMetricBindReceivers not implemented")
 }
+func (UnimplementedStrategyMetricServer) ParseStrategyMetricExpr(context.Context, *ParseStrategyMetricExprRequest) (*ParseStrategyMetricExprReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseStrategyMetricExpr not implemented")
+}
+func (UnimplementedStrategyMetricServer) BacktestStrategyMetric(context.Context, *BacktestStrategyMetricRequest) (*BacktestStrategyMetricReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BacktestStrategyMetric not implemented")
+}
 func (UnimplementedStrategyMetricServer) mustEmbedUnimplementedStrategyMetricServer() {}
 func (UnimplementedStrategyMetricServer) testEmbeddedByValue()                        {}
 
@@ -308,6 +340,42 @@ func _StrategyMetric_StrategyMetricBindReceivers_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StrategyMetric_ParseStrategyMetricExpr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseStrategyMetricExprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyMetricServer).ParseStrategyMetricExpr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StrategyMetric_ParseStrategyMetricExpr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyMetricServer).ParseStrategyMetricExpr(ctx, req.(*ParseStrategyMetricExprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyMetric_BacktestStrategyMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BacktestStrategyMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyMetricServer).BacktestStrategyMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StrategyMetric_BacktestStrategyMetric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyMetricServer).BacktestStrategyMetric(ctx, req.(*BacktestStrategyMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StrategyMetric_ServiceDesc is the grpc.ServiceDesc for StrategyMetric service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +411,14 @@ var StrategyMetric_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StrategyMetricBindReceivers",
 			Handler:    _StrategyMetric_StrategyMetricBindReceivers_Handler,
 		},
+		{
+			MethodName: "ParseStrategyMetricExpr",
+			Handler:    _StrategyMetric_ParseStrategyMetricExpr_Handler,
+		},
+		{
+			MethodName: "BacktestStrategyMetric",
+			Handler:    _StrategyMetric_BacktestStrategyMetric_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "marksman/api/v1/strategy_metric.proto",
@@ -19,18 +19,22 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationStrategyMetricBacktestStrategyMetric = "/marksman.api.v1.StrategyMetric/BacktestStrategyMetric"
 const OperationStrategyMetricDeleteStrategyMetricLevel = "/marksman.api.v1.StrategyMetric/DeleteStrategyMetricLevel"
 const OperationStrategyMetricGetStrategyMetric = "/marksman.api.v1.StrategyMetric/GetStrategyMetric"
 const OperationStrategyMetricGetStrategyMetricLevel = "/marksman.api.v1.StrategyMetric/GetStrategyMetricLevel"
+const OperationStrategyMetricParseStrategyMetricExpr = "/marksman.api.v1.StrategyMetric/ParseStrategyMetricExpr"
 const OperationStrategyMetricSaveStrategyMetric = "/marksman.api.v1.StrategyMetric/SaveStrategyMetric"
 const OperationStrategyMetricSaveStrategyMetricLevel = "/marksman.api.v1.StrategyMetric/SaveStrategyMetricLevel"
 const OperationStrategyMetricStrategyMetricBindReceivers = "/marksman.api.v1.StrategyMetric/StrategyMetricBindReceivers"
 const OperationStrategyMetricUpdateStrategyMetricLevelStatus = "/marksman.api.v1.StrategyMetric/UpdateStrategyMetricLevelStatus"
 
 type StrategyMetricHTTPServer interface {
+	BacktestStrategyMetric(context.Context, *BacktestStrategyMetricRequest) (*BacktestStrategyMetricReply, error)
 	DeleteStrategyMetricLevel(context.Context, *DeleteStrategyMetricLevelRequest) (*DeleteStrategyMetricLevelReply, error)
 	GetStrategyMetric(context.Context, *GetStrategyMetricRequest) (*StrategyMetricItem, error)
 	GetStrategyMetricLevel(context.Context, *GetStrategyMetricLevelRequest) (*StrategyMetricLevelItem, error)
+	ParseStrategyMetricExpr(context.Context, *ParseStrategyMetricExprRequest) (*ParseStrategyMetricExprReply, error)
 	SaveStrategyMetric(context.Context, *SaveStrategyMetricRequest) (*SaveStrategyMetricReply, error)
 	SaveStrategyMetricLevel(context.Context, *SaveStrategyMetricLevelRequest) (*SaveStrategyMetricLevelReply, error)
 	StrategyMetricBindReceivers(context.Context, *StrategyMetricBindReceiversRequest) (*StrategyMetricBindReceiversReply, error)
@@ -46,6 +50,8 @@ func RegisterStrategyMetricHTTPServer(s *http.Server, srv StrategyMetricHTTPServ
 	r.DELETE("/v1/metric/strategy/{strategyUID}/level/{uid}", _StrategyMetric_DeleteStrategyMetricLevel0_HTTP_Handler(srv))
 	r.GET("/v1/metric/strategy/{strategyUID}/level/{uid}", _StrategyMetric_GetStrategyMetricLevel0_HTTP_Handler(srv))
 	r.POST("/v1/metric/strategy/{strategyUID}/receivers", _StrategyMetric_StrategyMetricBindReceivers0_HTTP_Handler(srv))
+	r.POST("/v1/metric/expr/parse", _StrategyMetric_ParseStrategyMetricExpr0_HTTP_Handler(srv))
+	r.POST("/v1/metric/backtest", _StrategyMetric_BacktestStrategyMetric0_HTTP_Handler(srv))
 }
 
 func _StrategyMetric_SaveStrategyMetric0_HTTP_Handler(srv StrategyMetricHTTPServer) func(ctx http.Context) error {
@@ -214,10 +220,56 @@ func _StrategyMetric_StrategyMetricBindReceivers0_HTTP_Handler(srv StrategyMetri
 	}
 }
 
+func _StrategyMetric_ParseStrategyMetricExpr0_HTTP_Handler(srv StrategyMetricHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ParseStrategyMetricExprRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStrategyMetricParseStrategyMetricExpr)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ParseStrategyMetricExpr(ctx, req.(*ParseStrategyMetricExprRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ParseStrategyMetricExprReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StrategyMetric_BacktestStrategyMetric0_HTTP_Handler(srv StrategyMetricHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BacktestStrategyMetricRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStrategyMetricBacktestStrategyMetric)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BacktestStrategyMetric(ctx, req.(*BacktestStrategyMetricRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BacktestStrategyMetricReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type StrategyMetricHTTPClient interface {
+	BacktestStrategyMetric(ctx context.Context, req *BacktestStrategyMetricRequest, opts ...http.CallOption) (rsp *BacktestStrategyMetricReply, err error)
 	DeleteStrategyMetricLevel(ctx context.Context, req *DeleteStrategyMetricLevelRequest, opts ...http.CallOption) (rsp *DeleteStrategyMetricLevelReply, err error)
 	GetStrategyMetric(ctx context.Context, req *GetStrategyMetricRequest, opts ...http.CallOption) (rsp *StrategyMetricItem, err error)
 	GetStrategyMetricLevel(ctx context.Context, req *GetStrategyMetricLevelRequest, opts ...http.CallOption) (rsp *StrategyMetricLevelItem, err error)
+	ParseStrategyMetricExpr(ctx context.Context, req *ParseStrategyMetricExprRequest, opts ...http.CallOption) (rsp *ParseStrategyMetricExprReply, err error)
 	SaveStrategyMetric(ctx context.Context, req *SaveStrategyMetricRequest, opts ...http.CallOption) (rsp *SaveStrategyMetricReply, err error)
 	SaveStrategyMetricLevel(ctx context.Context, req *SaveStrategyMetricLevelRequest, opts ...http.CallOption) (rsp *SaveStrategyMetricLevelReply, err error)
 	StrategyMetricBindReceivers(ctx context.Context, req *StrategyMetricBindReceiversRequest, opts ...http.CallOption) (rsp *StrategyMetricBindReceiversReply, err error)
@@ -232,6 +284,19 @@ func NewStrategyMetricHTTPClient(client *http.Client) StrategyMetricHTTPClient {
 	return &StrategyMetricHTTPClientImpl{client}
 }
 
+func (c *StrategyMetricHTTPClientImpl) BacktestStrategyMetric(ctx context.Context, in *BacktestStrategyMetricRequest, opts ...http.CallOption) (*BacktestStrategyMetricReply, error) {
+	var out BacktestStrategyMetricReply
+	pattern := "/v1/metric/backtest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStrategyMetricBacktestStrategyMetric))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StrategyMetricHTTPClientImpl) DeleteStrategyMetricLevel(ctx context.Context, in *DeleteStrategyMetricLevelRequest, opts ...http.CallOption) (*DeleteStrategyMetricLevelReply, error) {
 	var out DeleteStrategyMetricLevelReply
 	pattern := "/v1/metric/strategy/{strategyUID}/level/{uid}"
@@ -271,6 +336,19 @@ func (c *StrategyMetricHTTPClientImpl) GetStrategyMetricLevel(ctx context.Contex
 	return &out, nil
 }
 
+func (c *StrategyMetricHTTPClientImpl) ParseStrategyMetricExpr(ctx context.Context, in *ParseStrategyMetricExprRequest, opts ...http.CallOption) (*ParseStrategyMetricExprReply, error) {
+	var out ParseStrategyMetricExprReply
+	pattern := "/v1/metric/expr/parse"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStrategyMetricParseStrategyMetricExpr))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StrategyMetricHTTPClientImpl) SaveStrategyMetric(ctx context.Context, in *SaveStrategyMetricRequest, opts ...http.CallOption) (*SaveStrategyMetricReply, error) {
 	var out SaveStrategyMetricReply
 	pattern := "/v1/metric/strategy/{strategyUID}"
@@ -0,0 +1,78 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: marksman/api/v1/template.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationTemplateRenderPreview = "/marksman.api.v1.Template/RenderPreview"
+
+type TemplateHTTPServer interface {
+	RenderPreview(context.Context, *RenderPreviewRequest) (*RenderPreviewReply, error)
+}
+
+func RegisterTemplateHTTPServer(s *http.Server, srv TemplateHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/template/preview", _Template_RenderPreview0_HTTP_Handler(srv))
+}
+
+func _Template_RenderPreview0_HTTP_Handler(srv TemplateHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenderPreviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateRenderPreview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenderPreview(ctx, req.(*RenderPreviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenderPreviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type TemplateHTTPClient interface {
+	RenderPreview(ctx context.Context, req *RenderPreviewRequest, opts ...http.CallOption) (rsp *RenderPreviewReply, err error)
+}
+
+type TemplateHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewTemplateHTTPClient(client *http.Client) TemplateHTTPClient {
+	return &TemplateHTTPClientImpl{client}
+}
+
+func (c *TemplateHTTPClientImpl) RenderPreview(ctx context.Context, in *RenderPreviewRequest, opts ...http.CallOption) (*RenderPreviewReply, error) {
+	var out RenderPreviewReply
+	pattern := "/v1/template/preview"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTemplateRenderPreview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}